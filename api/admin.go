@@ -0,0 +1,122 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/core/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/pkg/errors"
+)
+
+// AdminLeaveArgs is arguments that Admin.Leave accepts.
+type AdminLeaveArgs struct {
+	ETA uint32
+}
+
+// AdminLeaveReply is reply for Admin service leave requests.
+type AdminLeaveReply struct {
+	State            string
+	ETA              uint32
+	UnsyncedPulses   int
+	ActiveExecutions int
+}
+
+// AdminService is a service that provides API for node administration.
+// It is served on separate listener (APIRunner.AdminAddress) that should be reachable by node operator only.
+type AdminService struct {
+	runner *Runner
+}
+
+// NewAdminService creates new Admin service instance.
+func NewAdminService(runner *Runner) *AdminService {
+	return &AdminService{runner: runner}
+}
+
+// Leave schedules graceful leave of the node from the network at the ETA pulse.
+// Node sends leave claim to the network, stops accepting new executions and waits until queued requests
+// are processed and pending pulses are replicated to heavy node, waiting is limited by APIRunner.Timeout.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "admin.Leave",
+//     "params": {
+//       "ETA": int // Pulse number when node leaves the network.
+//     },
+//     "id": str|int|null
+//   }
+//
+//   Response structure is the same as for admin.LeaveStatus.
+//
+func (s *AdminService) Leave(r *http.Request, args *AdminLeaveArgs, reply *AdminLeaveReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ AdminService.Leave ] Incoming request: %s", r.RequestURI)
+
+	leaveCtx, cancel := context.WithTimeout(ctx, time.Duration(s.runner.cfg.Timeout)*time.Second)
+	defer cancel()
+	err := s.runner.NetworkCoordinator.Leave(leaveCtx, core.PulseNumber(args.ETA))
+	if err != nil {
+		return errors.Wrap(err, "[ AdminService.Leave ] Can't schedule leave")
+	}
+
+	return s.leaveStatus(ctx, reply)
+}
+
+// LeaveStatus returns progress of the scheduled graceful leave.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "admin.LeaveStatus",
+//     "params": {},
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "State": str, // NoLeaveState, ScheduledLeaveState, DrainingLeaveState or ReadyLeaveState.
+//     "ETA": int,
+//     "UnsyncedPulses": int, // Pulses waiting for replication to heavy node.
+//     "ActiveExecutions": int // Objects with requests executing or queued on the node.
+//   }
+//
+func (s *AdminService) LeaveStatus(r *http.Request, args *interface{}, reply *AdminLeaveReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ AdminService.LeaveStatus ] Incoming request: %s", r.RequestURI)
+
+	return s.leaveStatus(ctx, reply)
+}
+
+func (s *AdminService) leaveStatus(ctx context.Context, reply *AdminLeaveReply) error {
+	status, err := s.runner.NetworkCoordinator.LeaveStatus(ctx)
+	if err != nil {
+		return errors.Wrap(err, "[ AdminService ] Can't get leave status")
+	}
+
+	reply.State = status.State.String()
+	reply.ETA = uint32(status.ETA)
+	reply.UnsyncedPulses = status.UnsyncedPulses
+	reply.ActiveExecutions = status.ActiveExecutions
+	return nil
+}
@@ -44,9 +44,10 @@ type Request struct {
 }
 
 type answer struct {
-	Error   string      `json:"error,omitempty"`
-	Result  interface{} `json:"result,omitempty"`
-	TraceID string      `json:"traceID,omitempty"`
+	Error            string      `json:"error,omitempty"`
+	Result           interface{} `json:"result,omitempty"`
+	TraceID          string      `json:"traceID,omitempty"`
+	RequestReference string      `json:"requestReference,omitempty"`
 }
 
 // UnmarshalRequest unmarshals request to api
@@ -165,6 +166,22 @@ func (ar *Runner) callHandler() func(http.ResponseWriter, *http.Request) {
 			return
 		}
 
+		// Request reference is reported even if the call is not finished in time, so its receipt can be queried later.
+		requests := make(chan core.RecordRef, 1)
+		ctx = core.ContextWithRequestListener(ctx, func(request core.RecordRef) {
+			select {
+			case requests <- request:
+			default:
+			}
+		})
+		defer func() {
+			select {
+			case request := <-requests:
+				resp.RequestReference = request.String()
+			default:
+			}
+		}()
+
 		var result interface{}
 		ch := make(chan interface{}, 1)
 		go func() {
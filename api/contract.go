@@ -0,0 +1,125 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/core/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/pkg/errors"
+)
+
+// ContractABIArgs is arguments that Contract service GetABI method accepts.
+type ContractABIArgs struct {
+	PrototypeRef string
+}
+
+// ContractABIReply is reply for Contract service GetABI method requests.
+type ContractABIReply struct {
+	CodeRef string
+	ABI     json.RawMessage
+}
+
+// ContractService is a service that provides API for getting contracts description.
+type ContractService struct {
+	runner *Runner
+}
+
+// NewContractService creates new Contract service instance.
+func NewContractService(runner *Runner) *ContractService {
+	return &ContractService{runner: runner}
+}
+
+// GetABI returns ABI of contract's code by prototype reference.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "contract.GetABI",
+//     "params": {
+//       // Reference to the contract prototype.
+//       "PrototypeRef": str
+//       },
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "CodeRef": str, // Reference to the prototype's code.
+//     "ABI": {
+//       "contract": str, // Contract name.
+//       "constructors": [{
+//         "name": str,
+//         // Arguments are CBOR-encoded according to their types.
+//         "arguments": [{"name": str, "type": {
+//           // One of "bool", "int", "uint", "float", "string", "bytes", "reference", "error", "array", "map",
+//           // "struct" or "any".
+//           "kind": str,
+//           "bits": int, // Size of numeric types.
+//           "nullable": bool,
+//           "name": str, // Name of struct type.
+//           "elem": { ... }, // Type of array elements or map values.
+//           "key": { ... }, // Type of map keys.
+//           "fields": [{"name": str, "type": { ... }}] // Struct fields.
+//         }}],
+//         "results": [{"type": { ... }}],
+//         "noWait": bool, // Method is supposed to be called without waiting for results.
+//         "immutable": bool // Method doesn't change object's state.
+//       }],
+//       "methods": [{ ... }] // Same as constructors.
+//     }
+//   }
+//
+func (s *ContractService) GetABI(r *http.Request, args *ContractABIArgs, reply *ContractABIReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ ContractService.GetABI ] Incoming request: %s", r.RequestURI)
+
+	protoRef, err := core.NewRefFromBase58(args.PrototypeRef)
+	if err != nil {
+		return errors.Wrap(err, "[ ContractService.GetABI ] Can't parse prototype reference")
+	}
+
+	proto, err := s.runner.ArtifactManager.GetObject(ctx, *protoRef, nil, false)
+	if err != nil {
+		return errors.Wrap(err, "[ ContractService.GetABI ] Can't get prototype")
+	}
+	codeRef, err := proto.Code()
+	if err != nil {
+		return errors.Wrap(err, "[ ContractService.GetABI ] Can't get prototype code reference")
+	}
+	code, err := s.runner.ArtifactManager.GetCode(ctx, *codeRef)
+	if err != nil {
+		return errors.Wrap(err, "[ ContractService.GetABI ] Can't get code")
+	}
+	abi, err := code.ABI()
+	if err != nil {
+		return errors.Wrap(err, "[ ContractService.GetABI ] Can't get ABI")
+	}
+	if abi == nil {
+		return errors.New("[ ContractService.GetABI ] Code has no ABI")
+	}
+
+	reply.CodeRef = codeRef.String()
+	reply.ABI = abi
+
+	return nil
+}
@@ -0,0 +1,132 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/core/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/pkg/errors"
+)
+
+// EventsArgs is arguments that Events service accepts.
+type EventsArgs struct {
+	Reference string
+	Topic     string
+	FromPulse *uint32
+	ToPulse   *uint32
+}
+
+// Event is a single contract event in Events service reply.
+type Event struct {
+	Event   string
+	Request string
+	Topic   string
+	Pulse   uint32
+	Payload json.RawMessage
+}
+
+// EventsReply is reply for Events service requests.
+type EventsReply struct {
+	Events []Event
+}
+
+// EventsService is a service that provides API for querying events emitted by contracts.
+type EventsService struct {
+	runner *Runner
+}
+
+// NewEventsService creates new Events service instance.
+func NewEventsService(runner *Runner) *EventsService {
+	return &EventsService{runner: runner}
+}
+
+// Query returns contract events from the latest to the earliest.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "events.Query",
+//     "params": {
+//       // Reference to the contract emitted events.
+//       "Reference": str,
+//       // Optional topic. Events of all topics will be returned if empty.
+//       "Topic": str,
+//       // Optional pulse range. Only events emitted in [FromPulse, ToPulse] will be returned.
+//       "FromPulse": int|null,
+//       "ToPulse": int|null
+//       },
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "Events": [{
+//       "Event": str, // Event record ID.
+//       "Request": str, // Reference to the request during which the event was emitted.
+//       "Topic": str,
+//       "Pulse": int, // Pulse in which the event was emitted.
+//       "Payload": any // Event data as it was passed to foundation.Emit.
+//     }]
+//   }
+//
+func (s *EventsService) Query(r *http.Request, args *EventsArgs, reply *EventsReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ EventsService.Query ] Incoming request: %s", r.RequestURI)
+
+	object, err := core.NewRefFromBase58(args.Reference)
+	if err != nil {
+		return errors.Wrap(err, "[ EventsService.Query ] Can't parse reference")
+	}
+
+	var fromPulse, toPulse *core.PulseNumber
+	if args.FromPulse != nil {
+		pn := core.PulseNumber(*args.FromPulse)
+		fromPulse = &pn
+	}
+	if args.ToPulse != nil {
+		pn := core.PulseNumber(*args.ToPulse)
+		toPulse = &pn
+	}
+
+	iter, err := s.runner.ArtifactManager.GetEvents(ctx, *object, args.Topic, fromPulse, toPulse)
+	if err != nil {
+		return errors.Wrap(err, "[ EventsService.Query ] Can't get contract events")
+	}
+
+	reply.Events = []Event{}
+	for iter.HasNext() {
+		event, err := iter.Next()
+		if err != nil {
+			return errors.Wrap(err, "[ EventsService.Query ] Can't fetch contract event")
+		}
+		reply.Events = append(reply.Events, Event{
+			Event:   event.ID.String(),
+			Request: event.Request.String(),
+			Topic:   event.Topic,
+			Pulse:   uint32(event.Pulse),
+			Payload: event.Payload,
+		})
+	}
+
+	return nil
+}
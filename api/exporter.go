@@ -20,6 +20,7 @@ import (
 	"context"
 	"net/http"
 	"strings"
+	"time"
 
 	jsonrpc "github.com/gorilla/rpc/v2/json2"
 	"github.com/insolar/insolar/core"
@@ -28,6 +29,8 @@ import (
 
 const (
 	errCodePulseNotFound = 10404
+
+	subscribePollInterval = time.Second
 )
 
 // StorageExporterArgs is arguments that StorageExporter service accepts.
@@ -39,6 +42,21 @@ type StorageExporterArgs struct {
 // StorageExporterReply is reply for StorageExporter service requests.
 type StorageExporterReply = core.StorageExportResult
 
+// StorageExporterSubscribeArgs is arguments that StorageExporter Subscribe method accepts.
+type StorageExporterSubscribeArgs struct {
+	Pulse   uint32
+	JetID   string
+	Size    int
+	Timeout uint32
+}
+
+// StorageExporterSubscribeReply is reply for StorageExporter Subscribe method requests.
+type StorageExporterSubscribeReply struct {
+	Data  []interface{}
+	Pulse uint32
+	JetID string
+}
+
 // StorageExporterService is a service that provides API for exporting storage data.
 type StorageExporterService struct {
 	runner *Runner
@@ -106,3 +124,95 @@ func (s *StorageExporterService) Export(r *http.Request, args *StorageExporterAr
 
 	return nil
 }
+
+// Subscribe waits for data synced to heavy storage which follows provided cursor and returns it as soon as it's available.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "exporter.Subscribe",
+//     "params": {
+//       // Cursor of the last received chunk (pulse number and jet ID). Omit both to start from the beginning.
+//       "Pulse": int,
+//       "JetID": str,
+//       // Max number of chunks to return.
+//       "Size": int,
+//       // Number of seconds to wait for new data. Can't be greater than API timeout (used if omitted).
+//       "Timeout": int
+//       },
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "Data": [{
+//       "Records": { ... }, // Same as in exporter.Export.
+//       "Pulse": { ... },
+//       "JetID": str
+//     }],
+//     // Cursor of the last returned chunk. Put it as "Pulse" and "JetID" params for next subscription call.
+//     // If no data was available during timeout, provided cursor is returned.
+//     "Pulse": int,
+//     "JetID": str
+//   }
+//
+func (s *StorageExporterService) Subscribe(
+	r *http.Request, args *StorageExporterSubscribeArgs, reply *StorageExporterSubscribeReply,
+) error {
+	if args.Size <= 0 {
+		return errors.New("[ Subscribe ] Size must be positive")
+	}
+
+	if (args.Pulse == 0) != (args.JetID == "") {
+		return errors.New("[ Subscribe ] Pulse and JetID must be provided together")
+	}
+
+	var cursor *core.StorageExportCursor
+	if args.JetID != "" {
+		jetID, err := core.NewIDFromBase58(args.JetID)
+		if err != nil {
+			return errors.Wrap(err, "[ Subscribe ] Can't parse JetID")
+		}
+		cursor = &core.StorageExportCursor{Pulse: core.PulseNumber(args.Pulse), JetID: *jetID}
+	}
+
+	timeout := s.runner.cfg.Timeout
+	if args.Timeout > 0 && args.Timeout < timeout {
+		timeout = args.Timeout
+	}
+	deadline := time.After(time.Duration(timeout) * time.Second)
+
+	exp := s.runner.StorageExporter
+	ctx := context.TODO()
+	for {
+		result, err := exp.ExportAfter(ctx, cursor, args.Size)
+		if err != nil {
+			if strings.Contains(err.Error(), "failed to fetch pulse data") {
+				return &jsonrpc.Error{
+					Code:    errCodePulseNotFound,
+					Message: "[ Subscribe ]: " + err.Error(),
+					Data:    nil,
+				}
+			}
+			return errors.Wrap(err, "[ Subscribe ]")
+		}
+
+		if len(result.Data) > 0 {
+			reply.Data = result.Data
+			reply.Pulse = uint32(result.Cursor.Pulse)
+			reply.JetID = result.Cursor.JetID.String()
+			return nil
+		}
+
+		select {
+		case <-deadline:
+			reply.Data = result.Data
+			reply.Pulse = args.Pulse
+			reply.JetID = args.JetID
+			return nil
+		case <-r.Context().Done():
+			return errors.New("[ Subscribe ] Request is canceled")
+		case <-time.After(subscribePollInterval):
+		}
+	}
+}
@@ -0,0 +1,97 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/insolar/insolar/core/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/pkg/errors"
+)
+
+// HeavySyncJet is a replication state of the jet.
+type HeavySyncJet struct {
+	Jet            string
+	UnsyncedPulses []uint32
+	Lag            int
+	SyncedChunks   uint32
+}
+
+// HeavySyncReply is reply for HeavySync service requests.
+type HeavySyncReply struct {
+	Jets []HeavySyncJet
+}
+
+// HeavySyncService is a service that provides API for getting state of replication to heavy node.
+type HeavySyncService struct {
+	runner *Runner
+}
+
+// NewHeavySyncService creates new HeavySync service instance.
+func NewHeavySyncService(runner *Runner) *HeavySyncService {
+	return &HeavySyncService{runner: runner}
+}
+
+// Status returns replication state of jets processed by light material node.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "heavysync.Status",
+//     "params": {},
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "Jets": [
+//       {
+//         "Jet": str,
+//         "UnsyncedPulses": [int], // Pulses waiting for replication.
+//         "Lag": int, // Amount of pulses between current pulse and the first unsynced pulse.
+//         "SyncedChunks": int // Chunks of the first unsynced pulse accepted by heavy node.
+//       }
+//     ]
+//   }
+//
+func (s *HeavySyncService) Status(r *http.Request, args *interface{}, reply *HeavySyncReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ HeavySyncService.Status ] Incoming request: %s", r.RequestURI)
+
+	statuses, err := s.runner.HeavySyncStatus.JetsSyncStatus(ctx)
+	if err != nil {
+		return errors.Wrap(err, "[ HeavySyncService.Status ] Can't get jets sync status")
+	}
+
+	reply.Jets = make([]HeavySyncJet, 0, len(statuses))
+	for _, status := range statuses {
+		pulses := make([]uint32, 0, len(status.UnsyncedPulses))
+		for _, pn := range status.UnsyncedPulses {
+			pulses = append(pulses, uint32(pn))
+		}
+		reply.Jets = append(reply.Jets, HeavySyncJet{
+			Jet:            status.JetID.String(),
+			UnsyncedPulses: pulses,
+			Lag:            status.Lag,
+			SyncedChunks:   status.SyncedChunks,
+		})
+	}
+	return nil
+}
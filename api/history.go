@@ -0,0 +1,219 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/core/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/pkg/errors"
+)
+
+// HistoryArgs is arguments that History service accepts.
+type HistoryArgs struct {
+	Reference string
+	FromPulse *uint32
+	ToPulse   *uint32
+}
+
+// HistoryState is a single object state in History service reply.
+type HistoryState struct {
+	State       string
+	PrevState   string
+	Pulse       uint32
+	Request     string
+	Image       string
+	IsPrototype bool
+	MemoryHash  []byte
+	Deactivated bool
+}
+
+// HistoryReply is reply for History service requests.
+type HistoryReply struct {
+	States []HistoryState
+}
+
+// HistoryService is a service that provides API for fetching object states history.
+type HistoryService struct {
+	runner *Runner
+}
+
+// NewHistoryService creates new History service instance.
+func NewHistoryService(runner *Runner) *HistoryService {
+	return &HistoryService{runner: runner}
+}
+
+// Get returns object states from the latest to the earliest.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "history.Get",
+//     "params": {
+//       // Reference to the object head.
+//       "Reference": str,
+//       // Optional pulse range. Only states created in [FromPulse, ToPulse] will be returned.
+//       "FromPulse": int|null,
+//       "ToPulse": int|null
+//       },
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "States": [{
+//       "State": str, // State record ID.
+//       "PrevState": str, // Previous state record ID. Empty for the activation state.
+//       "Pulse": int, // Pulse in which the state was created.
+//       "Request": str, // Reference to the request produced the state.
+//       "Image": str, // Prototype (or code for prototypes) reference. Empty for the deactivation state.
+//       "IsPrototype": bool,
+//       "MemoryHash": str, // Base64 encoded hash of the state's memory. Null for the deactivation state.
+//       "Deactivated": bool
+//     }]
+//   }
+//
+func (s *HistoryService) Get(r *http.Request, args *HistoryArgs, reply *HistoryReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ HistoryService.Get ] Incoming request: %s", r.RequestURI)
+
+	head, err := core.NewRefFromBase58(args.Reference)
+	if err != nil {
+		return errors.Wrap(err, "[ HistoryService.Get ] Can't parse reference")
+	}
+
+	var fromPulse, toPulse *core.PulseNumber
+	if args.FromPulse != nil {
+		pn := core.PulseNumber(*args.FromPulse)
+		fromPulse = &pn
+	}
+	if args.ToPulse != nil {
+		pn := core.PulseNumber(*args.ToPulse)
+		toPulse = &pn
+	}
+
+	iter, err := s.runner.ArtifactManager.GetObjectHistory(ctx, *head, fromPulse, toPulse)
+	if err != nil {
+		return errors.Wrap(err, "[ HistoryService.Get ] Can't get object history")
+	}
+
+	reply.States = []HistoryState{}
+	for iter.HasNext() {
+		state, err := iter.Next()
+		if err != nil {
+			return errors.Wrap(err, "[ HistoryService.Get ] Can't fetch object state")
+		}
+		reply.States = append(reply.States, newHistoryState(state))
+	}
+
+	return nil
+}
+
+// HistoryAtPulseArgs is arguments that History service accepts for fetching object at pulse.
+type HistoryAtPulseArgs struct {
+	Reference string
+	Pulse     uint32
+}
+
+// HistoryAtPulseReply is reply for History service requests for object at pulse.
+type HistoryAtPulseReply struct {
+	State       string
+	Image       string
+	IsPrototype bool
+	Parent      string
+	Memory      []byte
+}
+
+// GetAtPulse returns the latest object state not newer than provided pulse.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "history.GetAtPulse",
+//     "params": {
+//       // Reference to the object head.
+//       "Reference": str,
+//       // Pulse to resolve state at. States created in later pulses are ignored.
+//       "Pulse": int
+//       },
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "State": str, // State record ID.
+//     "Image": str, // Prototype (or code for prototypes) reference.
+//     "IsPrototype": bool,
+//     "Parent": str, // Parent object reference.
+//     "Memory": str // Base64 encoded object memory.
+//   }
+//
+func (s *HistoryService) GetAtPulse(r *http.Request, args *HistoryAtPulseArgs, reply *HistoryAtPulseReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ HistoryService.GetAtPulse ] Incoming request: %s", r.RequestURI)
+
+	head, err := core.NewRefFromBase58(args.Reference)
+	if err != nil {
+		return errors.Wrap(err, "[ HistoryService.GetAtPulse ] Can't parse reference")
+	}
+
+	desc, err := s.runner.ArtifactManager.GetObjectAtPulse(ctx, *head, core.PulseNumber(args.Pulse))
+	if err != nil {
+		return errors.Wrap(err, "[ HistoryService.GetAtPulse ] Can't get object")
+	}
+
+	var image *core.RecordRef
+	if desc.IsPrototype() {
+		image, err = desc.Code()
+	} else {
+		image, err = desc.Prototype()
+	}
+	if err != nil {
+		return errors.Wrap(err, "[ HistoryService.GetAtPulse ] Can't get object image")
+	}
+
+	reply.State = desc.StateID().String()
+	reply.Image = image.String()
+	reply.IsPrototype = desc.IsPrototype()
+	reply.Parent = desc.Parent().String()
+	reply.Memory = desc.Memory()
+
+	return nil
+}
+
+func newHistoryState(desc *core.ObjectStateDescriptor) HistoryState {
+	state := HistoryState{
+		State:       desc.StateID.String(),
+		Pulse:       uint32(desc.Pulse),
+		Request:     desc.Request.String(),
+		IsPrototype: desc.IsPrototype,
+		MemoryHash:  desc.MemoryHash,
+		Deactivated: desc.Deactivated,
+	}
+	if desc.PrevStateID != nil {
+		state.PrevState = desc.PrevStateID.String()
+	}
+	if desc.Image != nil {
+		state.Image = desc.Image.String()
+	}
+	return state
+}
@@ -0,0 +1,160 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/core/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/pkg/errors"
+)
+
+// defaultIndexLimit is a page size of Index service if limit is not provided.
+const defaultIndexLimit = 100
+
+// IndexArgs is arguments that Index service accepts.
+type IndexArgs struct {
+	Reference string
+	From      string
+	Limit     int
+}
+
+// IndexedRecord is a single record in Index service reply.
+type IndexedRecord struct {
+	ID     string
+	Object string
+}
+
+// IndexReply is reply for Index service requests.
+type IndexReply struct {
+	Records []IndexedRecord
+	Next    string
+}
+
+// IndexService is a service that provides API for querying heavy node secondary indexes.
+type IndexService struct {
+	runner *Runner
+}
+
+// NewIndexService creates new Index service instance.
+func NewIndexService(runner *Runner) *IndexService {
+	return &IndexService{runner: runner}
+}
+
+type indexFinder func(ctx context.Context, key core.RecordRef, from *core.RecordID, limit int) (*core.IndexedRecords, error)
+
+// FindObjectsByPrototype returns objects of the prototype.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "index.FindObjectsByPrototype",
+//     "params": {
+//       // Reference to the prototype.
+//       "Reference": str,
+//       // Optional cursor. Records after this ID will be returned.
+//       "From": str,
+//       // Optional page size.
+//       "Limit": int
+//       },
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "Records": [{
+//       "ID": str, // Activation record ID.
+//       "Object": str // Reference to the object.
+//     }],
+//     "Next": str // Cursor for the next page. Empty if there are no more records.
+//   }
+//
+func (s *IndexService) FindObjectsByPrototype(r *http.Request, args *IndexArgs, reply *IndexReply) error {
+	return s.find(r, "FindObjectsByPrototype", s.runner.ArtifactManager.FindObjectsByPrototype, args, reply)
+}
+
+// FindRequestsByCaller returns requests made by the caller.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "index.FindRequestsByCaller",
+//     "params": {
+//       // Reference to the caller.
+//       "Reference": str,
+//       // Optional cursor. Records after this ID will be returned.
+//       "From": str,
+//       // Optional page size.
+//       "Limit": int
+//       },
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "Records": [{
+//       "ID": str, // Request record ID.
+//       "Object": str // Reference to the called object.
+//     }],
+//     "Next": str // Cursor for the next page. Empty if there are no more records.
+//   }
+//
+func (s *IndexService) FindRequestsByCaller(r *http.Request, args *IndexArgs, reply *IndexReply) error {
+	return s.find(r, "FindRequestsByCaller", s.runner.ArtifactManager.FindRequestsByCaller, args, reply)
+}
+
+func (s *IndexService) find(r *http.Request, method string, finder indexFinder, args *IndexArgs, reply *IndexReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ IndexService.%s ] Incoming request: %s", method, r.RequestURI)
+
+	key, err := core.NewRefFromBase58(args.Reference)
+	if err != nil {
+		return errors.Wrapf(err, "[ IndexService.%s ] Can't parse reference", method)
+	}
+	var from *core.RecordID
+	if args.From != "" {
+		from, err = core.NewIDFromBase58(args.From)
+		if err != nil {
+			return errors.Wrapf(err, "[ IndexService.%s ] Can't parse cursor", method)
+		}
+	}
+	limit := args.Limit
+	if limit == 0 {
+		limit = defaultIndexLimit
+	}
+
+	records, err := finder(ctx, *key, from, limit)
+	if err != nil {
+		return errors.Wrapf(err, "[ IndexService.%s ] Can't find records", method)
+	}
+
+	reply.Records = make([]IndexedRecord, 0, len(records.Records))
+	for _, rec := range records.Records {
+		reply.Records = append(reply.Records, IndexedRecord{
+			ID:     rec.ID.String(),
+			Object: rec.Object.String(),
+		})
+	}
+	if records.Next != nil {
+		reply.Next = records.Next.String()
+	}
+	return nil
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/insolar/insolar/application/extractor"
+	"github.com/insolar/insolar/core/reply"
 	"github.com/insolar/insolar/core/utils"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/pkg/errors"
@@ -36,6 +38,12 @@ type InfoReply struct {
 	TraceID    string
 }
 
+// RoutesReply is reply for Info service Routes method requests.
+type RoutesReply struct {
+	Routes  []extractor.Route
+	TraceID string
+}
+
 // InfoService is a service that provides API for getting info about genesis objects.
 type InfoService struct {
 	runner *Runner
@@ -103,3 +111,57 @@ func (s *InfoService) Get(r *http.Request, args *InfoArgs, reply *InfoReply) err
 
 	return nil
 }
+
+// Routes returns methods that members can call via contract api.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "info.Routes",
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"Routes": [{
+// 				"name": str, // method name for call request
+// 				"params": [{"name": str, "type": str}], // method params in order of appearance
+// 				"role": str, // role of caller, "member" or "root"
+// 				"unsigned": bool // method can be called without signature
+// 			}],
+// 			"TraceID": str // traceID for request
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *InfoService) Routes(r *http.Request, args *InfoArgs, result *RoutesReply) error {
+	traceID := utils.RandTraceID()
+	ctx, inslog := inslogger.WithTraceField(context.Background(), traceID)
+
+	inslog.Infof("[ INFO ] Incoming routes request: %s", r.RequestURI)
+
+	rootDomain := s.runner.GenesisDataProvider.GetRootDomain(ctx)
+	if rootDomain == nil {
+		inslog.Error("[ INFO ] rootDomain ref is nil")
+		return errors.New("[ INFO ] rootDomain ref is nil")
+	}
+
+	res, err := s.runner.ContractRequester.SendRequest(ctx, rootDomain, "ListRoutes", []interface{}{})
+	if err != nil {
+		inslog.Error(errors.Wrap(err, "[ INFO ] Can't get routes"))
+		return errors.Wrap(err, "[ INFO ] Can't get routes")
+	}
+
+	routes, err := extractor.RoutesResponse(res.(*reply.CallMethod).Result)
+	if err != nil {
+		inslog.Error(errors.Wrap(err, "[ INFO ] Can't extract routes"))
+		return errors.Wrap(err, "[ INFO ] Can't extract routes")
+	}
+
+	result.Routes = routes
+	result.TraceID = traceID
+
+	return nil
+}
@@ -42,14 +42,17 @@ import (
 type Runner struct {
 	CertificateManager  core.CertificateManager  `inject:""`
 	StorageExporter     core.StorageExporter     `inject:""`
+	ArtifactManager     core.ArtifactManager     `inject:""`
 	ContractRequester   core.ContractRequester   `inject:""`
 	NetworkCoordinator  core.NetworkCoordinator  `inject:""`
 	GenesisDataProvider core.GenesisDataProvider `inject:""`
 	NetworkSwitcher     core.NetworkSwitcher     `inject:""`
 	NodeNetwork         core.NodeNetwork         `inject:""`
 	PulseStorage        core.PulseStorage        `inject:""`
+	HeavySyncStatus     core.HeavySyncStatus     `inject:""`
 	server              *http.Server
 	rpcServer           *rpc.Server
+	adminServer         *http.Server
 	cfg                 *configuration.APIRunner
 	keyCache            map[string]crypto.PublicKey
 	cacheLock           *sync.RWMutex
@@ -83,6 +86,41 @@ func (ar *Runner) registerServices(rpcServer *rpc.Server) error {
 		return errors.New("[ registerServices ] Can't RegisterService: exporter")
 	}
 
+	err = rpcServer.RegisterService(NewHistoryService(ar), "history")
+	if err != nil {
+		return errors.New("[ registerServices ] Can't RegisterService: history")
+	}
+
+	err = rpcServer.RegisterService(NewEventsService(ar), "events")
+	if err != nil {
+		return errors.New("[ registerServices ] Can't RegisterService: events")
+	}
+
+	err = rpcServer.RegisterService(NewReceiptService(ar), "receipt")
+	if err != nil {
+		return errors.New("[ registerServices ] Can't RegisterService: receipt")
+	}
+
+	err = rpcServer.RegisterService(NewProofService(ar), "proof")
+	if err != nil {
+		return errors.New("[ registerServices ] Can't RegisterService: proof")
+	}
+
+	err = rpcServer.RegisterService(NewHeavySyncService(ar), "heavysync")
+	if err != nil {
+		return errors.New("[ registerServices ] Can't RegisterService: heavysync")
+	}
+
+	err = rpcServer.RegisterService(NewIndexService(ar), "index")
+	if err != nil {
+		return errors.New("[ registerServices ] Can't RegisterService: index")
+	}
+
+	err = rpcServer.RegisterService(NewContractService(ar), "contract")
+	if err != nil {
+		return errors.New("[ registerServices ] Can't RegisterService: contract")
+	}
+
 	err = rpcServer.RegisterService(NewSeedService(ar), "seed")
 	if err != nil {
 		return errors.New("[ registerServices ] Can't RegisterService: seed")
@@ -106,6 +144,20 @@ func (ar *Runner) registerServices(rpcServer *rpc.Server) error {
 	return nil
 }
 
+// newAdminServer creates server for node administration API, that is served on separate listener.
+func (ar *Runner) newAdminServer() (*http.Server, error) {
+	rpcServer := rpc.NewServer()
+	rpcServer.RegisterCodec(jsonrpc.NewCodec(), "application/json")
+	err := rpcServer.RegisterService(NewAdminService(ar), "admin")
+	if err != nil {
+		return nil, errors.New("[ newAdminServer ] Can't RegisterService: admin")
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(ar.cfg.RPC, rpcServer)
+	return &http.Server{Addr: ar.cfg.AdminAddress, Handler: mux}, nil
+}
+
 // NewRunner is C-tor for API Runner
 func NewRunner(cfg *configuration.APIRunner) (*Runner, error) {
 
@@ -129,6 +181,14 @@ func NewRunner(cfg *configuration.APIRunner) (*Runner, error) {
 		return nil, errors.Wrap(err, "[ NewAPIRunner ] Can't register services:")
 	}
 
+	if cfg.AdminAddress != "" {
+		adminServer, err := ar.newAdminServer()
+		if err != nil {
+			return nil, errors.Wrap(err, "[ NewAPIRunner ] Can't create admin server:")
+		}
+		ar.adminServer = adminServer
+	}
+
 	return &ar, nil
 }
 
@@ -154,6 +214,18 @@ func (ar *Runner) Start(ctx context.Context) error {
 			inslog.Error("Httpserver: ListenAndServe() error: ", err)
 		}
 	}()
+
+	if ar.adminServer != nil {
+		adminListener, err := net.Listen("tcp", ar.adminServer.Addr)
+		if err != nil {
+			return errors.Wrap(err, "Can't start listening admin API")
+		}
+		go func() {
+			if err := ar.adminServer.Serve(adminListener); err != nil {
+				inslog.Error("Admin httpserver: Serve() error: ", err)
+			}
+		}()
+	}
 	return nil
 }
 
@@ -168,6 +240,12 @@ func (ar *Runner) Stop(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "Can't gracefully stop API server")
 	}
+	if ar.adminServer != nil {
+		err = ar.adminServer.Shutdown(ctxWithTimeout)
+		if err != nil {
+			return errors.Wrap(err, "Can't gracefully stop admin API server")
+		}
+	}
 
 	return nil
 }
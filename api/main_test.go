@@ -21,11 +21,13 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/insolar/insolar/certificate"
 	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/testutils"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/insolar/insolar/configuration"
@@ -33,6 +35,7 @@ import (
 
 const HOST = "http://localhost:19101"
 const TestUrl = HOST + "/api/call"
+const AdminUrl = "http://localhost:19001/api/rpc"
 
 type MainAPISuite struct {
 	suite.Suite
@@ -46,6 +49,22 @@ func (suite *MainAPISuite) TestGetRequest() {
 	suite.Contains(string(body[:]), `"[ UnmarshalRequest ] Empty body"`)
 }
 
+func (suite *MainAPISuite) TestAdminServiceListener() {
+	request := `{"jsonrpc": "2.0", "method": "admin.LeaveStatus", "params": {}, "id": 1}`
+
+	resp, err := http.Post(HOST+"/api/rpc", "application/json", strings.NewReader(request))
+	suite.NoError(err)
+	body, err := ioutil.ReadAll(resp.Body)
+	suite.NoError(err)
+	suite.Contains(string(body), "can't find service")
+
+	resp, err = http.Post(AdminUrl, "application/json", strings.NewReader(request))
+	suite.NoError(err)
+	body, err = ioutil.ReadAll(resp.Body)
+	suite.NoError(err)
+	suite.Contains(string(body), `"State":"NoLeaveState"`)
+}
+
 func (suite *MainAPISuite) TestSerialization() {
 	var a uint = 1
 	var b bool = true
@@ -104,6 +123,9 @@ func TestMainTestSuite(t *testing.T) {
 
 	cm := certificate.NewCertificateManager(&certificate.Certificate{})
 	api.CertificateManager = cm
+	nc := testutils.NewNetworkCoordinatorMock(t)
+	nc.LeaveStatusMock.Return(&core.LeaveStatus{State: core.NoLeaveState}, nil)
+	api.NetworkCoordinator = nc
 	api.Start(ctx)
 
 	suite.Run(t, new(MainAPISuite))
@@ -0,0 +1,115 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/core/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/pkg/errors"
+)
+
+// ProofArgs is arguments that Proof service accepts.
+type ProofArgs struct {
+	Jet    string
+	Pulse  uint32
+	Record string
+}
+
+// ProofReply is reply for Proof service requests.
+type ProofReply struct {
+	Jet          string
+	Pulse        uint32
+	Record       string
+	Index        uint64
+	Count        uint64
+	Path         [][]byte
+	DropPrevHash []byte
+	DropHash     []byte
+}
+
+// ProofService is a service that provides API for fetching merkle proofs of records.
+type ProofService struct {
+	runner *Runner
+}
+
+// NewProofService creates new Proof service instance.
+func NewProofService(runner *Runner) *ProofService {
+	return &ProofService{runner: runner}
+}
+
+// Get returns merkle proof of the record inclusion into the jet drop.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "proof.Get",
+//     "params": {
+//       // Jet ID of the drop.
+//       "Jet": str,
+//       // Pulse of the drop.
+//       "Pulse": int,
+//       // Record ID to prove.
+//       "Record": str
+//       },
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "Jet": str,
+//     "Pulse": int,
+//     "Record": str,
+//     "Index": int, // Position of the record in the drop.
+//     "Count": int, // Amount of records in the drop.
+//     "Path": [str], // Base64 encoded sibling hashes from the record leaf up to the drop records root.
+//     "DropPrevHash": str, // Base64 encoded hash of the previous drop.
+//     "DropHash": str // Base64 encoded hash of the drop.
+//   }
+//
+func (s *ProofService) Get(r *http.Request, args *ProofArgs, reply *ProofReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ ProofService.Get ] Incoming request: %s", r.RequestURI)
+
+	jetID, err := core.NewIDFromBase58(args.Jet)
+	if err != nil {
+		return errors.Wrap(err, "[ ProofService.Get ] Can't parse jet")
+	}
+	recordID, err := core.NewIDFromBase58(args.Record)
+	if err != nil {
+		return errors.Wrap(err, "[ ProofService.Get ] Can't parse record")
+	}
+
+	proof, err := s.runner.ArtifactManager.GetRecordProof(ctx, *jetID, core.PulseNumber(args.Pulse), *recordID)
+	if err != nil {
+		return errors.Wrap(err, "[ ProofService.Get ] Can't get record proof")
+	}
+
+	reply.Jet = proof.Jet.String()
+	reply.Pulse = uint32(proof.Pulse)
+	reply.Record = proof.Record.String()
+	reply.Index = proof.Index
+	reply.Count = proof.Count
+	reply.Path = proof.Path
+	reply.DropPrevHash = proof.DropPrevHash
+	reply.DropHash = proof.DropHash
+	return nil
+}
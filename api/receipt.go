@@ -0,0 +1,128 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/insolar/insolar/application/extractor"
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/core/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/pkg/errors"
+)
+
+// Receipt statuses.
+const (
+	ReceiptPending  = "pending"
+	ReceiptExecuted = "executed"
+	ReceiptFailed   = "failed"
+)
+
+// ReceiptArgs is arguments that Receipt service accepts.
+type ReceiptArgs struct {
+	Object  string
+	Request string
+}
+
+// ReceiptReply is reply for Receipt service requests.
+type ReceiptReply struct {
+	Request string
+	Status  string
+	Pulse   uint32
+	Result  string
+	Payload interface{}
+	Error   string
+}
+
+// ReceiptService is a service that provides API for fetching request receipts.
+type ReceiptService struct {
+	runner *Runner
+}
+
+// NewReceiptService creates new Receipt service instance.
+func NewReceiptService(runner *Runner) *ReceiptService {
+	return &ReceiptService{runner: runner}
+}
+
+// Get returns execution status of the request.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "receipt.Get",
+//     "params": {
+//       // Reference of the object the request was sent to.
+//       "Object": str,
+//       // Request reference returned by the call API.
+//       "Request": str
+//       },
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "Request": str, // Request reference.
+//     "Status": str, // One of "pending", "executed" or "failed".
+//     "Pulse": int, // Pulse in which the request was registered.
+//     "Result": str, // Result record ID. Empty for the pending request.
+//     "Payload": any, // Value returned by the called method.
+//     "Error": str // Contract error for the failed request.
+//   }
+//
+func (s *ReceiptService) Get(r *http.Request, args *ReceiptArgs, reply *ReceiptReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ ReceiptService.Get ] Incoming request: %s", r.RequestURI)
+
+	object, err := core.NewRefFromBase58(args.Object)
+	if err != nil {
+		return errors.Wrap(err, "[ ReceiptService.Get ] Can't parse object reference")
+	}
+	request, err := core.NewRefFromBase58(args.Request)
+	if err != nil {
+		return errors.Wrap(err, "[ ReceiptService.Get ] Can't parse reference")
+	}
+
+	receipt, err := s.runner.ArtifactManager.GetReceipt(ctx, *object, *request)
+	if err != nil {
+		return errors.Wrap(err, "[ ReceiptService.Get ] Can't get receipt")
+	}
+
+	reply.Request = receipt.Request.String()
+	reply.Pulse = uint32(receipt.Pulse)
+	if receipt.Result == nil {
+		reply.Status = ReceiptPending
+		return nil
+	}
+
+	reply.Result = receipt.Result.String()
+	result, contractErr, err := extractor.CallResponse(receipt.Payload)
+	if err != nil {
+		return errors.Wrap(err, "[ ReceiptService.Get ] Can't extract response")
+	}
+	if contractErr != nil {
+		reply.Status = ReceiptFailed
+		reply.Error = contractErr.S
+		return nil
+	}
+
+	reply.Status = ReceiptExecuted
+	reply.Payload = result
+	return nil
+}
@@ -242,6 +242,28 @@ func Info(url string) (*InfoResponse, error) {
 	return res, nil
 }
 
+// Routes makes rpc request to info.Routes method and extracts it
+func Routes(url string) (*RoutesResponse, error) {
+	params := getDefaultRPCParams("info.Routes")
+
+	body, err := GetResponseBody(url+"/rpc", params)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Routes ]")
+	}
+
+	routesResp := rpcRoutesResponse{}
+
+	err = json.Unmarshal(body, &routesResp)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Routes ] Can't unmarshal")
+	}
+	if routesResp.Error != nil {
+		return nil, errors.New("[ Routes ] Field 'error' is not nil: " + fmt.Sprint(routesResp.Error))
+	}
+
+	return &routesResp.Result, nil
+}
+
 // Status makes rpc request to info.Status method and extracts it
 func Status(url string) (*StatusResponse, error) {
 	params := getDefaultRPCParams("status.Get")
@@ -267,3 +289,37 @@ func Status(url string) (*StatusResponse, error) {
 
 	return res, nil
 }
+
+// Leave makes rpc request to admin.Leave method and extracts it
+func Leave(url string, ETA uint32) (*LeaveResponse, error) {
+	params := getDefaultRPCParams("admin.Leave")
+	params["params"] = map[string]interface{}{"ETA": ETA}
+
+	return leaveRequest(url, params, "[ Leave ]")
+}
+
+// LeaveStatus makes rpc request to admin.LeaveStatus method and extracts it
+func LeaveStatus(url string) (*LeaveResponse, error) {
+	params := getDefaultRPCParams("admin.LeaveStatus")
+
+	return leaveRequest(url, params, "[ LeaveStatus ]")
+}
+
+func leaveRequest(url string, params PostParams, errPrefix string) (*LeaveResponse, error) {
+	body, err := GetResponseBody(url+"/rpc", params)
+	if err != nil {
+		return nil, errors.Wrap(err, errPrefix)
+	}
+
+	leaveResp := rpcLeaveResponse{}
+
+	err = json.Unmarshal(body, &leaveResp)
+	if err != nil {
+		return nil, errors.Wrap(err, errPrefix+" Can't unmarshal")
+	}
+	if leaveResp.Error != nil {
+		return nil, errors.New(errPrefix + " Field 'error' is not nil: " + fmt.Sprint(leaveResp.Error))
+	}
+
+	return &leaveResp.Result, nil
+}
@@ -40,10 +40,18 @@ const TESTSEED = "VGVzdA=="
 var testSeedResponse = seedResponse{Seed: []byte("Test"), TraceID: "testTraceID"}
 var testInfoResponse = InfoResponse{RootMember: "root_member_ref", RootDomain: "root_domain_ref", NodeDomain: "node_domain_ref"}
 var testStatusResponse = StatusResponse{NetworkState: "OK"}
+var testLeaveResponse = LeaveResponse{State: "DrainingLeaveState", ETA: 65600, UnsyncedPulses: 2, ActiveExecutions: 1}
+var testRoutesResponse = RoutesResponse{
+	Routes: []RouteResponse{
+		{Name: "Transfer", Params: []RouteParamResponse{{Name: "amount", Type: "uint"}, {Name: "to", Type: "string"}}, Role: "member"},
+	},
+	TraceID: "testTraceID",
+}
 
 type rpcRequest struct {
-	RPCVersion string `json:"jsonrpc"`
-	Method     string `json:"method"`
+	RPCVersion string                 `json:"jsonrpc"`
+	Method     string                 `json:"method"`
+	Params     map[string]interface{} `json:"params"`
 }
 
 func writeReponse(response http.ResponseWriter, answer map[string]interface{}) {
@@ -96,8 +104,18 @@ func FakeRPCHandler(response http.ResponseWriter, req *http.Request) {
 		answer["result"] = testStatusResponse
 	case "info.Get":
 		answer["result"] = testInfoResponse
+	case "info.Routes":
+		answer["result"] = testRoutesResponse
 	case "seed.Get":
 		answer["result"] = testSeedResponse
+	case "admin.Leave":
+		if rpcReq.Params["ETA"] == float64(testLeaveResponse.ETA) {
+			answer["result"] = testLeaveResponse
+		} else {
+			answer["error"] = map[string]interface{}{"message": "unexpected ETA"}
+		}
+	case "admin.LeaveStatus":
+		answer["result"] = testLeaveResponse
 	}
 	writeReponse(response, answer)
 }
@@ -266,3 +284,24 @@ func TestStatus(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, resp, &testStatusResponse)
 }
+
+func TestLeave(t *testing.T) {
+	resp, err := Leave(URL, testLeaveResponse.ETA)
+	require.NoError(t, err)
+	require.Equal(t, resp, &testLeaveResponse)
+
+	_, err = Leave(URL, testLeaveResponse.ETA+1)
+	require.Error(t, err)
+}
+
+func TestLeaveStatus(t *testing.T) {
+	resp, err := LeaveStatus(URL)
+	require.NoError(t, err)
+	require.Equal(t, resp, &testLeaveResponse)
+}
+
+func TestRoutes(t *testing.T) {
+	resp, err := Routes(URL)
+	require.NoError(t, err)
+	require.Equal(t, resp, &testRoutesResponse)
+}
@@ -40,6 +40,19 @@ type rpcStatusResponse struct {
 	Result StatusResponse `json:"result"`
 }
 
+// LeaveResponse represents response from rpc on admin.Leave and admin.LeaveStatus methods
+type LeaveResponse struct {
+	State            string `json:"State"`
+	ETA              uint32 `json:"ETA"`
+	UnsyncedPulses   int    `json:"UnsyncedPulses"`
+	ActiveExecutions int    `json:"ActiveExecutions"`
+}
+
+type rpcLeaveResponse struct {
+	rpcResponse
+	Result LeaveResponse `json:"result"`
+}
+
 // InfoResponse represents response from rpc on info.Get method
 type InfoResponse struct {
 	RootDomain string `json:"RootDomain"`
@@ -52,3 +65,28 @@ type rpcInfoResponse struct {
 	rpcResponse
 	Result InfoResponse `json:"result"`
 }
+
+// RouteParamResponse represents parameter of routed method
+type RouteParamResponse struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// RouteResponse represents method that can be called via contract api
+type RouteResponse struct {
+	Name     string               `json:"name"`
+	Params   []RouteParamResponse `json:"params"`
+	Role     string               `json:"role"`
+	Unsigned bool                 `json:"unsigned"`
+}
+
+// RoutesResponse represents response from rpc on info.Routes method
+type RoutesResponse struct {
+	Routes  []RouteResponse `json:"Routes"`
+	TraceID string          `json:"TraceID"`
+}
+
+type rpcRoutesResponse struct {
+	rpcResponse
+	Result RoutesResponse `json:"result"`
+}
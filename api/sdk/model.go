@@ -29,3 +29,58 @@ func NewMember(ref string, key string) *Member {
 		PrivateKey: key,
 	}
 }
+
+// Proposal model object of pending multisig transfer
+type Proposal struct {
+	Reference  string   `json:"reference"`
+	To         string   `json:"to"`
+	Amount     string   `json:"amount"`
+	Threshold  uint     `json:"threshold"`
+	Approvals  []string `json:"approvals"`
+	ExpireTime int64    `json:"expire_time"`
+}
+
+// HistoryEntry model object of transfer in wallet history, empty Token means native currency
+type HistoryEntry struct {
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Token   string `json:"token,omitempty"`
+	Amount  string `json:"amount"`
+	Pulse   uint32 `json:"pulse"`
+	Request string `json:"request"`
+}
+
+// Statement model object of wallet transfers in range of pulses
+type Statement struct {
+	Entries  []HistoryEntry `json:"entries"`
+	Incoming string         `json:"incoming"`
+	Outgoing string         `json:"outgoing"`
+}
+
+// TokenDefinition describes token to issue, TotalSupply is decimal amount credited to issuer
+type TokenDefinition struct {
+	Name        string
+	Symbol      string
+	Decimals    uint
+	TotalSupply string
+	Mintable    bool
+	Burnable    bool
+}
+
+// Token model object of issued token
+type Token struct {
+	Reference   string `json:"reference"`
+	Name        string `json:"name"`
+	Symbol      string `json:"symbol"`
+	Decimals    uint   `json:"decimals"`
+	Issuer      string `json:"issuer"`
+	TotalSupply string `json:"total_supply"`
+	Mintable    bool   `json:"mintable"`
+	Burnable    bool   `json:"burnable"`
+}
+
+// TokenBalance model object of member balance of token
+type TokenBalance struct {
+	Token   string `json:"token"`
+	Balance string `json:"balance"`
+}
@@ -18,6 +18,7 @@ package sdk
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"io/ioutil"
 	"sync"
@@ -157,8 +158,8 @@ func (sdk *SDK) CreateMember() (*Member, string, error) {
 	return NewMember(response.Result.(string), string(privateKeyStr)), response.TraceID, nil
 }
 
-// Transfer method send money from one member to another
-func (sdk *SDK) Transfer(amount uint, from *Member, to *Member) (string, error) {
+// Transfer method send money from one member to another, amount is decimal string like "10.05"
+func (sdk *SDK) Transfer(amount string, from *Member, to *Member) (string, error) {
 	ctx := inslogger.ContextWithTrace(context.Background(), "Transfer")
 	params := []interface{}{amount, to.Reference}
 	config, err := requester.CreateUserConfig(from.Reference, from.PrivateKey)
@@ -183,29 +184,246 @@ func (sdk *SDK) Transfer(amount uint, from *Member, to *Member) (string, error)
 	return response.TraceID, nil
 }
 
-// GetBalance returns current balance of the given member.
-func (sdk *SDK) GetBalance(m *Member) (uint64, error) {
+// GetBalance returns current balance of the given member as decimal string.
+func (sdk *SDK) GetBalance(m *Member) (string, error) {
 	ctx := inslogger.ContextWithTrace(context.Background(), "GetBalance")
 	params := []interface{}{m.Reference}
 	config, err := requester.CreateUserConfig(m.Reference, m.PrivateKey)
 	if err != nil {
-		return 0, errors.Wrap(err, "[ GetBalance ] can't create user config")
+		return "", errors.Wrap(err, "[ GetBalance ] can't create user config")
 	}
 
 	body, err := sdk.sendRequest(ctx, "GetBalance", params, config)
 	if err != nil {
-		return 0, errors.Wrap(err, "[ GetBalance ] can't send request")
+		return "", errors.Wrap(err, "[ GetBalance ] can't send request")
 	}
 
 	response, err := sdk.getResponse(body)
 	if err != nil {
-		return 0, errors.Wrap(err, "[ GetBalance ] can't get response")
+		return "", errors.Wrap(err, "[ GetBalance ] can't get response")
 	}
 
 	if response.Error != "" {
-		return 0, errors.New(response.Error)
+		return "", errors.New(response.Error)
 	}
 
-	// TODO FIXME don't transfer money in floats!
-	return uint64(response.Result.(float64)), nil
+	balance, ok := response.Result.(string)
+	if !ok {
+		return "", errors.New("[ GetBalance ] result is not a string")
+	}
+	return balance, nil
+}
+
+// SetWalletSigners turns wallet of the given member into multisig wallet.
+func (sdk *SDK) SetWalletSigners(owner *Member, signers []*Member, threshold uint) (string, error) {
+	refs := make([]string, 0, len(signers))
+	for _, s := range signers {
+		refs = append(refs, s.Reference)
+	}
+	_, traceID, err := sdk.Call(owner, "SetWalletSigners", refs, threshold)
+	if err != nil {
+		return traceID, errors.Wrap(err, "[ SetWalletSigners ] can't set signers")
+	}
+	return traceID, nil
+}
+
+// ProposeTransfer proposes transfer from multisig wallet of owner on behalf of signer and returns proposal reference.
+func (sdk *SDK) ProposeTransfer(amount string, signer *Member, owner *Member, to *Member) (string, string, error) {
+	result, traceID, err := sdk.Call(signer, "ProposeTransfer", owner.Reference, amount, to.Reference)
+	if err != nil {
+		return "", traceID, errors.Wrap(err, "[ ProposeTransfer ] can't propose transfer")
+	}
+	proposal, ok := result.(string)
+	if !ok {
+		return "", traceID, errors.New("[ ProposeTransfer ] result is not a reference")
+	}
+	return proposal, traceID, nil
+}
+
+// ApproveTransfer approves proposal from multisig wallet of owner on behalf of signer.
+func (sdk *SDK) ApproveTransfer(signer *Member, owner *Member, proposal string) (string, error) {
+	_, traceID, err := sdk.Call(signer, "ApproveTransfer", owner.Reference, proposal)
+	if err != nil {
+		return traceID, errors.Wrap(err, "[ ApproveTransfer ] can't approve transfer")
+	}
+	return traceID, nil
+}
+
+// GetProposals returns pending proposals of multisig wallet of owner.
+func (sdk *SDK) GetProposals(m *Member, owner *Member) ([]Proposal, error) {
+	result, _, err := sdk.Call(m, "GetProposals", owner.Reference)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ GetProposals ] can't get proposals")
+	}
+
+	proposals := []Proposal{}
+	err = unmarshalResult(result, &proposals)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ GetProposals ] can't unmarshal proposals")
+	}
+	return proposals, nil
+}
+
+// GetHistory returns page of transfers history of the given member.
+// Zero limit means all entries starting from offset.
+func (sdk *SDK) GetHistory(m *Member, offset uint, limit uint) ([]HistoryEntry, error) {
+	result, _, err := sdk.Call(m, "GetHistory", offset, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ GetHistory ] can't get history")
+	}
+
+	history := []HistoryEntry{}
+	err = unmarshalResult(result, &history)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ GetHistory ] can't unmarshal history")
+	}
+	return history, nil
+}
+
+// GetStatement returns transfers of the given member and their totals in range of pulses.
+func (sdk *SDK) GetStatement(m *Member, fromPulse uint32, toPulse uint32) (*Statement, error) {
+	result, _, err := sdk.Call(m, "GetStatement", fromPulse, toPulse)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ GetStatement ] can't get statement")
+	}
+
+	statement := &Statement{}
+	err = unmarshalResult(result, statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ GetStatement ] can't unmarshal statement")
+	}
+	return statement, nil
+}
+
+// IssueToken issues new token on behalf of issuer and returns token reference.
+// Total supply is credited to wallet of issuer, token without mintable policy can't be minted later.
+func (sdk *SDK) IssueToken(issuer *Member, token TokenDefinition) (string, string, error) {
+	result, traceID, err := sdk.Call(issuer, "IssueToken",
+		token.Name, token.Symbol, token.Decimals, token.TotalSupply, token.Mintable, token.Burnable)
+	if err != nil {
+		return "", traceID, errors.Wrap(err, "[ IssueToken ] can't issue token")
+	}
+	ref, ok := result.(string)
+	if !ok {
+		return "", traceID, errors.New("[ IssueToken ] result is not a reference")
+	}
+	return ref, traceID, nil
+}
+
+// MintToken mints decimal amount of token to wallet of the given member on behalf of issuer.
+func (sdk *SDK) MintToken(issuer *Member, token string, amount string, to *Member) (string, error) {
+	_, traceID, err := sdk.Call(issuer, "MintToken", token, amount, to.Reference)
+	if err != nil {
+		return traceID, errors.Wrap(err, "[ MintToken ] can't mint token")
+	}
+	return traceID, nil
+}
+
+// TransferToken sends decimal amount of token from one member to another.
+func (sdk *SDK) TransferToken(token string, amount string, from *Member, to *Member) (string, error) {
+	_, traceID, err := sdk.Call(from, "TransferToken", token, amount, to.Reference)
+	if err != nil {
+		return traceID, errors.Wrap(err, "[ TransferToken ] can't transfer token")
+	}
+	return traceID, nil
+}
+
+// BurnToken destroys decimal amount of token held by the given member.
+func (sdk *SDK) BurnToken(m *Member, token string, amount string) (string, error) {
+	_, traceID, err := sdk.Call(m, "BurnToken", token, amount)
+	if err != nil {
+		return traceID, errors.Wrap(err, "[ BurnToken ] can't burn token")
+	}
+	return traceID, nil
+}
+
+// GetTokenInfo returns definition and total supply of token.
+func (sdk *SDK) GetTokenInfo(m *Member, token string) (*Token, error) {
+	result, _, err := sdk.Call(m, "GetTokenInfo", token)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ GetTokenInfo ] can't get token info")
+	}
+
+	info := &Token{}
+	err = unmarshalResult(result, info)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ GetTokenInfo ] can't unmarshal token info")
+	}
+	return info, nil
+}
+
+// GetTokenBalance returns decimal balance of token of the given member.
+func (sdk *SDK) GetTokenBalance(m *Member, token string) (string, error) {
+	result, _, err := sdk.Call(m, "GetTokenBalance", token, m.Reference)
+	if err != nil {
+		return "", errors.Wrap(err, "[ GetTokenBalance ] can't get token balance")
+	}
+	balance, ok := result.(string)
+	if !ok {
+		return "", errors.New("[ GetTokenBalance ] result is not a string")
+	}
+	return balance, nil
+}
+
+// GetTokenBalances returns decimal balances of all tokens of the given member.
+func (sdk *SDK) GetTokenBalances(m *Member) ([]TokenBalance, error) {
+	result, _, err := sdk.Call(m, "GetTokenBalances")
+	if err != nil {
+		return nil, errors.Wrap(err, "[ GetTokenBalances ] can't get token balances")
+	}
+
+	balances := []TokenBalance{}
+	err = unmarshalResult(result, &balances)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ GetTokenBalances ] can't unmarshal token balances")
+	}
+	return balances, nil
+}
+
+// unmarshalResult unmarshals json that contract returned as bytes
+func unmarshalResult(result interface{}, to interface{}) error {
+	encoded, ok := result.(string)
+	if !ok {
+		return errors.New("result is not a string")
+	}
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return errors.Wrap(err, "can't decode result")
+	}
+	return json.Unmarshal(data, to)
+}
+
+// Routes returns methods that members can call via Call.
+func (sdk *SDK) Routes() ([]requester.RouteResponse, error) {
+	response, err := requester.Routes(sdk.apiURLs.next())
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Routes ] can't get routes")
+	}
+
+	return response.Routes, nil
+}
+
+// Call sends request for any routed method on behalf of the given member.
+func (sdk *SDK) Call(m *Member, method string, params ...interface{}) (interface{}, string, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), method)
+	config, err := requester.CreateUserConfig(m.Reference, m.PrivateKey)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "[ Call ] can't create user config")
+	}
+
+	body, err := sdk.sendRequest(ctx, method, params, config)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "[ Call ] can't send request")
+	}
+
+	response, err := sdk.getResponse(body)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "[ Call ] can't get response")
+	}
+
+	if response.Error != "" {
+		return nil, response.TraceID, errors.New(response.Error)
+	}
+
+	return response.Result, response.TraceID, nil
 }
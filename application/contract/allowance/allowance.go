@@ -20,15 +20,22 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/insolar/insolar/application/proxy/token"
 	"github.com/insolar/insolar/application/proxy/wallet"
 	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
 )
 
+// Allowance holds transferred amount until recipient wallet takes it
+// To is reference of recipient wallet and Receiver is reference of member who owns it
+// Token is reference of transferred token, empty Token means native currency
 type Allowance struct {
 	foundation.BaseContract
+	From       core.RecordRef
 	To         core.RecordRef
-	Amount     uint
+	Receiver   core.RecordRef
+	Token      core.RecordRef
+	Amount     string
 	ExpireTime int64
 }
 
@@ -37,42 +44,58 @@ func (a *Allowance) isExpired() bool {
 }
 
 // TakeAmount allows take amount and delete allowance
-func (a *Allowance) TakeAmount() (uint, error) {
+func (a *Allowance) TakeAmount() (string, error) {
 	if *(a.GetContext().Caller) != a.To {
-		return 0, fmt.Errorf("[ TakeAmount ] Only recepient can take amount")
+		return "", fmt.Errorf("[ TakeAmount ] Only recepient can take amount")
 	}
 	if a.isExpired() {
-		return 0, fmt.Errorf("[ TakeAmount ] Allowance expiried")
+		return "", fmt.Errorf("[ TakeAmount ] Allowance expiried")
 	}
 	if err := a.SelfDestruct(); err != nil {
-		return 0, err
+		return "", err
 	}
 	return a.Amount, nil
 }
 
+// GetSender returns reference of member who sent allowance
+func (a *Allowance) GetSender() (core.RecordRef, error) {
+	return a.From, nil
+}
+
+// GetReceiver returns reference of member who receives allowance
+func (a *Allowance) GetReceiver() (core.RecordRef, error) {
+	return a.Receiver, nil
+}
+
+// GetToken returns reference of transferred token, empty reference means native currency
+func (a *Allowance) GetToken() (core.RecordRef, error) {
+	return a.Token, nil
+}
+
 // GetBalanceForOwner returns balance
-func (a *Allowance) GetBalanceForOwner() (uint, error) {
+func (a *Allowance) GetBalanceForOwner() (string, error) {
 	return a.Amount, nil
 }
 
 // GetExpiredBalance gets balance from expired allowance and delete allowance
-func (a *Allowance) GetExpiredBalance() (uint, error) {
+func (a *Allowance) GetExpiredBalance() (string, error) {
 	if *(a.GetContext().Caller) != *(a.GetContext().Parent) {
-		return 0, fmt.Errorf("[ DeleteExpiredAllowance ] Only owner can delete expiried Allowance")
+		return "", fmt.Errorf("[ DeleteExpiredAllowance ] Only owner can delete expiried Allowance")
 	}
 	if a.isExpired() {
 		if err := a.SelfDestruct(); err != nil {
-			return 0, err
+			return "", err
 		}
 		return a.Amount, nil
 	}
-	return 0, nil
+	return "", nil
 }
 
-// New check is caller wallet and makes new allowance
-func New(to *core.RecordRef, amount uint, expire int64) (*Allowance, error) {
-	if !wallet.PrototypeReference.Equal(*foundation.GetContext().CallerPrototype) {
+// New check is caller wallet or token and makes new allowance, amount is integer number of minimal units
+func New(from *core.RecordRef, to *core.RecordRef, receiver *core.RecordRef, tokenRef *core.RecordRef, amount string, expire int64) (*Allowance, error) {
+	callerPrototype := *foundation.GetContext().CallerPrototype
+	if !wallet.PrototypeReference.Equal(callerPrototype) && !token.PrototypeReference.Equal(callerPrototype) {
 		return nil, fmt.Errorf("[ New Allowance ] : Can't create allowance from not wallet contract")
 	}
-	return &Allowance{To: *to, Amount: amount, ExpireTime: expire}, nil
+	return &Allowance{From: *from, To: *to, Receiver: *receiver, Token: *tokenRef, Amount: amount, ExpireTime: expire}, nil
 }
@@ -0,0 +1,77 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package historyentry
+
+import (
+	"fmt"
+
+	"github.com/insolar/insolar/application/proxy/wallet"
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+)
+
+// HistoryEntry is immutable record of transfer between members, it is saved as child of both wallets
+// Token is reference of transferred token, empty Token means native currency
+type HistoryEntry struct {
+	foundation.BaseContract
+	From    core.RecordRef
+	To      core.RecordRef
+	Token   core.RecordRef
+	Amount  string
+	Pulse   core.PulseNumber
+	Request core.RecordRef
+}
+
+// Info contains public state of history entry
+type Info struct {
+	From    string           `json:"from"`
+	To      string           `json:"to"`
+	Token   string           `json:"token,omitempty"`
+	Amount  string           `json:"amount"`
+	Pulse   core.PulseNumber `json:"pulse"`
+	Request string           `json:"request"`
+}
+
+// GetInfo returns public state of history entry
+func (e *HistoryEntry) GetInfo() (Info, error) {
+	info := Info{
+		From:    e.From.String(),
+		To:      e.To.String(),
+		Amount:  e.Amount,
+		Pulse:   e.Pulse,
+		Request: e.Request.String(),
+	}
+	if !e.Token.IsEmpty() {
+		info.Token = e.Token.String()
+	}
+	return info, nil
+}
+
+// New checks that caller is wallet and makes new history entry, amount is integer number of minimal units
+func New(from *core.RecordRef, to *core.RecordRef, token *core.RecordRef, amount string, pulse core.PulseNumber, request *core.RecordRef) (*HistoryEntry, error) {
+	if !wallet.PrototypeReference.Equal(*foundation.GetContext().CallerPrototype) {
+		return nil, fmt.Errorf("[ New HistoryEntry ] : Can't create history entry from not wallet contract")
+	}
+	return &HistoryEntry{
+		From:    *from,
+		To:      *to,
+		Token:   *token,
+		Amount:  amount,
+		Pulse:   pulse,
+		Request: *request,
+	}, nil
+}
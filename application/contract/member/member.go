@@ -17,13 +17,16 @@
 package member
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 
 	"github.com/insolar/insolar/application/contract/member/signer"
 	"github.com/insolar/insolar/application/proxy/nodedomain"
 	"github.com/insolar/insolar/application/proxy/rootdomain"
+	"github.com/insolar/insolar/application/proxy/token"
 	"github.com/insolar/insolar/application/proxy/wallet"
 	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
@@ -74,6 +77,34 @@ func (m *Member) verifySig(method string, params []byte, seed []byte, sign []byt
 	return nil
 }
 
+// builtinHandlers handle methods that are implemented by member itself
+// all other methods are routed via RootDomain's routes
+var builtinHandlers = map[string]func(m *Member, rootDomain core.RecordRef, params []byte) (interface{}, error){
+	"GetMyBalance": (*Member).getMyBalanceCall,
+	"GetBalance":   (*Member).getBalanceCall,
+	"Transfer":     (*Member).transferCall,
+	"DumpUserInfo": (*Member).dumpUserInfoCall,
+	"DumpAllUsers": (*Member).dumpAllUsersCall,
+	"RegisterNode": (*Member).registerNodeCall,
+	"GetNodeRef":   (*Member).getNodeRefCall,
+
+	"SetWalletSigners": (*Member).setWalletSignersCall,
+	"ProposeTransfer":  (*Member).proposeTransferCall,
+	"ApproveTransfer":  (*Member).approveTransferCall,
+	"GetProposals":     (*Member).getProposalsCall,
+
+	"GetHistory":   (*Member).getHistoryCall,
+	"GetStatement": (*Member).getStatementCall,
+
+	"IssueToken":       (*Member).issueTokenCall,
+	"MintToken":        (*Member).mintTokenCall,
+	"TransferToken":    (*Member).transferTokenCall,
+	"BurnToken":        (*Member).burnTokenCall,
+	"GetTokenInfo":     (*Member).getTokenInfoCall,
+	"GetTokenBalance":  (*Member).getTokenBalanceCall,
+	"GetTokenBalances": (*Member).getTokenBalancesCall,
+}
+
 var INSATTR_Call_API = true
 
 // Call method for authorized calls
@@ -88,23 +119,25 @@ func (m *Member) Call(rootDomain core.RecordRef, method string, params []byte, s
 		return nil, fmt.Errorf("[ Call ]: %s", err.Error())
 	}
 
-	switch method {
-	case "GetMyBalance":
-		return m.getMyBalanceCall()
-	case "GetBalance":
-		return m.getBalanceCall(params)
-	case "Transfer":
-		return m.transferCall(params)
-	case "DumpUserInfo":
-		return m.dumpUserInfoCall(rootDomain, params)
-	case "DumpAllUsers":
-		return m.dumpAllUsersCall(rootDomain)
-	case "RegisterNode":
-		return m.registerNodeCall(rootDomain, params)
-	case "GetNodeRef":
-		return m.getNodeRefCall(rootDomain, params)
-	}
-	return nil, &foundation.Error{S: "Unknown method"}
+	return m.routedCall(rootDomain, method, params)
+}
+
+// routedCall checks that member can call the method and calls its handler
+func (m *Member) routedCall(ref core.RecordRef, method string, params []byte) (interface{}, error) {
+	route, err := rootdomain.GetObject(ref).GetRoute(method)
+	if err != nil {
+		return nil, fmt.Errorf("[ routedCall ] %s", err.Error())
+	}
+
+	if handler, ok := builtinHandlers[method]; ok {
+		return handler(m, ref, params)
+	}
+
+	res, err := foundation.CallMethod(route.Target, route.Method, m.GetReference(), params)
+	if err != nil {
+		return nil, fmt.Errorf("[ routedCall ] %s: %s", method, err.Error())
+	}
+	return res, nil
 }
 
 func (m *Member) createMemberCall(ref core.RecordRef, params []byte) (interface{}, error) {
@@ -117,7 +150,7 @@ func (m *Member) createMemberCall(ref core.RecordRef, params []byte) (interface{
 	return rootDomain.CreateMember(name, key)
 }
 
-func (m *Member) getMyBalanceCall() (interface{}, error) {
+func (m *Member) getMyBalanceCall(ref core.RecordRef, params []byte) (interface{}, error) {
 	w, err := wallet.GetImplementationFrom(m.GetReference())
 	if err != nil {
 		return 0, fmt.Errorf("[ getMyBalanceCall ]: %s", err.Error())
@@ -126,7 +159,7 @@ func (m *Member) getMyBalanceCall() (interface{}, error) {
 	return w.GetBalance()
 }
 
-func (m *Member) getBalanceCall(params []byte) (interface{}, error) {
+func (m *Member) getBalanceCall(ref core.RecordRef, params []byte) (interface{}, error) {
 	var member string
 	if err := signer.UnmarshalParams(params, &member); err != nil {
 		return nil, fmt.Errorf("[ getBalanceCall ] : %s", err.Error())
@@ -143,33 +176,73 @@ func (m *Member) getBalanceCall(params []byte) (interface{}, error) {
 	return w.GetBalance()
 }
 
-func (m *Member) transferCall(params []byte) (interface{}, error) {
-	var amount uint
-	var toStr string
-	var inAmount interface{}
-	if err := signer.UnmarshalParams(params, &inAmount, &toStr); err != nil {
-		return nil, fmt.Errorf("[ transferCall ] Can't unmarshal params: %s", err.Error())
-	}
+// maxExactFloat is maximal integer that float64 represents exactly
+const maxExactFloat = 1 << 53
+
+// parseAmount converts amount param into decimal string, amounts should be passed as strings,
+// integer numbers are accepted only while they can't lose precision
+func parseAmount(inAmount interface{}) (string, error) {
 	switch a := inAmount.(type) {
+	case string:
+		return a, nil
 	case uint:
-		amount = a
+		return strconv.FormatUint(uint64(a), 10), nil
 	case uint64:
-		if a > math.MaxUint32 {
-			return nil, errors.New("Transfer ammount bigger than integer")
+		return strconv.FormatUint(a, 10), nil
+	case int64:
+		if a < 0 {
+			return "", errors.New("Transfer amount must not be negative")
 		}
-		amount = uint(a)
-	case float32:
+		return strconv.FormatInt(a, 10), nil
+	case float64:
+		if a < 0 || a > maxExactFloat || a != math.Trunc(a) {
+			return "", errors.New("Transfer amount can't be represented exactly as number, pass it as string")
+		}
+		return strconv.FormatUint(uint64(a), 10), nil
+	default:
+		return "", fmt.Errorf("Wrong type for amount %T", inAmount)
+	}
+}
+
+// parseUint converts numeric param into uint
+func parseUint(in interface{}) (uint, error) {
+	switch a := in.(type) {
+	case uint:
+		return a, nil
+	case uint64:
 		if a > math.MaxUint32 {
-			return nil, errors.New("Transfer ammount bigger than integer")
+			return 0, errors.New("Value bigger than integer")
+		}
+		return uint(a), nil
+	case int64:
+		if a < 0 {
+			return 0, errors.New("Value must not be negative")
 		}
-		amount = uint(a)
+		return parseUint(uint64(a))
+	case float32:
+		return parseUint(float64(a))
 	case float64:
+		if a < 0 || a != math.Trunc(a) {
+			return 0, errors.New("Value must be non-negative integer")
+		}
 		if a > math.MaxUint32 {
-			return nil, errors.New("Transfer ammount bigger than integer")
+			return 0, errors.New("Value bigger than integer")
 		}
-		amount = uint(a)
+		return uint(a), nil
 	default:
-		return nil, fmt.Errorf("Wrong type for amount %t", inAmount)
+		return 0, fmt.Errorf("Wrong type for value %T", in)
+	}
+}
+
+func (m *Member) transferCall(ref core.RecordRef, params []byte) (interface{}, error) {
+	var toStr string
+	var inAmount interface{}
+	if err := signer.UnmarshalParams(params, &inAmount, &toStr); err != nil {
+		return nil, fmt.Errorf("[ transferCall ] Can't unmarshal params: %s", err.Error())
+	}
+	amount, err := parseAmount(inAmount)
+	if err != nil {
+		return nil, err
 	}
 	to, err := core.NewRefFromBase58(toStr)
 	if err != nil {
@@ -186,6 +259,298 @@ func (m *Member) transferCall(params []byte) (interface{}, error) {
 	return nil, w.Transfer(amount, to)
 }
 
+func (m *Member) setWalletSignersCall(ref core.RecordRef, params []byte) (interface{}, error) {
+	var signersStr []string
+	var inThreshold interface{}
+	if err := signer.UnmarshalParams(params, &signersStr, &inThreshold); err != nil {
+		return nil, fmt.Errorf("[ setWalletSignersCall ] Can't unmarshal params: %s", err.Error())
+	}
+	threshold, err := parseUint(inThreshold)
+	if err != nil {
+		return nil, fmt.Errorf("[ setWalletSignersCall ] Wrong threshold: %s", err.Error())
+	}
+	signers := make([]core.RecordRef, 0, len(signersStr))
+	for _, s := range signersStr {
+		signerRef, err := core.NewRefFromBase58(s)
+		if err != nil {
+			return nil, fmt.Errorf("[ setWalletSignersCall ] Failed to parse signer: %s", err.Error())
+		}
+		signers = append(signers, *signerRef)
+	}
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ setWalletSignersCall ] Can't get implementation: %s", err.Error())
+	}
+
+	return nil, w.SetSigners(signers, threshold)
+}
+
+func getWalletOf(owner string) (*wallet.Wallet, error) {
+	ownerRef, err := core.NewRefFromBase58(owner)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse wallet owner: %s", err.Error())
+	}
+	w, err := wallet.GetImplementationFrom(*ownerRef)
+	if err != nil {
+		return nil, fmt.Errorf("Can't get implementation: %s", err.Error())
+	}
+	return w, nil
+}
+
+func (m *Member) proposeTransferCall(ref core.RecordRef, params []byte) (interface{}, error) {
+	var owner string
+	var inAmount interface{}
+	var toStr string
+	if err := signer.UnmarshalParams(params, &owner, &inAmount, &toStr); err != nil {
+		return nil, fmt.Errorf("[ proposeTransferCall ] Can't unmarshal params: %s", err.Error())
+	}
+	amount, err := parseAmount(inAmount)
+	if err != nil {
+		return nil, err
+	}
+	to, err := core.NewRefFromBase58(toStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ proposeTransferCall ] Failed to parse 'to' param: %s", err.Error())
+	}
+	if owner == toStr {
+		return nil, fmt.Errorf("[ proposeTransferCall ] Recipient must be different from the sender")
+	}
+	w, err := getWalletOf(owner)
+	if err != nil {
+		return nil, fmt.Errorf("[ proposeTransferCall ] %s", err.Error())
+	}
+
+	return w.ProposeTransfer(amount, to)
+}
+
+func (m *Member) approveTransferCall(ref core.RecordRef, params []byte) (interface{}, error) {
+	var owner string
+	var proposalStr string
+	if err := signer.UnmarshalParams(params, &owner, &proposalStr); err != nil {
+		return nil, fmt.Errorf("[ approveTransferCall ] Can't unmarshal params: %s", err.Error())
+	}
+	proposalRef, err := core.NewRefFromBase58(proposalStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ approveTransferCall ] Failed to parse proposal: %s", err.Error())
+	}
+	w, err := getWalletOf(owner)
+	if err != nil {
+		return nil, fmt.Errorf("[ approveTransferCall ] %s", err.Error())
+	}
+
+	return nil, w.ApproveTransfer(proposalRef)
+}
+
+func (m *Member) getProposalsCall(ref core.RecordRef, params []byte) (interface{}, error) {
+	var owner string
+	if err := signer.UnmarshalParams(params, &owner); err != nil {
+		return nil, fmt.Errorf("[ getProposalsCall ] Can't unmarshal params: %s", err.Error())
+	}
+	w, err := getWalletOf(owner)
+	if err != nil {
+		return nil, fmt.Errorf("[ getProposalsCall ] %s", err.Error())
+	}
+
+	return w.GetProposals()
+}
+
+func (m *Member) getHistoryCall(ref core.RecordRef, params []byte) (interface{}, error) {
+	var inOffset, inLimit interface{}
+	if err := signer.UnmarshalParams(params, &inOffset, &inLimit); err != nil {
+		return nil, fmt.Errorf("[ getHistoryCall ] Can't unmarshal params: %s", err.Error())
+	}
+	offset, err := parseUint(inOffset)
+	if err != nil {
+		return nil, fmt.Errorf("[ getHistoryCall ] Wrong offset: %s", err.Error())
+	}
+	limit, err := parseUint(inLimit)
+	if err != nil {
+		return nil, fmt.Errorf("[ getHistoryCall ] Wrong limit: %s", err.Error())
+	}
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ getHistoryCall ] Can't get implementation: %s", err.Error())
+	}
+
+	return w.GetHistory(offset, limit)
+}
+
+func (m *Member) getStatementCall(ref core.RecordRef, params []byte) (interface{}, error) {
+	var inFrom, inTo interface{}
+	if err := signer.UnmarshalParams(params, &inFrom, &inTo); err != nil {
+		return nil, fmt.Errorf("[ getStatementCall ] Can't unmarshal params: %s", err.Error())
+	}
+	fromPulse, err := parseUint(inFrom)
+	if err != nil {
+		return nil, fmt.Errorf("[ getStatementCall ] Wrong from pulse: %s", err.Error())
+	}
+	toPulse, err := parseUint(inTo)
+	if err != nil {
+		return nil, fmt.Errorf("[ getStatementCall ] Wrong to pulse: %s", err.Error())
+	}
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ getStatementCall ] Can't get implementation: %s", err.Error())
+	}
+
+	return w.GetStatement(core.PulseNumber(fromPulse), core.PulseNumber(toPulse))
+}
+
+func (m *Member) issueTokenCall(ref core.RecordRef, params []byte) (interface{}, error) {
+	var name, symbol string
+	var inDecimals, inSupply interface{}
+	var mintable, burnable bool
+	if err := signer.UnmarshalParams(params, &name, &symbol, &inDecimals, &inSupply, &mintable, &burnable); err != nil {
+		return nil, fmt.Errorf("[ issueTokenCall ] Can't unmarshal params: %s", err.Error())
+	}
+	decimals, err := parseUint(inDecimals)
+	if err != nil {
+		return nil, fmt.Errorf("[ issueTokenCall ] Wrong decimals: %s", err.Error())
+	}
+	supply, err := parseAmount(inSupply)
+	if err != nil {
+		return nil, fmt.Errorf("[ issueTokenCall ] Wrong total supply: %s", err.Error())
+	}
+
+	th := token.New(name, symbol, decimals, mintable, burnable)
+	t, err := th.AsChild(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ issueTokenCall ] Can't save as child: %s", err.Error())
+	}
+
+	memberRef := m.GetReference()
+	err = t.Mint(supply, &memberRef)
+	if err != nil {
+		return nil, fmt.Errorf("[ issueTokenCall ] Can't mint total supply: %s", err.Error())
+	}
+
+	return t.GetReference().String(), nil
+}
+
+func getToken(tokenStr string) (*token.Token, *core.RecordRef, error) {
+	tokenRef, err := core.NewRefFromBase58(tokenStr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to parse token: %s", err.Error())
+	}
+	return token.GetObject(*tokenRef), tokenRef, nil
+}
+
+func (m *Member) mintTokenCall(ref core.RecordRef, params []byte) (interface{}, error) {
+	var tokenStr, toStr string
+	var inAmount interface{}
+	if err := signer.UnmarshalParams(params, &tokenStr, &inAmount, &toStr); err != nil {
+		return nil, fmt.Errorf("[ mintTokenCall ] Can't unmarshal params: %s", err.Error())
+	}
+	amount, err := parseAmount(inAmount)
+	if err != nil {
+		return nil, err
+	}
+	to, err := core.NewRefFromBase58(toStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ mintTokenCall ] Failed to parse 'to' param: %s", err.Error())
+	}
+	t, _, err := getToken(tokenStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ mintTokenCall ] %s", err.Error())
+	}
+
+	return nil, t.Mint(amount, to)
+}
+
+func (m *Member) transferTokenCall(ref core.RecordRef, params []byte) (interface{}, error) {
+	var tokenStr, toStr string
+	var inAmount interface{}
+	if err := signer.UnmarshalParams(params, &tokenStr, &inAmount, &toStr); err != nil {
+		return nil, fmt.Errorf("[ transferTokenCall ] Can't unmarshal params: %s", err.Error())
+	}
+	amount, err := parseAmount(inAmount)
+	if err != nil {
+		return nil, err
+	}
+	to, err := core.NewRefFromBase58(toStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ transferTokenCall ] Failed to parse 'to' param: %s", err.Error())
+	}
+	if m.GetReference() == *to {
+		return nil, fmt.Errorf("[ transferTokenCall ] Recipient must be different from the sender")
+	}
+	_, tokenRef, err := getToken(tokenStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ transferTokenCall ] %s", err.Error())
+	}
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ transferTokenCall ] Can't get implementation: %s", err.Error())
+	}
+
+	return nil, w.TransferToken(tokenRef, amount, to)
+}
+
+func (m *Member) burnTokenCall(ref core.RecordRef, params []byte) (interface{}, error) {
+	var tokenStr string
+	var inAmount interface{}
+	if err := signer.UnmarshalParams(params, &tokenStr, &inAmount); err != nil {
+		return nil, fmt.Errorf("[ burnTokenCall ] Can't unmarshal params: %s", err.Error())
+	}
+	amount, err := parseAmount(inAmount)
+	if err != nil {
+		return nil, err
+	}
+	_, tokenRef, err := getToken(tokenStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ burnTokenCall ] %s", err.Error())
+	}
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ burnTokenCall ] Can't get implementation: %s", err.Error())
+	}
+
+	return nil, w.BurnToken(tokenRef, amount)
+}
+
+func (m *Member) getTokenInfoCall(ref core.RecordRef, params []byte) (interface{}, error) {
+	var tokenStr string
+	if err := signer.UnmarshalParams(params, &tokenStr); err != nil {
+		return nil, fmt.Errorf("[ getTokenInfoCall ] Can't unmarshal params: %s", err.Error())
+	}
+	t, _, err := getToken(tokenStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ getTokenInfoCall ] %s", err.Error())
+	}
+	info, err := t.GetInfo()
+	if err != nil {
+		return nil, fmt.Errorf("[ getTokenInfoCall ] %s", err.Error())
+	}
+
+	return json.Marshal(info)
+}
+
+func (m *Member) getTokenBalanceCall(ref core.RecordRef, params []byte) (interface{}, error) {
+	var tokenStr, member string
+	if err := signer.UnmarshalParams(params, &tokenStr, &member); err != nil {
+		return nil, fmt.Errorf("[ getTokenBalanceCall ] Can't unmarshal params: %s", err.Error())
+	}
+	_, tokenRef, err := getToken(tokenStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ getTokenBalanceCall ] %s", err.Error())
+	}
+	w, err := getWalletOf(member)
+	if err != nil {
+		return nil, fmt.Errorf("[ getTokenBalanceCall ] %s", err.Error())
+	}
+
+	return w.GetTokenBalance(tokenRef)
+}
+
+func (m *Member) getTokenBalancesCall(ref core.RecordRef, params []byte) (interface{}, error) {
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ getTokenBalancesCall ] Can't get implementation: %s", err.Error())
+	}
+
+	return w.GetTokenBalances()
+}
+
 func (m *Member) dumpUserInfoCall(ref core.RecordRef, params []byte) (interface{}, error) {
 	rootDomain := rootdomain.GetObject(ref)
 	var user string
@@ -195,7 +560,7 @@ func (m *Member) dumpUserInfoCall(ref core.RecordRef, params []byte) (interface{
 	return rootDomain.DumpUserInfo(user)
 }
 
-func (m *Member) dumpAllUsersCall(ref core.RecordRef) (interface{}, error) {
+func (m *Member) dumpAllUsersCall(ref core.RecordRef, params []byte) (interface{}, error) {
 	rootDomain := rootdomain.GetObject(ref)
 	return rootDomain.DumpAllUsers()
 }
@@ -0,0 +1,129 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package member
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/insolar/insolar/application/proxy/rootdomain"
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+	"github.com/insolar/insolar/logicrunner/goplugin/proxyctx"
+	"github.com/insolar/insolar/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tylerb/gls"
+	"github.com/ugorji/go/codec"
+)
+
+// routesProxyHelper serves GetRoute calls from routes and records calls of routed methods.
+type routesProxyHelper struct {
+	proxyctx.ProxyHelper
+
+	rootDomain core.RecordRef
+	routes     map[string]rootdomain.Route
+	called     []string
+}
+
+func (h *routesProxyHelper) RouteCall(
+	ref core.RecordRef, wait bool, method string, args []byte, proxyPrototype core.RecordRef,
+) ([]byte, error) {
+	var res []byte
+	if method == "GetRoute" {
+		if ref != h.rootDomain {
+			return nil, errors.New("GetRoute is called on wrong object")
+		}
+		var name string
+		if err := h.Deserialize(args, &[]interface{}{&name}); err != nil {
+			return nil, err
+		}
+		route, ok := h.routes[name]
+		var routeErr *foundation.Error
+		if !ok {
+			routeErr = &foundation.Error{S: "unknown or forbidden route"}
+		}
+		err := h.Serialize([]interface{}{route, routeErr}, &res)
+		return res, err
+	}
+
+	h.called = append(h.called, ref.String()+"."+method)
+	err := h.Serialize([]interface{}{"ok", nil}, &res)
+	return res, err
+}
+
+func (h *routesProxyHelper) Serialize(what interface{}, to *[]byte) error {
+	return codec.NewEncoderBytes(to, new(codec.CborHandle)).Encode(what)
+}
+
+func (h *routesProxyHelper) Deserialize(from []byte, into interface{}) error {
+	return codec.NewDecoderBytes(from, new(codec.CborHandle)).Decode(into)
+}
+
+func TestMember_RoutedCall(t *testing.T) {
+	defer gls.Cleanup()
+
+	memberRef := testutils.RandomRef()
+	gls.Set("callCtx", &core.LogicCallContext{Callee: &memberRef})
+
+	target := testutils.RandomRef()
+	helper := &routesProxyHelper{
+		rootDomain: testutils.RandomRef(),
+		routes: map[string]rootdomain.Route{
+			"Vote": {Name: "Vote", Target: target, Method: "HandleVote", Role: "member"},
+		},
+	}
+	// Prototype references of proxies are set when contracts are built.
+	if rootdomain.PrototypeReference == nil {
+		prototype := testutils.RandomRef()
+		rootdomain.PrototypeReference = &prototype
+	}
+	prevHelper := proxyctx.Current
+	proxyctx.Current = helper
+	defer func() { proxyctx.Current = prevHelper }()
+
+	m := &Member{}
+
+	res, err := m.routedCall(helper.rootDomain, "Vote", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", res)
+	assert.Equal(t, []string{target.String() + ".HandleVote"}, helper.called)
+
+	// Route is not returned for methods member can't call, including builtin ones.
+	_, err = m.routedCall(helper.rootDomain, "DumpAllUsers", nil)
+	assert.Error(t, err)
+	_, err = m.routedCall(helper.rootDomain, "Unknown", nil)
+	assert.Error(t, err)
+	assert.Len(t, helper.called, 1)
+}
+
+func TestParseUint(t *testing.T) {
+	for _, in := range []interface{}{uint(7), uint64(7), int64(7), float32(7), float64(7)} {
+		res, err := parseUint(in)
+		require.NoError(t, err, "%T", in)
+		assert.Equal(t, uint(7), res)
+	}
+
+	for _, in := range []interface{}{
+		int64(-1), float32(-1), float64(-1), float64(1.5), float32(0.5), math.NaN(), math.Inf(1),
+		uint64(math.MaxUint32 + 1), float64(math.MaxUint32 + 1), "7",
+	} {
+		_, err := parseUint(in)
+		assert.Error(t, err, "%T %v", in, in)
+	}
+}
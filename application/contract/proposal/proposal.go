@@ -0,0 +1,140 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package proposal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/insolar/insolar/application/proxy/wallet"
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+)
+
+// Proposal is pending transfer from multisig wallet, it holds amount until enough signers approve it
+// To is reference of recipient member
+type Proposal struct {
+	foundation.BaseContract
+	To         core.RecordRef
+	Amount     string
+	Threshold  uint
+	Approvals  []core.RecordRef
+	ExpireTime int64
+}
+
+// Info contains public state of proposal
+type Info struct {
+	Reference  string   `json:"reference"`
+	To         string   `json:"to"`
+	Amount     string   `json:"amount"`
+	Threshold  uint     `json:"threshold"`
+	Approvals  []string `json:"approvals"`
+	ExpireTime int64    `json:"expire_time"`
+}
+
+func (p *Proposal) isExpired() bool {
+	return p.GetContext().Time.After(time.Unix(p.ExpireTime, 0))
+}
+
+func (p *Proposal) isApproved() bool {
+	return uint(len(p.Approvals)) >= p.Threshold
+}
+
+func (p *Proposal) checkOwner(method string) error {
+	if *(p.GetContext().Caller) != *(p.GetContext().Parent) {
+		return fmt.Errorf("[ %s ] Only owner wallet can call this method", method)
+	}
+	return nil
+}
+
+// Approve adds approval of signer and returns true if proposal has enough approvals
+func (p *Proposal) Approve(signer *core.RecordRef) (bool, error) {
+	if err := p.checkOwner("Approve"); err != nil {
+		return false, err
+	}
+	if p.isExpired() {
+		return false, fmt.Errorf("[ Approve ] Proposal expired")
+	}
+	for _, a := range p.Approvals {
+		if a == *signer {
+			return false, fmt.Errorf("[ Approve ] Signer has already approved proposal")
+		}
+	}
+	p.Approvals = append(p.Approvals, *signer)
+	return p.isApproved(), nil
+}
+
+// Execute returns amount of approved proposal and deletes proposal
+func (p *Proposal) Execute() (string, error) {
+	if err := p.checkOwner("Execute"); err != nil {
+		return "", err
+	}
+	if p.isExpired() {
+		return "", fmt.Errorf("[ Execute ] Proposal expired")
+	}
+	if !p.isApproved() {
+		return "", fmt.Errorf("[ Execute ] Proposal has not enough approvals")
+	}
+	if err := p.SelfDestruct(); err != nil {
+		return "", err
+	}
+	return p.Amount, nil
+}
+
+// GetInfo returns public state of proposal
+func (p *Proposal) GetInfo() (Info, error) {
+	approvals := make([]string, 0, len(p.Approvals))
+	for _, a := range p.Approvals {
+		approvals = append(approvals, a.String())
+	}
+	return Info{
+		Reference:  p.GetReference().String(),
+		To:         p.To.String(),
+		Amount:     p.Amount,
+		Threshold:  p.Threshold,
+		Approvals:  approvals,
+		ExpireTime: p.ExpireTime,
+	}, nil
+}
+
+// GetExpiredBalance gets balance from expired proposal and deletes proposal
+func (p *Proposal) GetExpiredBalance() (string, error) {
+	if err := p.checkOwner("GetExpiredBalance"); err != nil {
+		return "", err
+	}
+	if p.isExpired() {
+		if err := p.SelfDestruct(); err != nil {
+			return "", err
+		}
+		return p.Amount, nil
+	}
+	return "", nil
+}
+
+// New checks that caller is wallet and makes new proposal approved by proposer, amount is integer number of minimal units
+func New(to *core.RecordRef, amount string, threshold uint, proposer *core.RecordRef, expire int64) (*Proposal, error) {
+	if !wallet.PrototypeReference.Equal(*foundation.GetContext().CallerPrototype) {
+		return nil, fmt.Errorf("[ New Proposal ] : Can't create proposal from not wallet contract")
+	}
+	return &Proposal{
+		To:         *to,
+		Amount:     amount,
+		Threshold:  threshold,
+		Approvals:  []core.RecordRef{*proposer},
+		ExpireTime: expire,
+	}, nil
+}
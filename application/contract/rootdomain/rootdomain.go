@@ -19,6 +19,7 @@ package rootdomain
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/insolar/insolar/application/proxy/member"
 	"github.com/insolar/insolar/application/proxy/wallet"
@@ -31,6 +32,74 @@ type RootDomain struct {
 	foundation.BaseContract
 	RootMember    core.RecordRef
 	NodeDomainRef core.RecordRef
+	Routes        map[string]Route
+}
+
+// RouteParam describes parameter of routed method
+type RouteParam struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Route describes method that members can call via Call
+// Handler of routed method is called on Target as Method(caller core.RecordRef, params []byte) (interface{}, error)
+// Handler must check caller with foundation.CheckRouteCaller, because any contract can call it directly
+// Builtin routes have empty Target and are handled by member contract itself
+// Target and Method aren't exposed via API, but are kept in contract's memory
+type Route struct {
+	Name     string         `json:"name"`
+	Target   core.RecordRef `json:"-" codec:"target"`
+	Method   string         `json:"-" codec:"method"`
+	Params   []RouteParam   `json:"params"`
+	Role     string         `json:"role"`
+	Unsigned bool           `json:"unsigned,omitempty"`
+}
+
+const (
+	// RoleMember allows any member to call route
+	RoleMember = "member"
+	// RoleRoot allows only root member to call route
+	RoleRoot = "root"
+)
+
+func builtinRoutes() []Route {
+	return []Route{
+		{Name: "CreateMember", Params: []RouteParam{{"name", "string"}, {"key", "string"}}, Role: RoleMember, Unsigned: true},
+		{Name: "GetMyBalance", Role: RoleMember},
+		{Name: "GetBalance", Params: []RouteParam{{"reference", "string"}}, Role: RoleMember},
+		{Name: "Transfer", Params: []RouteParam{{"amount", "decimal"}, {"to", "string"}}, Role: RoleMember},
+		{Name: "DumpUserInfo", Params: []RouteParam{{"reference", "string"}}, Role: RoleMember},
+		{Name: "DumpAllUsers", Role: RoleRoot},
+		{Name: "RegisterNode", Params: []RouteParam{{"publicKey", "string"}, {"role", "string"}}, Role: RoleRoot},
+		{Name: "GetNodeRef", Params: []RouteParam{{"publicKey", "string"}}, Role: RoleMember},
+		{Name: "SetWalletSigners", Params: []RouteParam{{"signers", "[]string"}, {"threshold", "uint"}}, Role: RoleMember},
+		{Name: "ProposeTransfer", Params: []RouteParam{{"walletOwner", "string"}, {"amount", "decimal"}, {"to", "string"}}, Role: RoleMember},
+		{Name: "ApproveTransfer", Params: []RouteParam{{"walletOwner", "string"}, {"proposal", "string"}}, Role: RoleMember},
+		{Name: "GetProposals", Params: []RouteParam{{"walletOwner", "string"}}, Role: RoleMember},
+		{Name: "GetHistory", Params: []RouteParam{{"offset", "uint"}, {"limit", "uint"}}, Role: RoleMember},
+		{Name: "GetStatement", Params: []RouteParam{{"fromPulse", "uint"}, {"toPulse", "uint"}}, Role: RoleMember},
+		{Name: "IssueToken", Params: []RouteParam{{"name", "string"}, {"symbol", "string"}, {"decimals", "uint"}, {"totalSupply", "decimal"}, {"mintable", "bool"}, {"burnable", "bool"}}, Role: RoleMember},
+		{Name: "MintToken", Params: []RouteParam{{"token", "string"}, {"amount", "decimal"}, {"to", "string"}}, Role: RoleMember},
+		{Name: "TransferToken", Params: []RouteParam{{"token", "string"}, {"amount", "decimal"}, {"to", "string"}}, Role: RoleMember},
+		{Name: "BurnToken", Params: []RouteParam{{"token", "string"}, {"amount", "decimal"}}, Role: RoleMember},
+		{Name: "GetTokenInfo", Params: []RouteParam{{"token", "string"}}, Role: RoleMember},
+		{Name: "GetTokenBalance", Params: []RouteParam{{"token", "string"}, {"reference", "string"}}, Role: RoleMember},
+		{Name: "GetTokenBalances", Role: RoleMember},
+	}
+}
+
+func builtinRoute(name string) (Route, bool) {
+	for _, r := range builtinRoutes() {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return Route{}, false
+}
+
+func isBuiltinRoute(name string) bool {
+	_, ok := builtinRoute(name)
+	return ok
 }
 
 var INSATTR_CreateMember_API = true
@@ -43,7 +112,7 @@ func (rd *RootDomain) CreateMember(name string, key string) (string, error) {
 		return "", fmt.Errorf("[ CreateMember ] Can't save as child: %s", err.Error())
 	}
 
-	wHolder := wallet.New(1000 * 1000 * 1000)
+	wHolder := wallet.New("1000000000")
 	_, err = wHolder.AsDelegate(m.GetReference())
 	if err != nil {
 		return "", fmt.Errorf("[ CreateMember ] Can't save as delegate: %s", err.Error())
@@ -144,6 +213,86 @@ func (rd *RootDomain) Info() (interface{}, error) {
 	return resJSON, nil
 }
 
+// RegisterRoute registers handler of routed method
+// Only root member or target of route itself can register route
+func (rd *RootDomain) RegisterRoute(route Route) error {
+	caller := *rd.GetContext().Caller
+	if caller != rd.RootMember && caller != route.Target {
+		return fmt.Errorf("[ RegisterRoute ] Only root member or route target can register route")
+	}
+	if route.Name == "" || route.Method == "" || route.Target.IsEmpty() {
+		return fmt.Errorf("[ RegisterRoute ] Route name, target and method must be set")
+	}
+	if isBuiltinRoute(route.Name) {
+		return fmt.Errorf("[ RegisterRoute ] Route %s is builtin", route.Name)
+	}
+	if route.Role != RoleMember && route.Role != RoleRoot {
+		return fmt.Errorf("[ RegisterRoute ] Unknown role %s", route.Role)
+	}
+	if route.Unsigned {
+		return fmt.Errorf("[ RegisterRoute ] Only builtin routes can be unsigned")
+	}
+	if old, ok := rd.Routes[route.Name]; ok && caller != rd.RootMember && old.Target != route.Target {
+		return fmt.Errorf("[ RegisterRoute ] Route %s is registered by another contract", route.Name)
+	}
+
+	if rd.Routes == nil {
+		rd.Routes = map[string]Route{}
+	}
+	rd.Routes[route.Name] = route
+	return nil
+}
+
+// UnregisterRoute removes routed method
+// Only root member or target of route can unregister route
+func (rd *RootDomain) UnregisterRoute(name string) error {
+	route, ok := rd.Routes[name]
+	if !ok {
+		return fmt.Errorf("[ UnregisterRoute ] Route %s is not registered", name)
+	}
+	caller := *rd.GetContext().Caller
+	if caller != rd.RootMember && caller != route.Target {
+		return fmt.Errorf("[ UnregisterRoute ] Only root member or route target can unregister route")
+	}
+	delete(rd.Routes, name)
+	return nil
+}
+
+// GetRoute returns builtin or registered route by name and checks that caller can call it
+func (rd *RootDomain) GetRoute(name string) (Route, error) {
+	route, ok := builtinRoute(name)
+	if !ok {
+		route, ok = rd.Routes[name]
+	}
+	if !ok {
+		return Route{}, fmt.Errorf("[ GetRoute ] Unknown method %s", name)
+	}
+	if route.Role == RoleRoot && *rd.GetContext().Caller != rd.RootMember {
+		return Route{}, fmt.Errorf("[ GetRoute ] Only root member can call %s", name)
+	}
+	return route, nil
+}
+
+var INSATTR_ListRoutes_API = true
+
+// ListRoutes returns description of all methods that members can call
+func (rd *RootDomain) ListRoutes() (interface{}, error) {
+	res := builtinRoutes()
+	names := make([]string, 0, len(rd.Routes))
+	for name := range rd.Routes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		res = append(res, rd.Routes[name])
+	}
+	resJSON, err := json.Marshal(res)
+	if err != nil {
+		return nil, fmt.Errorf("[ ListRoutes ] Can't marshal res: %s", err.Error())
+	}
+	return resJSON, nil
+}
+
 // GetNodeDomainRef returns reference of NodeDomain instance
 func (rd *RootDomain) GetNodeDomainRef() (core.RecordRef, error) {
 	return rd.NodeDomainRef, nil
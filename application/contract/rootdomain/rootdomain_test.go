@@ -0,0 +1,110 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package rootdomain
+
+import (
+	"testing"
+
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tylerb/gls"
+)
+
+func setCaller(caller core.RecordRef) {
+	gls.Set("callCtx", &core.LogicCallContext{Caller: &caller})
+}
+
+func TestRootDomain_RegisterRoute(t *testing.T) {
+	defer gls.Cleanup()
+
+	rootMember := testutils.RandomRef()
+	target := testutils.RandomRef()
+	rd := RootDomain{RootMember: rootMember}
+	route := Route{Name: "Vote", Target: target, Method: "Vote", Role: RoleMember}
+
+	setCaller(testutils.RandomRef())
+	require.Error(t, rd.RegisterRoute(route), "only root member or target can register route")
+
+	setCaller(target)
+	require.Error(t, rd.RegisterRoute(Route{Name: "Transfer", Target: target, Method: "Vote", Role: RoleMember}))
+	require.Error(t, rd.RegisterRoute(Route{Name: "Vote", Target: target, Role: RoleMember}))
+	require.Error(t, rd.RegisterRoute(Route{Name: "Vote", Target: target, Method: "Vote", Role: "admin"}))
+	require.Error(t, rd.RegisterRoute(Route{Name: "Vote", Target: target, Method: "Vote", Role: RoleMember, Unsigned: true}))
+	require.NoError(t, rd.RegisterRoute(route))
+	assert.Equal(t, route, rd.Routes["Vote"])
+
+	other := testutils.RandomRef()
+	setCaller(other)
+	require.Error(t, rd.RegisterRoute(Route{Name: "Vote", Target: other, Method: "Vote", Role: RoleMember}),
+		"route of another contract can't be replaced")
+
+	setCaller(rootMember)
+	replaced := Route{Name: "Vote", Target: other, Method: "Vote", Role: RoleRoot}
+	require.NoError(t, rd.RegisterRoute(replaced))
+	assert.Equal(t, replaced, rd.Routes["Vote"])
+}
+
+func TestRootDomain_UnregisterRoute(t *testing.T) {
+	defer gls.Cleanup()
+
+	rootMember := testutils.RandomRef()
+	target := testutils.RandomRef()
+	rd := RootDomain{RootMember: rootMember, Routes: map[string]Route{
+		"Vote": {Name: "Vote", Target: target, Method: "Vote", Role: RoleMember},
+	}}
+
+	setCaller(testutils.RandomRef())
+	require.Error(t, rd.UnregisterRoute("Vote"))
+	require.Error(t, rd.UnregisterRoute("Unknown"))
+
+	setCaller(target)
+	require.NoError(t, rd.UnregisterRoute("Vote"))
+	assert.Empty(t, rd.Routes)
+}
+
+func TestRootDomain_GetRoute(t *testing.T) {
+	defer gls.Cleanup()
+
+	rootMember := testutils.RandomRef()
+	rd := RootDomain{RootMember: rootMember, Routes: map[string]Route{
+		"Vote":  {Name: "Vote", Target: testutils.RandomRef(), Method: "Vote", Role: RoleMember},
+		"Close": {Name: "Close", Target: testutils.RandomRef(), Method: "Close", Role: RoleRoot},
+	}}
+
+	setCaller(testutils.RandomRef())
+	for _, name := range []string{"Transfer", "Vote"} {
+		route, err := rd.GetRoute(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, name, route.Name)
+	}
+	for _, name := range []string{"DumpAllUsers", "RegisterNode", "Close", "Unknown"} {
+		_, err := rd.GetRoute(name)
+		assert.Error(t, err, name)
+	}
+
+	setCaller(rootMember)
+	for _, name := range []string{"DumpAllUsers", "RegisterNode", "Close"} {
+		route, err := rd.GetRoute(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, RoleRoot, route.Role)
+	}
+	route, err := rd.GetRoute("DumpAllUsers")
+	require.NoError(t, err)
+	assert.True(t, route.Target.IsEmpty(), "builtin route is handled by member")
+}
@@ -0,0 +1,209 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package token
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/insolar/insolar/application/contract/wallet/safemath"
+	"github.com/insolar/insolar/application/proxy/allowance"
+	"github.com/insolar/insolar/application/proxy/wallet"
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+)
+
+// maxDecimals is maximal number of decimal places of token amounts
+const maxDecimals = 18
+
+// mintLifetime is time in seconds during which recipient wallet should accept minted amount
+const mintLifetime = 10
+
+// Token is definition of fungible token, it is saved as child of issuer member
+// TotalSupply is integer number of minimal units, public methods accept and return decimal amounts
+// Token without Mintable policy can be minted only once at issue
+type Token struct {
+	foundation.BaseContract
+	Name        string
+	Symbol      string
+	Decimals    uint
+	TotalSupply string
+	Mintable    bool
+	Burnable    bool
+	Issued      bool
+}
+
+// Info contains public state of token
+type Info struct {
+	Reference   string `json:"reference"`
+	Name        string `json:"name"`
+	Symbol      string `json:"symbol"`
+	Decimals    uint   `json:"decimals"`
+	Issuer      string `json:"issuer"`
+	TotalSupply string `json:"total_supply"`
+	Mintable    bool   `json:"mintable"`
+	Burnable    bool   `json:"burnable"`
+}
+
+func (t *Token) totalSupply() (*big.Int, error) {
+	return safemath.ParseUnits(t.TotalSupply)
+}
+
+// reclaimExpired removes minted amounts that recipients didn't accept in time from total supply
+func (t *Token) reclaimExpired() error {
+	iterator, err := t.NewChildrenTypedIterator(allowance.GetPrototype())
+	if err != nil {
+		return fmt.Errorf("Can't get children: %s", err.Error())
+	}
+
+	for iterator.HasNext() {
+		cref, err := iterator.Next()
+		if err != nil {
+			return fmt.Errorf("Can't get next child: %s", err.Error())
+		}
+		if cref.IsEmpty() {
+			continue
+		}
+
+		expired, err := allowance.GetObject(cref).GetExpiredBalance()
+		if err != nil || expired == "" {
+			continue
+		}
+		if err := t.sub(expired); err != nil {
+			return fmt.Errorf("Couldn't reclaim expired amount: %s", err.Error())
+		}
+	}
+	return nil
+}
+
+func (t *Token) sub(units string) error {
+	supply, err := t.totalSupply()
+	if err != nil {
+		return fmt.Errorf("Wrong total supply: %s", err.Error())
+	}
+	amount, err := safemath.ParseUnits(units)
+	if err != nil {
+		return fmt.Errorf("Wrong amount: %s", err.Error())
+	}
+	newSupply, err := safemath.Sub(supply, amount)
+	if err != nil {
+		return err
+	}
+	t.TotalSupply = newSupply.String()
+	return nil
+}
+
+// GetInfo returns public state of token
+func (t *Token) GetInfo() (Info, error) {
+	if err := t.reclaimExpired(); err != nil {
+		return Info{}, fmt.Errorf("[ GetInfo ] %s", err.Error())
+	}
+	supply, err := t.totalSupply()
+	if err != nil {
+		return Info{}, fmt.Errorf("[ GetInfo ] Wrong total supply: %s", err.Error())
+	}
+	return Info{
+		Reference:   t.GetReference().String(),
+		Name:        t.Name,
+		Symbol:      t.Symbol,
+		Decimals:    t.Decimals,
+		Issuer:      t.GetContext().Parent.String(),
+		TotalSupply: safemath.FormatAmount(supply, t.Decimals),
+		Mintable:    t.Mintable,
+		Burnable:    t.Burnable,
+	}, nil
+}
+
+// GetDecimals returns number of decimal places of token amounts
+func (t *Token) GetDecimals() (uint, error) {
+	return t.Decimals, nil
+}
+
+// Mint issues decimal amount of tokens to wallet of given member, only issuer can mint tokens
+func (t *Token) Mint(amount string, to *core.RecordRef) error {
+	issuer := *t.GetContext().Parent
+	if *t.GetContext().Caller != issuer {
+		return fmt.Errorf("[ Mint ] Only issuer can mint tokens")
+	}
+	if t.Issued && !t.Mintable {
+		return fmt.Errorf("[ Mint ] Token supply is fixed")
+	}
+
+	units, err := safemath.ParseAmount(amount, t.Decimals)
+	if err != nil {
+		return fmt.Errorf("[ Mint ] Wrong amount: %s", err.Error())
+	}
+	supply, err := t.totalSupply()
+	if err != nil {
+		return fmt.Errorf("[ Mint ] Wrong total supply: %s", err.Error())
+	}
+	newSupply, err := safemath.Add(supply, units)
+	if err != nil {
+		return fmt.Errorf("[ Mint ] %s", err.Error())
+	}
+
+	toWallet, err := wallet.GetImplementationFrom(*to)
+	if err != nil {
+		return fmt.Errorf("[ Mint ] Can't get implementation: %s", err.Error())
+	}
+	toWalletRef := toWallet.GetReference()
+	tokenRef := t.GetReference()
+
+	ah := allowance.New(&issuer, &toWalletRef, to, &tokenRef, units.String(), t.GetContext().Time.Unix()+mintLifetime)
+	a, err := ah.AsChild(tokenRef)
+	if err != nil {
+		return fmt.Errorf("[ Mint ] Can't save as child: %s", err.Error())
+	}
+
+	t.TotalSupply = newSupply.String()
+	t.Issued = true
+
+	r := a.GetReference()
+	return toWallet.AcceptNoWait(&r)
+}
+
+// Burn removes amount of minimal units from total supply, it is called by wallet that already withdrew amount
+func (t *Token) Burn(units string) error {
+	if !wallet.PrototypeReference.Equal(*t.GetContext().CallerPrototype) {
+		return fmt.Errorf("[ Burn ] Only wallet can burn tokens")
+	}
+	if !t.Burnable {
+		return fmt.Errorf("[ Burn ] Token can't be burned")
+	}
+	if err := t.sub(units); err != nil {
+		return fmt.Errorf("[ Burn ] %s", err.Error())
+	}
+	return nil
+}
+
+// New creates definition of token with zero total supply
+func New(name string, symbol string, decimals uint, mintable bool, burnable bool) (*Token, error) {
+	if name == "" || symbol == "" {
+		return nil, fmt.Errorf("[ New Token ] Name and symbol must not be empty")
+	}
+	if decimals > maxDecimals {
+		return nil, fmt.Errorf("[ New Token ] Decimals must not be greater than %d", maxDecimals)
+	}
+	return &Token{
+		Name:        name,
+		Symbol:      symbol,
+		Decimals:    decimals,
+		TotalSupply: "0",
+		Mintable:    mintable,
+		Burnable:    burnable,
+	}, nil
+}
@@ -18,54 +18,133 @@ package safemath
 
 import (
 	"errors"
+	"fmt"
+	"math/big"
+	"strings"
 )
 
-// Mul multiplies two uints, error on overflow.
-func Mul(a uint, b uint) (uint, error) {
-	if a == 0 {
-		return 0, nil
+// Mul multiplies two non-negative amounts.
+func Mul(a *big.Int, b *big.Int) (*big.Int, error) {
+	if err := checkNonNegative(a, b); err != nil {
+		return nil, err
 	}
+	return new(big.Int).Mul(a, b), nil
+}
 
-	c := a * b
-	if c/a != b {
-		return 0, errors.New("multiplication overflow")
+// Div is integer division of two non-negative amounts truncating the quotient, reverts on division by zero.
+func Div(a *big.Int, b *big.Int) (*big.Int, error) {
+	if err := checkNonNegative(a, b); err != nil {
+		return nil, err
+	}
+	if b.Sign() == 0 {
+		return nil, errors.New("divisor cannot be zero")
 	}
-	return c, nil
+
+	return new(big.Int).Quo(a, b), nil
 }
 
-// Div is integer division of two uints truncating the quotient, reverts on division by zero.
-func Div(a uint, b uint) (uint, error) {
-	if b == 0 {
-		return 0, errors.New("divisor cannot be zero")
+// Sub subtracts two non-negative amounts, reverts if subtrahend is greater than minuend.
+func Sub(a *big.Int, b *big.Int) (*big.Int, error) {
+	if err := checkNonNegative(a, b); err != nil {
+		return nil, err
+	}
+	if a.Cmp(b) < 0 {
+		return nil, errors.New("subtrahend must be smaller than minuend")
+	}
+	return new(big.Int).Sub(a, b), nil
+}
+
+// Add adds two non-negative amounts.
+func Add(a *big.Int, b *big.Int) (*big.Int, error) {
+	if err := checkNonNegative(a, b); err != nil {
+		return nil, err
+	}
+	return new(big.Int).Add(a, b), nil
+}
+
+// Mod divides two non-negative amounts and returns the remainder, reverts when dividing by zero.
+func Mod(a *big.Int, b *big.Int) (*big.Int, error) {
+	if err := checkNonNegative(a, b); err != nil {
+		return nil, err
+	}
+	if b.Sign() == 0 {
+		return nil, errors.New("divisor cannot be zero")
 	}
 
-	return a / b, nil
+	return new(big.Int).Rem(a, b), nil
 }
 
-// Sub subtracts two uints, reverts on overflow (i.e. if subtrahend is greater than minuend).
-func Sub(a uint, b uint) (uint, error) {
-	if a < b {
-		return 0, errors.New("subtrahend must be smaller than minuend")
+func checkNonNegative(values ...*big.Int) error {
+	for _, v := range values {
+		if v == nil {
+			return errors.New("amount is not set")
+		}
+		if v.Sign() < 0 {
+			return errors.New("amount must not be negative")
+		}
 	}
-	return a - b, nil
+	return nil
 }
 
-// Add adds two uints, reverts on overflow.
-func Add(a uint, b uint) (uint, error) {
-	c := a + b
+// ParseUnits parses non-negative integer number of minimal units, as amounts are stored in contracts state.
+// Empty string is zero.
+func ParseUnits(s string) (*big.Int, error) {
+	if s == "" {
+		return new(big.Int), nil
+	}
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("wrong amount %q", s)
+	}
+	if v.Sign() < 0 {
+		return nil, errors.New("amount must not be negative")
+	}
+	return v, nil
+}
 
-	if c < a {
-		return 0, errors.New("overflow at addition")
+// ParseAmount parses non-negative decimal amount like "12.05" into integer number of minimal units,
+// amount can't have more fractional digits than decimals.
+func ParseAmount(s string, decimals uint) (*big.Int, error) {
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	if intPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return nil, fmt.Errorf("wrong amount %q", s)
+	}
+	fracPart = strings.TrimRight(fracPart, "0")
+	if uint(len(fracPart)) > decimals {
+		return nil, fmt.Errorf("amount %q has more than %d decimals", s, decimals)
 	}
 
-	return c, nil
+	v, ok := new(big.Int).SetString(intPart+fracPart+strings.Repeat("0", int(decimals)-len(fracPart)), 10)
+	if !ok {
+		return nil, fmt.Errorf("wrong amount %q", s)
+	}
+	return v, nil
 }
 
-// Mod divides two uints and returns the remainder (unsigned integer modulo), reverts when dividing by zero.
-func Mod(a uint, b uint) (uint, error) {
-	if b == 0 {
-		return 0, errors.New("divisor cannot be zero")
+// FormatAmount formats integer number of minimal units as decimal amount without trailing zeros.
+func FormatAmount(v *big.Int, decimals uint) string {
+	s := v.String()
+	if decimals == 0 {
+		return s
+	}
+	if len(s) <= int(decimals) {
+		s = strings.Repeat("0", int(decimals)-len(s)+1) + s
+	}
+	intPart, fracPart := s[:len(s)-int(decimals)], strings.TrimRight(s[len(s)-int(decimals):], "0")
+	if fracPart == "" {
+		return intPart
 	}
+	return intPart + "." + fracPart
+}
 
-	return a % b, nil
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
 }
@@ -0,0 +1,115 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package safemath
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAmount(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	for _, tc := range []struct {
+		in       string
+		decimals uint
+		out      *big.Int
+	}{
+		{"0", 2, big.NewInt(0)},
+		{"12", 0, big.NewInt(12)},
+		{"12", 2, big.NewInt(1200)},
+		{"12.5", 2, big.NewInt(1250)},
+		{"12.05", 2, big.NewInt(1205)},
+		{"0.01", 2, big.NewInt(1)},
+		{"12.500", 2, big.NewInt(1250)},
+		{"12.", 2, big.NewInt(1200)},
+		{"1234567890123456789012.34567890", 8, huge},
+	} {
+		v, err := ParseAmount(tc.in, tc.decimals)
+		require.NoError(t, err, tc.in)
+		assert.Equal(t, 0, tc.out.Cmp(v), tc.in)
+	}
+
+	for _, in := range []string{"", ".5", "-1", "1.2.3", "1e5", "abc", "0.001"} {
+		_, err := ParseAmount(in, 2)
+		assert.Error(t, err, in)
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	assert.Equal(t, "0", FormatAmount(big.NewInt(0), 2))
+	assert.Equal(t, "12", FormatAmount(big.NewInt(12), 0))
+	assert.Equal(t, "12", FormatAmount(big.NewInt(1200), 2))
+	assert.Equal(t, "12.5", FormatAmount(big.NewInt(1250), 2))
+	assert.Equal(t, "0.01", FormatAmount(big.NewInt(1), 2))
+	assert.Equal(t, "1234567890123456789012.3456789", FormatAmount(huge, 8))
+}
+
+func TestParseUnits(t *testing.T) {
+	v, err := ParseUnits("")
+	require.NoError(t, err)
+	assert.Equal(t, 0, v.Sign())
+
+	v, err = ParseUnits("18446744073709551616")
+	require.NoError(t, err)
+	assert.Equal(t, "18446744073709551616", v.String())
+
+	_, err = ParseUnits("-1")
+	assert.Error(t, err)
+	_, err = ParseUnits("1.5")
+	assert.Error(t, err)
+}
+
+func TestArithmetic(t *testing.T) {
+	maxUint64 := new(big.Int).SetUint64(^uint64(0))
+
+	sum, err := Add(maxUint64, big.NewInt(1))
+	require.NoError(t, err)
+	assert.Equal(t, "18446744073709551616", sum.String())
+
+	diff, err := Sub(sum, big.NewInt(1))
+	require.NoError(t, err)
+	assert.Equal(t, 0, maxUint64.Cmp(diff))
+
+	_, err = Sub(big.NewInt(1), big.NewInt(2))
+	assert.Error(t, err)
+
+	_, err = Add(big.NewInt(-1), big.NewInt(2))
+	assert.Error(t, err)
+
+	prod, err := Mul(big.NewInt(3), big.NewInt(4))
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), prod.Int64())
+
+	quo, err := Div(big.NewInt(7), big.NewInt(2))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), quo.Int64())
+
+	rem, err := Mod(big.NewInt(7), big.NewInt(2))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), rem.Int64())
+
+	_, err = Div(big.NewInt(7), big.NewInt(0))
+	assert.Error(t, err)
+	_, err = Mod(big.NewInt(7), big.NewInt(0))
+	assert.Error(t, err)
+}
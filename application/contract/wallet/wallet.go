@@ -17,97 +17,692 @@
 package wallet
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"sort"
+	"time"
 
 	"github.com/insolar/insolar/application/contract/wallet/safemath"
 	"github.com/insolar/insolar/application/proxy/allowance"
+	"github.com/insolar/insolar/application/proxy/historyentry"
+	"github.com/insolar/insolar/application/proxy/proposal"
+	"github.com/insolar/insolar/application/proxy/token"
 	"github.com/insolar/insolar/application/proxy/wallet"
 	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
 )
 
+// proposalLifetime is time in seconds during which signers can approve proposed transfer
+const proposalLifetime = 24 * 60 * 60
+
+// decimals is number of decimal places of wallet amounts
+const decimals = 8
+
 // Wallet - basic wallet contract
+// Wallet with non zero Threshold is multisig wallet, its transfers must be approved by Threshold of Signers
+// Balance is integer number of minimal units, public methods accept and return decimal amounts
+// Tokens maps token reference to balance of that token in minimal units
 type Wallet struct {
 	foundation.BaseContract
-	Balance uint
+	Balance   string
+	Tokens    map[string]string
+	Signers   []core.RecordRef
+	Threshold uint
+}
+
+// balanceOf returns balance of token in minimal units, empty token reference means native currency
+func (w *Wallet) balanceOf(tokenRef core.RecordRef) (*big.Int, error) {
+	if tokenRef.IsEmpty() {
+		return safemath.ParseUnits(w.Balance)
+	}
+	return safemath.ParseUnits(w.Tokens[tokenRef.String()])
+}
+
+func (w *Wallet) setBalanceOf(tokenRef core.RecordRef, balance *big.Int) {
+	if tokenRef.IsEmpty() {
+		w.Balance = balance.String()
+		return
+	}
+	if w.Tokens == nil {
+		w.Tokens = map[string]string{}
+	}
+	w.Tokens[tokenRef.String()] = balance.String()
+}
+
+// withdraw returns balance of token that remains after withdrawing decimal amount and amount in minimal units
+func (w *Wallet) withdraw(tokenRef core.RecordRef, amount string, precision uint) (*big.Int, *big.Int, error) {
+	units, err := safemath.ParseAmount(amount, precision)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Wrong amount: %s", err.Error())
+	}
+	balance, err := w.balanceOf(tokenRef)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Wrong balance: %s", err.Error())
+	}
+	newBalance, err := safemath.Sub(balance, units)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Not enough balance for transfer: %s", err.Error())
+	}
+	return newBalance, units, nil
+}
+
+func (w *Wallet) deposit(tokenRef core.RecordRef, units string) error {
+	balance, err := w.balanceOf(tokenRef)
+	if err != nil {
+		return fmt.Errorf("Wrong balance: %s", err.Error())
+	}
+	amount, err := safemath.ParseUnits(units)
+	if err != nil {
+		return fmt.Errorf("Wrong amount: %s", err.Error())
+	}
+	newBalance, err := safemath.Add(balance, amount)
+	if err != nil {
+		return err
+	}
+	w.setBalanceOf(tokenRef, newBalance)
+	return nil
+}
+
+func formatUnits(units string) (string, error) {
+	amount, err := safemath.ParseUnits(units)
+	if err != nil {
+		return "", err
+	}
+	return safemath.FormatAmount(amount, decimals), nil
+}
+
+// decimalsOf returns number of decimal places of token amounts, cache is filled with already known tokens
+func decimalsOf(tokenRef string, cache map[string]uint) (uint, error) {
+	if tokenRef == "" {
+		return decimals, nil
+	}
+	if d, ok := cache[tokenRef]; ok {
+		return d, nil
+	}
+	ref, err := core.NewRefFromBase58(tokenRef)
+	if err != nil {
+		return 0, fmt.Errorf("Failed to parse token: %s", err.Error())
+	}
+	d, err := token.GetObject(*ref).GetDecimals()
+	if err != nil {
+		return 0, fmt.Errorf("Can't get token decimals: %s", err.Error())
+	}
+	cache[tokenRef] = d
+	return d, nil
+}
+
+// formatTokenUnits formats units of token as decimal amount, empty token reference means native currency
+func formatTokenUnits(tokenRef string, units string, cache map[string]uint) (string, error) {
+	d, err := decimalsOf(tokenRef, cache)
+	if err != nil {
+		return "", err
+	}
+	amount, err := safemath.ParseUnits(units)
+	if err != nil {
+		return "", err
+	}
+	return safemath.FormatAmount(amount, d), nil
 }
 
 // Transfer transfers money to given wallet
-func (w *Wallet) Transfer(amount uint, to *core.RecordRef) error {
+func (w *Wallet) Transfer(amount string, to *core.RecordRef) error {
+	if w.Threshold > 0 {
+		return fmt.Errorf("[ Transfer ] Multisig wallet transfers must be proposed and approved by signers")
+	}
 
 	toWallet, err := wallet.GetImplementationFrom(*to)
 	if err != nil {
 		return fmt.Errorf("[ Transfer ] Can't get implementation: %s", err.Error())
 	}
 
-	toWalletRef := toWallet.GetReference()
+	newBalance, units, err := w.withdraw(core.RecordRef{}, amount, decimals)
+	if err != nil {
+		return fmt.Errorf("[ Transfer ] %s", err.Error())
+	}
 
-	newBalance, err := safemath.Sub(w.Balance, amount)
+	err = w.sendAllowance(toWallet, *to, core.RecordRef{}, units.String())
 	if err != nil {
-		return fmt.Errorf("[ Transfer ] Not enough balance for transfer: %s", err.Error())
+		return fmt.Errorf("[ Transfer ] %s", err.Error())
+	}
+
+	// Changing balance only after allowance was successfully create
+	w.Balance = newBalance.String()
+	return nil
+}
+
+// TransferToken transfers decimal amount of token to given wallet
+func (w *Wallet) TransferToken(tokenRef *core.RecordRef, amount string, to *core.RecordRef) error {
+	if w.Threshold > 0 {
+		return fmt.Errorf("[ TransferToken ] Multisig wallet transfers must be proposed and approved by signers")
 	}
 
-	ah := allowance.New(&toWalletRef, amount, w.GetContext().Time.Unix()+10)
+	tokenDecimals, err := token.GetObject(*tokenRef).GetDecimals()
+	if err != nil {
+		return fmt.Errorf("[ TransferToken ] Can't get token: %s", err.Error())
+	}
+
+	toWallet, err := wallet.GetImplementationFrom(*to)
+	if err != nil {
+		return fmt.Errorf("[ TransferToken ] Can't get implementation: %s", err.Error())
+	}
+
+	newBalance, units, err := w.withdraw(*tokenRef, amount, tokenDecimals)
+	if err != nil {
+		return fmt.Errorf("[ TransferToken ] %s", err.Error())
+	}
+
+	err = w.sendAllowance(toWallet, *to, *tokenRef, units.String())
+	if err != nil {
+		return fmt.Errorf("[ TransferToken ] %s", err.Error())
+	}
+
+	w.setBalanceOf(*tokenRef, newBalance)
+	return nil
+}
+
+// BurnToken destroys decimal amount of token held by wallet, only owner can burn tokens
+func (w *Wallet) BurnToken(tokenRef *core.RecordRef, amount string) error {
+	if *w.GetContext().Caller != *w.GetContext().Parent {
+		return fmt.Errorf("[ BurnToken ] Only owner can burn tokens")
+	}
+
+	t := token.GetObject(*tokenRef)
+	tokenDecimals, err := t.GetDecimals()
+	if err != nil {
+		return fmt.Errorf("[ BurnToken ] Can't get token: %s", err.Error())
+	}
+
+	newBalance, units, err := w.withdraw(*tokenRef, amount, tokenDecimals)
+	if err != nil {
+		return fmt.Errorf("[ BurnToken ] %s", err.Error())
+	}
+
+	err = t.Burn(units.String())
+	if err != nil {
+		return fmt.Errorf("[ BurnToken ] Can't burn: %s", err.Error())
+	}
+
+	w.setBalanceOf(*tokenRef, newBalance)
+	return nil
+}
+
+func (w *Wallet) sendAllowance(toWallet *wallet.Wallet, to core.RecordRef, tokenRef core.RecordRef, amount string) error {
+	toWalletRef := toWallet.GetReference()
+	owner := *w.GetContext().Parent
+
+	ah := allowance.New(&owner, &toWalletRef, &to, &tokenRef, amount, w.GetContext().Time.Unix()+10)
 	a, err := ah.AsChild(w.GetReference())
 	if err != nil {
-		return fmt.Errorf("[ Transfer ] Can't save as child: %s", err.Error())
+		return fmt.Errorf("Can't save as child: %s", err.Error())
 	}
 
-	// Changing balance only after allowance was successfully create
-	w.Balance = newBalance
+	err = w.addHistoryEntry(owner, to, tokenRef, amount)
+	if err != nil {
+		return err
+	}
+
+	event := map[string]string{"from": owner.String(), "to": to.String(), "amount": amount}
+	if !tokenRef.IsEmpty() {
+		event["token"] = tokenRef.String()
+	}
+	err = foundation.Emit("transfer", event)
+	if err != nil {
+		return fmt.Errorf("Can't emit transfer event: %s", err.Error())
+	}
 
 	r := a.GetReference()
-	err = toWallet.AcceptNoWait(&r)
-	return err
+	return toWallet.AcceptNoWait(&r)
+}
+
+func (w *Wallet) addHistoryEntry(from core.RecordRef, to core.RecordRef, tokenRef core.RecordRef, amount string) error {
+	eh := historyentry.New(&from, &to, &tokenRef, amount, w.GetContext().Pulse.PulseNumber, w.GetContext().Request)
+	_, err := eh.AsChild(w.GetReference())
+	if err != nil {
+		return fmt.Errorf("Can't save history entry: %s", err.Error())
+	}
+	return nil
+}
+
+func (w *Wallet) isSigner(ref core.RecordRef) bool {
+	for _, s := range w.Signers {
+		if s == ref {
+			return true
+		}
+	}
+	return false
+}
+
+// SetSigners turns wallet into multisig wallet, only owner can do it and only once
+func (w *Wallet) SetSigners(signers []core.RecordRef, threshold uint) error {
+	if *w.GetContext().Caller != *w.GetContext().Parent {
+		return fmt.Errorf("[ SetSigners ] Only owner can set signers")
+	}
+	if w.Threshold > 0 {
+		return fmt.Errorf("[ SetSigners ] Wallet is already multisig")
+	}
+	if threshold == 0 || threshold > uint(len(signers)) {
+		return fmt.Errorf("[ SetSigners ] Threshold must be between 1 and number of signers")
+	}
+	for i, s := range signers {
+		for _, other := range signers[:i] {
+			if s == other {
+				return fmt.Errorf("[ SetSigners ] Duplicate signer %s", s.String())
+			}
+		}
+	}
+
+	w.Signers = signers
+	w.Threshold = threshold
+	return nil
+}
+
+// ProposeTransfer creates proposal of transfer from multisig wallet approved by calling signer
+func (w *Wallet) ProposeTransfer(amount string, to *core.RecordRef) (string, error) {
+	signer := *w.GetContext().Caller
+	if !w.isSigner(signer) {
+		return "", fmt.Errorf("[ ProposeTransfer ] Only signers can propose transfer")
+	}
+
+	toWallet, err := wallet.GetImplementationFrom(*to)
+	if err != nil {
+		return "", fmt.Errorf("[ ProposeTransfer ] Can't get implementation: %s", err.Error())
+	}
+
+	newBalance, units, err := w.withdraw(core.RecordRef{}, amount, decimals)
+	if err != nil {
+		return "", fmt.Errorf("[ ProposeTransfer ] %s", err.Error())
+	}
+
+	ph := proposal.New(to, units.String(), w.Threshold, &signer, w.GetContext().Time.Unix()+proposalLifetime)
+	p, err := ph.AsChild(w.GetReference())
+	if err != nil {
+		return "", fmt.Errorf("[ ProposeTransfer ] Can't save as child: %s", err.Error())
+	}
+
+	// Amount is held by proposal until it is executed or expired
+	w.Balance = newBalance.String()
+
+	if w.Threshold == 1 {
+		err = w.executeProposal(p, toWallet, *to)
+		if err != nil {
+			return "", fmt.Errorf("[ ProposeTransfer ] %s", err.Error())
+		}
+	}
+
+	return p.GetReference().String(), nil
+}
+
+// ApproveTransfer adds approval of calling signer to proposal and executes it when threshold is met
+func (w *Wallet) ApproveTransfer(proposalRef *core.RecordRef) error {
+	signer := *w.GetContext().Caller
+	if !w.isSigner(signer) {
+		return fmt.Errorf("[ ApproveTransfer ] Only signers can approve transfer")
+	}
+
+	p := proposal.GetObject(*proposalRef)
+	ready, err := p.Approve(&signer)
+	if err != nil {
+		return fmt.Errorf("[ ApproveTransfer ] Can't approve: %s", err.Error())
+	}
+	if !ready {
+		return nil
+	}
+
+	info, err := p.GetInfo()
+	if err != nil {
+		return fmt.Errorf("[ ApproveTransfer ] Can't get proposal info: %s", err.Error())
+	}
+	to, err := core.NewRefFromBase58(info.To)
+	if err != nil {
+		return fmt.Errorf("[ ApproveTransfer ] Failed to parse recipient: %s", err.Error())
+	}
+	toWallet, err := wallet.GetImplementationFrom(*to)
+	if err != nil {
+		return fmt.Errorf("[ ApproveTransfer ] Can't get implementation: %s", err.Error())
+	}
+
+	err = w.executeProposal(p, toWallet, *to)
+	if err != nil {
+		return fmt.Errorf("[ ApproveTransfer ] %s", err.Error())
+	}
+	return nil
+}
+
+func (w *Wallet) executeProposal(p *proposal.Proposal, toWallet *wallet.Wallet, to core.RecordRef) error {
+	amount, err := p.Execute()
+	if err != nil {
+		return fmt.Errorf("Can't execute proposal: %s", err.Error())
+	}
+	return w.sendAllowance(toWallet, to, core.RecordRef{}, amount)
+}
+
+// GetProposals returns pending proposals of multisig wallet
+//
+//ins:immutable
+func (w *Wallet) GetProposals() ([]byte, error) {
+	caller := *w.GetContext().Caller
+	if caller != *w.GetContext().Parent && !w.isSigner(caller) {
+		return nil, fmt.Errorf("[ GetProposals ] Only owner or signers can get proposals")
+	}
+
+	iterator, err := w.NewChildrenTypedIterator(proposal.GetPrototype())
+	if err != nil {
+		return nil, fmt.Errorf("[ GetProposals ] Can't get children: %s", err.Error())
+	}
+
+	res := []proposal.Info{}
+	for iterator.HasNext() {
+		cref, err := iterator.Next()
+		if err != nil {
+			return nil, fmt.Errorf("[ GetProposals ] Can't get next child: %s", err.Error())
+		}
+		if cref.IsEmpty() {
+			continue
+		}
+
+		info, err := proposal.GetObject(cref).GetInfo()
+		if err != nil {
+			return nil, fmt.Errorf("[ GetProposals ] Can't get proposal info: %s", err.Error())
+		}
+		if w.GetContext().Time.After(time.Unix(info.ExpireTime, 0)) {
+			continue
+		}
+		info.Amount, err = formatUnits(info.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("[ GetProposals ] Wrong proposal amount: %s", err.Error())
+		}
+		res = append(res, info)
+	}
+
+	return json.Marshal(res)
 }
 
 // Accept transforms allowance to balance
+//
+//ins:nowait
 func (w *Wallet) Accept(aRef *core.RecordRef) error {
-	b, err := allowance.GetObject(*aRef).TakeAmount()
+	a := allowance.GetObject(*aRef)
+	from, err := a.GetSender()
+	if err != nil {
+		return fmt.Errorf("[ Accept ] Can't get sender: %s", err.Error())
+	}
+	tokenRef, err := a.GetToken()
+	if err != nil {
+		return fmt.Errorf("[ Accept ] Can't get token: %s", err.Error())
+	}
+	b, err := a.TakeAmount()
 	if err != nil {
 		return fmt.Errorf("[ Accept ] Can't take amount: %s", err.Error())
 	}
-	w.Balance, err = safemath.Add(w.Balance, b)
+	err = w.deposit(tokenRef, b)
 	if err != nil {
 		return fmt.Errorf("[ Accept ] Couldn't add amount to balance: %s", err.Error())
 	}
+	err = w.addHistoryEntry(from, *w.GetContext().Parent, tokenRef, b)
+	if err != nil {
+		return fmt.Errorf("[ Accept ] %s", err.Error())
+	}
 	return nil
 }
 
-// GetBalance gets total balance
-func (w *Wallet) GetBalance() (uint, error) {
+func (w *Wallet) checkOwner(method string) error {
+	if *w.GetContext().Caller != *w.GetContext().Parent {
+		return fmt.Errorf("[ %s ] Only owner can get wallet history", method)
+	}
+	return nil
+}
+
+// forEachHistoryEntry calls f for each history entry of wallet in order of creation until f returns false
+func (w *Wallet) forEachHistoryEntry(f func(historyentry.Info) bool) error {
+	iterator, err := w.NewChildrenTypedIterator(historyentry.GetPrototype())
+	if err != nil {
+		return fmt.Errorf("Can't get history entries: %s", err.Error())
+	}
+
+	for iterator.HasNext() {
+		cref, err := iterator.Next()
+		if err != nil {
+			return fmt.Errorf("Can't get next history entry: %s", err.Error())
+		}
+		if cref.IsEmpty() {
+			continue
+		}
+
+		info, err := historyentry.GetObject(cref).GetInfo()
+		if err != nil {
+			return fmt.Errorf("Can't get history entry info: %s", err.Error())
+		}
+		if !f(info) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// GetHistory returns page of wallet history entries starting from offset
+//
+//ins:immutable
+func (w *Wallet) GetHistory(offset uint, limit uint) ([]byte, error) {
+	if err := w.checkOwner("GetHistory"); err != nil {
+		return nil, err
+	}
+
+	res := []historyentry.Info{}
+	decimalsCache := map[string]uint{}
+	var i uint
+	var formatErr error
+	err := w.forEachHistoryEntry(func(info historyentry.Info) bool {
+		if i >= offset {
+			info.Amount, formatErr = formatTokenUnits(info.Token, info.Amount, decimalsCache)
+			res = append(res, info)
+		}
+		i++
+		return formatErr == nil && (limit == 0 || uint(len(res)) < limit)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ GetHistory ] %s", err.Error())
+	}
+	if formatErr != nil {
+		return nil, fmt.Errorf("[ GetHistory ] Wrong history entry amount: %s", formatErr.Error())
+	}
+
+	return json.Marshal(res)
+}
+
+// GetStatement returns history entries and totals of incoming and outgoing transfers of native currency in range of pulses
+//
+//ins:immutable
+func (w *Wallet) GetStatement(fromPulse core.PulseNumber, toPulse core.PulseNumber) ([]byte, error) {
+	if err := w.checkOwner("GetStatement"); err != nil {
+		return nil, err
+	}
+
+	owner := w.GetContext().Parent.String()
+	entries := []historyentry.Info{}
+	incoming, outgoing := new(big.Int), new(big.Int)
+
+	var sumErr error
+	err := w.forEachHistoryEntry(func(info historyentry.Info) bool {
+		if info.Pulse < fromPulse || info.Pulse > toPulse || info.Token != "" {
+			return true
+		}
+		amount, err := safemath.ParseUnits(info.Amount)
+		if err != nil {
+			sumErr = err
+			return false
+		}
+		if info.From == owner {
+			outgoing, sumErr = safemath.Add(outgoing, amount)
+		} else {
+			incoming, sumErr = safemath.Add(incoming, amount)
+		}
+		info.Amount = safemath.FormatAmount(amount, decimals)
+		entries = append(entries, info)
+		return sumErr == nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ GetStatement ] %s", err.Error())
+	}
+	if sumErr != nil {
+		return nil, fmt.Errorf("[ GetStatement ] Couldn't sum amounts: %s", sumErr.Error())
+	}
+
+	return json.Marshal(struct {
+		Entries  []historyentry.Info `json:"entries"`
+		Incoming string              `json:"incoming"`
+		Outgoing string              `json:"outgoing"`
+	}{
+		Entries:  entries,
+		Incoming: safemath.FormatAmount(incoming, decimals),
+		Outgoing: safemath.FormatAmount(outgoing, decimals),
+	})
+}
+
+// returnExpiredAllowances returns amounts of allowances that recipients didn't accept in time
+func (w *Wallet) returnExpiredAllowances() error {
 	iterator, err := w.NewChildrenTypedIterator(allowance.GetPrototype())
 	if err != nil {
-		return 0, fmt.Errorf("[ GetBalance ] Can't get children: %s", err.Error())
+		return fmt.Errorf("Can't get children: %s", err.Error())
 	}
 
 	for iterator.HasNext() {
 		cref, err := iterator.Next()
 		if err != nil {
-			return 0, fmt.Errorf("[ GetBalance ] Can't get next child: %s", err.Error())
+			return fmt.Errorf("Can't get next child: %s", err.Error())
 		}
 
 		if !cref.IsEmpty() {
 			a := allowance.GetObject(cref)
 			balance, err := a.GetExpiredBalance()
 
-			if err != nil {
-				balance = 0
-				//return 0, fmt.Errorf("[ GetBalance ] Can't get balance for owner: %s", err.Error())
+			if err != nil || balance == "" {
+				continue
 			}
 
-			w.Balance, err = safemath.Add(w.Balance, balance)
+			tokenRef, err := a.GetToken()
 			if err != nil {
-				return 0, fmt.Errorf("[ GetBalance ] Couldn't add expired allowance to balance: %s", err.Error())
+				return fmt.Errorf("Can't get token of expired allowance: %s", err.Error())
 			}
+			receiver, err := a.GetReceiver()
+			if err != nil {
+				return fmt.Errorf("Can't get receiver of expired allowance: %s", err.Error())
+			}
+			err = w.deposit(tokenRef, balance)
+			if err != nil {
+				return fmt.Errorf("Couldn't add expired allowance to balance: %s", err.Error())
+			}
+			// Refund compensates outgoing history entry written when allowance was sent.
+			err = w.addHistoryEntry(receiver, *w.GetContext().Parent, tokenRef, balance)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// GetBalance gets total balance
+func (w *Wallet) GetBalance() (string, error) {
+	err := w.returnExpiredAllowances()
+	if err != nil {
+		return "", fmt.Errorf("[ GetBalance ] %s", err.Error())
+	}
+
+	if w.Threshold > 0 {
+		err = w.returnExpiredProposals()
+		if err != nil {
+			return "", fmt.Errorf("[ GetBalance ] %s", err.Error())
+		}
+	}
+
+	balance, err := formatUnits(w.Balance)
+	if err != nil {
+		return "", fmt.Errorf("[ GetBalance ] Wrong balance: %s", err.Error())
+	}
+	return balance, nil
+}
+
+func (w *Wallet) returnExpiredProposals() error {
+	iterator, err := w.NewChildrenTypedIterator(proposal.GetPrototype())
+	if err != nil {
+		return fmt.Errorf("Can't get proposals: %s", err.Error())
+	}
+
+	for iterator.HasNext() {
+		cref, err := iterator.Next()
+		if err != nil {
+			return fmt.Errorf("Can't get next proposal: %s", err.Error())
+		}
+		if cref.IsEmpty() {
+			continue
+		}
+
+		balance, err := proposal.GetObject(cref).GetExpiredBalance()
+		if err != nil {
+			return fmt.Errorf("Can't get balance of expired proposal: %s", err.Error())
+		}
+		if balance == "" {
+			continue
+		}
+		err = w.deposit(core.RecordRef{}, balance)
+		if err != nil {
+			return fmt.Errorf("Couldn't add expired proposal to balance: %s", err.Error())
 		}
 	}
-	return w.Balance, nil
+	return nil
 }
 
-// New creates new allowance
-func New(balance uint) (*Wallet, error) {
+// GetTokenBalance gets total balance of token as decimal amount
+func (w *Wallet) GetTokenBalance(tokenRef *core.RecordRef) (string, error) {
+	err := w.returnExpiredAllowances()
+	if err != nil {
+		return "", fmt.Errorf("[ GetTokenBalance ] %s", err.Error())
+	}
+
+	balance, err := formatTokenUnits(tokenRef.String(), w.Tokens[tokenRef.String()], map[string]uint{})
+	if err != nil {
+		return "", fmt.Errorf("[ GetTokenBalance ] %s", err.Error())
+	}
+	return balance, nil
+}
+
+// GetTokenBalances returns decimal balances of all tokens that wallet ever held
+func (w *Wallet) GetTokenBalances() ([]byte, error) {
+	err := w.returnExpiredAllowances()
+	if err != nil {
+		return nil, fmt.Errorf("[ GetTokenBalances ] %s", err.Error())
+	}
+
+	tokens := make([]string, 0, len(w.Tokens))
+	for t := range w.Tokens {
+		tokens = append(tokens, t)
+	}
+	sort.Strings(tokens)
+
+	type tokenBalance struct {
+		Token   string `json:"token"`
+		Balance string `json:"balance"`
+	}
+	res := make([]tokenBalance, 0, len(tokens))
+	decimalsCache := map[string]uint{}
+	for _, t := range tokens {
+		balance, err := formatTokenUnits(t, w.Tokens[t], decimalsCache)
+		if err != nil {
+			return nil, fmt.Errorf("[ GetTokenBalances ] %s", err.Error())
+		}
+		res = append(res, tokenBalance{Token: t, Balance: balance})
+	}
+	return json.Marshal(res)
+}
+
+// New creates new wallet with decimal balance
+func New(balance string) (*Wallet, error) {
+	units, err := safemath.ParseAmount(balance, decimals)
+	if err != nil {
+		return nil, fmt.Errorf("[ New ] Wrong balance: %s", err.Error())
+	}
 	return &Wallet{
-		Balance: balance,
+		Balance: units.String(),
 	}, nil
 }
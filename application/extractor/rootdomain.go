@@ -52,3 +52,42 @@ func InfoResponse(data []byte) (*Info, error) {
 
 	return &info, nil
 }
+
+// RouteParam represents parameter of routed method
+type RouteParam struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Route represents method that can be called via member's Call
+type Route struct {
+	Name     string       `json:"name"`
+	Params   []RouteParam `json:"params"`
+	Role     string       `json:"role"`
+	Unsigned bool         `json:"unsigned,omitempty"`
+}
+
+// RoutesResponse returns response from ListRoutes() method of RootDomain contract
+func RoutesResponse(data []byte) ([]Route, error) {
+	var routesJSON interface{}
+	var contractErr *foundation.Error
+	_, err := core.UnMarshalResponse(data, []interface{}{&routesJSON, &contractErr})
+	if err != nil {
+		return nil, errors.Wrap(err, "[ RoutesResponse ] Can't unmarshal")
+	}
+	if contractErr != nil {
+		return nil, errors.Wrap(contractErr, "[ RoutesResponse ] Has error in response")
+	}
+
+	data, ok := routesJSON.([]byte)
+	if !ok {
+		return nil, errors.New("[ RoutesResponse ] Unexpected type of response")
+	}
+	var routes []Route
+	err = json.Unmarshal(data, &routes)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ RoutesResponse ] Can't unmarshal response ")
+	}
+
+	return routes, nil
+}
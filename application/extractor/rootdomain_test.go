@@ -70,3 +70,32 @@ func TestInfoResponse_UnmarshalError(t *testing.T) {
 	require.Contains(t, err.Error(), "Can't unmarshal")
 	require.Nil(t, info)
 }
+
+func TestRoutesResponse(t *testing.T) {
+	expectedValue := []Route{
+		{Name: "CreateMember", Params: []RouteParam{{"name", "string"}, {"key", "string"}}, Role: "member", Unsigned: true},
+		{Name: "DumpAllUsers", Params: []RouteParam{}, Role: "root"},
+	}
+	testValue, _ := json.Marshal(expectedValue)
+
+	data, err := core.Serialize([]interface{}{testValue, nil})
+	require.NoError(t, err)
+
+	routes, err := RoutesResponse(data)
+
+	require.NoError(t, err)
+	require.Equal(t, expectedValue, routes)
+}
+
+func TestRoutesResponse_ErrorResponse(t *testing.T) {
+	contractErr := &foundation.Error{S: "Custom test error"}
+
+	data, err := core.Serialize([]interface{}{nil, contractErr})
+	require.NoError(t, err)
+
+	routes, err := RoutesResponse(data)
+
+	require.Contains(t, err.Error(), "Has error in response")
+	require.Contains(t, err.Error(), "Custom test error")
+	require.Nil(t, routes)
+}
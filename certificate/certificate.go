@@ -201,6 +201,11 @@ func (cert *Certificate) GetDiscoveryNodes() []core.DiscoveryNode {
 	return result
 }
 
+// GetPulsarPublicKeys returns public keys of pulsars
+func (cert *Certificate) GetPulsarPublicKeys() []crypto.PublicKey {
+	return cert.pulsarPublicKey
+}
+
 // Dump returns all info about certificate in json format
 func (cert *Certificate) Dump() (string, error) {
 	result, err := json.MarshalIndent(cert, "", "    ")
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math/big"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -164,10 +165,10 @@ func createMembers(insSDK *sdk.SDK, count int) ([]*sdk.Member, int32) {
 	return members, retriesCount
 }
 
-func getTotalBalance(insSDK *sdk.SDK, members []*sdk.Member) (totalBalance uint64, penRetires int32) {
+func getTotalBalance(insSDK *sdk.SDK, members []*sdk.Member) (totalBalance *big.Rat, penRetires int32) {
 	type Result struct {
 		num     int
-		balance uint64
+		balance string
 		err     error
 	}
 
@@ -201,6 +202,7 @@ func getTotalBalance(insSDK *sdk.SDK, members []*sdk.Member) (totalBalance uint6
 	}
 
 	wg.Wait()
+	totalBalance = new(big.Rat)
 	for i := 0; i < nmembers; i++ {
 		res := <-results
 		if res.err != nil {
@@ -209,7 +211,12 @@ func getTotalBalance(insSDK *sdk.SDK, members []*sdk.Member) (totalBalance uint6
 			}
 			continue
 		}
-		totalBalance += res.balance
+		balance, ok := new(big.Rat).SetString(res.balance)
+		if !ok {
+			fmt.Printf("Wrong balance for %v-th member: %v\n", res.num, res.balance)
+			continue
+		}
+		totalBalance.Add(totalBalance, balance)
 	}
 
 	return totalBalance, penRetires
@@ -298,7 +305,7 @@ func main() {
 
 	members, crMemPenBefore, err := getMembers(insSDK)
 	check("Error while loading members: ", err)
-	var totalBalanceBefore uint64
+	totalBalanceBefore := new(big.Rat)
 	var balancePenRetries int32
 	if !noCheckBalance {
 		totalBalanceBefore, balancePenRetries = getTotalBalance(insSDK, members)
@@ -339,19 +346,19 @@ func main() {
 	fmt.Printf("\nFinish: %s\n\n", t.String())
 
 	if !noCheckBalance {
-		totalBalanceAfter := uint64(0)
+		totalBalanceAfter := new(big.Rat)
 		for nretries := 0; nretries < 3; nretries++ {
 			totalBalanceAfter, _ = getTotalBalance(insSDK, members)
-			if totalBalanceAfter == totalBalanceBefore {
+			if totalBalanceAfter.Cmp(totalBalanceBefore) == 0 {
 				break
 			}
 			fmt.Printf("Total balance before and after don't match: %v vs %v - retrying in 3 seconds...\n",
-				totalBalanceBefore, totalBalanceAfter)
+				totalBalanceBefore.FloatString(8), totalBalanceAfter.FloatString(8))
 			time.Sleep(3 * time.Second)
 
 		}
-		fmt.Printf("Total balance before: %v and after: %v\n", totalBalanceBefore, totalBalanceAfter)
-		if totalBalanceBefore != totalBalanceAfter {
+		fmt.Printf("Total balance before: %v and after: %v\n", totalBalanceBefore.FloatString(8), totalBalanceAfter.FloatString(8))
+		if totalBalanceBefore.Cmp(totalBalanceAfter) != 0 {
 			log.Fatal("Total balance mismatch!\n")
 		}
 	}
@@ -129,7 +129,7 @@ func (s *transferDifferentMembersScenario) startMember(ctx context.Context, inde
 		retry := true
 		for retry && bof.Attempt() < backoffAttemptsCount {
 			start = time.Now()
-			traceID, err = s.insSDK.Transfer(1, from, to)
+			traceID, err = s.insSDK.Transfer("1", from, to)
 			stop = time.Since(start)
 
 			if err == nil {
@@ -170,6 +170,29 @@ func main() {
 	}
 	cmdImports.Flags().VarP(output, "output", "o", "output file (use - for STDOUT)")
 
+	var cmdABI = &cobra.Command{
+		Use:   "abi [flags] <file name to process>",
+		Short: "Generate contract's JSON ABI",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				fmt.Println("abi command should be followed by exactly one file name to process")
+				os.Exit(1)
+			}
+			parsed, err := preprocessor.ParseFile(args[0])
+			if err != nil {
+				fmt.Println(errors.Wrap(err, "couldn't parse"))
+				os.Exit(1)
+			}
+
+			err = parsed.WriteABI(output.writer)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		},
+	}
+	cmdABI.Flags().VarP(output, "output", "o", "output file (use - for STDOUT)")
+
 	// PLEASE NOTE that `insgocc compile` is in fact not used for compiling contracts by insolard.
 	// Instead contracts are compiled when `insolard genesis` is executed without using `insgocc`.
 	keepTemp := false
@@ -247,6 +270,19 @@ func main() {
 				fmt.Println(errors.Wrap(err, "can't build contract: "+string(out)))
 				os.Exit(1)
 			}
+
+			abi, err := os.Create(path.Join(dir, outdir, name+".abi.json"))
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			defer abi.Close()
+
+			err = parsed.WriteABI(abi)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
 		},
 	}
 	// default value for string flags is displayed automatically
@@ -255,7 +291,7 @@ func main() {
 	cmdCompile.Flags().BoolVarP(&keepTemp, "keep-temp", "k", false, "keep temp directory (default \"false\")")
 
 	var rootCmd = &cobra.Command{Use: "insgocc"}
-	rootCmd.AddCommand(cmdProxy, cmdWrapper, cmdImports, cmdCompile)
+	rootCmd.AddCommand(cmdProxy, cmdWrapper, cmdImports, cmdABI, cmdCompile)
 	err := rootCmd.Execute()
 	if err != nil {
 		fmt.Println(err)
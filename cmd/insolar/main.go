@@ -23,6 +23,7 @@ import (
 	"io"
 	"os"
 	"reflect"
+	"strings"
 
 	"github.com/insolar/insolar/api/requester"
 	"github.com/insolar/insolar/certificate"
@@ -93,12 +94,13 @@ var (
 	verbose            bool
 	sendUrls           string
 	rootAsCaller       bool
+	leavePulse         uint32
 )
 
 func parseInputParams() {
 	var rootCmd = &cobra.Command{}
 	rootCmd.Flags().StringVarP(&cmd, "cmd", "c", "",
-		"available commands: default_config | random_ref | version | gen_keys | gen_certificate | send_request | gen_send_configs | get_info | create_member")
+		"available commands: default_config | random_ref | version | gen_keys | gen_certificate | send_request | gen_send_configs | get_info | get_routes | create_member | leave | leave_status")
 	rootCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "be verbose (default false)")
 	rootCmd.Flags().StringVarP(&output, "output", "o", defaultStdoutPath, "output file (use - for STDOUT)")
 	rootCmd.Flags().StringVarP(&sendUrls, "url", "u", defaultURL, "api url")
@@ -106,6 +108,7 @@ func parseInputParams() {
 	rootCmd.Flags().StringVarP(&configPath, "config", "g", "config.json", "path to configuration file")
 	rootCmd.Flags().StringVarP(&paramsPath, "params", "p", "", "path to params file (default params.json)")
 	rootCmd.Flags().BoolVarP(&rootAsCaller, "root_as_caller", "r", false, "use root member as caller")
+	rootCmd.Flags().Uint32VarP(&leavePulse, "pulse", "l", 0, "pulse number when node leaves the network")
 	err := rootCmd.Execute()
 	check("Wrong input params:", err)
 
@@ -143,8 +146,14 @@ func main() {
 		genSendConfigs(out)
 	case "get_info":
 		getInfo(out)
+	case "get_routes":
+		getRoutes(out)
 	case "create_member":
 		createMember(out)
+	case "leave":
+		leave(out)
+	case "leave_status":
+		leaveStatus(out)
 	}
 }
 
@@ -279,6 +288,8 @@ func sendRequest(out io.Writer) {
 	reqCfg, err := requester.ReadRequestConfigFromFile(pPath)
 	check("[ sendRequest ]", err)
 
+	checkRoute(reqCfg.Method)
+
 	verboseInfo(fmt.Sprintln("User Config: ", userCfg))
 	verboseInfo(fmt.Sprintln("Requester Config: ", reqCfg))
 
@@ -312,3 +323,58 @@ func getInfo(out io.Writer) {
 	fmt.Fprintf(out, "NodeDomain : %s\n", info.NodeDomain)
 	fmt.Fprintf(out, "RootDomain : %s\n", info.RootDomain)
 }
+
+func checkRoute(method string) {
+	routes, err := requester.Routes(sendUrls)
+	if err != nil {
+		verboseInfo(fmt.Sprintln("Can't get routes, skip method check: ", err))
+		return
+	}
+
+	names := make([]string, 0, len(routes.Routes))
+	for _, r := range routes.Routes {
+		if r.Name == method {
+			return
+		}
+		names = append(names, r.Name)
+	}
+	check("[ sendRequest ]", fmt.Errorf("unknown method %s, available methods: %s", method, strings.Join(names, ", ")))
+}
+
+func getRoutes(out io.Writer) {
+	routes, err := requester.Routes(sendUrls)
+	check("[ getRoutes ]", err)
+	for _, r := range routes.Routes {
+		params := make([]string, 0, len(r.Params))
+		for _, p := range r.Params {
+			params = append(params, p.Name+" "+p.Type)
+		}
+		fmt.Fprintf(out, "%s(%s) role: %s", r.Name, strings.Join(params, ", "), r.Role)
+		if r.Unsigned {
+			fmt.Fprint(out, ", unsigned")
+		}
+		fmt.Fprintln(out)
+	}
+}
+
+func leave(out io.Writer) {
+	if leavePulse == 0 {
+		check("[ leave ]", errors.New("pulse number is required, use --pulse flag"))
+	}
+	status, err := requester.Leave(sendUrls, leavePulse)
+	check("[ leave ]", err)
+	printLeaveStatus(out, status)
+}
+
+func leaveStatus(out io.Writer) {
+	status, err := requester.LeaveStatus(sendUrls)
+	check("[ leaveStatus ]", err)
+	printLeaveStatus(out, status)
+}
+
+func printLeaveStatus(out io.Writer, status *requester.LeaveResponse) {
+	fmt.Fprintf(out, "State            : %s\n", status.State)
+	fmt.Fprintf(out, "ETA              : %d\n", status.ETA)
+	fmt.Fprintf(out, "UnsyncedPulses   : %d\n", status.UnsyncedPulses)
+	fmt.Fprintf(out, "ActiveExecutions : %d\n", status.ActiveExecutions)
+}
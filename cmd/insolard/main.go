@@ -45,16 +45,25 @@ type inputParams struct {
 }
 
 func parseInputParams() inputParams {
-	var rootCmd = &cobra.Command{Use: "insolard"}
+	var rootCmd = &cobra.Command{
+		Use: "insolard",
+		// Node is started after input params are parsed.
+		Run: func(*cobra.Command, []string) {},
+	}
 	var result inputParams
 	rootCmd.Flags().StringVarP(&result.configPath, "config", "c", "", "path to config file")
 	rootCmd.Flags().StringVarP(&result.genesisConfigPath, "genesis", "g", "", "path to genesis config file")
 	rootCmd.Flags().StringVarP(&result.genesisKeyOut, "keyout", "", ".", "genesis certificates path")
 	rootCmd.Flags().BoolVarP(&result.traceEnabled, "trace", "t", false, "enable tracing")
-	err := rootCmd.Execute()
+	rootCmd.AddCommand(snapshotCommand(), restoreCommand())
+	executed, err := rootCmd.ExecuteC()
 	if err != nil {
 		log.Fatal("Wrong input params:", err)
 	}
+	if executed != rootCmd {
+		// Ledger maintenance command is done, node should not be started.
+		os.Exit(0)
+	}
 
 	if result.genesisConfigPath != "" {
 		result.isGenesis = true
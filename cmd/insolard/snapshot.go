@@ -0,0 +1,182 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/core/utils"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/log"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+// snapshotCommand writes ledger snapshot of the stopped node.
+func snapshotCommand() *cobra.Command {
+	var (
+		configPath   string
+		outPath      string
+		manifestPath string
+		pulse        uint32
+	)
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "write ledger snapshot (node should be stopped)",
+		Run: func(*cobra.Command, []string) {
+			ctx, cfg := initLedgerCommand(configPath)
+			if manifestPath == "" {
+				manifestPath = outPath + ".manifest.json"
+			}
+			err := writeSnapshot(ctx, cfg, core.PulseNumber(pulse), outPath, manifestPath)
+			checkError(ctx, err, "failed to write ledger snapshot")
+		},
+	}
+	cmd.Flags().StringVarP(&configPath, "config", "c", "", "path to config file")
+	cmd.Flags().StringVarP(&outPath, "out", "o", "ledger.snapshot", "path to snapshot file")
+	cmd.Flags().StringVarP(&manifestPath, "manifest", "m", "", "path to manifest file (default <out>.manifest.json)")
+	cmd.Flags().Uint32VarP(&pulse, "pulse", "p", 0, "snapshot pulse (default is the latest pulse)")
+	return cmd
+}
+
+// restoreCommand restores ledger of the stopped node from snapshot.
+func restoreCommand() *cobra.Command {
+	var (
+		configPath string
+		inPath     string
+	)
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "restore ledger from snapshot into empty data directory (node should be stopped)",
+		Run: func(*cobra.Command, []string) {
+			ctx, cfg := initLedgerCommand(configPath)
+			err := restoreSnapshot(ctx, cfg, inPath)
+			checkError(ctx, err, "failed to restore ledger snapshot")
+		},
+	}
+	cmd.Flags().StringVarP(&configPath, "config", "c", "", "path to config file")
+	cmd.Flags().StringVarP(&inPath, "in", "i", "ledger.snapshot", "path to snapshot file")
+	return cmd
+}
+
+func initLedgerCommand(configPath string) (context.Context, configuration.Configuration) {
+	cfgHolder := configuration.NewHolder()
+	var err error
+	if len(configPath) != 0 {
+		err = cfgHolder.LoadFromFile(configPath)
+	} else {
+		err = cfgHolder.Load()
+	}
+	if err != nil {
+		log.Warn("failed to load configuration from file: ", err.Error())
+	}
+
+	ctx, inslog := initLogger(context.Background(), cfgHolder.Configuration.Log, "ledger_"+utils.RandTraceID())
+	log.SetGlobalLogger(inslog)
+	return ctx, cfgHolder.Configuration
+}
+
+func writeSnapshot(
+	ctx context.Context,
+	cfg configuration.Configuration,
+	pulse core.PulseNumber,
+	outPath, manifestPath string,
+) error {
+	db, err := storage.NewDB(cfg.Ledger, nil)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	out, err := os.OpenFile(outPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Wrap(err, "couldn't open file for writing")
+	}
+	defer out.Close()
+
+	manifest, err := storage.WriteSnapshot(ctx, db, platformpolicy.NewPlatformCryptographyScheme(), pulse, out)
+	if err != nil {
+		return err
+	}
+	err = out.Sync()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "    ")
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(manifestPath, data, 0600)
+	if err != nil {
+		return errors.Wrap(err, "couldn't write manifest")
+	}
+
+	fmt.Printf("Snapshot on pulse %v is written to %v (%v entries, %v drops)\n",
+		manifest.Pulse, outPath, manifest.Entries, len(manifest.Drops))
+	return nil
+}
+
+func restoreSnapshot(ctx context.Context, cfg configuration.Configuration, inPath string) error {
+	if cfg.Ledger.Storage.Backend == storage.BackendMemory {
+		return errors.New("snapshot can't be restored into memory storage")
+	}
+	dir := cfg.Ledger.Storage.DataDirectory
+	files, err := ioutil.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(files) > 0 {
+		return errors.Errorf("data directory %v is not empty", dir)
+	}
+
+	in, err := os.Open(inPath)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	db, err := storage.NewDB(cfg.Ledger, nil)
+	if err != nil {
+		return err
+	}
+	manifest, err := storage.RestoreSnapshot(ctx, db, platformpolicy.NewPlatformCryptographyScheme(), in)
+	closeErr := db.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// Node should not start with partially restored or unverified ledger.
+		rmErr := os.RemoveAll(dir)
+		if rmErr != nil {
+			log.Error("failed to remove data directory: ", rmErr)
+		}
+		return err
+	}
+
+	fmt.Printf("Snapshot on pulse %v is restored to %v (%v entries, %v drops)\n",
+		manifest.Pulse, dir, manifest.Entries, len(manifest.Drops))
+	return nil
+}
@@ -0,0 +1,139 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/log"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+// keysFile is a subset of keys and certificate files with node public keys.
+type keysFile struct {
+	PublicKey      string `json:"public_key"`
+	BootstrapNodes []struct {
+		PublicKey string `json:"public_key"`
+	} `json:"bootstrap_nodes"`
+}
+
+func main() {
+	configPath := pflag.StringP("config", "c", "", "path to node config file")
+	keyPaths := pflag.StringArrayP("keys", "k", nil, "path to keys or certificate file with node public keys (repeatable)")
+	outPath := pflag.StringP("out", "o", "", "path to report file (default is stdout)")
+	pflag.Parse()
+
+	cfgHolder := configuration.NewHolder()
+	var err error
+	if len(*configPath) != 0 {
+		err = cfgHolder.LoadFromFile(*configPath)
+	} else {
+		err = cfgHolder.Load()
+	}
+	if err != nil {
+		log.Error("failed to load configuration from file: ", err.Error())
+		os.Exit(2)
+	}
+
+	keys, err := loadKeys(*keyPaths)
+	if err != nil {
+		log.Error(err)
+		os.Exit(2)
+	}
+	if len(keys) == 0 {
+		log.Warn("no public keys provided, drop size signatures won't be verified")
+	}
+
+	report, err := verify(cfgHolder.Configuration.Ledger, keys)
+	if err != nil {
+		log.Error(err)
+		os.Exit(2)
+	}
+
+	data, err := json.MarshalIndent(report, "", "    ")
+	if err != nil {
+		log.Error(err)
+		os.Exit(2)
+	}
+	if len(*outPath) != 0 {
+		err = ioutil.WriteFile(*outPath, data, 0600)
+	} else {
+		_, err = fmt.Println(string(data))
+	}
+	if err != nil {
+		log.Error(errors.Wrap(err, "couldn't write report"))
+		os.Exit(2)
+	}
+
+	if !report.OK() {
+		os.Exit(1)
+	}
+}
+
+func verify(conf configuration.Ledger, keys []crypto.PublicKey) (*storage.VerifyReport, error) {
+	store, err := storage.NewReadOnlyKVStore(conf.Storage)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't open ledger storage")
+	}
+	defer store.Close()
+
+	return storage.VerifyLedger(
+		context.Background(), store, conf.Archive.Directory, platformpolicy.NewPlatformCryptographyScheme(), keys,
+	)
+}
+
+func loadKeys(paths []string) ([]crypto.PublicKey, error) {
+	keyProcessor := platformpolicy.NewKeyProcessor()
+	var keys []crypto.PublicKey
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't read keys file %v", path)
+		}
+		var file keysFile
+		err = json.Unmarshal(data, &file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't parse keys file %v", path)
+		}
+
+		pems := []string{file.PublicKey}
+		for _, node := range file.BootstrapNodes {
+			pems = append(pems, node.PublicKey)
+		}
+		for _, pem := range pems {
+			if len(pem) == 0 {
+				continue
+			}
+			key, err := keyProcessor.ImportPublicKeyPEM([]byte(pem))
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't import public key from %v", path)
+			}
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
@@ -18,6 +18,7 @@ package main
 
 import (
 	"context"
+	"crypto"
 	"fmt"
 	"net"
 	"os"
@@ -25,6 +26,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	jww "github.com/spf13/jwalterweatherman"
 
@@ -139,7 +141,22 @@ func initPulsar(ctx context.Context, cfg configuration.Configuration) (*componen
 	cryptographyService := cryptography.NewCryptographyService()
 	keyProcessor := platformpolicy.NewKeyProcessor()
 
-	tp, err := transport.NewTransport(cfg.Pulsar.DistributionTransport, relay.NewProxy())
+	var identity *transport.TLSIdentity
+	if cfg.Pulsar.DistributionTransport.TLS {
+		privateKey, err := keyStore.GetPrivateKey("")
+		if err != nil {
+			inslogger.FromContext(ctx).Fatal(err)
+		}
+		verifyPeer, err := newPeerVerifier(keyProcessor, cfg.Pulsar)
+		if err != nil {
+			inslogger.FromContext(ctx).Fatal(err)
+		}
+		identity = &transport.TLSIdentity{
+			PrivateKey: privateKey,
+			VerifyPeer: verifyPeer,
+		}
+	}
+	tp, err := transport.NewSecureTransport(cfg.Pulsar.DistributionTransport, relay.NewProxy(), identity)
 	if err != nil {
 		inslogger.FromContext(ctx).Fatal(err)
 	}
@@ -231,3 +248,41 @@ func initLogger(ctx context.Context, cfg configuration.Log, traceid string) (con
 	}
 	return inslogger.WithTraceField(inslogger.SetLogger(ctx, inslog), traceid)
 }
+
+// newPeerVerifier returns TLS peer check that allows only configured bootstrap nodes and neighbour pulsars.
+func newPeerVerifier(keyProcessor core.KeyProcessor, cfg configuration.Pulsar) (func(crypto.PublicKey, []byte) error, error) {
+	pemKeys := append([]string{}, cfg.PulseDistributor.BootstrapPublicKeys...)
+	for _, neighbour := range cfg.Neighbours {
+		pemKeys = append(pemKeys, neighbour.PublicKey)
+	}
+
+	known := make(map[string]struct{}, len(pemKeys))
+	for _, pemKey := range pemKeys {
+		if len(pemKey) == 0 {
+			continue
+		}
+		key, err := keyProcessor.ImportPublicKeyPEM([]byte(pemKey))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to import peer public key")
+		}
+		rawKey, err := keyProcessor.ExportPublicKeyBinary(key)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to export peer public key")
+		}
+		known[string(rawKey)] = struct{}{}
+	}
+	if len(known) == 0 {
+		return nil, errors.New("TLS is enabled but no bootstrap public keys are configured")
+	}
+
+	return func(key crypto.PublicKey, _ []byte) error {
+		rawKey, err := keyProcessor.ExportPublicKeyBinary(key)
+		if err != nil {
+			return errors.Wrap(err, "failed to export peer key")
+		}
+		if _, ok := known[string(rawKey)]; !ok {
+			return errors.New("unknown peer key")
+		}
+		return nil
+	}, nil
+}
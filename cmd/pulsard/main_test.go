@@ -0,0 +1,60 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package main
+
+import (
+	"crypto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+func TestNewPeerVerifier(t *testing.T) {
+	kp := platformpolicy.NewKeyProcessor()
+	newKey := func() ([]byte, crypto.PublicKey) {
+		privateKey, err := kp.GeneratePrivateKey()
+		require.NoError(t, err)
+		publicKey := kp.ExtractPublicKey(privateKey)
+		pemKey, err := kp.ExportPublicKeyPEM(publicKey)
+		require.NoError(t, err)
+		return pemKey, publicKey
+	}
+	bootstrapPEM, bootstrapKey := newKey()
+	neighbourPEM, neighbourKey := newKey()
+	_, unknownKey := newKey()
+
+	cfg := configuration.NewPulsar()
+	_, err := newPeerVerifier(kp, cfg)
+	assert.Error(t, err, "no keys configured")
+
+	cfg.PulseDistributor.BootstrapPublicKeys = []string{string(bootstrapPEM)}
+	cfg.Neighbours = []configuration.PulsarNodeAddress{{PublicKey: string(neighbourPEM)}}
+	verify, err := newPeerVerifier(kp, cfg)
+	require.NoError(t, err)
+
+	assert.NoError(t, verify(bootstrapKey, nil))
+	assert.NoError(t, verify(neighbourKey, nil))
+	assert.Error(t, verify(unknownKey, nil))
+
+	cfg.PulseDistributor.BootstrapPublicKeys = []string{"not a key"}
+	_, err = newPeerVerifier(kp, cfg)
+	assert.Error(t, err)
+}
@@ -26,6 +26,9 @@ type APIRunner struct {
 	Call    string
 	RPC     string
 	Timeout uint32
+	// AdminAddress is a listen address of node administration API, it is disabled if empty.
+	// It should be reachable by node operator only.
+	AdminAddress string
 }
 
 // NewAPIRunner creates new api config
@@ -35,10 +38,12 @@ func NewAPIRunner() APIRunner {
 		Call:    "/api/call",
 		RPC:     "/api/rpc",
 		Timeout: 15,
+
+		AdminAddress: "localhost:19001",
 	}
 }
 
 func (ar *APIRunner) String() string {
-	res := fmt.Sprintln("Addr ->", ar.Address, ", Call ->", ar.Call, ", RPC ->", ar.RPC)
+	res := fmt.Sprintln("Addr ->", ar.Address, ", Call ->", ar.Call, ", RPC ->", ar.RPC, ", AdminAddr ->", ar.AdminAddress)
 	return res
 }
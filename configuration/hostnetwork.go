@@ -27,6 +27,9 @@ type Transport struct {
 	// if not empty - this should be public address of instance (to connect from the "other" side to)
 	// conflicts in BehindNAT
 	FixedPublicAddress string
+	// if true TCP and QUIC transports use mutually authenticated TLS with identity derived from the node key,
+	// connections from nodes with unknown keys are rejected
+	TLS bool
 }
 
 // HostNetwork holds configuration for HostNetwork
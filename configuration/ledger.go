@@ -24,6 +24,12 @@ import (
 type Storage struct {
 	// DataDirectory is a directory where database's files live.
 	DataDirectory string
+	// Backend is a key-value engine ledger data is stored in: "badger", "bolt" or "memory" (data is not persisted).
+	// Empty value means "badger".
+	Backend string
+	// Compression is an algorithm blobs contents are compressed with: "none", "snappy" or "zstd".
+	// Empty value means "none". Changing it affects only new blobs.
+	Compression string
 	// TxRetriesOnConflict defines how many retries on transaction conflicts
 	// storage update methods should do.
 	TxRetriesOnConflict int
@@ -39,6 +45,9 @@ type PulseManager struct {
 	HeavyBackoff Backoff
 	// SplitThreshold is a drop size threshold in bytes to perform split.
 	SplitThreshold uint64
+	// MergeThreshold is a drop size threshold in bytes to perform merge. Sibling jets are merged if all their drops
+	// in sizes history are smaller than threshold. Zero value disables merge.
+	MergeThreshold uint64
 }
 
 // Backoff configures retry backoff algorithm
@@ -62,6 +71,18 @@ type Exporter struct {
 	ExportLag uint32
 }
 
+// Archive configures moving of old heavy node data into archive segments.
+type Archive struct {
+	// Directory is a directory where archive segment files live. Archived data can't be read if it is empty.
+	Directory string
+	// Lag is a number of pulses data stays in the storage before it is archived. Zero value disables archiving.
+	Lag int
+	// SegmentPulses is a minimal number of pulses archived at once. Data of every jet is archived into its own segment.
+	SegmentPulses int
+	// CompressionLevel is a flate compression level of archived values (from -2 to 9).
+	CompressionLevel int
+}
+
 // Ledger holds configuration for ledger.
 type Ledger struct {
 	// Storage defines storage configuration.
@@ -85,6 +106,9 @@ type Ledger struct {
 	// Exporter holds configuration of Exporter
 	Exporter Exporter
 
+	// Archive holds configuration of heavy node data archiving.
+	Archive Archive
+
 	// PendingRequestsLimit holds a number of pending requests, what can be stored in the system
 	// before they are declined
 	PendingRequestsLimit int
@@ -95,6 +119,8 @@ func NewLedger() Ledger {
 	return Ledger{
 		Storage: Storage{
 			DataDirectory:       "./data",
+			Backend:             "badger",
+			Compression:         "none",
 			TxRetriesOnConflict: 3,
 		},
 
@@ -108,6 +134,7 @@ func NewLedger() Ledger {
 				Factor: 2,
 			},
 			SplitThreshold: 10 * 100, // 10 megabytes.
+			MergeThreshold: 100,
 		},
 
 		RecentStorage: RecentStorage{
@@ -123,5 +150,12 @@ func NewLedger() Ledger {
 		},
 
 		PendingRequestsLimit: 1000,
+
+		Archive: Archive{
+			Directory:        "./archive",
+			Lag:              0, // disabled
+			SegmentPulses:    100,
+			CompressionLevel: 6,
+		},
 	}
 }
@@ -52,6 +52,9 @@ type PulseDistributor struct {
 	RandomHostsRequestTimeout int32 // ms
 	PulseRequestTimeout       int32 // ms
 	RandomNodesCount          int
+
+	// BootstrapPublicKeys are PEM keys of bootstrap nodes, checked when TLS transport is enabled.
+	BootstrapPublicKeys []string
 }
 
 type PulsarNodeAddress struct {
@@ -87,6 +90,7 @@ func NewPulsar() Pulsar {
 			RandomHostsRequestTimeout: 1000,
 			PulseRequestTimeout:       1000,
 			RandomNodesCount:          5,
+			BootstrapPublicKeys:       []string{},
 		},
 	}
 }
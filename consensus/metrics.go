@@ -27,6 +27,8 @@ import (
 var (
 	// TagPhase is a tag for consensus metrics.
 	TagPhase = insmetrics.MustTagKey("phase")
+	// TagViolation is a tag for consensus blames metrics.
+	TagViolation = insmetrics.MustTagKey("violation")
 )
 
 var (
@@ -50,6 +52,10 @@ var (
 	Phase3Exec = stats.Int64("consensus/phase3/exec", "Phase 3 execution counter", stats.UnitDimensionless)
 	// ActiveNodes active nodes count after consensus.
 	ActiveNodes = stats.Int64("consensus/activenodes/count", "Active nodes count after consensus", stats.UnitDimensionless)
+	// BlamesEmitted node violation blames emitted by this node counter.
+	BlamesEmitted = stats.Int64("consensus/blames/emitted", "Node violation blames emitted counter", stats.UnitDimensionless)
+	// BlamesAccepted node violation blames received from other nodes and verified counter.
+	BlamesAccepted = stats.Int64("consensus/blames/accepted", "Node violation blames accepted counter", stats.UnitDimensionless)
 )
 
 func init() {
@@ -117,6 +123,20 @@ func init() {
 			Measure:     ActiveNodes,
 			Aggregation: view.LastValue(),
 		},
+		&view.View{
+			Name:        BlamesEmitted.Name(),
+			Description: BlamesEmitted.Description(),
+			Measure:     BlamesEmitted,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{TagViolation},
+		},
+		&view.View{
+			Name:        BlamesAccepted.Name(),
+			Description: BlamesAccepted.Description(),
+			Measure:     BlamesAccepted,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{TagViolation},
+		},
 	)
 	if err != nil {
 		panic(err)
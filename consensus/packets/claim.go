@@ -96,14 +96,48 @@ func (cpa *CapabilityPoolingAndActivation) Type() ClaimType {
 	return TypeCapabilityPollingAndActivation
 }
 
-// NodeViolationBlame is a type 2.
+//go:generate stringer -type=ViolationType
+type ViolationType uint8
+
+const (
+	// ViolationConflictingPulseProofs means node signed different pulse proofs for the same pulse.
+	// Evidence is ConflictingProofsEvidence.
+	ViolationConflictingPulseProofs = ViolationType(iota + 1)
+	// ViolationInvalidJoinClaim means node sent Phase1 packet with NodeJoinClaim that has invalid signature.
+	// Evidence is the serialized Phase1 packet.
+	ViolationInvalidJoinClaim
+	// ViolationWrongPulseData means node sent Phase1 packet with pulse data that doesn't match the pulse.
+	// Evidence is the serialized Phase1 packet.
+	ViolationWrongPulseData
+)
+
+// maxEvidenceSize is a limit of blame evidence size, so the blame length fits in claim header.
+const maxEvidenceSize = 0x3ff - 7
+
+// NodeViolationBlame is a type 2. Evidence is signed by the blamed node, so every node can check it independently.
 type NodeViolationBlame struct {
 	BlameNodeID   uint32
 	TypeViolation uint8
+	Evidence      []byte
+}
+
+// NewNodeViolationBlame creates blame of the node with provided violation evidence.
+func NewNodeViolationBlame(nodeID core.ShortNodeID, violation ViolationType, evidence []byte) (*NodeViolationBlame, error) {
+	if len(evidence) > maxEvidenceSize {
+		return nil, errors.Errorf("evidence is too big: %d bytes", len(evidence))
+	}
+	return &NodeViolationBlame{
+		BlameNodeID:   uint32(nodeID),
+		TypeViolation: uint8(violation),
+		Evidence:      evidence,
+	}, nil
 }
 
 func (nvb *NodeViolationBlame) Clone() ReferendumClaim {
 	result := *nvb
+	if nvb.Evidence != nil {
+		result.Evidence = append([]byte(nil), nvb.Evidence...)
+	}
 	return &result
 }
 
@@ -111,6 +145,27 @@ func (nvb *NodeViolationBlame) Type() ClaimType {
 	return TypeNodeViolationBlame
 }
 
+// Violation returns type of the violation.
+func (nvb *NodeViolationBlame) Violation() ViolationType {
+	return ViolationType(nvb.TypeViolation)
+}
+
+// GetBlameNodeID returns short ID of the blamed node.
+func (nvb *NodeViolationBlame) GetBlameNodeID() core.ShortNodeID {
+	return core.ShortNodeID(nvb.BlameNodeID)
+}
+
+func (nvb *NodeViolationBlame) size() uint16 {
+	return claimSizeMap[TypeNodeViolationBlame] + uint16(len(nvb.Evidence))
+}
+
+// ConflictingProofsEvidence is an evidence of ViolationConflictingPulseProofs.
+type ConflictingProofsEvidence struct {
+	PulseHash [HashLength]byte
+	Proof1    NodePulseProof
+	Proof2    NodePulseProof
+}
+
 const NodeAddressSize = 20
 
 // TODO: create heterogeneous structure for variuos types of adresses (IPv4, IPv6, etc.)
@@ -219,6 +274,10 @@ func (nlc *NodeLeaveClaim) Type() ClaimType {
 }
 
 func getClaimSize(claim ReferendumClaim) uint16 {
+	// blame has variable-sized evidence
+	if blame, ok := claim.(*NodeViolationBlame); ok {
+		return blame.size()
+	}
 	return claimSizeMap[claim.Type()]
 }
 
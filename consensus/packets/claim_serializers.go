@@ -101,6 +101,24 @@ func (nvb *NodeViolationBlame) Deserialize(data io.Reader) error {
 		return errors.Wrap(err, "[ NodeViolationBlame.Deserialize ] Can't read TypeViolation")
 	}
 
+	var evidenceSize uint16
+	err = binary.Read(data, defaultByteOrder, &evidenceSize)
+	if err != nil {
+		return errors.Wrap(err, "[ NodeViolationBlame.Deserialize ] Can't read evidence size")
+	}
+	if evidenceSize > maxEvidenceSize {
+		return errors.New("[ NodeViolationBlame.Deserialize ] Evidence is too big")
+	}
+	if evidenceSize == 0 {
+		return nil
+	}
+
+	nvb.Evidence = make([]byte, evidenceSize)
+	_, err = io.ReadFull(data, nvb.Evidence)
+	if err != nil {
+		return errors.Wrap(err, "[ NodeViolationBlame.Deserialize ] Can't read Evidence")
+	}
+
 	return nil
 }
 
@@ -117,6 +135,58 @@ func (nvb *NodeViolationBlame) Serialize() ([]byte, error) {
 		return nil, errors.Wrap(err, "[ NodeViolationBlame.Serialize ] Can't write TypeViolation")
 	}
 
+	err = binary.Write(result, defaultByteOrder, uint16(len(nvb.Evidence)))
+	if err != nil {
+		return nil, errors.Wrap(err, "[ NodeViolationBlame.Serialize ] Can't write evidence size")
+	}
+
+	_, err = result.Write(nvb.Evidence)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ NodeViolationBlame.Serialize ] Can't write Evidence")
+	}
+
+	return result.Bytes(), nil
+}
+
+// Deserialize implements interface method
+func (cpe *ConflictingProofsEvidence) Deserialize(data io.Reader) error {
+	err := binary.Read(data, defaultByteOrder, &cpe.PulseHash)
+	if err != nil {
+		return errors.Wrap(err, "[ ConflictingProofsEvidence.Deserialize ] Can't read PulseHash")
+	}
+
+	err = cpe.Proof1.Deserialize(data)
+	if err != nil {
+		return errors.Wrap(err, "[ ConflictingProofsEvidence.Deserialize ] Can't read Proof1")
+	}
+
+	err = cpe.Proof2.Deserialize(data)
+	if err != nil {
+		return errors.Wrap(err, "[ ConflictingProofsEvidence.Deserialize ] Can't read Proof2")
+	}
+
+	return nil
+}
+
+// Serialize implements interface method
+func (cpe *ConflictingProofsEvidence) Serialize() ([]byte, error) {
+	result := allocateBuffer(512)
+	err := binary.Write(result, defaultByteOrder, cpe.PulseHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ ConflictingProofsEvidence.Serialize ] Can't write PulseHash")
+	}
+
+	for _, proof := range []*NodePulseProof{&cpe.Proof1, &cpe.Proof2} {
+		proofRaw, err := proof.Serialize()
+		if err != nil {
+			return nil, errors.Wrap(err, "[ ConflictingProofsEvidence.Serialize ] Can't serialize proof")
+		}
+		_, err = result.Write(proofRaw)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ ConflictingProofsEvidence.Serialize ] Can't write proof")
+		}
+	}
+
 	return result.Bytes(), nil
 }
 
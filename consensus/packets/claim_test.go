@@ -22,6 +22,8 @@ import (
 
 	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func makeNodeBroadCast() *NodeBroadcast {
@@ -59,6 +61,47 @@ func TestNodeViolationBlame(t *testing.T) {
 	checkSerializationDeserialization(t, makeNodeViolationBlame())
 }
 
+func TestNodeViolationBlame_Evidence(t *testing.T) {
+	blame, err := NewNodeViolationBlame(core.ShortNodeID(11), ViolationWrongPulseData, genRandomSlice(300))
+	require.NoError(t, err)
+	assert.Equal(t, ViolationWrongPulseData, blame.Violation())
+	assert.Equal(t, core.ShortNodeID(11), blame.GetBlameNodeID())
+	assert.Equal(t, uint16(len(serializeData(t, blame))), getClaimSize(blame))
+
+	checkSerializationDeserialization(t, blame)
+	checkBadDataSerializationDeserialization(t, blame, "unexpected EOF")
+
+	clone := blame.Clone().(*NodeViolationBlame)
+	assert.Equal(t, blame, clone)
+	clone.Evidence[0]++
+	assert.NotEqual(t, blame.Evidence[0], clone.Evidence[0])
+}
+
+func TestNodeViolationBlame_TooBigEvidence(t *testing.T) {
+	_, err := NewNodeViolationBlame(core.ShortNodeID(11), ViolationWrongPulseData, genRandomSlice(maxEvidenceSize+1))
+	assert.Error(t, err)
+
+	blame, err := NewNodeViolationBlame(core.ShortNodeID(11), ViolationWrongPulseData, genRandomSlice(maxEvidenceSize))
+	require.NoError(t, err)
+	packet := NewPhase1Packet(core.Pulse{})
+	assert.True(t, packet.AddClaim(blame))
+}
+
+func TestConflictingProofsEvidence(t *testing.T) {
+	evidence := &ConflictingProofsEvidence{
+		PulseHash: randomArray64(),
+		Proof1:    NodePulseProof{NodeSignature: randomArray66(), NodeStateHash: randomArray64()},
+		Proof2:    NodePulseProof{NodeSignature: randomArray66(), NodeStateHash: randomArray64()},
+	}
+	checkSerializationDeserialization(t, evidence)
+	checkBadDataSerializationDeserialization(t, evidence, "unexpected EOF")
+}
+
+func TestViolationType_String(t *testing.T) {
+	assert.Equal(t, "ViolationInvalidJoinClaim", ViolationInvalidJoinClaim.String())
+	assert.Equal(t, "ViolationType(4)", ViolationType(4).String())
+}
+
 func makeNodeJoinClaim(withSignature bool) *NodeJoinClaim {
 	nodeJoinClaim := &NodeJoinClaim{}
 	nodeJoinClaim.ShortNodeID = core.ShortNodeID(77)
@@ -270,6 +270,25 @@ func TestPhase1Packet_Deserialize(t *testing.T) {
 	checkSerializationDeserialization(t, makePhase1Packet())
 }
 
+func TestPhase1Packet_HasPulseData(t *testing.T) {
+	pulse := core.Pulse{
+		PulseNumber:     core.FirstPulseNumber + 10,
+		PrevPulseNumber: core.FirstPulseNumber,
+		NextPulseNumber: core.FirstPulseNumber + 20,
+		Entropy:         core.Entropy{1, 2, 3},
+	}
+	packet := NewPhase1Packet(pulse)
+	assert.True(t, packet.HasPulseData(pulse))
+
+	otherEntropy := pulse
+	otherEntropy.Entropy = core.Entropy{3, 2, 1}
+	assert.False(t, packet.HasPulseData(otherEntropy))
+
+	otherNumber := pulse
+	otherNumber.PulseNumber++
+	assert.False(t, packet.HasPulseData(otherNumber))
+}
+
 func makePhase2Packet() *Phase2Packet {
 	phase2Packet := &Phase2Packet{}
 	phase2Packet.packetHeader = *makeDefaultPacketHeader(Phase2)
@@ -124,6 +124,11 @@ func dataToPulse(number core.PulseNumber, data PulseDataExt) core.Pulse {
 	return result
 }
 
+// HasPulseData checks that packet is sent in the pulse and contains the same pulse data.
+func (p1p *Phase1Packet) HasPulseData(pulse core.Pulse) bool {
+	return p1p.GetPulseNumber() == pulse.PulseNumber && p1p.pulseData == pulseToDataExt(pulse)
+}
+
 func (p1p *Phase1Packet) hasPulseDataExt() bool { // nolint: megacheck
 	return p1p.packetHeader.f00
 }
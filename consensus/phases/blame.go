@@ -0,0 +1,190 @@
+/*
+ * The Clear BSD License
+ *
+ * Copyright (c) 2019 Insolar Technologies
+ *
+ * All rights reserved.
+ *
+ * Redistribution and use in source and binary forms, with or without modification, are permitted (subject to the limitations in the disclaimer below) provided that the following conditions are met:
+ *
+ *  Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+ *  Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+ *  Neither the name of Insolar Technologies nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+ *
+ * NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ */
+
+package phases
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+
+	"github.com/insolar/insolar/consensus"
+	"github.com/insolar/insolar/consensus/packets"
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network/merkle"
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+)
+
+// blamePacket blames origin of the signed phase1 packet, the packet itself is the evidence of the violation.
+func (fp *FirstPhaseImpl) blamePacket(ctx context.Context, pulse *core.Pulse, violation packets.ViolationType, packet *packets.Phase1Packet) {
+	evidence, err := packet.Serialize()
+	if err != nil {
+		inslogger.FromContext(ctx).Warnf("[ NET Consensus phase-1 ] Failed to serialize %s evidence: %s", violation, err.Error())
+		return
+	}
+	fp.blame(ctx, pulse, packet.GetOrigin(), violation, evidence)
+}
+
+// blameConflictingProofs blames origin of the phase1 packets that contain different pulse proofs for the same pulse.
+func (fp *FirstPhaseImpl) blameConflictingProofs(ctx context.Context, pulse *core.Pulse, pulseHash []byte, first, second *packets.Phase1Packet) {
+	evidence := packets.ConflictingProofsEvidence{
+		Proof1: *first.GetPulseProof(),
+		Proof2: *second.GetPulseProof(),
+	}
+	copy(evidence.PulseHash[:], pulseHash)
+	evidenceRaw, err := evidence.Serialize()
+	if err != nil {
+		inslogger.FromContext(ctx).Warnf("[ NET Consensus phase-1 ] Failed to serialize %s evidence: %s",
+			packets.ViolationConflictingPulseProofs, err.Error())
+		return
+	}
+	fp.blame(ctx, pulse, second.GetOrigin(), packets.ViolationConflictingPulseProofs, evidenceRaw)
+}
+
+// blame adds violation blame to the claim queue, so it is sent to other nodes in the next pulse.
+func (fp *FirstPhaseImpl) blame(ctx context.Context, pulse *core.Pulse, nodeID core.ShortNodeID, violation packets.ViolationType, evidence []byte) {
+	logger := inslogger.FromContext(ctx)
+	blame, err := packets.NewNodeViolationBlame(nodeID, violation, evidence)
+	if err != nil {
+		logger.Warnf("[ NET Consensus phase-1 ] Failed to blame node %d for %s: %s", nodeID, violation, err.Error())
+		return
+	}
+	// other nodes should be able to verify the blame, so check it the same way they will
+	err = fp.checkBlame(blame, pulse)
+	if err != nil {
+		logger.Warnf("[ NET Consensus phase-1 ] Failed to blame node %d for %s, evidence is invalid: %s", nodeID, violation, err.Error())
+		return
+	}
+	if !fp.NodeKeeper.AddPendingClaim(blame) {
+		logger.Warnf("[ NET Consensus phase-1 ] Failed to add blame of node %d for %s to the claim queue", nodeID, violation)
+		return
+	}
+
+	logger.Warnf("[ NET Consensus phase-1 ] Blamed node %d for %s", nodeID, violation)
+	err = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(consensus.TagViolation, violation.String())}, consensus.BlamesEmitted.M(1))
+	if err != nil {
+		logger.Warn("[ NET Consensus phase-1 ] Failed to record emitted blames metric: " + err.Error())
+	}
+}
+
+// errBlameSkipped is returned for blames this node can't check. Such blames are dropped, but not counted as declined.
+var errBlameSkipped = errors.New("blame can't be checked by this node")
+
+// acceptBlame checks blame received from other node in the provided pulse. Blames are sent in the pulse after
+// the violation, so pulse data of the violation pulse is known only if this node has processed it (it's not the case
+// after restart or join). Blames for wrong pulse data are skipped then.
+func (fp *FirstPhaseImpl) acceptBlame(ctx context.Context, pulse *core.Pulse, nodeID core.RecordRef, blame *packets.NodeViolationBlame) error {
+	violationPulse := fp.prevPulse
+	if violationPulse != nil && violationPulse.PulseNumber != pulse.PrevPulseNumber {
+		violationPulse = nil
+	}
+	if violationPulse == nil && blame.Violation() == packets.ViolationWrongPulseData {
+		return errBlameSkipped
+	}
+
+	err := fp.checkBlame(blame, violationPulse)
+	if err != nil {
+		return err
+	}
+
+	logger := inslogger.FromContext(ctx)
+	logger.Warnf("[ NET Consensus phase-1 ] Node %s blamed node %d for %s, node will be evicted",
+		nodeID, blame.GetBlameNodeID(), blame.Violation())
+	err = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(consensus.TagViolation, blame.Violation().String())}, consensus.BlamesAccepted.M(1))
+	if err != nil {
+		logger.Warn("[ NET Consensus phase-1 ] Failed to record accepted blames metric: " + err.Error())
+	}
+	return nil
+}
+
+// checkBlame verifies evidence of the blame. Pulse is the pulse the violation has happened in.
+func (fp *FirstPhaseImpl) checkBlame(blame *packets.NodeViolationBlame, pulse *core.Pulse) error {
+	node := fp.NodeKeeper.GetActiveNodeByShortID(blame.GetBlameNodeID())
+	if node == nil {
+		return errors.Errorf("blamed node %d is not active", blame.BlameNodeID)
+	}
+
+	switch blame.Violation() {
+	case packets.ViolationConflictingPulseProofs:
+		return fp.checkConflictingProofs(node.PublicKey(), blame.Evidence)
+	case packets.ViolationInvalidJoinClaim, packets.ViolationWrongPulseData:
+		packet := &packets.Phase1Packet{}
+		err := packet.Deserialize(bytes.NewReader(blame.Evidence))
+		if err != nil {
+			return errors.Wrap(err, "failed to deserialize evidence")
+		}
+		if packet.GetOrigin() != blame.GetBlameNodeID() {
+			return errors.New("evidence is sent by other node")
+		}
+		err = packet.Verify(fp.Cryptography, node.PublicKey())
+		if err != nil {
+			return errors.Wrap(err, "failed to check evidence signature")
+		}
+		if blame.Violation() == packets.ViolationInvalidJoinClaim {
+			return fp.checkInvalidJoinClaim(packet)
+		}
+		return checkWrongPulseData(packet, pulse)
+	default:
+		return errors.Errorf("unknown violation %s", blame.Violation())
+	}
+}
+
+func (fp *FirstPhaseImpl) checkConflictingProofs(key crypto.PublicKey, evidenceRaw []byte) error {
+	evidence := packets.ConflictingProofsEvidence{}
+	err := evidence.Deserialize(bytes.NewReader(evidenceRaw))
+	if err != nil {
+		return errors.Wrap(err, "failed to deserialize evidence")
+	}
+	if evidence.Proof1.NodeStateHash == evidence.Proof2.NodeStateHash {
+		return errors.New("proofs have the same state hash")
+	}
+	for _, proof := range []*packets.NodePulseProof{&evidence.Proof1, &evidence.Proof2} {
+		pulseProof := &merkle.PulseProof{
+			BaseProof: merkle.BaseProof{
+				Signature: core.SignatureFromBytes(proof.Signature()),
+			},
+			StateHash: proof.StateHash(),
+		}
+		if !fp.Calculator.IsValid(pulseProof, evidence.PulseHash[:], key) {
+			return errors.New("proof is not signed by blamed node")
+		}
+	}
+	return nil
+}
+
+func (fp *FirstPhaseImpl) checkInvalidJoinClaim(packet *packets.Phase1Packet) error {
+	for _, claim := range packet.GetClaims() {
+		joinClaim, ok := claim.(*packets.NodeJoinClaim)
+		if ok && fp.checkClaimSignature(joinClaim) != nil {
+			return nil
+		}
+	}
+	return errors.New("evidence has no join claims with invalid signature")
+}
+
+func checkWrongPulseData(packet *packets.Phase1Packet, pulse *core.Pulse) error {
+	if pulse == nil || packet.GetPulseNumber() != pulse.PulseNumber {
+		return errors.New("evidence is sent in unknown pulse")
+	}
+	if packet.HasPulseData(*pulse) {
+		return errors.New("evidence has valid pulse data")
+	}
+	return nil
+}
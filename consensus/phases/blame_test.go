@@ -0,0 +1,184 @@
+/*
+ * The Clear BSD License
+ *
+ * Copyright (c) 2019 Insolar Technologies
+ *
+ * All rights reserved.
+ *
+ * Redistribution and use in source and binary forms, with or without modification, are permitted (subject to the limitations in the disclaimer below) provided that the following conditions are met:
+ *
+ *  Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+ *  Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+ *  Neither the name of Insolar Technologies nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+ *
+ * NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ */
+
+package phases
+
+import (
+	"context"
+	"crypto"
+	"testing"
+
+	"github.com/insolar/insolar/consensus/packets"
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/cryptography"
+	networkMerkle "github.com/insolar/insolar/network/merkle"
+	"github.com/insolar/insolar/network/nodenetwork"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/testutils"
+	"github.com/insolar/insolar/testutils/merkle"
+	"github.com/insolar/insolar/testutils/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type blameTestEnv struct {
+	firstPhase *FirstPhaseImpl
+	blamed     core.Node
+	service    core.CryptographyService
+	claims     []packets.ReferendumClaim
+}
+
+func newBlameTestEnv(t *testing.T) *blameTestEnv {
+	key, err := platformpolicy.NewKeyProcessor().GeneratePrivateKey()
+	require.NoError(t, err)
+	service := cryptography.NewKeyBoundCryptographyService(key)
+	publicKey, err := service.GetPublicKey()
+	require.NoError(t, err)
+
+	env := &blameTestEnv{
+		blamed:  nodenetwork.NewNode(testutils.RandomRef(), core.StaticRoleVirtual, publicKey, "127.0.0.1:1", ""),
+		service: service,
+	}
+
+	nodeKeeper := network.NewNodeKeeperMock(t)
+	nodeKeeper.GetActiveNodeByShortIDFunc = func(id core.ShortNodeID) core.Node {
+		if id == env.blamed.ShortID() {
+			return env.blamed
+		}
+		return nil
+	}
+	nodeKeeper.AddPendingClaimFunc = func(claim packets.ReferendumClaim) bool {
+		env.claims = append(env.claims, claim)
+		return true
+	}
+
+	calculator := merkle.NewCalculatorMock(t)
+	calculator.IsValidFunc = func(proof networkMerkle.Proof, hash networkMerkle.OriginHash, key crypto.PublicKey) bool {
+		return key == publicKey
+	}
+
+	env.firstPhase = &FirstPhaseImpl{
+		Calculator:   calculator,
+		Cryptography: service,
+		NodeKeeper:   nodeKeeper,
+	}
+	return env
+}
+
+func (env *blameTestEnv) signedPacket(t *testing.T, pulse core.Pulse, claims ...packets.ReferendumClaim) *packets.Phase1Packet {
+	packet := packets.NewPhase1Packet(pulse)
+	err := packet.SetPulseProof(testutils.RandomRef().Bytes(), make([]byte, packets.SignatureLength))
+	require.NoError(t, err)
+	for _, claim := range claims {
+		require.True(t, packet.AddClaim(claim))
+	}
+	packet.SetRouting(env.blamed.ShortID(), core.ShortNodeID(1))
+	require.NoError(t, packet.Sign(env.service))
+	return packet
+}
+
+func testPulse(entropy byte) core.Pulse {
+	return core.Pulse{
+		PulseNumber:     core.FirstPulseNumber + 10,
+		PrevPulseNumber: core.FirstPulseNumber,
+		NextPulseNumber: core.FirstPulseNumber + 20,
+		Entropy:         core.Entropy{entropy},
+	}
+}
+
+func packetBlame(t *testing.T, violation packets.ViolationType, packet *packets.Phase1Packet) *packets.NodeViolationBlame {
+	evidence, err := packet.Serialize()
+	require.NoError(t, err)
+	blame, err := packets.NewNodeViolationBlame(packet.GetOrigin(), violation, evidence)
+	require.NoError(t, err)
+	return blame
+}
+
+func TestFirstPhase_checkBlame_WrongPulseData(t *testing.T) {
+	env := newBlameTestEnv(t)
+	pulse := testPulse(1)
+	wrongPulse := testPulse(2)
+	blame := packetBlame(t, packets.ViolationWrongPulseData, env.signedPacket(t, wrongPulse))
+
+	assert.NoError(t, env.firstPhase.checkBlame(blame, &pulse))
+	assert.Error(t, env.firstPhase.checkBlame(blame, &wrongPulse))
+	assert.Error(t, env.firstPhase.checkBlame(blame, nil))
+
+	blame.Evidence[len(blame.Evidence)-1]++
+	assert.Error(t, env.firstPhase.checkBlame(blame, &pulse))
+}
+
+func TestFirstPhase_checkBlame_InvalidJoinClaim(t *testing.T) {
+	env := newBlameTestEnv(t)
+	pulse := testPulse(1)
+
+	joinClaim := &packets.NodeJoinClaim{NodeRef: testutils.RandomRef()}
+	copy(joinClaim.NodePK[:], testutils.RandomRef().Bytes())
+	blame := packetBlame(t, packets.ViolationInvalidJoinClaim, env.signedPacket(t, pulse, joinClaim))
+	assert.NoError(t, env.firstPhase.checkBlame(blame, &pulse))
+
+	blame = packetBlame(t, packets.ViolationInvalidJoinClaim, env.signedPacket(t, pulse))
+	assert.Error(t, env.firstPhase.checkBlame(blame, &pulse))
+}
+
+func TestFirstPhase_checkBlame_ConflictingProofs(t *testing.T) {
+	env := newBlameTestEnv(t)
+	pulse := testPulse(1)
+	first := env.signedPacket(t, pulse)
+	second := env.signedPacket(t, pulse)
+
+	env.firstPhase.blameConflictingProofs(context.Background(), &pulse, testutils.RandomRef().Bytes(), first, second)
+	require.Len(t, env.claims, 1)
+	blame := env.claims[0].(*packets.NodeViolationBlame)
+	assert.Equal(t, packets.ViolationConflictingPulseProofs, blame.Violation())
+	assert.Equal(t, env.blamed.ShortID(), blame.GetBlameNodeID())
+
+	// the same state hash is not a violation
+	env.firstPhase.blameConflictingProofs(context.Background(), &pulse, testutils.RandomRef().Bytes(), first, first)
+	assert.Len(t, env.claims, 1)
+}
+
+func TestFirstPhase_checkBlame_UnknownNode(t *testing.T) {
+	env := newBlameTestEnv(t)
+	pulse := testPulse(1)
+	blame := packetBlame(t, packets.ViolationWrongPulseData, env.signedPacket(t, testPulse(2)))
+	blame.BlameNodeID++
+
+	assert.Error(t, env.firstPhase.checkBlame(blame, &pulse))
+}
+
+func TestFirstPhase_acceptBlame(t *testing.T) {
+	env := newBlameTestEnv(t)
+	pulse := testPulse(1)
+	nextPulse := core.Pulse{PulseNumber: pulse.NextPulseNumber, PrevPulseNumber: pulse.PulseNumber}
+	blame := packetBlame(t, packets.ViolationWrongPulseData, env.signedPacket(t, testPulse(2)))
+
+	// previous pulse is unknown after restart or join
+	assert.Equal(t, errBlameSkipped, env.firstPhase.acceptBlame(context.Background(), &nextPulse, testutils.RandomRef(), blame))
+	env.firstPhase.prevPulse = &pulse
+	assert.NoError(t, env.firstPhase.acceptBlame(context.Background(), &nextPulse, testutils.RandomRef(), blame))
+	// the node has missed the violation pulse
+	laterPulse := core.Pulse{PulseNumber: nextPulse.PulseNumber + 10, PrevPulseNumber: nextPulse.PulseNumber}
+	assert.Equal(t, errBlameSkipped, env.firstPhase.acceptBlame(context.Background(), &laterPulse, testutils.RandomRef(), blame))
+
+	// other violations don't depend on the previous pulse
+	joinClaim := &packets.NodeJoinClaim{NodeRef: testutils.RandomRef()}
+	copy(joinClaim.NodePK[:], testutils.RandomRef().Bytes())
+	blame = packetBlame(t, packets.ViolationInvalidJoinClaim, env.signedPacket(t, pulse, joinClaim))
+	env.firstPhase.prevPulse = nil
+	assert.NoError(t, env.firstPhase.acceptBlame(context.Background(), &nextPulse, testutils.RandomRef(), blame))
+}
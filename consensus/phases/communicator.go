@@ -19,6 +19,7 @@ package phases
 
 import (
 	"context"
+	"sync"
 	"sync/atomic"
 
 	"github.com/pkg/errors"
@@ -46,6 +47,9 @@ type Communicator interface {
 		participants []core.Node,
 		packet *packets.Phase1Packet,
 	) (map[core.RecordRef]*packets.Phase1Packet, error)
+	// GetConflictingPhase1Packets returns packets received during the last ExchangePhase1 that
+	// have different pulse state hash than the packets from the same nodes returned by ExchangePhase1
+	GetConflictingPhase1Packets() map[core.RecordRef]*packets.Phase1Packet
 	// ExchangePhase2 used in second consensus step to exchange data between participants
 	ExchangePhase2(ctx context.Context, list network.UnsyncList, participants []core.Node, packet *packets.Phase2Packet) (map[core.RecordRef]*packets.Phase2Packet, error)
 	// ExchangePhase21 is used between phases 2 and 3 of consensus to send additional MissingNode requests
@@ -83,6 +87,9 @@ type ConsensusCommunicator struct {
 	phase3result chan phase3Result
 
 	currentPulseNumber uint32
+
+	conflictsLock sync.Mutex
+	conflicts     map[core.RecordRef]*packets.Phase1Packet
 }
 
 // NewCommunicator constructor creates new ConsensusCommunicator
@@ -241,6 +248,8 @@ func (nc *ConsensusCommunicator) ExchangePhase1(
 
 	result := make(map[core.RecordRef]*packets.Phase1Packet, len(participants))
 	result[nc.ConsensusNetwork.GetNodeID()] = packet
+	conflicts := make(map[core.RecordRef]*packets.Phase1Packet)
+	nc.setConflicts(conflicts)
 	nc.setPulseNumber(packet.GetPulse().PulseNumber)
 
 	var request *packets.Phase1Packet
@@ -310,7 +319,15 @@ func (nc *ConsensusCommunicator) ExchangePhase1(
 			}
 			if !res.id.IsEmpty() {
 				sentRequests[res.id] = none{}
-				result[res.id] = res.packet
+				received, ok := result[res.id]
+				if !ok {
+					result[res.id] = res.packet
+				} else if received.GetPulseProof().NodeStateHash != res.packet.GetPulseProof().NodeStateHash {
+					logger.Warnf("Got phase1 packet with conflicting pulse proof from %s", res.id)
+					nc.conflictsLock.Lock()
+					conflicts[res.id] = res.packet
+					nc.conflictsLock.Unlock()
+				}
 			}
 
 			// FIXME: early return is commented to have synchronized length of phases on all nodes
@@ -323,6 +340,26 @@ func (nc *ConsensusCommunicator) ExchangePhase1(
 	}
 }
 
+// GetConflictingPhase1Packets returns packets received during the last ExchangePhase1 that
+// have different pulse state hash than the packets from the same nodes returned by ExchangePhase1
+func (nc *ConsensusCommunicator) GetConflictingPhase1Packets() map[core.RecordRef]*packets.Phase1Packet {
+	nc.conflictsLock.Lock()
+	defer nc.conflictsLock.Unlock()
+
+	result := make(map[core.RecordRef]*packets.Phase1Packet, len(nc.conflicts))
+	for ref, packet := range nc.conflicts {
+		result[ref] = packet
+	}
+	return result
+}
+
+func (nc *ConsensusCommunicator) setConflicts(conflicts map[core.RecordRef]*packets.Phase1Packet) {
+	nc.conflictsLock.Lock()
+	defer nc.conflictsLock.Unlock()
+
+	nc.conflicts = conflicts
+}
+
 // ExchangePhase2 used in second consensus phase to exchange data between participants
 func (nc *ConsensusCommunicator) ExchangePhase2(ctx context.Context, list network.UnsyncList,
 	participants []core.Node, packet *packets.Phase2Packet) (map[core.RecordRef]*packets.Phase2Packet, error) {
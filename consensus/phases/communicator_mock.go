@@ -42,6 +42,11 @@ type CommunicatorMock struct {
 	ExchangePhase3PreCounter uint64
 	ExchangePhase3Mock       mCommunicatorMockExchangePhase3
 
+	GetConflictingPhase1PacketsFunc       func() (r map[core.RecordRef]*packets.Phase1Packet)
+	GetConflictingPhase1PacketsCounter    uint64
+	GetConflictingPhase1PacketsPreCounter uint64
+	GetConflictingPhase1PacketsMock       mCommunicatorMockGetConflictingPhase1Packets
+
 	InitFunc       func(p context.Context) (r error)
 	InitCounter    uint64
 	InitPreCounter uint64
@@ -60,6 +65,7 @@ func NewCommunicatorMock(t minimock.Tester) *CommunicatorMock {
 	m.ExchangePhase2Mock = mCommunicatorMockExchangePhase2{mock: m}
 	m.ExchangePhase21Mock = mCommunicatorMockExchangePhase21{mock: m}
 	m.ExchangePhase3Mock = mCommunicatorMockExchangePhase3{mock: m}
+	m.GetConflictingPhase1PacketsMock = mCommunicatorMockGetConflictingPhase1Packets{mock: m}
 	m.InitMock = mCommunicatorMockInit{mock: m}
 
 	return m
@@ -676,6 +682,140 @@ func (m *CommunicatorMock) ExchangePhase3Finished() bool {
 	return true
 }
 
+type mCommunicatorMockGetConflictingPhase1Packets struct {
+	mock              *CommunicatorMock
+	mainExpectation   *CommunicatorMockGetConflictingPhase1PacketsExpectation
+	expectationSeries []*CommunicatorMockGetConflictingPhase1PacketsExpectation
+}
+
+type CommunicatorMockGetConflictingPhase1PacketsExpectation struct {
+	result *CommunicatorMockGetConflictingPhase1PacketsResult
+}
+
+type CommunicatorMockGetConflictingPhase1PacketsResult struct {
+	r map[core.RecordRef]*packets.Phase1Packet
+}
+
+//Expect specifies that invocation of Communicator.GetConflictingPhase1Packets is expected from 1 to Infinity times
+func (m *mCommunicatorMockGetConflictingPhase1Packets) Expect() *mCommunicatorMockGetConflictingPhase1Packets {
+	m.mock.GetConflictingPhase1PacketsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &CommunicatorMockGetConflictingPhase1PacketsExpectation{}
+	}
+
+	return m
+}
+
+//Return specifies results of invocation of Communicator.GetConflictingPhase1Packets
+func (m *mCommunicatorMockGetConflictingPhase1Packets) Return(r map[core.RecordRef]*packets.Phase1Packet) *CommunicatorMock {
+	m.mock.GetConflictingPhase1PacketsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &CommunicatorMockGetConflictingPhase1PacketsExpectation{}
+	}
+	m.mainExpectation.result = &CommunicatorMockGetConflictingPhase1PacketsResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Communicator.GetConflictingPhase1Packets is expected once
+func (m *mCommunicatorMockGetConflictingPhase1Packets) ExpectOnce() *CommunicatorMockGetConflictingPhase1PacketsExpectation {
+	m.mock.GetConflictingPhase1PacketsFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &CommunicatorMockGetConflictingPhase1PacketsExpectation{}
+
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *CommunicatorMockGetConflictingPhase1PacketsExpectation) Return(r map[core.RecordRef]*packets.Phase1Packet) {
+	e.result = &CommunicatorMockGetConflictingPhase1PacketsResult{r}
+}
+
+//Set uses given function f as a mock of Communicator.GetConflictingPhase1Packets method
+func (m *mCommunicatorMockGetConflictingPhase1Packets) Set(f func() (r map[core.RecordRef]*packets.Phase1Packet)) *CommunicatorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetConflictingPhase1PacketsFunc = f
+	return m.mock
+}
+
+//GetConflictingPhase1Packets implements github.com/insolar/insolar/consensus/phases.Communicator interface
+func (m *CommunicatorMock) GetConflictingPhase1Packets() (r map[core.RecordRef]*packets.Phase1Packet) {
+	counter := atomic.AddUint64(&m.GetConflictingPhase1PacketsPreCounter, 1)
+	defer atomic.AddUint64(&m.GetConflictingPhase1PacketsCounter, 1)
+
+	if len(m.GetConflictingPhase1PacketsMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetConflictingPhase1PacketsMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to CommunicatorMock.GetConflictingPhase1Packets.")
+			return
+		}
+
+		result := m.GetConflictingPhase1PacketsMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the CommunicatorMock.GetConflictingPhase1Packets")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.GetConflictingPhase1PacketsMock.mainExpectation != nil {
+
+		result := m.GetConflictingPhase1PacketsMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the CommunicatorMock.GetConflictingPhase1Packets")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.GetConflictingPhase1PacketsFunc == nil {
+		m.t.Fatalf("Unexpected call to CommunicatorMock.GetConflictingPhase1Packets.")
+		return
+	}
+
+	return m.GetConflictingPhase1PacketsFunc()
+}
+
+//GetConflictingPhase1PacketsMinimockCounter returns a count of CommunicatorMock.GetConflictingPhase1PacketsFunc invocations
+func (m *CommunicatorMock) GetConflictingPhase1PacketsMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetConflictingPhase1PacketsCounter)
+}
+
+//GetConflictingPhase1PacketsMinimockPreCounter returns the value of CommunicatorMock.GetConflictingPhase1Packets invocations
+func (m *CommunicatorMock) GetConflictingPhase1PacketsMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetConflictingPhase1PacketsPreCounter)
+}
+
+//GetConflictingPhase1PacketsFinished returns true if mock invocations count is ok
+func (m *CommunicatorMock) GetConflictingPhase1PacketsFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetConflictingPhase1PacketsMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetConflictingPhase1PacketsCounter) == uint64(len(m.GetConflictingPhase1PacketsMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetConflictingPhase1PacketsMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetConflictingPhase1PacketsCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetConflictingPhase1PacketsFunc != nil {
+		return atomic.LoadUint64(&m.GetConflictingPhase1PacketsCounter) > 0
+	}
+
+	return true
+}
+
 type mCommunicatorMockInit struct {
 	mock              *CommunicatorMock
 	mainExpectation   *CommunicatorMockInitExpectation
@@ -843,6 +983,10 @@ func (m *CommunicatorMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to CommunicatorMock.ExchangePhase3")
 	}
 
+	if !m.GetConflictingPhase1PacketsFinished() {
+		m.t.Fatal("Expected call to CommunicatorMock.GetConflictingPhase1Packets")
+	}
+
 	if !m.InitFinished() {
 		m.t.Fatal("Expected call to CommunicatorMock.Init")
 	}
@@ -880,6 +1024,10 @@ func (m *CommunicatorMock) MinimockFinish() {
 		m.t.Fatal("Expected call to CommunicatorMock.ExchangePhase3")
 	}
 
+	if !m.GetConflictingPhase1PacketsFinished() {
+		m.t.Fatal("Expected call to CommunicatorMock.GetConflictingPhase1Packets")
+	}
+
 	if !m.InitFinished() {
 		m.t.Fatal("Expected call to CommunicatorMock.Init")
 	}
@@ -902,6 +1050,7 @@ func (m *CommunicatorMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.ExchangePhase2Finished()
 		ok = ok && m.ExchangePhase21Finished()
 		ok = ok && m.ExchangePhase3Finished()
+		ok = ok && m.GetConflictingPhase1PacketsFinished()
 		ok = ok && m.InitFinished()
 
 		if ok {
@@ -927,6 +1076,10 @@ func (m *CommunicatorMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to CommunicatorMock.ExchangePhase3")
 			}
 
+			if !m.GetConflictingPhase1PacketsFinished() {
+				m.t.Error("Expected call to CommunicatorMock.GetConflictingPhase1Packets")
+			}
+
 			if !m.InitFinished() {
 				m.t.Error("Expected call to CommunicatorMock.Init")
 			}
@@ -959,6 +1112,10 @@ func (m *CommunicatorMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.GetConflictingPhase1PacketsFinished() {
+		return false
+	}
+
 	if !m.InitFinished() {
 		return false
 	}
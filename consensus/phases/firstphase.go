@@ -66,6 +66,9 @@ type FirstPhaseImpl struct {
 	Communicator Communicator             `inject:""`
 	Cryptography core.CryptographyService `inject:""`
 	NodeKeeper   network.NodeKeeper       `inject:""`
+
+	// prevPulse is used to check blames, that are sent in the pulse after the violation
+	prevPulse *core.Pulse
 }
 
 // Execute do first phase
@@ -145,15 +148,22 @@ func (fp *FirstPhaseImpl) Execute(ctx context.Context, pulse *core.Pulse) (*Firs
 	proofSet := make(map[core.RecordRef]*merkle.PulseProof)
 	rawProofs := make(map[core.RecordRef]*packets.NodePulseProof)
 	claimMap := make(map[core.RecordRef][]packets.ReferendumClaim)
+	canBlame := fp.NodeKeeper.GetState() == core.ReadyNodeNetworkState
 	for ref, packet := range resultPackets {
 		err = nil
-		if !ref.Equal(fp.NodeKeeper.GetOrigin().ID()) {
+		isOrigin := ref.Equal(fp.NodeKeeper.GetOrigin().ID())
+		if !isOrigin {
 			err = fp.checkPacketSignature(packet, ref)
 		}
 		if err != nil {
 			logger.Warnf("[ NET Consensus phase-1 ] Failed to check phase1 packet signature from %s: %s", ref, err.Error())
 			continue
 		}
+		if canBlame && !isOrigin && !packet.HasPulseData(*pulse) {
+			logger.Warnf("[ NET Consensus phase-1 ] Got phase1 packet with wrong pulse data from %s", ref)
+			fp.blamePacket(ctx, pulse, packets.ViolationWrongPulseData, packet)
+			continue
+		}
 		rawProof := packet.GetPulseProof()
 		rawProofs[ref] = rawProof
 		proofSet[ref] = &merkle.PulseProof{
@@ -162,7 +172,17 @@ func (fp *FirstPhaseImpl) Execute(ctx context.Context, pulse *core.Pulse) (*Firs
 			},
 			StateHash: rawProof.StateHash(),
 		}
-		claimMap[ref] = fp.filterClaims(ref, packet.GetClaims())
+		claimMap[ref] = fp.filterClaims(ctx, pulse, ref, packet)
+	}
+
+	if canBlame {
+		for ref, conflict := range fp.Communicator.GetConflictingPhase1Packets() {
+			packet, ok := resultPackets[ref]
+			if !ok || fp.checkPacketSignature(packet, ref) != nil || fp.checkPacketSignature(conflict, ref) != nil {
+				continue
+			}
+			fp.blameConflictingProofs(ctx, pulse, pulseHash, packet, conflict)
+		}
 	}
 
 	if fp.NodeKeeper.GetState() == core.WaitingNodeNetworkState {
@@ -187,6 +207,7 @@ func (fp *FirstPhaseImpl) Execute(ctx context.Context, pulse *core.Pulse) (*Firs
 		unsyncList.RemoveNode(nodeID)
 	}
 	logger.Infof("[ NET Consensus phase-1 ] Valid proofs after phase: %d/%d", len(valid), unsyncList.Length())
+	fp.prevPulse = pulse
 
 	return &FirstPhaseState{
 		PulseEntry:  entry,
@@ -241,15 +262,36 @@ func detectSparseBitsetLength(claims map[core.RecordRef][]packets.ReferendumClai
 	return 0, errors.New("no announce claims were received")
 }
 
-func (fp *FirstPhaseImpl) filterClaims(nodeID core.RecordRef, claims []packets.ReferendumClaim) []packets.ReferendumClaim {
+func (fp *FirstPhaseImpl) filterClaims(ctx context.Context, pulse *core.Pulse, nodeID core.RecordRef, packet *packets.Phase1Packet) []packets.ReferendumClaim {
 	result := make([]packets.ReferendumClaim, 0)
-	for _, claim := range claims {
+	isOrigin := nodeID.Equal(fp.NodeKeeper.GetOrigin().ID())
+	blamed := false
+	for _, claim := range packet.GetClaims() {
 		signedClaim, ok := claim.(packets.SignedClaim)
-		if ok && !nodeID.Equal(fp.NodeKeeper.GetOrigin().ID()) {
+		if ok && !isOrigin {
 			err := fp.checkClaimSignature(signedClaim)
 			if err != nil {
 				stats.Record(context.Background(), consensus.DeclinedClaims.M(1))
 				log.Error("failed to check claim signature: " + err.Error())
+				_, isJoinClaim := claim.(*packets.NodeJoinClaim)
+				if isJoinClaim && !blamed && fp.NodeKeeper.GetState() == core.ReadyNodeNetworkState {
+					fp.blamePacket(ctx, pulse, packets.ViolationInvalidJoinClaim, packet)
+					blamed = true
+				}
+				continue
+			}
+		}
+		blame, ok := claim.(*packets.NodeViolationBlame)
+		if ok && !isOrigin {
+			err := fp.acceptBlame(ctx, pulse, nodeID, blame)
+			if err == errBlameSkipped {
+				log.Infof("skipped violation blame of node %d for %s: violation pulse is unknown",
+					blame.GetBlameNodeID(), blame.Violation())
+				continue
+			}
+			if err != nil {
+				stats.Record(context.Background(), consensus.DeclinedClaims.M(1))
+				log.Error("failed to check violation blame: " + err.Error())
 				continue
 			}
 		}
@@ -144,6 +144,10 @@ func (cr *ContractRequester) CallMethod(ctx context.Context, base core.Message,
 		return nil, errors.New("Got not reply.RegisterRequest in reply for CallMethod")
 	}
 
+	if listener := core.RequestListenerFromContext(ctx); listener != nil {
+		listener(r.Request)
+	}
+
 	if async {
 		return res, nil
 	}
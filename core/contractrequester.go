@@ -31,3 +31,19 @@ type ContractRequester interface {
 	CallConstructor(ctx context.Context, base Message, async bool,
 		prototype *RecordRef, to *RecordRef, method string, argsIn Arguments, saveType int) (*RecordRef, error)
 }
+
+// RequestListener is notified with the reference of a request as soon as it is registered.
+type RequestListener func(request RecordRef)
+
+type requestListenerKey struct{}
+
+// ContextWithRequestListener returns new context with provided request listener.
+func ContextWithRequestListener(ctx context.Context, listener RequestListener) context.Context {
+	return context.WithValue(ctx, requestListenerKey{}, listener)
+}
+
+// RequestListenerFromContext returns RequestListener from context or nil if context does not have one.
+func RequestListenerFromContext(ctx context.Context) RequestListener {
+	listener, _ := ctx.Value(requestListenerKey{}).(RequestListener)
+	return listener
+}
@@ -22,6 +22,10 @@ type DelegationTokenFactory interface {
 	IssueGetObjectRedirect(sender *RecordRef, redirectedMessage Message) (DelegationToken, error)
 	IssueGetChildrenRedirect(sender *RecordRef, redirectedMessage Message) (DelegationToken, error)
 	IssueGetCodeRedirect(sender *RecordRef, redirectedMessage Message) (DelegationToken, error)
+	IssueGetObjectHistoryRedirect(sender *RecordRef, redirectedMessage Message) (DelegationToken, error)
+	IssueGetEventsRedirect(sender *RecordRef, redirectedMessage Message) (DelegationToken, error)
+	IssueGetReceiptRedirect(sender *RecordRef, redirectedMessage Message) (DelegationToken, error)
+	IssueGetRecordProofRedirect(sender *RecordRef, redirectedMessage Message) (DelegationToken, error)
 	Verify(parcel Parcel) (bool, error)
 }
 
@@ -89,9 +89,73 @@ func (t *GetCodeRedirectToken) Verify(parcel core.Parcel) (bool, error) {
 	panic("implement me")
 }
 
+// GetObjectHistoryRedirectToken is a redirect token for the GetObjectHistory method
+type GetObjectHistoryRedirectToken struct {
+	Signature []byte
+}
+
+// Type implementation of Token interface.
+func (t *GetObjectHistoryRedirectToken) Type() core.DelegationTokenType {
+	return core.DTTypeGetObjectHistoryRedirect
+}
+
+// Verify implementation of Token interface.
+func (t *GetObjectHistoryRedirectToken) Verify(parcel core.Parcel) (bool, error) {
+	panic("implement me")
+}
+
+// GetEventsRedirectToken is a redirect token for the GetEvents method
+type GetEventsRedirectToken struct {
+	Signature []byte
+}
+
+// Type implementation of Token interface.
+func (t *GetEventsRedirectToken) Type() core.DelegationTokenType {
+	return core.DTTypeGetEventsRedirect
+}
+
+// Verify implementation of Token interface.
+func (t *GetEventsRedirectToken) Verify(parcel core.Parcel) (bool, error) {
+	panic("implement me")
+}
+
+// GetReceiptRedirectToken is a redirect token for the GetReceipt method
+type GetReceiptRedirectToken struct {
+	Signature []byte
+}
+
+// Type implementation of Token interface.
+func (t *GetReceiptRedirectToken) Type() core.DelegationTokenType {
+	return core.DTTypeGetReceiptRedirect
+}
+
+// Verify implementation of Token interface.
+func (t *GetReceiptRedirectToken) Verify(parcel core.Parcel) (bool, error) {
+	panic("implement me")
+}
+
+// GetRecordProofRedirectToken is a redirect token for the GetRecordProof method
+type GetRecordProofRedirectToken struct {
+	Signature []byte
+}
+
+// Type implementation of Token interface.
+func (t *GetRecordProofRedirectToken) Type() core.DelegationTokenType {
+	return core.DTTypeGetRecordProofRedirect
+}
+
+// Verify implementation of Token interface.
+func (t *GetRecordProofRedirectToken) Verify(parcel core.Parcel) (bool, error) {
+	panic("implement me")
+}
+
 func init() {
 	gob.Register(&PendingExecutionToken{})
 	gob.Register(&GetObjectRedirectToken{})
 	gob.Register(&GetChildrenRedirectToken{})
 	gob.Register(&GetCodeRedirectToken{})
+	gob.Register(&GetObjectHistoryRedirectToken{})
+	gob.Register(&GetEventsRedirectToken{})
+	gob.Register(&GetReceiptRedirectToken{})
+	gob.Register(&GetRecordProofRedirectToken{})
 }
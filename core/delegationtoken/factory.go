@@ -93,6 +93,58 @@ func (f *delegationTokenFactory) IssueGetCodeRedirect(
 	return &GetCodeRedirectToken{Signature: sign.Bytes()}, nil
 }
 
+// IssueGetObjectHistoryRedirect creates new token for provided message.
+func (f *delegationTokenFactory) IssueGetObjectHistoryRedirect(
+	sender *core.RecordRef, redirectedMessage core.Message,
+) (core.DelegationToken, error) {
+	parsedMessage := redirectedMessage.(*message.GetObjectHistory)
+	dataForSign := append(sender.Bytes(), message.ToBytes(parsedMessage)...)
+	sign, err := f.Cryptography.Sign(dataForSign)
+	if err != nil {
+		return nil, err
+	}
+	return &GetObjectHistoryRedirectToken{Signature: sign.Bytes()}, nil
+}
+
+// IssueGetEventsRedirect creates new token for provided message.
+func (f *delegationTokenFactory) IssueGetEventsRedirect(
+	sender *core.RecordRef, redirectedMessage core.Message,
+) (core.DelegationToken, error) {
+	parsedMessage := redirectedMessage.(*message.GetEvents)
+	dataForSign := append(sender.Bytes(), message.ToBytes(parsedMessage)...)
+	sign, err := f.Cryptography.Sign(dataForSign)
+	if err != nil {
+		return nil, err
+	}
+	return &GetEventsRedirectToken{Signature: sign.Bytes()}, nil
+}
+
+// IssueGetReceiptRedirect creates new token for provided message.
+func (f *delegationTokenFactory) IssueGetReceiptRedirect(
+	sender *core.RecordRef, redirectedMessage core.Message,
+) (core.DelegationToken, error) {
+	parsedMessage := redirectedMessage.(*message.GetReceipt)
+	dataForSign := append(sender.Bytes(), message.ToBytes(parsedMessage)...)
+	sign, err := f.Cryptography.Sign(dataForSign)
+	if err != nil {
+		return nil, err
+	}
+	return &GetReceiptRedirectToken{Signature: sign.Bytes()}, nil
+}
+
+// IssueGetRecordProofRedirect creates new token for provided message.
+func (f *delegationTokenFactory) IssueGetRecordProofRedirect(
+	sender *core.RecordRef, redirectedMessage core.Message,
+) (core.DelegationToken, error) {
+	parsedMessage := redirectedMessage.(*message.GetRecordProof)
+	dataForSign := append(sender.Bytes(), message.ToBytes(parsedMessage)...)
+	sign, err := f.Cryptography.Sign(dataForSign)
+	if err != nil {
+		return nil, err
+	}
+	return &GetRecordProofRedirectToken{Signature: sign.Bytes()}, nil
+}
+
 // Verify performs token validation.
 func (f *delegationTokenFactory) Verify(parcel core.Parcel) (bool, error) {
 	if parcel.DelegationToken() == nil {
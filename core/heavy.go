@@ -23,8 +23,39 @@ import (
 // HeavySync provides methods for sync on heavy node.
 //go:generate minimock -i github.com/insolar/insolar/core.HeavySync -o ../testutils -s _mock.go
 type HeavySync interface {
-	Start(ctx context.Context, jet RecordID, pn PulseNumber) error
-	Store(ctx context.Context, jet RecordID, pn PulseNumber, kvs []KV) error
+	Start(ctx context.Context, jet RecordID, pn PulseNumber) (HeavySyncProgress, error)
+	Store(ctx context.Context, jet RecordID, pn PulseNumber, chunk uint32, checksum []byte, kvs []KV) (HeavySyncProgress, error)
 	Stop(ctx context.Context, jet RecordID, pn PulseNumber) error
 	Reset(ctx context.Context, jet RecordID, pn PulseNumber) error
 }
+
+// HeavySyncProgress is an acknowledgement of chunks accepted by heavy node for the synced pulse.
+type HeavySyncProgress struct {
+	// Chunks is an amount of accepted chunks (chunk numbers start from zero).
+	Chunks uint32
+	// Checksum is a checksum of the last accepted chunk.
+	Checksum []byte
+}
+
+// HeavySyncStatus provides state of replication to heavy node and allows to speed it up.
+//go:generate minimock -i github.com/insolar/insolar/core.HeavySyncStatus -o ../testutils -s _mock.go
+type HeavySyncStatus interface {
+	// JetsSyncStatus returns replication state of all jets processed by node.
+	JetsSyncStatus(ctx context.Context) ([]JetSyncStatus, error)
+	// FlushHeavySync starts replication of all pending pulses without waiting for the next pulse
+	// and waits until it is finished or context is done.
+	FlushHeavySync(ctx context.Context) error
+	// FlushHeavySyncOnPulse schedules replication of all pending pulses when the pulse comes.
+	FlushHeavySyncOnPulse(ctx context.Context, pn PulseNumber)
+}
+
+// JetSyncStatus is a replication state of the jet.
+type JetSyncStatus struct {
+	JetID RecordID
+	// UnsyncedPulses are pulses waiting for replication.
+	UnsyncedPulses []PulseNumber
+	// Lag is an amount of pulses between current pulse and the first unsynced pulse.
+	Lag int
+	// SyncedChunks is an amount of chunks of the first unsynced pulse accepted by heavy node.
+	SyncedChunks uint32
+}
@@ -18,6 +18,8 @@ package core
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
 )
 
 const (
@@ -124,6 +126,12 @@ type ArtifactManager interface {
 	// provide methods for fetching all related data.
 	GetObject(ctx context.Context, head RecordRef, state *RecordID, approved bool) (ObjectDescriptor, error)
 
+	// GetObjectAtPulse returns descriptor for the latest object state not newer than provided pulse.
+	//
+	// If there is no such state, ErrStateNotAvailable is returned. If the object was deactivated by the pulse,
+	// ErrDeactivated is returned.
+	GetObjectAtPulse(ctx context.Context, head RecordRef, pulse PulseNumber) (ObjectDescriptor, error)
+
 	// GetPendingRequest returns a pending request for object.
 	GetPendingRequest(ctx context.Context, objectID RecordID) (Parcel, error)
 
@@ -141,6 +149,41 @@ type ArtifactManager interface {
 	// During iteration children refs will be fetched from remote source (parent object).
 	GetChildren(ctx context.Context, parent RecordRef, pulse *PulseNumber) (RefIterator, error)
 
+	// GetObjectHistory returns object states iterator.
+	//
+	// States are returned from the latest to the earliest. If pulses are provided, only states created in
+	// [fromPulse, toPulse] range will be returned. During iteration states will be fetched from remote source.
+	GetObjectHistory(ctx context.Context, head RecordRef, fromPulse, toPulse *PulseNumber) (ObjectHistoryIterator, error)
+
+	// RegisterEvent creates event record in storage and links it to the object.
+	//
+	// Events are indexed by object and topic. Provided request is the request during which the event was emitted.
+	RegisterEvent(ctx context.Context, object, request RecordRef, topic string, payload []byte) (*RecordID, error)
+
+	// GetEvents returns object events iterator.
+	//
+	// Events are returned from the latest to the earliest. If topic is empty, events of all topics are returned. If
+	// pulses are provided, only events emitted in [fromPulse, toPulse] range will be returned.
+	GetEvents(ctx context.Context, object RecordRef, topic string, fromPulse, toPulse *PulseNumber) (EventIterator, error)
+
+	// GetReceipt returns provided request to the object with the result of its execution.
+	//
+	// If the request is not executed yet, receipt without result will be returned.
+	GetReceipt(ctx context.Context, object, request RecordRef) (*RequestReceipt, error)
+
+	// GetRecordProof returns merkle proof of the record inclusion into the jet drop of provided pulse.
+	GetRecordProof(ctx context.Context, jet RecordID, pulse PulseNumber, record RecordID) (*RecordProof, error)
+
+	// FindObjectsByPrototype returns objects of provided prototype from heavy node index.
+	//
+	// Records are returned in ascending id order starting after "from" id. Limit restricts page size.
+	FindObjectsByPrototype(ctx context.Context, prototype RecordRef, from *RecordID, limit int) (*IndexedRecords, error)
+
+	// FindRequestsByCaller returns requests made by provided caller from heavy node index.
+	//
+	// Records are returned in ascending id order starting after "from" id. Limit restricts page size.
+	FindRequestsByCaller(ctx context.Context, caller RecordRef, from *RecordID, limit int) (*IndexedRecords, error)
+
 	// DeclareType creates new type record in storage.
 	//
 	// Type is a contract interface. It contains one method signature.
@@ -148,8 +191,9 @@ type ArtifactManager interface {
 
 	// DeployCode creates new code record in storage.
 	//
-	// Code records are used to activate prototype.
-	DeployCode(ctx context.Context, domain, request RecordRef, code []byte, machineType MachineType) (*RecordID, error)
+	// Code records are used to activate prototype. Provided ABI (contract description) is stored alongside the code and
+	// can be empty.
+	DeployCode(ctx context.Context, domain, request RecordRef, code []byte, machineType MachineType, abi []byte) (*RecordID, error)
 
 	// ActivatePrototype creates activate object record in storage. Provided prototype reference will be used as objects prototype
 	// memory as memory of created object. If memory is not provided, the prototype default memory will be used.
@@ -216,6 +260,9 @@ type CodeDescriptor interface {
 
 	// Code returns code data.
 	Code() ([]byte, error)
+
+	// ABI returns code ABI. It's nil if ABI was not provided on deploy.
+	ABI() ([]byte, error)
 }
 
 // ObjectDescriptor represents meta info required to fetch all object data.
@@ -255,6 +302,112 @@ type RefIterator interface {
 	HasNext() bool
 }
 
+// ObjectStateDescriptor represents a single object state from object's history.
+type ObjectStateDescriptor struct {
+	// StateID is an id of the state record.
+	StateID RecordID
+	// PrevStateID is an id of the previous state record. It's nil for the first (activation) state.
+	PrevStateID *RecordID
+	// Pulse is a pulse in which the state was created.
+	Pulse PulseNumber
+	// Request is a reference to the request that produced the state.
+	Request RecordRef
+	// Image is a prototype (or code for prototypes) reference.
+	Image *RecordRef
+	// IsPrototype determines if the object was a prototype in this state.
+	IsPrototype bool
+	// MemoryHash is a hash of the state's memory. It's nil for deactivation state.
+	MemoryHash []byte
+	// Deactivated is true if the state is a deactivation.
+	Deactivated bool
+}
+
+// ObjectHistoryIterator is used for iteration over object states.
+type ObjectHistoryIterator interface {
+	Next() (*ObjectStateDescriptor, error)
+	HasNext() bool
+}
+
+// ContractEvent represents a single event emitted by a contract.
+type ContractEvent struct {
+	// ID is an id of the event record.
+	ID RecordID
+	// Object is a reference to the object that emitted the event.
+	Object RecordRef
+	// Request is a reference to the request during which the event was emitted.
+	Request RecordRef
+	// Topic is an event topic.
+	Topic string
+	// Payload is a serialized event data.
+	Payload []byte
+	// Pulse is a pulse in which the event was emitted.
+	Pulse PulseNumber
+}
+
+// EventIterator is used for iteration over object events.
+type EventIterator interface {
+	Next() (*ContractEvent, error)
+	HasNext() bool
+}
+
+// RequestReceipt represents a request with the result of its execution.
+type RequestReceipt struct {
+	// Request is a reference to the request.
+	Request RecordRef
+	// Pulse is a pulse in which the request was registered.
+	Pulse PulseNumber
+	// Result is an id of the result record. It's nil if the request is not executed yet.
+	Result *RecordID
+	// Payload is a serialized result of the execution.
+	Payload []byte
+}
+
+// RecordProof proves that the record is included into the jet drop.
+type RecordProof struct {
+	// Jet and Pulse identify the drop.
+	Jet   RecordID
+	Pulse PulseNumber
+	// Record is an id of the proved record.
+	Record RecordID
+	// Index is a position of the record in the drop. Count is an amount of records in the drop.
+	Index uint64
+	Count uint64
+	// Path is a list of sibling hashes from the record leaf up to the drop records root.
+	Path [][]byte
+	// DropPrevHash and DropHash are hashes of the previous and the proved drops.
+	DropPrevHash []byte
+	DropHash     []byte
+}
+
+// SecondaryIndex is a type of heavy node index over stored records.
+type SecondaryIndex byte
+
+const (
+	// SecondaryIndexObjectsByPrototype indexes object activations by prototype reference.
+	SecondaryIndexObjectsByPrototype SecondaryIndex = iota + 1
+	// SecondaryIndexObjectsByParent indexes object and prototype activations by parent reference.
+	SecondaryIndexObjectsByParent
+	// SecondaryIndexRequestsByCaller indexes requests by caller reference.
+	SecondaryIndexRequestsByCaller
+	// SecondaryIndexResultsByRequest indexes results by request reference.
+	SecondaryIndexResultsByRequest
+)
+
+// IndexedRecord is an entry of the secondary index.
+type IndexedRecord struct {
+	// ID is an id of the indexed record.
+	ID RecordID
+	// Object is a reference to the object the record belongs to.
+	Object RecordRef
+}
+
+// IndexedRecords is a page of the secondary index.
+type IndexedRecords struct {
+	Records []IndexedRecord
+	// Next is a cursor for the next page. It's nil if there are no more records.
+	Next *RecordID
+}
+
 // KV is a generic key/value struct.
 type KV struct {
 	K []byte
@@ -269,6 +422,19 @@ func KVSize(kvs []KV) (amount int64) {
 	return
 }
 
+// KVChecksum returns checksum of key/value array.
+func KVChecksum(kvs []KV) []byte {
+	h := sha256.New()
+	buf := make([]byte, binary.MaxVarintLen64)
+	for _, kv := range kvs {
+		_, _ = h.Write(buf[:binary.PutUvarint(buf, uint64(len(kv.K)))])
+		_, _ = h.Write(kv.K)
+		_, _ = h.Write(buf[:binary.PutUvarint(buf, uint64(len(kv.V)))])
+		_, _ = h.Write(kv.V)
+	}
+	return h.Sum(nil)
+}
+
 // StorageExportResult represents storage data view.
 type StorageExportResult struct {
 	Data     map[string]interface{}
@@ -276,10 +442,28 @@ type StorageExportResult struct {
 	Size     int
 }
 
+// StorageExportCursor points to a position in exported data. Exported data is split into chunks by pulse and jet.
+type StorageExportCursor struct {
+	Pulse PulseNumber
+	JetID RecordID
+}
+
+// StorageExportStreamResult represents a part of continuous storage data view.
+type StorageExportStreamResult struct {
+	// Data contains pulse data for every jet ordered by pulse and jet.
+	Data []interface{}
+	// Cursor points to the last returned chunk. Pass it to the next call to continue without gaps or duplicates.
+	Cursor *StorageExportCursor
+}
+
 // StorageExporter provides methods for fetching data view from storage.
 type StorageExporter interface {
 	// Export returns data view from storage.
 	Export(ctx context.Context, fromPulse PulseNumber, size int) (*StorageExportResult, error)
+
+	// ExportAfter returns up to size chunks of data view synced to heavy storage which follow provided cursor. If
+	// cursor is nil, export starts from the first pulse.
+	ExportAfter(ctx context.Context, cursor *StorageExportCursor, size int) (*StorageExportStreamResult, error)
 }
 
 var (
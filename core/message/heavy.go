@@ -22,9 +22,13 @@ import (
 
 // HeavyPayload carries Key/Value records and pulse number
 // that replicates to Heavy Material node.
+//
+// Records of the pulse are split into chunks numbered from zero, Checksum is core.KVChecksum of Records.
 type HeavyPayload struct {
 	JetID    core.RecordID
 	PulseNum core.PulseNumber
+	Chunk    uint32
+	Checksum []byte
 	Records  []core.KV
 }
 
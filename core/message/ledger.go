@@ -227,6 +227,182 @@ func (*GetChildren) Type() core.MessageType {
 	return core.TypeGetChildren
 }
 
+// GetObjectHistory retrieves a chunk of object states starting from the latest one (or FromState) and going back in
+// time. States outside of [FromPulse, ToPulse] range are skipped.
+type GetObjectHistory struct {
+	ledgerMessage
+	Head      core.RecordRef
+	FromState *core.RecordID
+	FromPulse *core.PulseNumber
+	ToPulse   *core.PulseNumber
+	Amount    int
+}
+
+// AllowedSenderObjectAndRole implements interface method
+func (m *GetObjectHistory) AllowedSenderObjectAndRole() (*core.RecordRef, core.DynamicRole) {
+	return nil, core.DynamicRoleUndefined
+}
+
+// DefaultRole returns role for this event
+func (*GetObjectHistory) DefaultRole() core.DynamicRole {
+	return core.DynamicRoleLightExecutor
+}
+
+// DefaultTarget returns of target of this event.
+func (m *GetObjectHistory) DefaultTarget() *core.RecordRef {
+	return &m.Head
+}
+
+// Type implementation of Message interface.
+func (*GetObjectHistory) Type() core.MessageType {
+	return core.TypeGetObjectHistory
+}
+
+// RegisterEvent saves contract event and links it to the object.
+type RegisterEvent struct {
+	ledgerMessage
+	Record []byte
+	Object core.RecordRef
+}
+
+// AllowedSenderObjectAndRole implements interface method
+func (m *RegisterEvent) AllowedSenderObjectAndRole() (*core.RecordRef, core.DynamicRole) {
+	return &m.Object, core.DynamicRoleVirtualExecutor
+}
+
+// DefaultRole returns role for this event
+func (*RegisterEvent) DefaultRole() core.DynamicRole {
+	return core.DynamicRoleLightExecutor
+}
+
+// DefaultTarget returns of target of this event.
+func (m *RegisterEvent) DefaultTarget() *core.RecordRef {
+	return &m.Object
+}
+
+// Type implementation of Message interface.
+func (*RegisterEvent) Type() core.MessageType {
+	return core.TypeRegisterEvent
+}
+
+// GetEvents retrieves a chunk of object events starting from the latest one (or FromEvent) and going back in time.
+// Events outside of [FromPulse, ToPulse] range are skipped. Empty topic means events of any topic.
+type GetEvents struct {
+	ledgerMessage
+	Object    core.RecordRef
+	Topic     string
+	FromEvent *core.RecordID
+	FromPulse *core.PulseNumber
+	ToPulse   *core.PulseNumber
+	Amount    int
+}
+
+// AllowedSenderObjectAndRole implements interface method
+func (m *GetEvents) AllowedSenderObjectAndRole() (*core.RecordRef, core.DynamicRole) {
+	return nil, core.DynamicRoleUndefined
+}
+
+// DefaultRole returns role for this event
+func (*GetEvents) DefaultRole() core.DynamicRole {
+	return core.DynamicRoleLightExecutor
+}
+
+// DefaultTarget returns of target of this event.
+func (m *GetEvents) DefaultTarget() *core.RecordRef {
+	return &m.Object
+}
+
+// Type implementation of Message interface.
+func (*GetEvents) Type() core.MessageType {
+	return core.TypeGetEvents
+}
+
+// GetReceipt retrieves request with the result of its execution.
+//
+// Requests and results are stored in the jet of the object, so the message is routed by the object.
+type GetReceipt struct {
+	ledgerMessage
+	Object  core.RecordRef
+	Request core.RecordRef
+}
+
+// AllowedSenderObjectAndRole implements interface method
+func (m *GetReceipt) AllowedSenderObjectAndRole() (*core.RecordRef, core.DynamicRole) {
+	return nil, core.DynamicRoleUndefined
+}
+
+// DefaultRole returns role for this event
+func (*GetReceipt) DefaultRole() core.DynamicRole {
+	return core.DynamicRoleLightExecutor
+}
+
+// DefaultTarget returns of target of this event.
+func (m *GetReceipt) DefaultTarget() *core.RecordRef {
+	return &m.Object
+}
+
+// Type implementation of Message interface.
+func (*GetReceipt) Type() core.MessageType {
+	return core.TypeGetReceipt
+}
+
+// GetRecordProof retrieves merkle proof of the record inclusion into the jet drop.
+type GetRecordProof struct {
+	ledgerMessage
+	Jet    core.RecordID
+	Pulse  core.PulseNumber
+	Record core.RecordID
+}
+
+// AllowedSenderObjectAndRole implements interface method
+func (m *GetRecordProof) AllowedSenderObjectAndRole() (*core.RecordRef, core.DynamicRole) {
+	return nil, core.DynamicRoleUndefined
+}
+
+// DefaultRole returns role for this event
+func (*GetRecordProof) DefaultRole() core.DynamicRole {
+	return core.DynamicRoleLightExecutor
+}
+
+// DefaultTarget returns of target of this event.
+func (m *GetRecordProof) DefaultTarget() *core.RecordRef {
+	return core.NewRecordRef(core.RecordID{}, m.Jet)
+}
+
+// Type implementation of Message interface.
+func (*GetRecordProof) Type() core.MessageType {
+	return core.TypeGetRecordProof
+}
+
+// FindIndexedRecords retrieves a page of records from heavy node secondary index.
+type FindIndexedRecords struct {
+	ledgerMessage
+	Index core.SecondaryIndex
+	Key   core.RecordRef
+	From  *core.RecordID
+	Limit int
+}
+
+// AllowedSenderObjectAndRole implements interface method
+func (m *FindIndexedRecords) AllowedSenderObjectAndRole() (*core.RecordRef, core.DynamicRole) {
+	return nil, core.DynamicRoleUndefined
+}
+
+// DefaultRole returns role for this event
+func (*FindIndexedRecords) DefaultRole() core.DynamicRole {
+	return core.DynamicRoleHeavyExecutor
+}
+
+// DefaultTarget returns of target of this event.
+func (m *FindIndexedRecords) DefaultTarget() *core.RecordRef {
+	return &m.Key
+}
+
+// Type implementation of Message interface.
+func (*FindIndexedRecords) Type() core.MessageType {
+	return core.TypeFindIndexedRecords
+}
+
 // JetDrop spreads jet drop
 type JetDrop struct {
 	ledgerMessage
@@ -384,6 +560,7 @@ type HotData struct {
 	PendingRequests    map[core.RecordID]recentstorage.PendingObjectContext
 	PulseNumber        core.PulseNumber
 	JetDropSizeHistory jet.DropSizeHistory
+	Merged             bool // Jet is a result of merging its children.
 }
 
 // AllowedSenderObjectAndRole implements interface method
@@ -495,9 +672,12 @@ func (m *AbandonedRequestsNotification) DefaultTarget() *core.RecordRef {
 }
 
 // GetRequest fetches request from ledger.
+//
+// Requests are stored in the jet of the object, so the message is routed by the object.
 type GetRequest struct {
 	ledgerMessage
 
+	Object  core.RecordID
 	Request core.RecordID
 }
 
@@ -518,7 +698,7 @@ func (*GetRequest) DefaultRole() core.DynamicRole {
 
 // DefaultTarget returns of target of this event.
 func (m *GetRequest) DefaultTarget() *core.RecordRef {
-	return core.NewRecordRef(core.DomainID, m.Request)
+	return core.NewRecordRef(core.DomainID, m.Object)
 }
 
 // GetPendingRequestID fetches a pending request id for an object from current LME
@@ -81,6 +81,18 @@ func getEmptyMessage(mt core.MessageType) (core.Message, error) {
 		return &AbandonedRequestsNotification{}, nil
 	case core.TypeGetPendingRequestID:
 		return &GetPendingRequestID{}, nil
+	case core.TypeGetObjectHistory:
+		return &GetObjectHistory{}, nil
+	case core.TypeRegisterEvent:
+		return &RegisterEvent{}, nil
+	case core.TypeGetEvents:
+		return &GetEvents{}, nil
+	case core.TypeGetReceipt:
+		return &GetReceipt{}, nil
+	case core.TypeGetRecordProof:
+		return &GetRecordProof{}, nil
+	case core.TypeFindIndexedRecords:
+		return &FindIndexedRecords{}, nil
 	case core.TypeGetRequest:
 		return &GetRequest{}, nil
 
@@ -219,6 +231,12 @@ func init() {
 	gob.Register(&AbandonedRequestsNotification{})
 	gob.Register(&HotData{})
 	gob.Register(&GetPendingRequestID{})
+	gob.Register(&GetObjectHistory{})
+	gob.Register(&RegisterEvent{})
+	gob.Register(&GetEvents{})
+	gob.Register(&GetReceipt{})
+	gob.Register(&GetRecordProof{})
+	gob.Register(&FindIndexedRecords{})
 	gob.Register(&GetRequest{})
 
 	// heavy
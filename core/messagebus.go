@@ -259,6 +259,18 @@ const (
 	TypeGetRequest
 	// TypeGetPendingRequestID fetches a pending request id from ledger
 	TypeGetPendingRequestID
+	// TypeGetObjectHistory retrieves a chunk of object's state history.
+	TypeGetObjectHistory
+	// TypeRegisterEvent saves contract event and links it to the object.
+	TypeRegisterEvent
+	// TypeGetEvents retrieves a chunk of object's events.
+	TypeGetEvents
+	// TypeGetReceipt retrieves request with the result of its execution.
+	TypeGetReceipt
+	// TypeGetRecordProof retrieves merkle proof of the record inclusion into the jet drop.
+	TypeGetRecordProof
+	// TypeFindIndexedRecords retrieves records from heavy node secondary index.
+	TypeFindIndexedRecords
 
 	// TypeValidationCheck checks if validation of a particular record can be performed.
 	TypeValidationCheck
@@ -291,4 +303,8 @@ const (
 	DTTypeGetObjectRedirect
 	DTTypeGetChildrenRedirect
 	DTTypeGetCodeRedirect
+	DTTypeGetObjectHistoryRedirect
+	DTTypeGetEventsRedirect
+	DTTypeGetReceiptRedirect
+	DTTypeGetRecordProofRedirect
 )
@@ -41,6 +41,10 @@ type Network interface {
 	SendCascadeMessage(data Cascade, method string, msg Parcel) error
 	// RemoteProcedureRegister is remote procedure register func.
 	RemoteProcedureRegister(name string, method RemoteProcedure)
+	// Leave notifies the network that node leaves it at the ETA pulse.
+	Leave(ctx context.Context, ETA PulseNumber) error
+	// PreferHealthy returns nodes ordered from the healthiest peer by connection statistics.
+	PreferHealthy(nodes []RecordRef) []RecordRef
 }
 
 // PulseDistributor is interface for pulse distribution.
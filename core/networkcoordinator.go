@@ -34,4 +34,35 @@ type NetworkCoordinator interface {
 
 	// IsStarted returns true if component was started and false in other way
 	IsStarted() bool
+
+	// Leave schedules graceful leave of the node from the network at the ETA pulse
+	Leave(ctx context.Context, ETA PulseNumber) error
+
+	// LeaveStatus returns progress of the scheduled graceful leave
+	LeaveStatus(ctx context.Context) (*LeaveStatus, error)
+}
+
+// LeaveState is a stage of the node graceful leave
+type LeaveState int
+
+//go:generate stringer -type=LeaveState
+const (
+	// NoLeaveState means that leave is not scheduled
+	NoLeaveState LeaveState = iota
+	// ScheduledLeaveState means that leave claim is sent and node waits for the ETA pulse
+	ScheduledLeaveState
+	// DrainingLeaveState means that ETA pulse is reached, but node still has unsynced pulses or requests in progress
+	DrainingLeaveState
+	// ReadyLeaveState means that ETA pulse is reached and node can be safely stopped
+	ReadyLeaveState
+)
+
+// LeaveStatus is a progress of the node graceful leave
+type LeaveStatus struct {
+	State LeaveState
+	ETA   PulseNumber
+	// UnsyncedPulses is an amount of pulses waiting for replication to heavy node
+	UnsyncedPulses int
+	// ActiveExecutions is an amount of objects with requests executing or queued on the node
+	ActiveExecutions int
 }
@@ -42,6 +42,14 @@ const (
 	TypeGetObjectRedirect
 	// TypeGetChildrenRedirect is a redirect reply for children-call
 	TypeGetChildrenRedirect
+	// TypeGetObjectHistoryRedirect is a redirect reply for object history-call
+	TypeGetObjectHistoryRedirect
+	// TypeGetEventsRedirect is a redirect reply for events-call
+	TypeGetEventsRedirect
+	// TypeGetReceiptRedirect is a redirect reply for receipt-call
+	TypeGetReceiptRedirect
+	// TypeGetRecordProofRedirect is a redirect reply for record proof-call
+	TypeGetRecordProofRedirect
 
 	// Logicrunner
 
@@ -74,10 +82,22 @@ const (
 	TypeJet
 	// TypeRequest contains request.
 	TypeRequest
+	// TypeObjectHistory is a reply for fetching object states history in chunks.
+	TypeObjectHistory
+	// TypeEvents is a reply for fetching object events in chunks.
+	TypeEvents
+	// TypeReceipt is a reply for fetching request with the result of its execution.
+	TypeReceipt
+	// TypeRecordProof is a reply for fetching merkle proof of the record inclusion into the jet drop.
+	TypeRecordProof
+	// TypeIndexedRecords is a reply for fetching records from heavy node secondary index.
+	TypeIndexedRecords
 	// TypeHeavyError carries heavy record sync
 	TypeHeavyError
 
 	TypeNodeSign
+	// TypeHeavySyncProgress carries chunks accepted by heavy node during sync.
+	TypeHeavySyncProgress
 )
 
 // ErrType is used to determine and compare reply errors.
@@ -118,6 +138,8 @@ func getEmptyReply(t core.ReplyType) (core.Reply, error) {
 		return &Error{}, nil
 	case TypeHeavyError:
 		return &HeavyError{}, nil
+	case TypeHeavySyncProgress:
+		return &HeavySyncProgress{}, nil
 	case TypeOK:
 		return &OK{}, nil
 	case TypeObjectIndex:
@@ -128,6 +150,14 @@ func getEmptyReply(t core.ReplyType) (core.Reply, error) {
 		return &GetObjectRedirectReply{}, nil
 	case TypeGetChildrenRedirect:
 		return &GetChildrenRedirectReply{}, nil
+	case TypeGetObjectHistoryRedirect:
+		return &GetObjectHistoryRedirectReply{}, nil
+	case TypeGetEventsRedirect:
+		return &GetEventsRedirectReply{}, nil
+	case TypeGetReceiptRedirect:
+		return &GetReceiptRedirectReply{}, nil
+	case TypeGetRecordProofRedirect:
+		return &GetRecordProofRedirectReply{}, nil
 	case TypeJetMiss:
 		return &JetMiss{}, nil
 	case TypePendingRequests:
@@ -136,6 +166,16 @@ func getEmptyReply(t core.ReplyType) (core.Reply, error) {
 		return &Jet{}, nil
 	case TypeRequest:
 		return &Request{}, nil
+	case TypeObjectHistory:
+		return &ObjectHistory{}, nil
+	case TypeEvents:
+		return &Events{}, nil
+	case TypeReceipt:
+		return &Receipt{}, nil
+	case TypeRecordProof:
+		return &RecordProof{}, nil
+	case TypeIndexedRecords:
+		return &IndexedRecords{}, nil
 
 	case TypeNodeSign:
 		return &NodeSign{}, nil
@@ -199,9 +239,19 @@ func init() {
 	gob.Register(&GetCodeRedirectReply{})
 	gob.Register(&GetObjectRedirectReply{})
 	gob.Register(&GetChildrenRedirectReply{})
+	gob.Register(&GetObjectHistoryRedirectReply{})
+	gob.Register(&GetEventsRedirectReply{})
+	gob.Register(&GetReceiptRedirectReply{})
+	gob.Register(&GetRecordProofRedirectReply{})
 	gob.Register(&HeavyError{})
+	gob.Register(&HeavySyncProgress{})
 	gob.Register(&JetMiss{})
 	gob.Register(&NodeSign{})
 	gob.Register(&HasPendingRequests{})
 	gob.Register(&Request{})
+	gob.Register(&ObjectHistory{})
+	gob.Register(&Events{})
+	gob.Register(&Receipt{})
+	gob.Register(&RecordProof{})
+	gob.Register(&IndexedRecords{})
 }
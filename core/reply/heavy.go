@@ -22,9 +22,13 @@ import (
 	"github.com/insolar/insolar/core"
 )
 
-// ErrHeavySyncInProgress returned when heavy sync in progress.
 const (
+	// ErrHeavySyncInProgress returned when heavy sync in progress.
 	ErrHeavySyncInProgress ErrType = iota + 1
+	// ErrHeavySyncChecksum returned when chunk doesn't match its checksum.
+	ErrHeavySyncChecksum
+	// ErrHeavySyncChunk returned when chunk number doesn't follow the last accepted chunk.
+	ErrHeavySyncChunk
 )
 
 // HeavyError carries heavy sync error information.
@@ -52,5 +56,22 @@ func (e *HeavyError) Error() string {
 
 // IsRetryable returns true if retry could be performed.
 func (e *HeavyError) IsRetryable() bool {
-	return e.SubType == ErrHeavySyncInProgress
+	switch e.SubType {
+	case ErrHeavySyncInProgress, ErrHeavySyncChecksum, ErrHeavySyncChunk:
+		return true
+	}
+	return false
+}
+
+// HeavySyncProgress carries chunks of the synced pulse accepted by heavy node.
+type HeavySyncProgress struct {
+	JetID    core.RecordID
+	PulseNum core.PulseNumber
+	Chunks   uint32
+	Checksum []byte
+}
+
+// Type implementation of Reply interface.
+func (e *HeavySyncProgress) Type() core.ReplyType {
+	return TypeHeavySyncProgress
 }
@@ -24,6 +24,7 @@ import (
 type Code struct {
 	Code        []byte
 	MachineType core.MachineType
+	ABI         []byte
 }
 
 // Type implementation of Reply interface.
@@ -78,6 +79,59 @@ func (e *Children) Type() core.ReplyType {
 	return TypeChildren
 }
 
+// ObjectHistory is a reply for fetching object states history in chunks.
+type ObjectHistory struct {
+	States   []core.ObjectStateDescriptor
+	NextFrom *core.RecordID
+}
+
+// Type implementation of Reply interface.
+func (e *ObjectHistory) Type() core.ReplyType {
+	return TypeObjectHistory
+}
+
+// Events is a reply for fetching object events in chunks.
+type Events struct {
+	Events   []core.ContractEvent
+	NextFrom *core.RecordID
+}
+
+// Type implementation of Reply interface.
+func (e *Events) Type() core.ReplyType {
+	return TypeEvents
+}
+
+// Receipt is a reply for fetching request with the result of its execution.
+type Receipt struct {
+	Result  *core.RecordID
+	Payload []byte
+}
+
+// Type implementation of Reply interface.
+func (e *Receipt) Type() core.ReplyType {
+	return TypeReceipt
+}
+
+// RecordProof is a reply for fetching merkle proof of the record inclusion into the jet drop.
+type RecordProof struct {
+	Proof core.RecordProof
+}
+
+// Type implementation of Reply interface.
+func (e *RecordProof) Type() core.ReplyType {
+	return TypeRecordProof
+}
+
+// IndexedRecords is a reply for fetching records from heavy node secondary index.
+type IndexedRecords struct {
+	Records core.IndexedRecords
+}
+
+// Type implementation of Reply interface.
+func (e *IndexedRecords) Type() core.ReplyType {
+	return TypeIndexedRecords
+}
+
 // ObjectIndex contains serialized object index. It can be stored in DB without processing.
 type ObjectIndex struct {
 	Index []byte
@@ -124,6 +124,59 @@ func (r *GetChildrenRedirectReply) Redirected(genericMsg core.Message) core.Mess
 	}
 }
 
+// GetObjectHistoryRedirectReply is a redirect reply for get object history.
+type GetObjectHistoryRedirectReply struct {
+	Receiver *core.RecordRef
+	Token    core.DelegationToken
+
+	FromState core.RecordID
+}
+
+// NewGetObjectHistoryRedirect creates a new instance of GetObjectHistoryRedirectReply.
+func NewGetObjectHistoryRedirect(
+	factory core.DelegationTokenFactory, parcel core.Parcel, receiver *core.RecordRef, fromState core.RecordID,
+) (*GetObjectHistoryRedirectReply, error) {
+	var err error
+	rep := GetObjectHistoryRedirectReply{
+		Receiver:  receiver,
+		FromState: fromState,
+	}
+	redirectedMessage := rep.Redirected(parcel.Message())
+	sender := parcel.GetSender()
+	rep.Token, err = factory.IssueGetObjectHistoryRedirect(&sender, redirectedMessage)
+	if err != nil {
+		return nil, err
+	}
+	return &rep, nil
+}
+
+// GetReceiver returns node reference to send message to.
+func (r *GetObjectHistoryRedirectReply) GetReceiver() *core.RecordRef {
+	return r.Receiver
+}
+
+// GetToken returns delegation token.
+func (r *GetObjectHistoryRedirectReply) GetToken() core.DelegationToken {
+	return r.Token
+}
+
+// Type returns type of the reply
+func (r *GetObjectHistoryRedirectReply) Type() core.ReplyType {
+	return TypeGetObjectHistoryRedirect
+}
+
+// Redirected creates redirected message from redirect data.
+func (r *GetObjectHistoryRedirectReply) Redirected(genericMsg core.Message) core.Message {
+	msg := genericMsg.(*message.GetObjectHistory)
+	return &message.GetObjectHistory{
+		Head:      msg.Head,
+		FromState: &r.FromState,
+		FromPulse: msg.FromPulse,
+		ToPulse:   msg.ToPulse,
+		Amount:    msg.Amount,
+	}
+}
+
 // GetCodeRedirectReply is a redirect reply for get children.
 type GetCodeRedirectReply struct {
 	Receiver *core.RecordRef
@@ -166,3 +219,152 @@ func (r *GetCodeRedirectReply) Type() core.ReplyType {
 func (r *GetCodeRedirectReply) Redirected(genericMsg core.Message) core.Message {
 	return genericMsg
 }
+
+// GetEventsRedirectReply is a redirect reply for get events.
+type GetEventsRedirectReply struct {
+	Receiver *core.RecordRef
+	Token    core.DelegationToken
+
+	FromEvent core.RecordID
+}
+
+// NewGetEventsRedirect creates a new instance of GetEventsRedirectReply.
+func NewGetEventsRedirect(
+	factory core.DelegationTokenFactory, parcel core.Parcel, receiver *core.RecordRef, fromEvent core.RecordID,
+) (*GetEventsRedirectReply, error) {
+	var err error
+	rep := GetEventsRedirectReply{
+		Receiver:  receiver,
+		FromEvent: fromEvent,
+	}
+	redirectedMessage := rep.Redirected(parcel.Message())
+	sender := parcel.GetSender()
+	rep.Token, err = factory.IssueGetEventsRedirect(&sender, redirectedMessage)
+	if err != nil {
+		return nil, err
+	}
+	return &rep, nil
+}
+
+// GetReceiver returns node reference to send message to.
+func (r *GetEventsRedirectReply) GetReceiver() *core.RecordRef {
+	return r.Receiver
+}
+
+// GetToken returns delegation token.
+func (r *GetEventsRedirectReply) GetToken() core.DelegationToken {
+	return r.Token
+}
+
+// Type returns type of the reply
+func (r *GetEventsRedirectReply) Type() core.ReplyType {
+	return TypeGetEventsRedirect
+}
+
+// Redirected creates redirected message from redirect data.
+func (r *GetEventsRedirectReply) Redirected(genericMsg core.Message) core.Message {
+	msg := genericMsg.(*message.GetEvents)
+	return &message.GetEvents{
+		Object:    msg.Object,
+		Topic:     msg.Topic,
+		FromEvent: &r.FromEvent,
+		FromPulse: msg.FromPulse,
+		ToPulse:   msg.ToPulse,
+		Amount:    msg.Amount,
+	}
+}
+
+// GetReceiptRedirectReply is a redirect reply for get receipt.
+type GetReceiptRedirectReply struct {
+	Receiver *core.RecordRef
+	Token    core.DelegationToken
+}
+
+// NewGetReceiptRedirect creates a new instance of GetReceiptRedirectReply.
+func NewGetReceiptRedirect(
+	factory core.DelegationTokenFactory, parcel core.Parcel, receiver *core.RecordRef,
+) (*GetReceiptRedirectReply, error) {
+	var err error
+	rep := GetReceiptRedirectReply{
+		Receiver: receiver,
+	}
+	redirectedMessage := rep.Redirected(parcel.Message())
+	sender := parcel.GetSender()
+	rep.Token, err = factory.IssueGetReceiptRedirect(&sender, redirectedMessage)
+	if err != nil {
+		return nil, err
+	}
+	return &rep, nil
+}
+
+// GetReceiver returns node reference to send message to.
+func (r *GetReceiptRedirectReply) GetReceiver() *core.RecordRef {
+	return r.Receiver
+}
+
+// GetToken returns delegation token.
+func (r *GetReceiptRedirectReply) GetToken() core.DelegationToken {
+	return r.Token
+}
+
+// Type returns type of the reply
+func (r *GetReceiptRedirectReply) Type() core.ReplyType {
+	return TypeGetReceiptRedirect
+}
+
+// Redirected creates redirected message from redirect data.
+func (r *GetReceiptRedirectReply) Redirected(genericMsg core.Message) core.Message {
+	msg := genericMsg.(*message.GetReceipt)
+	return &message.GetReceipt{
+		Object:  msg.Object,
+		Request: msg.Request,
+	}
+}
+
+// GetRecordProofRedirectReply is a redirect reply for get record proof.
+type GetRecordProofRedirectReply struct {
+	Receiver *core.RecordRef
+	Token    core.DelegationToken
+}
+
+// NewGetRecordProofRedirect creates a new instance of GetRecordProofRedirectReply.
+func NewGetRecordProofRedirect(
+	factory core.DelegationTokenFactory, parcel core.Parcel, receiver *core.RecordRef,
+) (*GetRecordProofRedirectReply, error) {
+	var err error
+	rep := GetRecordProofRedirectReply{
+		Receiver: receiver,
+	}
+	redirectedMessage := rep.Redirected(parcel.Message())
+	sender := parcel.GetSender()
+	rep.Token, err = factory.IssueGetRecordProofRedirect(&sender, redirectedMessage)
+	if err != nil {
+		return nil, err
+	}
+	return &rep, nil
+}
+
+// GetReceiver returns node reference to send message to.
+func (r *GetRecordProofRedirectReply) GetReceiver() *core.RecordRef {
+	return r.Receiver
+}
+
+// GetToken returns delegation token.
+func (r *GetRecordProofRedirectReply) GetToken() core.DelegationToken {
+	return r.Token
+}
+
+// Type returns type of the reply
+func (r *GetRecordProofRedirectReply) Type() core.ReplyType {
+	return TypeGetRecordProofRedirect
+}
+
+// Redirected creates redirected message from redirect data.
+func (r *GetRecordProofRedirectReply) Redirected(genericMsg core.Message) core.Message {
+	msg := genericMsg.(*message.GetRecordProof)
+	return &message.GetRecordProof{
+		Jet:    msg.Jet,
+		Pulse:  msg.Pulse,
+		Record: msg.Record,
+	}
+}
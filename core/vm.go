@@ -60,6 +60,11 @@ type LogicRunner interface {
 	HandleValidationResultsMessage(context.Context, Parcel) (res Reply, err error)
 	HandleExecutorResultsMessage(context.Context, Parcel) (res Reply, err error)
 	OnPulse(context.Context, Pulse) error
+	// ActiveExecutions returns amount of objects with requests executing or queued on the node.
+	ActiveExecutions(context.Context) int
+	// Leave stops accepting new executions and waits until queued requests are processed or the ETA pulse comes,
+	// requests left on the ETA pulse are handed off to the next executor.
+	Leave(ctx context.Context, ETA PulseNumber) error
 }
 
 // LogicCallContext is a context of contract execution
@@ -46,12 +46,12 @@ func TestDumpUser(t *testing.T) {
 
 	result := struct {
 		Member string
-		Wallet int
+		Wallet string
 	}{}
 	err = json.Unmarshal(data, &result)
 	require.NoError(t, err)
 	require.Equal(t, "Member", result.Member)
-	require.Equal(t, 1000*1000*1000, result.Wallet)
+	require.Equal(t, "1000000000", result.Wallet)
 }
 
 func TestDumpUserWrongRef(t *testing.T) {
@@ -0,0 +1,109 @@
+// +build functest
+
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package functest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type historyEntry struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Amount string `json:"amount"`
+	Pulse  uint32 `json:"pulse"`
+}
+
+func getHistory(t *testing.T, caller *user, offset int, limit int) []historyEntry {
+	res, err := signedRequest(caller, "GetHistory", offset, limit)
+	require.NoError(t, err)
+	data, err := base64.StdEncoding.DecodeString(res.(string))
+	require.NoError(t, err)
+
+	var history []historyEntry
+	err = json.Unmarshal(data, &history)
+	require.NoError(t, err)
+	return history
+}
+
+func getHistoryFewTimes(t *testing.T, caller *user, expectedLen int) []historyEntry {
+	var history []historyEntry
+	for i := 0; i < times; i++ {
+		history = getHistory(t, caller, 0, 0)
+		if len(history) == expectedLen {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+	require.Len(t, history, expectedLen)
+	return history
+}
+
+func TestTransferHistory(t *testing.T) {
+	firstMember := createMember(t, "Member1")
+	secondMember := createMember(t, "Member2")
+
+	_, err := signedRequest(firstMember, "Transfer", 111, secondMember.ref)
+	require.NoError(t, err)
+	_, err = signedRequest(firstMember, "Transfer", 222, secondMember.ref)
+	require.NoError(t, err)
+
+	sent := getHistoryFewTimes(t, firstMember, 2)
+	require.Equal(t, firstMember.ref, sent[0].From)
+	require.Equal(t, secondMember.ref, sent[0].To)
+	require.Equal(t, "111", sent[0].Amount)
+	require.Equal(t, "222", sent[1].Amount)
+
+	received := getHistoryFewTimes(t, secondMember, 2)
+	require.Equal(t, firstMember.ref, received[0].From)
+	require.Equal(t, secondMember.ref, received[0].To)
+
+	page := getHistory(t, firstMember, 1, 1)
+	require.Len(t, page, 1)
+	require.Equal(t, "222", page[0].Amount)
+}
+
+func TestTransferStatement(t *testing.T) {
+	firstMember := createMember(t, "Member1")
+	secondMember := createMember(t, "Member2")
+
+	_, err := signedRequest(firstMember, "Transfer", 111, secondMember.ref)
+	require.NoError(t, err)
+	sent := getHistoryFewTimes(t, firstMember, 1)
+
+	res, err := signedRequest(firstMember, "GetStatement", sent[0].Pulse, sent[0].Pulse)
+	require.NoError(t, err)
+	data, err := base64.StdEncoding.DecodeString(res.(string))
+	require.NoError(t, err)
+
+	statement := struct {
+		Entries  []historyEntry `json:"entries"`
+		Incoming string         `json:"incoming"`
+		Outgoing string         `json:"outgoing"`
+	}{}
+	err = json.Unmarshal(data, &statement)
+	require.NoError(t, err)
+	require.Len(t, statement.Entries, 1)
+	require.Equal(t, "0", statement.Incoming)
+	require.Equal(t, "111", statement.Outgoing)
+}
@@ -0,0 +1,116 @@
+// +build functest
+
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package functest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type proposalInfo struct {
+	Reference string   `json:"reference"`
+	To        string   `json:"to"`
+	Amount    string   `json:"amount"`
+	Approvals []string `json:"approvals"`
+}
+
+func getProposals(t *testing.T, caller *user, owner string) []proposalInfo {
+	res, err := signedRequest(caller, "GetProposals", owner)
+	require.NoError(t, err)
+	data, err := base64.StdEncoding.DecodeString(res.(string))
+	require.NoError(t, err)
+
+	var proposals []proposalInfo
+	err = json.Unmarshal(data, &proposals)
+	require.NoError(t, err)
+	return proposals
+}
+
+func TestMultisigTransfer(t *testing.T) {
+	treasury := createMember(t, "Treasury")
+	firstSigner := createMember(t, "Signer1")
+	secondSigner := createMember(t, "Signer2")
+	recipient := createMember(t, "Recipient")
+
+	_, err := signedRequest(treasury, "SetWalletSigners", []string{firstSigner.ref, secondSigner.ref}, 2)
+	require.NoError(t, err)
+
+	oldTreasuryBalance := getBalanceNoErr(t, treasury, treasury.ref)
+	oldRecipientBalance := getBalanceNoErr(t, recipient, recipient.ref)
+
+	amount := 111
+	res, err := signedRequest(firstSigner, "ProposeTransfer", treasury.ref, amount, recipient.ref)
+	require.NoError(t, err)
+	proposal := res.(string)
+
+	proposals := getProposals(t, firstSigner, treasury.ref)
+	require.Len(t, proposals, 1)
+	require.Equal(t, proposal, proposals[0].Reference)
+	require.Equal(t, []string{firstSigner.ref}, proposals[0].Approvals)
+	require.Equal(t, oldTreasuryBalance-amount, getBalanceNoErr(t, treasury, treasury.ref))
+
+	_, err = signedRequest(secondSigner, "ApproveTransfer", treasury.ref, proposal)
+	require.NoError(t, err)
+
+	require.Empty(t, getProposals(t, secondSigner, treasury.ref))
+	checkBalanceFewTimes(t, recipient, recipient.ref, oldRecipientBalance+amount)
+}
+
+func TestMultisigTransferDirectly(t *testing.T) {
+	treasury := createMember(t, "Treasury")
+	signer := createMember(t, "Signer")
+	recipient := createMember(t, "Recipient")
+
+	_, err := signedRequest(treasury, "SetWalletSigners", []string{signer.ref}, 1)
+	require.NoError(t, err)
+
+	_, err = signedRequest(treasury, "Transfer", 111, recipient.ref)
+	require.Contains(t, err.Error(), "Multisig wallet transfers must be proposed and approved by signers")
+}
+
+func TestMultisigProposeNotSigner(t *testing.T) {
+	treasury := createMember(t, "Treasury")
+	signer := createMember(t, "Signer")
+	recipient := createMember(t, "Recipient")
+
+	_, err := signedRequest(treasury, "SetWalletSigners", []string{signer.ref}, 1)
+	require.NoError(t, err)
+
+	_, err = signedRequest(recipient, "ProposeTransfer", treasury.ref, 111, recipient.ref)
+	require.Contains(t, err.Error(), "Only signers can propose transfer")
+}
+
+func TestMultisigApproveTwice(t *testing.T) {
+	treasury := createMember(t, "Treasury")
+	firstSigner := createMember(t, "Signer1")
+	secondSigner := createMember(t, "Signer2")
+	recipient := createMember(t, "Recipient")
+
+	_, err := signedRequest(treasury, "SetWalletSigners", []string{firstSigner.ref, secondSigner.ref}, 2)
+	require.NoError(t, err)
+
+	res, err := signedRequest(firstSigner, "ProposeTransfer", treasury.ref, 111, recipient.ref)
+	require.NoError(t, err)
+
+	_, err = signedRequest(firstSigner, "ApproveTransfer", treasury.ref, res.(string))
+	require.Contains(t, err.Error(), "Signer has already approved proposal")
+}
@@ -104,6 +104,28 @@ func TestTransferNegativeAmount(t *testing.T) {
 	require.Equal(t, oldSecondBalance, newSecondBalance)
 }
 
+func TestTransferFractionalAmount(t *testing.T) {
+	firstMember := createMember(t, "Member1")
+	secondMember := createMember(t, "Member2")
+
+	_, err := signedRequest(firstMember, "Transfer", "0.00000001", secondMember.ref)
+	require.NoError(t, err)
+
+	var balance interface{}
+	for i := 0; i < times; i++ {
+		balance, err = signedRequest(secondMember, "GetBalance", secondMember.ref)
+		require.NoError(t, err)
+		if balance == "1000000000.00000001" {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+	require.Equal(t, "1000000000.00000001", balance)
+
+	_, err = signedRequest(firstMember, "Transfer", "0.000000001", secondMember.ref)
+	require.Error(t, err)
+}
+
 // TODO: unskip test after undoing of all transaction in failed request will be supported
 func TestTransferAllAmount(t *testing.T) {
 	t.Skip()
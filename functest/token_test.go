@@ -0,0 +1,117 @@
+// +build functest
+
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package functest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type tokenInfo struct {
+	Reference   string `json:"reference"`
+	Symbol      string `json:"symbol"`
+	Decimals    uint   `json:"decimals"`
+	Issuer      string `json:"issuer"`
+	TotalSupply string `json:"total_supply"`
+}
+
+func issueToken(t *testing.T, issuer *user, supply string, mintable bool) string {
+	res, err := signedRequest(issuer, "IssueToken", "Test Points", "TP", 2, supply, mintable, true)
+	require.NoError(t, err)
+	return res.(string)
+}
+
+func getTokenInfo(t *testing.T, caller *user, token string) tokenInfo {
+	res, err := signedRequest(caller, "GetTokenInfo", token)
+	require.NoError(t, err)
+	data, err := base64.StdEncoding.DecodeString(res.(string))
+	require.NoError(t, err)
+
+	var info tokenInfo
+	err = json.Unmarshal(data, &info)
+	require.NoError(t, err)
+	return info
+}
+
+func checkTokenBalanceFewTimes(t *testing.T, caller *user, token string, ref string, expected string) {
+	var balance interface{}
+	var err error
+	for i := 0; i < times; i++ {
+		balance, err = signedRequest(caller, "GetTokenBalance", token, ref)
+		require.NoError(t, err)
+		if balance == expected {
+			return
+		}
+		time.Sleep(time.Second)
+	}
+	require.Equal(t, expected, balance)
+}
+
+func TestIssueAndTransferToken(t *testing.T) {
+	issuer := createMember(t, "Issuer")
+	recipient := createMember(t, "Recipient")
+
+	token := issueToken(t, issuer, "100.50", false)
+	info := getTokenInfo(t, issuer, token)
+	require.Equal(t, "TP", info.Symbol)
+	require.Equal(t, uint(2), info.Decimals)
+	require.Equal(t, issuer.ref, info.Issuer)
+	require.Equal(t, "100.5", info.TotalSupply)
+	checkTokenBalanceFewTimes(t, issuer, token, issuer.ref, "100.5")
+
+	_, err := signedRequest(issuer, "TransferToken", token, "0.5", recipient.ref)
+	require.NoError(t, err)
+	checkTokenBalanceFewTimes(t, recipient, token, recipient.ref, "0.5")
+	checkTokenBalanceFewTimes(t, issuer, token, issuer.ref, "100")
+
+	oldBalance := getBalanceNoErr(t, recipient, recipient.ref)
+	require.Equal(t, 1000*1000*1000, oldBalance)
+
+	_, err = signedRequest(issuer, "TransferToken", token, "0.001", recipient.ref)
+	require.Error(t, err)
+
+	_, err = signedRequest(issuer, "MintToken", token, "1", issuer.ref)
+	require.Contains(t, err.Error(), "Token supply is fixed")
+}
+
+func TestMintAndBurnToken(t *testing.T) {
+	issuer := createMember(t, "Issuer")
+	holder := createMember(t, "Holder")
+
+	token := issueToken(t, issuer, "0", true)
+
+	_, err := signedRequest(holder, "MintToken", token, "10", holder.ref)
+	require.Contains(t, err.Error(), "Only issuer can mint tokens")
+
+	_, err = signedRequest(issuer, "MintToken", token, "10", holder.ref)
+	require.NoError(t, err)
+	checkTokenBalanceFewTimes(t, holder, token, holder.ref, "10")
+
+	_, err = signedRequest(holder, "BurnToken", token, "2.25")
+	require.NoError(t, err)
+	checkTokenBalanceFewTimes(t, holder, token, holder.ref, "7.75")
+	require.Equal(t, "7.75", getTokenInfo(t, holder, token).TotalSupply)
+
+	_, err = signedRequest(holder, "BurnToken", token, "8")
+	require.Error(t, err)
+}
@@ -26,6 +26,7 @@ import (
 	"io/ioutil"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -115,11 +116,11 @@ func getBalance(caller *user, reference string) (int, error) {
 	if err != nil {
 		return 0, err
 	}
-	amount, ok := res.(float64)
+	amount, ok := res.(string)
 	if !ok {
-		return 0, errors.New("result is not int")
+		return 0, errors.New("result is not string")
 	}
-	return int(amount), nil
+	return strconv.Atoi(amount)
 }
 
 func getRPSResponseBody(t *testing.T, postParams map[string]interface{}) []byte {
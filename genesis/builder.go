@@ -17,6 +17,7 @@
 package genesis
 
 import (
+	"bytes"
 	"context"
 	"go/build"
 	"io/ioutil"
@@ -113,9 +114,17 @@ func (cb *ContractsBuilder) Build(ctx context.Context, contracts map[string]*pre
 		cb.Prototypes[name] = protoRef
 	}
 
+	abis := make(map[string][]byte)
 	for name, code := range contracts {
 		code.ChangePackageToMain()
 
+		abi := bytes.Buffer{}
+		err := code.WriteABI(&abi)
+		if err != nil {
+			return errors.Wrap(err, "[ Build ] Can't write ABI")
+		}
+		abis[name] = abi.Bytes()
+
 		ctr, err := OpenFile(filepath.Join(cb.root, "src/contract", name), "main.go")
 		if err != nil {
 			return errors.Wrap(err, "[ Build ] Can't open contract file")
@@ -170,7 +179,7 @@ func (cb *ContractsBuilder) Build(ctx context.Context, contracts map[string]*pre
 		codeID, err := cb.ArtifactManager.DeployCode(
 			ctx,
 			*domainRef, *core.NewRecordRef(*domain, *codeReq),
-			pluginBinary, core.MachineTypeGoPlugin,
+			pluginBinary, core.MachineTypeGoPlugin, abis[name],
 		)
 		codeRef := core.NewRecordRef(*domain, *codeID)
 		if err != nil {
@@ -29,6 +29,16 @@ type Node struct {
 	CertName string `mapstructure:"cert_name"`
 }
 
+// Token contains info about token precreated by genesis, total supply is credited to root member wallet
+type Token struct {
+	Name        string `mapstructure:"name"`
+	Symbol      string `mapstructure:"symbol"`
+	Decimals    uint   `mapstructure:"decimals"`
+	TotalSupply string `mapstructure:"total_supply"`
+	Mintable    bool   `mapstructure:"mintable"`
+	Burnable    bool   `mapstructure:"burnable"`
+}
+
 // Config contains all genesis config
 type Config struct {
 	RootKeysFile     string `mapstructure:"root_keys_file"`
@@ -36,7 +46,7 @@ type Config struct {
 	DiscoveryKeysDir string `mapstructure:"discovery_keys_dir"`
 	KeysNameFormat   string `mapstructure:"keys_name_format"`
 	ReuseKeys        bool   `mapstructure:"reuse_keys"`
-	RootBalance      uint   `mapstructure:"root_balance"`
+	RootBalance      string `mapstructure:"root_balance"`
 	MajorityRule     int    `mapstructure:"majority_rule"`
 	MinRoles         struct {
 		Virtual       uint `mapstructure:"virtual"`
@@ -46,6 +56,7 @@ type Config struct {
 	PulsarPublicKeys []string `mapstructure:"pulsar_public_keys"`
 	DiscoveryNodes   []Node   `mapstructure:"discovery_nodes"`
 	Nodes            []Node   `mapstructure:"nodes"`
+	Tokens           []Token  `mapstructure:"tokens"`
 }
 
 // It's very light check. It's not about majority rule
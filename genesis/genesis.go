@@ -31,7 +31,9 @@ import (
 	"github.com/insolar/insolar/application/contract/nodedomain"
 	"github.com/insolar/insolar/application/contract/noderecord"
 	"github.com/insolar/insolar/application/contract/rootdomain"
+	"github.com/insolar/insolar/application/contract/token"
 	"github.com/insolar/insolar/application/contract/wallet"
+	"github.com/insolar/insolar/application/contract/wallet/safemath"
 	"github.com/insolar/insolar/certificate"
 	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/core/message"
@@ -48,10 +50,13 @@ const (
 	walletContract    = "wallet"
 	memberContract    = "member"
 	allowanceContract = "allowance"
+	proposalContract  = "proposal"
+	historyContract   = "historyentry"
+	tokenContract     = "token"
 	nodeAmount        = 32
 )
 
-var contractNames = []string{walletContract, memberContract, allowanceContract, rootDomain, nodeDomain, nodeRecord}
+var contractNames = []string{walletContract, memberContract, allowanceContract, proposalContract, historyContract, tokenContract, rootDomain, nodeDomain, nodeRecord}
 
 type messageBusLocker interface {
 	Lock(ctx context.Context)
@@ -247,14 +252,67 @@ func (g *Genesis) updateRootDomain(
 	return nil
 }
 
-func (g *Genesis) activateRootMemberWallet(
+// activateTokens creates tokens from genesis config as children of root member
+// and returns their total supplies in minimal units by token references
+func (g *Genesis) activateTokens(
 	ctx context.Context, domain *core.RecordID, cb *ContractsBuilder,
+) (map[string]string, error) {
+	supplies := make(map[string]string, len(g.config.Tokens))
+
+	for _, conf := range g.config.Tokens {
+		t, err := token.New(conf.Name, conf.Symbol, conf.Decimals, conf.Mintable, conf.Burnable)
+		if err != nil {
+			return nil, errors.Wrapf(err, "[ ActivateTokens ] wrong token %s", conf.Symbol)
+		}
+		supply, err := safemath.ParseAmount(conf.TotalSupply, conf.Decimals)
+		if err != nil {
+			return nil, errors.Wrapf(err, "[ ActivateTokens ] wrong total supply of token %s", conf.Symbol)
+		}
+		t.TotalSupply = supply.String()
+		t.Issued = true
+
+		instanceData, err := serializeInstance(t)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ ActivateTokens ]")
+		}
+
+		contractID, err := g.ArtifactManager.RegisterRequest(ctx, *g.rootDomainRef, &message.Parcel{Msg: &message.GenesisRequest{Name: "Token_" + conf.Symbol}})
+		if err != nil {
+			return nil, errors.Wrapf(err, "[ ActivateTokens ] couldn't create token %s", conf.Symbol)
+		}
+		contract := core.NewRecordRef(*domain, *contractID)
+		_, err = g.ArtifactManager.ActivateObject(
+			ctx,
+			core.RecordRef{},
+			*contract,
+			*g.rootMemberRef,
+			*cb.Prototypes[tokenContract],
+			false,
+			instanceData,
+		)
+		if err != nil {
+			return nil, errors.Wrapf(err, "[ ActivateTokens ] couldn't create token %s", conf.Symbol)
+		}
+		_, err = g.ArtifactManager.RegisterResult(ctx, *g.rootDomainRef, *contract, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "[ ActivateTokens ] couldn't create token %s", conf.Symbol)
+		}
+
+		supplies[contract.String()] = t.TotalSupply
+	}
+
+	return supplies, nil
+}
+
+func (g *Genesis) activateRootMemberWallet(
+	ctx context.Context, domain *core.RecordID, cb *ContractsBuilder, tokens map[string]string,
 ) error {
 
 	w, err := wallet.New(g.config.RootBalance)
 	if err != nil {
 		return errors.Wrap(err, "[ ActivateRootWallet ]")
 	}
+	w.Tokens = tokens
 
 	instanceData, err := serializeInstance(w)
 	if err != nil {
@@ -309,7 +367,11 @@ func (g *Genesis) activateSmartContracts(
 	if err != nil {
 		return nil, errors.Wrap(err, errMsg)
 	}
-	err = g.activateRootMemberWallet(ctx, rootDomainID, cb)
+	tokens, err := g.activateTokens(ctx, rootDomainID, cb)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg)
+	}
+	err = g.activateRootMemberWallet(ctx, rootDomainID, cb, tokens)
 	if err != nil {
 		return nil, errors.Wrap(err, errMsg)
 	}
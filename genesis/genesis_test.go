@@ -20,6 +20,7 @@ import (
 	"context"
 	"io/ioutil"
 	"os"
+	"sort"
 	"testing"
 
 	"github.com/insolar/insolar/application/contract/noderecord"
@@ -490,3 +491,43 @@ func (s *genesisWithDataSuite) TestAddIndex() {
 	require.Nil(s.T(), err)
 	require.Len(s.T(), resIndexMap, nodeAmount)
 }
+
+func TestActivateTokens(t *testing.T) {
+	am := mockArtifactManager(t)
+	g := mockGenesis(t, am)
+	g.rootMemberRef = g.rootDomainRef
+	g.config.Tokens = []Token{
+		{Name: "Loyalty Points", Symbol: "LP", Decimals: 2, TotalSupply: "100.5"},
+		{Name: "Stable", Symbol: "ST", Decimals: 6, TotalSupply: "1", Mintable: true},
+	}
+	cb := mockContractBuilder(t, g)
+	tokenProto := testutils.RandomRef()
+	cb.Prototypes[tokenContract] = &tokenProto
+	ctx := inslogger.TestContext(t)
+
+	supplies, err := g.activateTokens(ctx, g.rootDomainRef.Record(), cb)
+	require.NoError(t, err)
+	require.Len(t, supplies, 2)
+
+	var units []string
+	for _, s := range supplies {
+		units = append(units, s)
+	}
+	sort.Strings(units)
+	require.Equal(t, []string{"1000000", "10050"}, units)
+}
+
+func TestActivateTokens_WrongSupply(t *testing.T) {
+	am := mockArtifactManager(t)
+	g := mockGenesis(t, am)
+	g.rootMemberRef = g.rootDomainRef
+	g.config.Tokens = []Token{
+		{Name: "Loyalty Points", Symbol: "LP", Decimals: 2, TotalSupply: "0.001"},
+	}
+	cb := mockContractBuilder(t, g)
+	ctx := inslogger.TestContext(t)
+
+	_, err := g.activateTokens(ctx, g.rootDomainRef.Record(), cb)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "[ ActivateTokens ] wrong total supply of token LP")
+}
@@ -33,8 +33,10 @@ import (
 )
 
 const (
-	getChildrenChunkSize = 10 * 1000
-	jetMissRetryCount    = 10
+	getChildrenChunkSize      = 10 * 1000
+	getObjectHistoryChunkSize = 1000
+	getEventsChunkSize        = 1000
+	jetMissRetryCount         = 10
 )
 
 // LedgerArtifactManager provides concrete API to storage for processing module.
@@ -48,8 +50,10 @@ type LedgerArtifactManager struct {
 	PulseStorage               core.PulseStorage               `inject:""`
 	JetCoordinator             core.JetCoordinator             `inject:""`
 
-	getChildrenChunkSize int
-	senders              *ledgerArtifactSenders
+	getChildrenChunkSize      int
+	getObjectHistoryChunkSize int
+	getEventsChunkSize        int
+	senders                   *ledgerArtifactSenders
 }
 
 // State returns hash state for artifact manager.
@@ -61,8 +65,10 @@ func (m *LedgerArtifactManager) State() ([]byte, error) {
 // NewArtifactManger creates new manager instance.
 func NewArtifactManger() *LedgerArtifactManager {
 	return &LedgerArtifactManager{
-		getChildrenChunkSize: getChildrenChunkSize,
-		senders:              newLedgerArtifactSenders(),
+		getChildrenChunkSize:      getChildrenChunkSize,
+		getObjectHistoryChunkSize: getObjectHistoryChunkSize,
+		getEventsChunkSize:        getEventsChunkSize,
+		senders:                   newLedgerArtifactSenders(),
 	}
 }
 
@@ -99,15 +105,11 @@ func (m *LedgerArtifactManager) RegisterRequest(
 		MessageHash: m.PlatformCryptographyScheme.IntegrityHasher().Hash(message.MustSerializeBytes(parcel.Message())),
 		Object:      *obj.Record(),
 	}
-	recID := record.NewRecordIDFromRecord(
-		m.PlatformCryptographyScheme,
-		currentPN,
-		rec)
-	recRef := core.NewRecordRef(*parcel.DefaultTarget().Domain(), *recID)
+	// Requests are stored in the jet of the object, so they can be found by the object after jet splits.
 	id, err := m.setRecord(
 		ctx,
 		rec,
-		*recRef,
+		obj,
 		currentPN,
 	)
 	return id, errors.Wrap(err, "[ RegisterRequest ] ")
@@ -156,6 +158,7 @@ func (m *LedgerArtifactManager) GetCode(
 			ref:         code,
 			machineType: rep.MachineType,
 			code:        rep.Code,
+			abi:         rep.ABI,
 		}
 		return &desc, nil
 	case *reply.Error:
@@ -236,6 +239,73 @@ func (m *LedgerArtifactManager) GetObject(
 	}
 }
 
+// GetObjectAtPulse returns descriptor for the latest object state not newer than provided pulse.
+//
+// State is resolved by walking object's history on its executor (or heavy), so states created after the pulse don't
+// affect the result. If the object was deactivated by the pulse, core.ErrDeactivated is returned.
+func (m *LedgerArtifactManager) GetObjectAtPulse(
+	ctx context.Context, head core.RecordRef, pulse core.PulseNumber,
+) (core.ObjectDescriptor, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetObjectAtPulse")
+	instrumenter := instrument(ctx, "GetObjectAtPulse").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	currentPN, err := m.pulse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bus := core.MessageBusFromContext(ctx, m.DefaultBus)
+	sender := BuildSender(bus.Send, followRedirectSender(bus), retryJetSender(currentPN, m.JetStorage))
+
+	var (
+		state        *core.ObjectStateDescriptor
+		fromState    *core.RecordID
+		genericReply core.Reply
+	)
+	for {
+		genericReply, err = sender(ctx, &message.GetObjectHistory{
+			Head:      head,
+			FromState: fromState,
+			ToPulse:   &pulse,
+			Amount:    m.getObjectHistoryChunkSize,
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		rep, ok := genericReply.(*reply.ObjectHistory)
+		if !ok {
+			err = fmt.Errorf("GetObjectAtPulse: unexpected reply: %#v", genericReply)
+			return nil, err
+		}
+
+		// States are ordered from the latest, so the first one is the state at the pulse.
+		if len(rep.States) > 0 {
+			state = &rep.States[0]
+			break
+		}
+		if rep.NextFrom == nil {
+			err = core.ErrStateNotAvailable
+			return nil, err
+		}
+		fromState = rep.NextFrom
+	}
+	if state.Deactivated {
+		err = core.ErrDeactivated
+		return nil, err
+	}
+
+	desc, err := m.GetObject(ctx, head, &state.StateID, false)
+	return desc, err
+}
+
 // GetPendingRequest returns an unclosed pending request
 // It takes an id from current LME
 // Then goes either to a light node or heavy node
@@ -289,6 +359,7 @@ func (m *LedgerArtifactManager) GetPendingRequest(ctx context.Context, objectID
 	genericReply, err = sender(
 		ctx,
 		&message.GetRequest{
+			Object:  objectID,
 			Request: requestIDReply.ID,
 		}, &core.MessageSendOptions{
 			Receiver: node,
@@ -419,6 +490,65 @@ func (m *LedgerArtifactManager) GetChildren(
 	return iter, err
 }
 
+// GetObjectHistory returns object states iterator.
+//
+// During iteration states will be fetched from remote source (object's executor or heavy).
+func (m *LedgerArtifactManager) GetObjectHistory(
+	ctx context.Context, head core.RecordRef, fromPulse, toPulse *core.PulseNumber,
+) (core.ObjectHistoryIterator, error) {
+	var err error
+
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetObjectHistory")
+	instrumenter := instrument(ctx, "GetObjectHistory").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	currentPN, err := m.pulse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bus := core.MessageBusFromContext(ctx, m.DefaultBus)
+	sender := BuildSender(bus.Send, followRedirectSender(bus), retryJetSender(currentPN, m.JetStorage))
+	iter, err := NewObjectHistoryIterator(ctx, sender, head, fromPulse, toPulse, m.getObjectHistoryChunkSize)
+	return iter, err
+}
+
+// GetEvents returns object events iterator.
+//
+// Events are returned from the latest to the earliest. If topic is empty, events of all topics are returned. If
+// pulses are provided, only events emitted in [fromPulse, toPulse] range will be returned.
+func (m *LedgerArtifactManager) GetEvents(
+	ctx context.Context, object core.RecordRef, topic string, fromPulse, toPulse *core.PulseNumber,
+) (core.EventIterator, error) {
+	var err error
+
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetEvents")
+	instrumenter := instrument(ctx, "GetEvents").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	currentPN, err := m.pulse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bus := core.MessageBusFromContext(ctx, m.DefaultBus)
+	sender := BuildSender(bus.Send, followRedirectSender(bus), retryJetSender(currentPN, m.JetStorage))
+	iter, err := NewEventIterator(ctx, sender, object, topic, fromPulse, toPulse, m.getEventsChunkSize)
+	return iter, err
+}
+
 // DeclareType creates new type record in storage.
 //
 // Type is a contract interface. It contains one method signature.
@@ -465,6 +595,7 @@ func (m *LedgerArtifactManager) DeployCode(
 	request core.RecordRef,
 	code []byte,
 	machineType core.MachineType,
+	abi []byte,
 ) (*core.RecordID, error) {
 	var err error
 	ctx, span := instracer.StartSpan(ctx, "artifactmanager.DeployCode")
@@ -490,6 +621,9 @@ func (m *LedgerArtifactManager) DeployCode(
 		Code:        record.CalculateIDForBlob(m.PlatformCryptographyScheme, currentPN, code),
 		MachineType: machineType,
 	}
+	if len(abi) > 0 {
+		codeRec.ABI = record.CalculateIDForBlob(m.PlatformCryptographyScheme, currentPN, abi)
+	}
 	codeID := record.NewRecordIDFromRecord(m.PlatformCryptographyScheme, currentPN, codeRec)
 	codeRef := core.NewRecordRef(*domain.Record(), *codeID)
 
@@ -497,6 +631,12 @@ func (m *LedgerArtifactManager) DeployCode(
 	if err != nil {
 		return nil, err
 	}
+	if codeRec.ABI != nil {
+		_, err = m.setBlob(ctx, abi, *codeRef, currentPN)
+		if err != nil {
+			return nil, err
+		}
+	}
 	id, err := m.setRecord(
 		ctx,
 		codeRec,
@@ -722,12 +862,195 @@ func (m *LedgerArtifactManager) RegisterResult(
 			Request: request,
 			Payload: payload,
 		},
-		request,
+		object,
 		currentPN,
 	)
 	return recid, err
 }
 
+// GetReceipt returns provided request to the object with the result of its execution.
+//
+// If the request is not executed yet, receipt without result will be returned.
+func (m *LedgerArtifactManager) GetReceipt(
+	ctx context.Context, object, request core.RecordRef,
+) (*core.RequestReceipt, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetReceipt")
+	instrumenter := instrument(ctx, "GetReceipt").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	currentPN, err := m.pulse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bus := core.MessageBusFromContext(ctx, m.DefaultBus)
+	sender := BuildSender(bus.Send, followRedirectSender(bus), retryJetSender(currentPN, m.JetStorage))
+	genericReply, err := sender(ctx, &message.GetReceipt{
+		Object:  object,
+		Request: request,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	switch rep := genericReply.(type) {
+	case *reply.Receipt:
+		return &core.RequestReceipt{
+			Request: request,
+			Pulse:   request.Record().Pulse(),
+			Result:  rep.Result,
+			Payload: rep.Payload,
+		}, nil
+	case *reply.Error:
+		return nil, rep.Error()
+	default:
+		return nil, fmt.Errorf("GetReceipt: unexpected reply: %#v", rep)
+	}
+}
+
+// GetRecordProof returns merkle proof of the record inclusion into the jet drop of provided pulse.
+func (m *LedgerArtifactManager) GetRecordProof(
+	ctx context.Context, jet core.RecordID, pulse core.PulseNumber, record core.RecordID,
+) (*core.RecordProof, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetRecordProof")
+	instrumenter := instrument(ctx, "GetRecordProof").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	bus := core.MessageBusFromContext(ctx, m.DefaultBus)
+	sender := BuildSender(bus.Send, followRedirectSender(bus))
+	genericReply, err := sender(ctx, &message.GetRecordProof{
+		Jet:    jet,
+		Pulse:  pulse,
+		Record: record,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	switch rep := genericReply.(type) {
+	case *reply.RecordProof:
+		return &rep.Proof, nil
+	case *reply.Error:
+		return nil, rep.Error()
+	default:
+		return nil, fmt.Errorf("GetRecordProof: unexpected reply: %#v", rep)
+	}
+}
+
+// FindObjectsByPrototype returns objects of provided prototype from heavy node index.
+func (m *LedgerArtifactManager) FindObjectsByPrototype(
+	ctx context.Context, prototype core.RecordRef, from *core.RecordID, limit int,
+) (*core.IndexedRecords, error) {
+	return m.findIndexedRecords(ctx, "FindObjectsByPrototype", core.SecondaryIndexObjectsByPrototype, prototype, from, limit)
+}
+
+// FindRequestsByCaller returns requests made by provided caller from heavy node index.
+func (m *LedgerArtifactManager) FindRequestsByCaller(
+	ctx context.Context, caller core.RecordRef, from *core.RecordID, limit int,
+) (*core.IndexedRecords, error) {
+	return m.findIndexedRecords(ctx, "FindRequestsByCaller", core.SecondaryIndexRequestsByCaller, caller, from, limit)
+}
+
+func (m *LedgerArtifactManager) findIndexedRecords(
+	ctx context.Context, name string, index core.SecondaryIndex, key core.RecordRef, from *core.RecordID, limit int,
+) (*core.IndexedRecords, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager."+name)
+	instrumenter := instrument(ctx, name).err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	bus := core.MessageBusFromContext(ctx, m.DefaultBus)
+	genericReply, err := bus.Send(ctx, &message.FindIndexedRecords{
+		Index: index,
+		Key:   key,
+		From:  from,
+		Limit: limit,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	switch rep := genericReply.(type) {
+	case *reply.IndexedRecords:
+		return &rep.Records, nil
+	case *reply.Error:
+		err = rep.Error()
+		return nil, err
+	default:
+		err = fmt.Errorf("%v: unexpected reply: %#v", name, rep)
+		return nil, err
+	}
+}
+
+// RegisterEvent creates event record in storage and links it to the object.
+//
+// Events are indexed by object and topic. Provided request is the request during which the event was emitted.
+func (m *LedgerArtifactManager) RegisterEvent(
+	ctx context.Context, object, request core.RecordRef, topic string, payload []byte,
+) (*core.RecordID, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.RegisterEvent")
+	instrumenter := instrument(ctx, "RegisterEvent").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	currentPN, err := m.pulse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rec := record.EventRecord{
+		Object:  *object.Record(),
+		Request: request,
+		Topic:   topic,
+		Payload: payload,
+	}
+
+	bus := core.MessageBusFromContext(ctx, m.DefaultBus)
+	sender := BuildSender(bus.Send, retryJetSender(currentPN, m.JetStorage))
+	genericReply, err := sender(ctx, &message.RegisterEvent{
+		Record: record.SerializeRecord(&rec),
+		Object: object,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	switch rep := genericReply.(type) {
+	case *reply.ID:
+		return &rep.ID, nil
+	case *reply.Error:
+		return nil, rep.Error()
+	default:
+		return nil, fmt.Errorf("RegisterEvent: unexpected reply: %#v", rep)
+	}
+}
+
 // pulse returns current PulseNumber for artifact manager
 func (m *LedgerArtifactManager) pulse(ctx context.Context) (pn core.PulseNumber, err error) {
 	pulse, err := m.PulseStorage.Current(ctx)
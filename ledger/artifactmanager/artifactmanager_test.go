@@ -205,6 +205,8 @@ func getTestData(s *amSuite) (
 		DB:                         s.db,
 		DefaultBus:                 mb,
 		getChildrenChunkSize:       100,
+		getObjectHistoryChunkSize:  100,
+		getEventsChunkSize:         100,
 		PlatformCryptographyScheme: s.scheme,
 		PulseStorage:               pulseStorage,
 		GenesisState:               s.genesisState,
@@ -231,12 +233,14 @@ func (s *amSuite) TestLedgerArtifactManager_RegisterRequest() {
 
 func (s *amSuite) TestLedgerArtifactManager_GetCodeWithCache() {
 	code := []byte("test_code")
+	abi := []byte("test_abi")
 	codeRef := testutils.RandomRef()
 
 	mb := testutils.NewMessageBusMock(s.T())
 	mb.SendFunc = func(p context.Context, p1 core.Message, p3 *core.MessageSendOptions) (r core.Reply, r1 error) {
 		return &reply.Code{
 			Code: code,
+			ABI:  abi,
 		}, nil
 	}
 
@@ -264,6 +268,9 @@ func (s *amSuite) TestLedgerArtifactManager_GetCodeWithCache() {
 	receivedCode, err := desc.Code()
 	require.NoError(s.T(), err)
 	require.Equal(s.T(), code, receivedCode)
+	receivedABI, err := desc.ABI()
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), abi, receivedABI)
 
 	mb.SendFunc = func(p context.Context, p1 core.Message, p3 *core.MessageSendOptions) (r core.Reply, r1 error) {
 		s.T().Fatal("Func must not be called here")
@@ -303,6 +310,7 @@ func (s *amSuite) TestLedgerArtifactManager_DeployCode_CreatesCorrectRecord() {
 		requestRef,
 		[]byte{1, 2, 3},
 		core.MachineTypeBuiltin,
+		[]byte{4, 5, 6},
 	)
 	assert.NoError(s.T(), err)
 	codeRec, err := os.GetRecord(ctx, *jet.NewID(0, nil), id)
@@ -314,6 +322,7 @@ func (s *amSuite) TestLedgerArtifactManager_DeployCode_CreatesCorrectRecord() {
 		},
 		Code:        record.CalculateIDForBlob(am.PlatformCryptographyScheme, core.GenesisPulse.PulseNumber, []byte{1, 2, 3}),
 		MachineType: core.MachineTypeBuiltin,
+		ABI:         record.CalculateIDForBlob(am.PlatformCryptographyScheme, core.GenesisPulse.PulseNumber, []byte{4, 5, 6}),
 	})
 }
 
@@ -743,6 +752,380 @@ func (s *amSuite) TestLedgerArtifactManager_GetChildren_FollowsRedirect() {
 	require.NoError(s.T(), err)
 }
 
+func (s *amSuite) TestLedgerArtifactManager_GetObjectHistory() {
+	ctx, os, am := getTestData(s)
+	jetID := *jet.NewID(0, nil)
+
+	memory := record.CalculateIDForBlob(am.PlatformCryptographyScheme, core.GenesisPulse.PulseNumber, []byte{0})
+	request1 := genRandomRef(0)
+	request2 := genRandomRef(0)
+	stateID1, _ := os.SetRecord(
+		ctx,
+		jetID,
+		core.GenesisPulse.PulseNumber,
+		&record.ObjectActivateRecord{
+			SideEffectRecord: record.SideEffectRecord{
+				Domain:  domainRef,
+				Request: *request1,
+			},
+			ObjectStateRecord: record.ObjectStateRecord{
+				Memory: memory,
+			},
+		})
+	stateID2, _ := os.SetRecord(
+		ctx,
+		jetID,
+		core.GenesisPulse.PulseNumber,
+		&record.ObjectAmendRecord{
+			SideEffectRecord: record.SideEffectRecord{
+				Domain:  domainRef,
+				Request: *request2,
+			},
+			ObjectStateRecord: record.ObjectStateRecord{
+				Memory: memory,
+			},
+			PrevState: *stateID1,
+		})
+	stateID3, _ := os.SetRecord(
+		ctx,
+		jetID,
+		core.GenesisPulse.PulseNumber,
+		&record.DeactivationRecord{
+			SideEffectRecord: record.SideEffectRecord{
+				Domain: domainRef,
+			},
+			PrevState: *stateID2,
+		})
+
+	objIndex := index.ObjectLifeline{
+		LatestState: stateID3,
+	}
+	require.NoError(
+		s.T(),
+		os.SetObjectIndex(ctx, jetID, stateID1, &objIndex),
+	)
+
+	s.T().Run("returns states from the latest", func(t *testing.T) {
+		i, err := am.GetObjectHistory(ctx, *genRefWithID(stateID1), nil, nil)
+		require.NoError(t, err)
+		state, err := i.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, *stateID3, state.StateID)
+		assert.Equal(t, stateID2, state.PrevStateID)
+		assert.True(t, state.Deactivated)
+		assert.Nil(t, state.MemoryHash)
+		state, err = i.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, *stateID2, state.StateID)
+		assert.Equal(t, *request2, state.Request)
+		assert.Equal(t, memory.Hash(), state.MemoryHash)
+		assert.False(t, state.Deactivated)
+		state, err = i.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, *stateID1, state.StateID)
+		assert.Equal(t, *request1, state.Request)
+		assert.Equal(t, core.GenesisPulse.PulseNumber, state.Pulse)
+		assert.Nil(t, state.PrevStateID)
+		assert.False(t, i.HasNext())
+		_, err = i.Next()
+		assert.Error(t, err)
+	})
+
+	s.T().Run("returns states in many chunks", func(t *testing.T) {
+		am.getObjectHistoryChunkSize = 1
+		i, err := am.GetObjectHistory(ctx, *genRefWithID(stateID1), nil, nil)
+		require.NoError(t, err)
+		var states []core.RecordID
+		for i.HasNext() {
+			state, err := i.Next()
+			require.NoError(t, err)
+			states = append(states, state.StateID)
+		}
+		assert.Equal(t, []core.RecordID{*stateID3, *stateID2, *stateID1}, states)
+	})
+
+	s.T().Run("skips states out of pulse range", func(t *testing.T) {
+		am.getObjectHistoryChunkSize = 100
+		pn := core.GenesisPulse.PulseNumber - 1
+		i, err := am.GetObjectHistory(ctx, *genRefWithID(stateID1), nil, &pn)
+		require.NoError(t, err)
+		assert.False(t, i.HasNext())
+
+		pn = core.GenesisPulse.PulseNumber + 1
+		i, err = am.GetObjectHistory(ctx, *genRefWithID(stateID1), &pn, nil)
+		require.NoError(t, err)
+		assert.False(t, i.HasNext())
+	})
+
+	s.T().Run("stops paging at pulse range boundaries", func(t *testing.T) {
+		pulse1 := core.GenesisPulse.PulseNumber
+		pulse2 := pulse1 + 10
+		pulse3 := pulse1 + 20
+		for _, pn := range []core.PulseNumber{pulse1, pulse2, pulse3} {
+			s.jetStorage.UpdateJetTree(ctx, pn, true, jetID)
+		}
+		first, _ := os.SetRecord(ctx, jetID, pulse1, &record.ObjectActivateRecord{
+			SideEffectRecord: record.SideEffectRecord{Domain: domainRef},
+		})
+		second, _ := os.SetRecord(ctx, jetID, pulse2, &record.ObjectAmendRecord{
+			SideEffectRecord: record.SideEffectRecord{Domain: domainRef},
+			PrevState:        *first,
+		})
+		third, _ := os.SetRecord(ctx, jetID, pulse3, &record.ObjectAmendRecord{
+			SideEffectRecord: record.SideEffectRecord{Domain: domainRef},
+			PrevState:        *second,
+		})
+		require.NoError(t, os.SetObjectIndex(ctx, jetID, first, &index.ObjectLifeline{LatestState: third}))
+		objRef := genRefWithID(first)
+
+		am.getObjectHistoryChunkSize = 2
+		defer func() { am.getObjectHistoryChunkSize = 100 }()
+
+		i, err := am.GetObjectHistory(ctx, *objRef, &pulse2, nil)
+		require.NoError(t, err)
+		var states []core.RecordID
+		for i.HasNext() {
+			state, err := i.Next()
+			require.NoError(t, err)
+			states = append(states, state.StateID)
+		}
+		assert.Equal(t, []core.RecordID{*third, *second}, states)
+
+		am.getObjectHistoryChunkSize = 1
+		i, err = am.GetObjectHistory(ctx, *objRef, nil, &pulse1)
+		require.NoError(t, err)
+		require.True(t, i.HasNext())
+		state, err := i.Next()
+		require.NoError(t, err)
+		assert.Equal(t, *first, state.StateID)
+		assert.False(t, i.HasNext())
+	})
+}
+
+func (s *amSuite) TestLedgerArtifactManager_GetObjectHistory_FollowsRedirect() {
+	mc := minimock.NewController(s.T())
+	am := NewArtifactManger()
+	mb := testutils.NewMessageBusMock(mc)
+
+	am.DB = s.db
+	am.PulseStorage = makePulseStorage(s)
+
+	objRef := genRandomRef(0)
+	nodeRef := genRandomRef(0)
+	mb.SendFunc = func(c context.Context, m core.Message, o *core.MessageSendOptions) (r core.Reply, r1 error) {
+		o = o.Safe()
+		if o.Receiver == nil {
+			return &reply.GetObjectHistoryRedirectReply{
+				Receiver: nodeRef,
+				Token:    &delegationtoken.GetObjectHistoryRedirectToken{Signature: []byte{1, 2, 3}},
+			}, nil
+		}
+
+		token, ok := o.Token.(*delegationtoken.GetObjectHistoryRedirectToken)
+		assert.True(s.T(), ok)
+		assert.Equal(s.T(), []byte{1, 2, 3}, token.Signature)
+		assert.Equal(s.T(), nodeRef, o.Receiver)
+		return &reply.ObjectHistory{}, nil
+	}
+	am.DefaultBus = mb
+
+	_, err := am.GetObjectHistory(s.ctx, *objRef, nil, nil)
+	require.NoError(s.T(), err)
+}
+
+func (s *amSuite) TestLedgerArtifactManager_GetObjectAtPulse() {
+	ctx, os, am := getTestData(s)
+	jetID := *jet.NewID(0, nil)
+	pulse1 := core.GenesisPulse.PulseNumber
+	pulse2 := pulse1 + 10
+	pulse3 := pulse1 + 20
+	for _, pn := range []core.PulseNumber{pulse1, pulse2, pulse3} {
+		s.jetStorage.UpdateJetTree(ctx, pn, true, jetID)
+	}
+
+	setState := func(pn core.PulseNumber, rec record.Record, memory []byte) *core.RecordID {
+		if memory != nil {
+			_, err := os.SetBlob(ctx, jetID, pn, memory)
+			require.NoError(s.T(), err)
+		}
+		id, err := os.SetRecord(ctx, jetID, pn, rec)
+		require.NoError(s.T(), err)
+		return id
+	}
+	prototypeRef := genRandomRef(0)
+	stateID1 := setState(pulse1, &record.ObjectActivateRecord{
+		SideEffectRecord: record.SideEffectRecord{Domain: domainRef, Request: *genRandomRef(0)},
+		ObjectStateRecord: record.ObjectStateRecord{
+			Memory: record.CalculateIDForBlob(am.PlatformCryptographyScheme, pulse1, []byte{1}),
+			Image:  *prototypeRef,
+		},
+	}, []byte{1})
+	stateID2 := setState(pulse2, &record.ObjectAmendRecord{
+		SideEffectRecord: record.SideEffectRecord{Domain: domainRef, Request: *genRandomRef(0)},
+		ObjectStateRecord: record.ObjectStateRecord{
+			Memory: record.CalculateIDForBlob(am.PlatformCryptographyScheme, pulse2, []byte{2}),
+			Image:  *prototypeRef,
+		},
+		PrevState: *stateID1,
+	}, []byte{2})
+	stateID3 := setState(pulse3, &record.DeactivationRecord{
+		SideEffectRecord: record.SideEffectRecord{Domain: domainRef, Request: *genRandomRef(0)},
+		PrevState:        *stateID2,
+	}, nil)
+
+	objRef := genRefWithID(stateID1)
+	require.NoError(
+		s.T(),
+		os.SetObjectIndex(ctx, jetID, objRef.Record(), &index.ObjectLifeline{LatestState: stateID3}),
+	)
+
+	s.T().Run("returns the latest state not newer than pulse", func(t *testing.T) {
+		for pn, expected := range map[core.PulseNumber]struct {
+			state  *core.RecordID
+			memory []byte
+		}{
+			pulse1:     {stateID1, []byte{1}},
+			pulse2 - 1: {stateID1, []byte{1}},
+			pulse2:     {stateID2, []byte{2}},
+			pulse3 - 1: {stateID2, []byte{2}},
+		} {
+			desc, err := am.GetObjectAtPulse(ctx, *objRef, pn)
+			require.NoError(t, err)
+			assert.Equal(t, expected.state, desc.StateID())
+			assert.Equal(t, expected.memory, desc.Memory())
+			prototype, err := desc.Prototype()
+			require.NoError(t, err)
+			assert.Equal(t, prototypeRef, prototype)
+		}
+	})
+
+	s.T().Run("skips states in many chunks", func(t *testing.T) {
+		am.getObjectHistoryChunkSize = 1
+		defer func() { am.getObjectHistoryChunkSize = 100 }()
+
+		desc, err := am.GetObjectAtPulse(ctx, *objRef, pulse1)
+		require.NoError(t, err)
+		assert.Equal(t, stateID1, desc.StateID())
+	})
+
+	s.T().Run("returns error for deactivated object", func(t *testing.T) {
+		_, err := am.GetObjectAtPulse(ctx, *objRef, pulse3)
+		assert.Equal(t, core.ErrDeactivated, err)
+	})
+
+	s.T().Run("returns error before activation", func(t *testing.T) {
+		_, err := am.GetObjectAtPulse(ctx, *objRef, pulse1-1)
+		assert.Equal(t, core.ErrStateNotAvailable, err)
+	})
+}
+
+func (s *amSuite) TestLedgerArtifactManager_RegisterEvent_GetEvents() {
+	ctx, os, am := getTestData(s)
+	jetID := *jet.NewID(0, nil)
+
+	objID := *genRandomID(0)
+	objRef := genRefWithID(&objID)
+	require.NoError(
+		s.T(),
+		os.SetObjectIndex(ctx, jetID, &objID, &index.ObjectLifeline{State: record.StateActivation}),
+	)
+
+	request := genRandomRef(0)
+	eventID1, err := am.RegisterEvent(ctx, *objRef, *request, "transfer", []byte("1"))
+	require.NoError(s.T(), err)
+	eventID2, err := am.RegisterEvent(ctx, *objRef, *request, "mint", []byte("2"))
+	require.NoError(s.T(), err)
+	eventID3, err := am.RegisterEvent(ctx, *objRef, *request, "transfer", []byte("3"))
+	require.NoError(s.T(), err)
+
+	rec, err := os.GetRecord(ctx, jetID, eventID3)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), record.EventRecord{
+		Object:         objID,
+		Request:        *request,
+		Topic:          "transfer",
+		Payload:        []byte("3"),
+		PrevEvent:      eventID2,
+		PrevTopicEvent: eventID1,
+	}, *rec.(*record.EventRecord))
+
+	idx, err := os.GetObjectIndex(ctx, jetID, &objID, false)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), eventID3, idx.LatestEvent)
+	assert.Equal(s.T(), map[string]*core.RecordID{"transfer": eventID3, "mint": eventID2}, idx.TopicEvents)
+
+	collect := func(t *testing.T, i core.EventIterator) []core.RecordID {
+		var events []core.RecordID
+		for i.HasNext() {
+			event, err := i.Next()
+			require.NoError(t, err)
+			events = append(events, event.ID)
+		}
+		return events
+	}
+
+	s.T().Run("returns events of all topics from the latest", func(t *testing.T) {
+		i, err := am.GetEvents(ctx, *objRef, "", nil, nil)
+		require.NoError(t, err)
+		event, err := i.Next()
+		require.NoError(t, err)
+		assert.Equal(t, core.ContractEvent{
+			ID:      *eventID3,
+			Object:  *objRef,
+			Request: *request,
+			Topic:   "transfer",
+			Payload: []byte("3"),
+			Pulse:   eventID3.Pulse(),
+		}, *event)
+		assert.Equal(t, []core.RecordID{*eventID2, *eventID1}, collect(t, i))
+	})
+
+	s.T().Run("returns events of the topic", func(t *testing.T) {
+		i, err := am.GetEvents(ctx, *objRef, "transfer", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []core.RecordID{*eventID3, *eventID1}, collect(t, i))
+
+		i, err = am.GetEvents(ctx, *objRef, "burn", nil, nil)
+		require.NoError(t, err)
+		assert.False(t, i.HasNext())
+	})
+
+	s.T().Run("returns events in many chunks", func(t *testing.T) {
+		am.getEventsChunkSize = 1
+		i, err := am.GetEvents(ctx, *objRef, "", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []core.RecordID{*eventID3, *eventID2, *eventID1}, collect(t, i))
+	})
+
+	s.T().Run("skips events out of pulse range", func(t *testing.T) {
+		am.getEventsChunkSize = 100
+		pn := eventID1.Pulse() - 1
+		i, err := am.GetEvents(ctx, *objRef, "", nil, &pn)
+		require.NoError(t, err)
+		assert.False(t, i.HasNext())
+
+		pn = eventID1.Pulse() + 1
+		i, err = am.GetEvents(ctx, *objRef, "", &pn, nil)
+		require.NoError(t, err)
+		assert.False(t, i.HasNext())
+	})
+
+	s.T().Run("skips empty chunks out of pulse range", func(t *testing.T) {
+		am.getEventsChunkSize = 1
+		defer func() { am.getEventsChunkSize = 100 }()
+
+		pn := eventID1.Pulse() - 1
+		i, err := am.GetEvents(ctx, *objRef, "", nil, &pn)
+		require.NoError(t, err)
+		assert.False(t, i.HasNext())
+
+		pn = eventID1.Pulse() + 1
+		i, err = am.GetEvents(ctx, *objRef, "", &pn, nil)
+		require.NoError(t, err)
+		assert.False(t, i.HasNext())
+	})
+}
+
 func (s *amSuite) TestLedgerArtifactManager_HandleJetDrop() {
 	s.T().Skip("jet drops are for validation and it doesn't work")
 
@@ -831,6 +1214,8 @@ func (s *amSuite) TestLedgerArtifactManager_RegisterValidation() {
 		DB:                         s.db,
 		DefaultBus:                 mb,
 		getChildrenChunkSize:       100,
+		getObjectHistoryChunkSize:  100,
+		getEventsChunkSize:         100,
 		PlatformCryptographyScheme: s.scheme,
 		PulseStorage:               amPulseStorageMock,
 		GenesisState:               s.genesisState,
@@ -906,6 +1291,79 @@ func (s *amSuite) TestLedgerArtifactManager_RegisterResult() {
 	}, *rec.(*record.ResultRecord))
 }
 
+func (s *amSuite) TestLedgerArtifactManager_GetReceipt() {
+	ctx, _, am := getTestData(s)
+
+	requestID, err := am.RegisterRequest(ctx, *am.GenesisRef(), &message.Parcel{
+		Msg: &message.GenesisRequest{Name: "receipt"},
+	})
+	require.NoError(s.T(), err)
+	requestRef := genRefWithID(requestID)
+
+	receipt, err := am.GetReceipt(ctx, *am.GenesisRef(), *requestRef)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), core.RequestReceipt{
+		Request: *requestRef,
+		Pulse:   requestID.Pulse(),
+	}, *receipt)
+
+	resultID, err := am.RegisterResult(ctx, *am.GenesisRef(), *requestRef, []byte{1, 2, 3})
+	require.NoError(s.T(), err)
+
+	receipt, err = am.GetReceipt(ctx, *am.GenesisRef(), *requestRef)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), core.RequestReceipt{
+		Request: *requestRef,
+		Pulse:   requestID.Pulse(),
+		Result:  resultID,
+		Payload: []byte{1, 2, 3},
+	}, *receipt)
+}
+
+func (s *amSuite) TestLedgerArtifactManager_FindIndexedRecords() {
+	mc := minimock.NewController(s.T())
+	defer mc.Finish()
+
+	key := testutils.RandomRef()
+	from := testutils.RandomID()
+	next := testutils.RandomID()
+	records := core.IndexedRecords{
+		Records: []core.IndexedRecord{{ID: next, Object: testutils.RandomRef()}},
+		Next:    &next,
+	}
+
+	am := NewArtifactManger()
+	mb := testutils.NewMessageBusMock(mc)
+	am.DefaultBus = mb
+
+	var expected core.SecondaryIndex
+	mb.SendFunc = func(c context.Context, m core.Message, o *core.MessageSendOptions) (core.Reply, error) {
+		assert.Equal(s.T(), &message.FindIndexedRecords{
+			Index: expected,
+			Key:   key,
+			From:  &from,
+			Limit: 10,
+		}, m)
+		return &reply.IndexedRecords{Records: records}, nil
+	}
+
+	expected = core.SecondaryIndexObjectsByPrototype
+	found, err := am.FindObjectsByPrototype(s.ctx, key, &from, 10)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), records, *found)
+
+	expected = core.SecondaryIndexRequestsByCaller
+	found, err = am.FindRequestsByCaller(s.ctx, key, &from, 10)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), records, *found)
+
+	mb.SendFunc = func(c context.Context, m core.Message, o *core.MessageSendOptions) (core.Reply, error) {
+		return &reply.Error{ErrType: reply.ErrStateNotAvailable}, nil
+	}
+	_, err = am.FindRequestsByCaller(s.ctx, key, nil, 10)
+	require.Error(s.T(), err)
+}
+
 func (s *amSuite) TestLedgerArtifactManager_RegisterRequest_JetMiss() {
 	mc := minimock.NewController(s.T())
 	defer mc.Finish()
@@ -30,6 +30,7 @@ import (
 // CodeDescriptor represents meta info required to fetch all code data.
 type CodeDescriptor struct {
 	code        []byte
+	abi         []byte
 	machineType core.MachineType
 	ref         core.RecordRef
 
@@ -51,6 +52,11 @@ func (d *CodeDescriptor) Code() ([]byte, error) {
 	return d.code, nil
 }
 
+// ABI returns code ABI.
+func (d *CodeDescriptor) ABI() ([]byte, error) {
+	return d.abi, nil
+}
+
 // ObjectDescriptor represents meta info required to fetch all object data.
 type ObjectDescriptor struct {
 	ctx context.Context
@@ -240,3 +246,231 @@ func (i *ChildIterator) fetch() error {
 func (i *ChildIterator) hasInBuffer() bool {
 	return i.buffIndex < len(i.buff)
 }
+
+// ObjectHistoryIterator is used to iterate over object states. States are fetched from the latest to the earliest in
+// chunks. Fetching follows the same redirect rules as ChildIterator.
+type ObjectHistoryIterator struct {
+	ctx         context.Context
+	senderChain Sender
+	head        core.RecordRef
+	chunkSize   int
+	fromPulse   *core.PulseNumber
+	toPulse     *core.PulseNumber
+	fromState   *core.RecordID
+	buff        []core.ObjectStateDescriptor
+	buffIndex   int
+	canFetch    bool
+}
+
+// NewObjectHistoryIterator creates new object history iterator.
+func NewObjectHistoryIterator(
+	ctx context.Context,
+	senderChain Sender,
+	head core.RecordRef,
+	fromPulse *core.PulseNumber,
+	toPulse *core.PulseNumber,
+	chunkSize int,
+) (*ObjectHistoryIterator, error) {
+	iter := ObjectHistoryIterator{
+		ctx:         ctx,
+		senderChain: senderChain,
+		head:        head,
+		fromPulse:   fromPulse,
+		toPulse:     toPulse,
+		chunkSize:   chunkSize,
+		canFetch:    true,
+	}
+	err := iter.fetch()
+	if err != nil {
+		return nil, err
+	}
+	return &iter, nil
+}
+
+// HasNext checks if any elements left in iterator.
+func (i *ObjectHistoryIterator) HasNext() bool {
+	return i.hasInBuffer() || i.canFetch
+}
+
+// Next returns next element.
+func (i *ObjectHistoryIterator) Next() (*core.ObjectStateDescriptor, error) {
+	// Get element from buffer.
+	if !i.hasInBuffer() && i.canFetch {
+		err := i.fetch()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	state := i.nextFromBuffer()
+	if state == nil {
+		return nil, errors.New("failed to retrieve a state from buffer")
+	}
+
+	return state, nil
+}
+
+func (i *ObjectHistoryIterator) nextFromBuffer() *core.ObjectStateDescriptor {
+	if !i.hasInBuffer() {
+		return nil
+	}
+	state := i.buff[i.buffIndex]
+	i.buffIndex++
+	return &state
+}
+
+func (i *ObjectHistoryIterator) fetch() error {
+	if !i.canFetch {
+		return errors.New("failed to fetch a history chunk")
+	}
+
+	genericReply, err := i.senderChain(i.ctx, &message.GetObjectHistory{
+		Head:      i.head,
+		FromState: i.fromState,
+		FromPulse: i.fromPulse,
+		ToPulse:   i.toPulse,
+		Amount:    i.chunkSize,
+	}, nil)
+	if err != nil {
+		return err
+	}
+	rep, ok := genericReply.(*reply.ObjectHistory)
+	if !ok {
+		return fmt.Errorf("unexpected reply: %#v", genericReply)
+	}
+
+	if rep.NextFrom == nil {
+		i.canFetch = false
+	}
+	i.buff = rep.States
+	i.buffIndex = 0
+	i.fromState = rep.NextFrom
+
+	// A chunk can be empty when all its states are filtered out by the pulse range. Keep fetching until we get
+	// some states or reach the end of the history.
+	if len(i.buff) == 0 && i.canFetch {
+		return i.fetch()
+	}
+
+	return nil
+}
+
+func (i *ObjectHistoryIterator) hasInBuffer() bool {
+	return i.buffIndex < len(i.buff)
+}
+
+// EventIterator is used to iterate over object events. Events are fetched from the latest to the earliest in chunks.
+// Fetching follows the same redirect rules as ObjectHistoryIterator.
+type EventIterator struct {
+	ctx         context.Context
+	senderChain Sender
+	object      core.RecordRef
+	topic       string
+	chunkSize   int
+	fromPulse   *core.PulseNumber
+	toPulse     *core.PulseNumber
+	fromEvent   *core.RecordID
+	buff        []core.ContractEvent
+	buffIndex   int
+	canFetch    bool
+}
+
+// NewEventIterator creates new object events iterator.
+func NewEventIterator(
+	ctx context.Context,
+	senderChain Sender,
+	object core.RecordRef,
+	topic string,
+	fromPulse *core.PulseNumber,
+	toPulse *core.PulseNumber,
+	chunkSize int,
+) (*EventIterator, error) {
+	iter := EventIterator{
+		ctx:         ctx,
+		senderChain: senderChain,
+		object:      object,
+		topic:       topic,
+		fromPulse:   fromPulse,
+		toPulse:     toPulse,
+		chunkSize:   chunkSize,
+		canFetch:    true,
+	}
+	err := iter.fetch()
+	if err != nil {
+		return nil, err
+	}
+	return &iter, nil
+}
+
+// HasNext checks if any elements left in iterator.
+func (i *EventIterator) HasNext() bool {
+	return i.hasInBuffer() || i.canFetch
+}
+
+// Next returns next element.
+func (i *EventIterator) Next() (*core.ContractEvent, error) {
+	// Get element from buffer.
+	if !i.hasInBuffer() && i.canFetch {
+		err := i.fetch()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	event := i.nextFromBuffer()
+	if event == nil {
+		return nil, errors.New("failed to retrieve an event from buffer")
+	}
+
+	return event, nil
+}
+
+func (i *EventIterator) nextFromBuffer() *core.ContractEvent {
+	if !i.hasInBuffer() {
+		return nil
+	}
+	event := i.buff[i.buffIndex]
+	i.buffIndex++
+	return &event
+}
+
+func (i *EventIterator) fetch() error {
+	if !i.canFetch {
+		return errors.New("failed to fetch an events chunk")
+	}
+
+	genericReply, err := i.senderChain(i.ctx, &message.GetEvents{
+		Object:    i.object,
+		Topic:     i.topic,
+		FromEvent: i.fromEvent,
+		FromPulse: i.fromPulse,
+		ToPulse:   i.toPulse,
+		Amount:    i.chunkSize,
+	}, nil)
+	if err != nil {
+		return err
+	}
+	rep, ok := genericReply.(*reply.Events)
+	if !ok {
+		return fmt.Errorf("unexpected reply: %#v", genericReply)
+	}
+
+	if rep.NextFrom == nil {
+		i.canFetch = false
+	}
+	i.buff = rep.Events
+	i.buffIndex = 0
+	i.fromEvent = rep.NextFrom
+
+	// A chunk can be empty when all its events are filtered out by the pulse range. Keep fetching until we get
+	// some events or reach the end of the list.
+	if len(i.buff) == 0 && i.canFetch {
+		return i.fetch()
+	}
+
+	return nil
+}
+
+func (i *EventIterator) hasInBuffer() bool {
+	return i.buffIndex < len(i.buff)
+}
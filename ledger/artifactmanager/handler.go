@@ -59,6 +59,7 @@ type MessageHandler struct {
 	Nodes                      node.Accessor                   `inject:""`
 	PulseTracker               storage.PulseTracker            `inject:""`
 	DBContext                  storage.DBContext               `inject:""`
+	SecondaryIndex             storage.SecondaryIndex          `inject:""`
 	HotDataWaiter              HotDataWaiter                   `inject:""`
 
 	certificate    core.Certificate
@@ -125,6 +126,10 @@ func (h *MessageHandler) Init(ctx context.Context) error {
 
 	if h.isHeavy {
 		h.setHandlersForHeavy(m)
+		err := h.SecondaryIndex.Reindex(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to rebuild secondary indexes")
+		}
 	}
 
 	return nil
@@ -155,6 +160,33 @@ func (h *MessageHandler) setHandlersForLight(m *middleware) {
 			m.checkJet,
 			m.waitForHotData))
 
+	h.Bus.MustRegister(core.TypeGetObjectHistory,
+		BuildMiddleware(h.handleGetObjectHistory,
+			instrumentHandler("handleGetObjectHistory"),
+			m.addFieldsToLogger,
+			m.checkJet,
+			m.waitForHotData))
+
+	h.Bus.MustRegister(core.TypeGetEvents,
+		BuildMiddleware(h.handleGetEvents,
+			instrumentHandler("handleGetEvents"),
+			m.addFieldsToLogger,
+			m.checkJet,
+			m.waitForHotData))
+
+	h.Bus.MustRegister(core.TypeGetReceipt,
+		BuildMiddleware(h.handleGetReceipt,
+			instrumentHandler("handleGetReceipt"),
+			m.addFieldsToLogger,
+			m.checkJet,
+			m.waitForHotData))
+
+	h.Bus.MustRegister(core.TypeGetRecordProof,
+		BuildMiddleware(h.handleGetRecordProof,
+			instrumentHandler("handleGetRecordProof"),
+			m.addFieldsToLogger,
+			m.checkJet))
+
 	h.Bus.MustRegister(core.TypeSetRecord,
 		BuildMiddleware(h.handleSetRecord,
 			instrumentHandler("handleSetRecord"),
@@ -176,6 +208,13 @@ func (h *MessageHandler) setHandlersForLight(m *middleware) {
 			m.checkJet,
 			m.waitForHotData))
 
+	h.Bus.MustRegister(core.TypeRegisterEvent,
+		BuildMiddleware(h.handleRegisterEvent,
+			instrumentHandler("handleRegisterEvent"),
+			m.addFieldsToLogger,
+			m.checkJet,
+			m.waitForHotData))
+
 	h.Bus.MustRegister(core.TypeSetBlob,
 		BuildMiddleware(h.handleSetBlob,
 			instrumentHandler("handleSetBlob"),
@@ -246,9 +285,14 @@ func (h *MessageHandler) setReplayHandlers(m *middleware) {
 	h.replayHandlers[core.TypeGetObject] = BuildMiddleware(h.handleGetObject, m.addFieldsToLogger, m.checkJet)
 	h.replayHandlers[core.TypeGetDelegate] = BuildMiddleware(h.handleGetDelegate, m.addFieldsToLogger, m.checkJet)
 	h.replayHandlers[core.TypeGetChildren] = BuildMiddleware(h.handleGetChildren, m.addFieldsToLogger, m.checkJet)
+	h.replayHandlers[core.TypeGetObjectHistory] = BuildMiddleware(h.handleGetObjectHistory, m.addFieldsToLogger, m.checkJet)
+	h.replayHandlers[core.TypeGetEvents] = BuildMiddleware(h.handleGetEvents, m.addFieldsToLogger, m.checkJet)
+	h.replayHandlers[core.TypeGetReceipt] = BuildMiddleware(h.handleGetReceipt, m.addFieldsToLogger, m.checkJet)
+	h.replayHandlers[core.TypeGetRecordProof] = BuildMiddleware(h.handleGetRecordProof, m.addFieldsToLogger, m.checkJet)
 	h.replayHandlers[core.TypeSetRecord] = BuildMiddleware(h.handleSetRecord, m.addFieldsToLogger, m.checkJet)
 	h.replayHandlers[core.TypeUpdateObject] = BuildMiddleware(h.handleUpdateObject, m.addFieldsToLogger, m.checkJet)
 	h.replayHandlers[core.TypeRegisterChild] = BuildMiddleware(h.handleRegisterChild, m.addFieldsToLogger, m.checkJet)
+	h.replayHandlers[core.TypeRegisterEvent] = BuildMiddleware(h.handleRegisterEvent, m.addFieldsToLogger, m.checkJet)
 	h.replayHandlers[core.TypeSetBlob] = BuildMiddleware(h.handleSetBlob, m.addFieldsToLogger, m.checkJet)
 	h.replayHandlers[core.TypeGetObjectIndex] = BuildMiddleware(h.handleGetObjectIndex, m.addFieldsToLogger, m.checkJet)
 	h.replayHandlers[core.TypeGetPendingRequests] = BuildMiddleware(h.handleHasPendingRequests, m.addFieldsToLogger, m.checkJet)
@@ -287,6 +331,30 @@ func (h *MessageHandler) setHandlersForHeavy(m *middleware) {
 			instrumentHandler("handleGetChildren"),
 			m.zeroJetForHeavy))
 
+	h.Bus.MustRegister(core.TypeGetObjectHistory,
+		BuildMiddleware(h.handleGetObjectHistory,
+			instrumentHandler("handleGetObjectHistory"),
+			m.zeroJetForHeavy))
+
+	h.Bus.MustRegister(core.TypeGetEvents,
+		BuildMiddleware(h.handleGetEvents,
+			instrumentHandler("handleGetEvents"),
+			m.zeroJetForHeavy))
+
+	h.Bus.MustRegister(core.TypeGetReceipt,
+		BuildMiddleware(h.handleGetReceipt,
+			instrumentHandler("handleGetReceipt"),
+			m.zeroJetForHeavy))
+
+	h.Bus.MustRegister(core.TypeGetRecordProof,
+		BuildMiddleware(h.handleGetRecordProof,
+			instrumentHandler("handleGetRecordProof"),
+			m.zeroJetForHeavy))
+
+	h.Bus.MustRegister(core.TypeFindIndexedRecords,
+		BuildMiddleware(h.handleFindIndexedRecords,
+			instrumentHandler("handleFindIndexedRecords")))
+
 	h.Bus.MustRegister(core.TypeGetObjectIndex,
 		BuildMiddleware(h.handleGetObjectIndex,
 			instrumentHandler("handleGetObjectIndex"),
@@ -324,6 +392,13 @@ func (h *MessageHandler) handleSetRecord(ctx context.Context, parcel core.Parcel
 		return nil, err
 	}
 
+	if r, ok := rec.(*record.ResultRecord); ok {
+		err = h.ObjectStorage.SetRequestResult(ctx, jetID, r.Request.Record(), id)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to save request result")
+		}
+	}
+
 	return &reply.ID{ID: *id}, nil
 }
 
@@ -374,10 +449,18 @@ func (h *MessageHandler) handleGetCode(ctx context.Context, parcel core.Parcel)
 	if err != nil {
 		return nil, err
 	}
+	var abi []byte
+	if codeRec.ABI != nil {
+		abi, err = h.ObjectStorage.GetBlob(ctx, jetID, codeRec.ABI)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	rep := reply.Code{
 		Code:        code,
 		MachineType: codeRec.MachineType,
+		ABI:         abi,
 	}
 
 	return &rep, nil
@@ -736,11 +819,420 @@ func (h *MessageHandler) handleGetChildren(
 	return &reply.Children{Refs: refs, NextFrom: nil}, nil
 }
 
+func (h *MessageHandler) handleGetObjectHistory(
+	ctx context.Context, parcel core.Parcel,
+) (core.Reply, error) {
+	msg := parcel.Message().(*message.GetObjectHistory)
+	jetID := jetFromContext(ctx)
+
+	if !h.isHeavy {
+		h.RecentStorageProvider.GetIndexStorage(ctx, jetID).AddObject(ctx, *msg.Head.Record())
+	}
+
+	idx, err := h.ObjectStorage.GetObjectIndex(ctx, jetID, msg.Head.Record(), false)
+	if err == core.ErrNotFound {
+		if h.isHeavy {
+			return nil, fmt.Errorf("failed to fetch index for %v", msg.Head.Record())
+		}
+
+		heavy, err := h.JetCoordinator.Heavy(ctx, parcel.Pulse())
+		if err != nil {
+			return nil, err
+		}
+		idx, err = h.saveIndexFromHeavy(ctx, jetID, msg.Head, heavy)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to fetch index from heavy")
+		}
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch object index")
+	}
+
+	var (
+		states       []core.ObjectStateDescriptor
+		currentState *core.RecordID
+	)
+
+	// Counting from specified state or the latest.
+	if msg.FromState != nil {
+		currentState = msg.FromState
+	} else {
+		currentState = idx.LatestState
+	}
+
+	// The object has no states.
+	if currentState == nil {
+		return &reply.ObjectHistory{States: nil, NextFrom: nil}, nil
+	}
+
+	var stateJet *core.RecordID
+	if h.isHeavy {
+		stateJet = &jetID
+	} else {
+		var actual bool
+		onHeavy, err := h.JetCoordinator.IsBeyondLimit(ctx, parcel.Pulse(), currentState.Pulse())
+		if err != nil {
+			return nil, err
+		}
+		if onHeavy {
+			node, err := h.JetCoordinator.Heavy(ctx, parcel.Pulse())
+			if err != nil {
+				return nil, err
+			}
+			return reply.NewGetObjectHistoryRedirect(h.DelegationTokenFactory, parcel, node, *currentState)
+		}
+
+		stateJet, actual = h.JetStorage.FindJet(ctx, currentState.Pulse(), *msg.Head.Record())
+		if !actual {
+			actualJet, err := h.jetTreeUpdater.fetchJet(ctx, *msg.Head.Record(), currentState.Pulse())
+			if err != nil {
+				return nil, err
+			}
+			stateJet = actualJet
+		}
+	}
+
+	// Try to fetch the first state.
+	_, err = h.ObjectStorage.GetRecord(ctx, *stateJet, currentState)
+	if err == core.ErrNotFound {
+		if h.isHeavy {
+			return nil, fmt.Errorf("failed to fetch state for %v. jet: %v, state: %v", msg.Head.Record(), stateJet.DebugString(), currentState.DebugString())
+		}
+		node, err := h.JetCoordinator.NodeForJet(ctx, *stateJet, parcel.Pulse(), currentState.Pulse())
+		if err != nil {
+			return nil, err
+		}
+		return reply.NewGetObjectHistoryRedirect(h.DelegationTokenFactory, parcel, node, *currentState)
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch state")
+	}
+
+	counter := 0
+	for currentState != nil {
+		// States are ordered by time, so all the rest are earlier than specified pulse.
+		if msg.FromPulse != nil && currentState.Pulse() < *msg.FromPulse {
+			break
+		}
+		// We have enough results.
+		if counter >= msg.Amount {
+			return &reply.ObjectHistory{States: states, NextFrom: currentState}, nil
+		}
+		counter++
+
+		rec, err := h.ObjectStorage.GetRecord(ctx, *stateJet, currentState)
+		// We don't have this state. Return what was collected.
+		if err == core.ErrNotFound {
+			return &reply.ObjectHistory{States: states, NextFrom: currentState}, nil
+		}
+		if err != nil {
+			return nil, errors.New("failed to retrieve states")
+		}
+
+		state, ok := rec.(record.ObjectState)
+		if !ok {
+			return nil, errors.New("failed to retrieve states")
+		}
+		stateID := *currentState
+		currentState = state.PrevStateID()
+
+		// Skip states later than specified pulse.
+		if msg.ToPulse != nil && stateID.Pulse() > *msg.ToPulse {
+			continue
+		}
+		states = append(states, objectStateDescriptor(stateID, state))
+	}
+
+	return &reply.ObjectHistory{States: states, NextFrom: nil}, nil
+}
+
+func (h *MessageHandler) handleGetEvents(
+	ctx context.Context, parcel core.Parcel,
+) (core.Reply, error) {
+	msg := parcel.Message().(*message.GetEvents)
+	jetID := jetFromContext(ctx)
+
+	if !h.isHeavy {
+		h.RecentStorageProvider.GetIndexStorage(ctx, jetID).AddObject(ctx, *msg.Object.Record())
+	}
+
+	idx, err := h.ObjectStorage.GetObjectIndex(ctx, jetID, msg.Object.Record(), false)
+	if err == core.ErrNotFound {
+		if h.isHeavy {
+			return nil, fmt.Errorf("failed to fetch index for %v", msg.Object.Record())
+		}
+
+		heavy, err := h.JetCoordinator.Heavy(ctx, parcel.Pulse())
+		if err != nil {
+			return nil, err
+		}
+		idx, err = h.saveIndexFromHeavy(ctx, jetID, msg.Object, heavy)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to fetch index from heavy")
+		}
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch object index")
+	}
+
+	var (
+		events       []core.ContractEvent
+		currentEvent *core.RecordID
+	)
+
+	// Counting from specified event or the latest.
+	if msg.FromEvent != nil {
+		currentEvent = msg.FromEvent
+	} else if msg.Topic != "" {
+		currentEvent = idx.TopicEvents[msg.Topic]
+	} else {
+		currentEvent = idx.LatestEvent
+	}
+
+	// The object has no events.
+	if currentEvent == nil {
+		return &reply.Events{Events: nil, NextFrom: nil}, nil
+	}
+
+	var eventJet *core.RecordID
+	if h.isHeavy {
+		eventJet = &jetID
+	} else {
+		var actual bool
+		onHeavy, err := h.JetCoordinator.IsBeyondLimit(ctx, parcel.Pulse(), currentEvent.Pulse())
+		if err != nil {
+			return nil, err
+		}
+		if onHeavy {
+			node, err := h.JetCoordinator.Heavy(ctx, parcel.Pulse())
+			if err != nil {
+				return nil, err
+			}
+			return reply.NewGetEventsRedirect(h.DelegationTokenFactory, parcel, node, *currentEvent)
+		}
+
+		eventJet, actual = h.JetStorage.FindJet(ctx, currentEvent.Pulse(), *msg.Object.Record())
+		if !actual {
+			actualJet, err := h.jetTreeUpdater.fetchJet(ctx, *msg.Object.Record(), currentEvent.Pulse())
+			if err != nil {
+				return nil, err
+			}
+			eventJet = actualJet
+		}
+	}
+
+	// Try to fetch the first event.
+	_, err = h.ObjectStorage.GetRecord(ctx, *eventJet, currentEvent)
+	if err == core.ErrNotFound {
+		if h.isHeavy {
+			return nil, fmt.Errorf("failed to fetch event for %v. jet: %v, event: %v", msg.Object.Record(), eventJet.DebugString(), currentEvent.DebugString())
+		}
+		node, err := h.JetCoordinator.NodeForJet(ctx, *eventJet, parcel.Pulse(), currentEvent.Pulse())
+		if err != nil {
+			return nil, err
+		}
+		return reply.NewGetEventsRedirect(h.DelegationTokenFactory, parcel, node, *currentEvent)
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch event")
+	}
+
+	counter := 0
+	for currentEvent != nil {
+		// Events are ordered by time, so all the rest are earlier than specified pulse.
+		if msg.FromPulse != nil && currentEvent.Pulse() < *msg.FromPulse {
+			break
+		}
+		// We have enough results.
+		if counter >= msg.Amount {
+			return &reply.Events{Events: events, NextFrom: currentEvent}, nil
+		}
+		counter++
+
+		rec, err := h.ObjectStorage.GetRecord(ctx, *eventJet, currentEvent)
+		// We don't have this event. Return what was collected.
+		if err == core.ErrNotFound {
+			return &reply.Events{Events: events, NextFrom: currentEvent}, nil
+		}
+		if err != nil {
+			return nil, errors.New("failed to retrieve events")
+		}
+
+		event, ok := rec.(*record.EventRecord)
+		if !ok {
+			return nil, errors.New("failed to retrieve events")
+		}
+		eventID := *currentEvent
+		if msg.Topic != "" {
+			currentEvent = event.PrevTopicEvent
+		} else {
+			currentEvent = event.PrevEvent
+		}
+
+		// Skip events later than specified pulse.
+		if msg.ToPulse != nil && eventID.Pulse() > *msg.ToPulse {
+			continue
+		}
+		events = append(events, contractEvent(msg.Object, eventID, event))
+	}
+
+	return &reply.Events{Events: events, NextFrom: nil}, nil
+}
+
+func (h *MessageHandler) handleGetReceipt(ctx context.Context, parcel core.Parcel) (core.Reply, error) {
+	msg := parcel.Message().(*message.GetReceipt)
+	jetID := jetFromContext(ctx)
+	objectID := msg.Object.Record()
+	requestID := msg.Request.Record()
+
+	// Requests and results are stored in the jet the object had on the pulse of their registration.
+	var requestJet *core.RecordID
+	if h.isHeavy {
+		requestJet = &jetID
+	} else {
+		var actual bool
+		onHeavy, err := h.JetCoordinator.IsBeyondLimit(ctx, parcel.Pulse(), requestID.Pulse())
+		if err != nil {
+			return nil, err
+		}
+		if onHeavy {
+			node, err := h.JetCoordinator.Heavy(ctx, parcel.Pulse())
+			if err != nil {
+				return nil, err
+			}
+			return reply.NewGetReceiptRedirect(h.DelegationTokenFactory, parcel, node)
+		}
+
+		requestJet, actual = h.JetStorage.FindJet(ctx, requestID.Pulse(), *objectID)
+		if !actual {
+			actualJet, err := h.jetTreeUpdater.fetchJet(ctx, *objectID, requestID.Pulse())
+			if err != nil {
+				return nil, err
+			}
+			requestJet = actualJet
+		}
+	}
+
+	rec, err := h.ObjectStorage.GetRecord(ctx, *requestJet, requestID)
+	if err == core.ErrNotFound {
+		if h.isHeavy {
+			return nil, fmt.Errorf("failed to fetch request %v. jet: %v", requestID.DebugString(), requestJet.DebugString())
+		}
+		node, err := h.JetCoordinator.NodeForJet(ctx, *requestJet, parcel.Pulse(), requestID.Pulse())
+		if err != nil {
+			return nil, err
+		}
+		return reply.NewGetReceiptRedirect(h.DelegationTokenFactory, parcel, node)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch request")
+	}
+	request, ok := rec.(record.Request)
+	if !ok {
+		return nil, errors.New("failed to decode request")
+	}
+	if request.GetObject() != *objectID {
+		return nil, errors.New("request is not made to the object")
+	}
+
+	// The result is usually registered on the request pulse. Requests finished after pulse change are registered by
+	// the current executor of the object.
+	resultID, err := h.ObjectStorage.GetRequestResult(ctx, *requestJet, requestID)
+	if err == core.ErrNotFound && *requestJet != jetID {
+		resultID, err = h.ObjectStorage.GetRequestResult(ctx, jetID, requestID)
+	}
+	// The request is not executed yet.
+	if err == core.ErrNotFound {
+		return &reply.Receipt{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch request result")
+	}
+
+	resultJet := &jetID
+	if !h.isHeavy {
+		resultJet, _ = h.JetStorage.FindJet(ctx, resultID.Pulse(), *objectID)
+	}
+	rec, err = h.ObjectStorage.GetRecord(ctx, *resultJet, resultID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch result")
+	}
+	result, ok := rec.(*record.ResultRecord)
+	if !ok {
+		return nil, errors.New("failed to decode result")
+	}
+
+	return &reply.Receipt{Result: resultID, Payload: result.Payload}, nil
+}
+
+func (h *MessageHandler) handleGetRecordProof(ctx context.Context, parcel core.Parcel) (core.Reply, error) {
+	msg := parcel.Message().(*message.GetRecordProof)
+
+	if !h.isHeavy {
+		onHeavy, err := h.JetCoordinator.IsBeyondLimit(ctx, parcel.Pulse(), msg.Pulse)
+		if err != nil {
+			return nil, err
+		}
+		if onHeavy {
+			node, err := h.JetCoordinator.Heavy(ctx, parcel.Pulse())
+			if err != nil {
+				return nil, err
+			}
+			return reply.NewGetRecordProofRedirect(h.DelegationTokenFactory, parcel, node)
+		}
+	}
+
+	_, err := h.DropStorage.GetDrop(ctx, msg.Jet, msg.Pulse)
+	if err == core.ErrNotFound {
+		if h.isHeavy {
+			return nil, fmt.Errorf("failed to fetch drop %v. jet: %v", msg.Pulse, msg.Jet.DebugString())
+		}
+		node, err := h.JetCoordinator.NodeForJet(ctx, msg.Jet, parcel.Pulse(), msg.Pulse)
+		if err != nil {
+			return nil, err
+		}
+		if *node == h.JetCoordinator.Me() {
+			return nil, fmt.Errorf("drop %v is not created yet. jet: %v", msg.Pulse, msg.Jet.DebugString())
+		}
+		return reply.NewGetRecordProofRedirect(h.DelegationTokenFactory, parcel, node)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch drop")
+	}
+
+	proof, err := h.DropStorage.GetRecordProof(ctx, msg.Jet, msg.Pulse, msg.Record)
+	if err == core.ErrNotFound {
+		return nil, fmt.Errorf("record %v is not found in the drop", msg.Record.DebugString())
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate record proof")
+	}
+
+	return &reply.RecordProof{Proof: *proof}, nil
+}
+
+func (h *MessageHandler) handleFindIndexedRecords(ctx context.Context, parcel core.Parcel) (core.Reply, error) {
+	msg := parcel.Message().(*message.FindIndexedRecords)
+
+	records, err := h.SecondaryIndex.Find(ctx, msg.Index, msg.Key, msg.From, msg.Limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find indexed records")
+	}
+
+	return &reply.IndexedRecords{Records: *records}, nil
+}
+
 func (h *MessageHandler) handleGetRequest(ctx context.Context, parcel core.Parcel) (core.Reply, error) {
 	jetID := jetFromContext(ctx)
 	msg := parcel.Message().(*message.GetRequest)
 
-	rec, err := h.ObjectStorage.GetRecord(ctx, jetID, &msg.Request)
+	// The request is stored in the jet the object had on the request pulse.
+	requestJet := &jetID
+	if !h.isHeavy {
+		requestJet, _ = h.JetStorage.FindJet(ctx, msg.Request.Pulse(), msg.Object)
+	}
+
+	rec, err := h.ObjectStorage.GetRecord(ctx, *requestJet, &msg.Request)
 	if err != nil {
 		return nil, errors.New("failed to fetch request")
 	}
@@ -950,6 +1442,65 @@ func (h *MessageHandler) handleRegisterChild(ctx context.Context, parcel core.Pa
 	return &reply.ID{ID: *child}, nil
 }
 
+func (h *MessageHandler) handleRegisterEvent(ctx context.Context, parcel core.Parcel) (core.Reply, error) {
+	if h.isHeavy {
+		return nil, errors.New("heavy updates are forbidden")
+	}
+
+	msg := parcel.Message().(*message.RegisterEvent)
+	jetID := jetFromContext(ctx)
+	rec := record.DeserializeRecord(msg.Record)
+	eventRec, ok := rec.(*record.EventRecord)
+	if !ok {
+		return nil, errors.New("wrong event record")
+	}
+	if !eventRec.Object.Equal(msg.Object.Record()) {
+		return nil, errors.New("event record does not belong to the object")
+	}
+
+	h.RecentStorageProvider.GetIndexStorage(ctx, jetID).AddObject(ctx, *msg.Object.Record())
+
+	var event *core.RecordID
+	err := h.DBContext.Update(ctx, func(tx *storage.TransactionManager) error {
+		idx, err := h.ObjectStorage.GetObjectIndex(ctx, jetID, msg.Object.Record(), false)
+		if err == core.ErrNotFound {
+			heavy, err := h.JetCoordinator.Heavy(ctx, parcel.Pulse())
+			if err != nil {
+				return err
+			}
+			idx, err = h.saveIndexFromHeavy(ctx, jetID, msg.Object, heavy)
+			if err != nil {
+				return errors.Wrap(err, "failed to fetch index from heavy")
+			}
+		} else if err != nil {
+			return err
+		}
+
+		// Linking the event into object and topic chains.
+		eventRec.PrevEvent = idx.LatestEvent
+		eventRec.PrevTopicEvent = idx.TopicEvents[eventRec.Topic]
+
+		event, err = tx.SetRecord(ctx, jetID, parcel.Pulse(), eventRec)
+		if err != nil {
+			return err
+		}
+
+		if idx.TopicEvents == nil {
+			idx.TopicEvents = map[string]*core.RecordID{}
+		}
+		idx.LatestEvent = event
+		idx.TopicEvents[eventRec.Topic] = event
+		idx.LatestUpdate = parcel.Pulse()
+		return tx.SetObjectIndex(ctx, jetID, msg.Object.Record(), idx)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &reply.ID{ID: *event}, nil
+}
+
 func (h *MessageHandler) handleJetDrop(ctx context.Context, parcel core.Parcel) (core.Reply, error) {
 	msg := parcel.Message().(*message.JetDrop)
 
@@ -1116,6 +1667,40 @@ func validateState(old record.State, new record.State) error {
 	return nil
 }
 
+func objectStateDescriptor(id core.RecordID, state record.ObjectState) core.ObjectStateDescriptor {
+	desc := core.ObjectStateDescriptor{
+		StateID:     id,
+		PrevStateID: state.PrevStateID(),
+		Pulse:       id.Pulse(),
+		Image:       state.GetImage(),
+		IsPrototype: state.GetIsPrototype(),
+		Deactivated: state.State() == record.StateDeactivation,
+	}
+	if memory := state.GetMemory(); memory != nil {
+		desc.MemoryHash = memory.Hash()
+	}
+	switch r := state.(type) {
+	case *record.ObjectActivateRecord:
+		desc.Request = r.Request
+	case *record.ObjectAmendRecord:
+		desc.Request = r.Request
+	case *record.DeactivationRecord:
+		desc.Request = r.Request
+	}
+	return desc
+}
+
+func contractEvent(object core.RecordRef, id core.RecordID, event *record.EventRecord) core.ContractEvent {
+	return core.ContractEvent{
+		ID:      id,
+		Object:  object,
+		Request: event.Request,
+		Topic:   event.Topic,
+		Payload: event.Payload,
+		Pulse:   id.Pulse(),
+	}
+}
+
 func (h *MessageHandler) saveIndexFromHeavy(
 	ctx context.Context, jetID core.RecordID, obj core.RecordRef, heavy *core.RecordRef,
 ) (*index.ObjectLifeline, error) {
@@ -1253,9 +1838,14 @@ func (h *MessageHandler) handleHotRecords(ctx context.Context, parcel core.Parce
 		indexStorage.AddObjectWithTLL(ctx, id, meta.TTL)
 	}
 
-	h.JetStorage.UpdateJetTree(
-		ctx, msg.PulseNumber, true, jetID,
-	)
+	if msg.Merged {
+		// Children of the merged jet are not valid anymore.
+		h.JetStorage.PruneJetTree(ctx, msg.PulseNumber, jetID)
+	} else {
+		h.JetStorage.UpdateJetTree(
+			ctx, msg.PulseNumber, true, jetID,
+		)
+	}
 
 	h.jetTreeUpdater.releaseJet(ctx, jetID, msg.PulseNumber)
 
@@ -39,6 +39,7 @@ import (
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
 	"github.com/insolar/insolar/testutils/network"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -1082,6 +1083,75 @@ func (s *handlerSuite) TestMessageHandler_HandleHotRecords() {
 	pendingMock.MinimockFinish()
 }
 
+func (s *handlerSuite) TestMessageHandler_HandleHotRecords_Merged() {
+	mc := minimock.NewController(s.T())
+	defer mc.Finish()
+
+	pn := core.PulseNumber(core.FirstPulseNumber + 1)
+	root := *jet.NewID(0, nil)
+	left := *jet.NewID(1, nil)
+	right := *jet.NewID(1, []byte{0x80}) // 10000000
+	objectID := core.NewRecordID(pn, []byte{0xFF})
+	s.jetStorage.UpdateJetTree(s.ctx, pn, false, left, right)
+
+	provideMock := recentstorage.NewProviderMock(mc)
+	provideMock.GetPendingStorageMock.Return(recentstorage.NewPendingStorageMock(mc))
+	provideMock.GetIndexStorageMock.Return(recentstorage.NewRecentIndexStorageMock(mc))
+
+	certificate := testutils.NewCertificateMock(s.T())
+	certificate.GetRoleMock.Return(core.StaticRoleLightMaterial)
+
+	h := NewMessageHandler(&configuration.Ledger{}, certificate)
+	h.JetCoordinator = testutils.NewJetCoordinatorMock(mc)
+	h.RecentStorageProvider = provideMock
+	mb := testutils.NewMessageBusMock(mc)
+	mb.MustRegisterMock.Return()
+	h.Bus = mb
+	h.JetStorage = s.jetStorage
+	h.Nodes = s.nodeStorage
+	h.DBContext = s.db
+	h.PulseTracker = s.pulseTracker
+	h.ObjectStorage = s.objectStorage
+	h.DropStorage = s.dropStorage
+
+	err := h.Init(s.ctx)
+	require.NoError(s.T(), err)
+
+	res, err := h.handleHotRecords(s.ctx, &message.Parcel{Msg: &message.HotData{
+		Jet:         *core.NewRecordRef(core.DomainID, root),
+		DropJet:     root,
+		Drop:        jet.JetDrop{Pulse: pn - 1, Hash: []byte{1}},
+		PulseNumber: pn,
+		Merged:      true,
+	}})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), &reply.OK{}, res)
+
+	jetID, actual := s.jetStorage.FindJet(s.ctx, pn, *objectID)
+	assert.Equal(s.T(), root, *jetID)
+	assert.True(s.T(), actual)
+}
+
+func (s *handlerSuite) TestMessageHandler_Init_ReindexesOnHeavy() {
+	mc := minimock.NewController(s.T())
+	defer mc.Finish()
+
+	certificate := testutils.NewCertificateMock(s.T())
+	certificate.GetRoleMock.Return(core.StaticRoleHeavyMaterial)
+
+	h := NewMessageHandler(&configuration.Ledger{}, certificate)
+	mb := testutils.NewMessageBusMock(mc)
+	mb.MustRegisterMock.Return()
+	h.Bus = mb
+	secondaryIndex := storage.NewSecondaryIndexMock(mc)
+	secondaryIndex.ReindexMock.Return(errors.New("reindex failed"))
+	h.SecondaryIndex = secondaryIndex
+
+	err := h.Init(s.ctx)
+	require.Error(s.T(), err)
+	assert.Equal(s.T(), uint64(1), secondaryIndex.ReindexCounter)
+}
+
 func (s *handlerSuite) TestMessageHandler_HandleValidationCheck() {
 	mc := minimock.NewController(s.T())
 	defer mc.Finish()
@@ -1269,12 +1339,14 @@ func (s *handlerSuite) TestMessageHandler_HandleGetRequest() {
 	reqID, err := s.objectStorage.SetRecord(s.ctx, jetID, core.FirstPulseNumber, &req)
 
 	msg := message.GetRequest{
+		Object:  req.Object,
 		Request: *reqID,
 	}
 	certificate := testutils.NewCertificateMock(s.T())
 	certificate.GetRoleMock.Return(core.StaticRoleLightMaterial)
 
 	h := NewMessageHandler(&configuration.Ledger{}, certificate)
+	h.JetStorage = s.jetStorage
 	h.ObjectStorage = s.objectStorage
 
 	rep, err := h.handleGetRequest(contextWithJet(s.ctx, jetID), &message.Parcel{
@@ -1286,3 +1358,73 @@ func (s *handlerSuite) TestMessageHandler_HandleGetRequest() {
 	require.True(s.T(), ok)
 	assert.Equal(s.T(), req, *record.DeserializeRecord(reqReply.Record).(*record.RequestRecord))
 }
+
+func (s *handlerSuite) TestMessageHandler_HandleGetReceipt_SplitJet() {
+	mc := minimock.NewController(s.T())
+	defer mc.Finish()
+
+	requestPulse := core.PulseNumber(core.FirstPulseNumber + 1)
+	rootJet := *jet.NewID(0, nil)
+	rightJet := *jet.NewID(1, []byte{1 << 7})
+	s.jetStorage.UpdateJetTree(s.ctx, requestPulse, true, rightJet)
+
+	objID := *genRandomID(core.FirstPulseNumber)
+	objID[core.PulseNumberSize] = 0xFF
+	reqID, err := s.objectStorage.SetRecord(s.ctx, rightJet, requestPulse, &record.RequestRecord{Object: objID})
+	require.NoError(s.T(), err)
+
+	jc := testutils.NewJetCoordinatorMock(mc)
+	jc.IsBeyondLimitMock.Return(false, nil)
+
+	certificate := testutils.NewCertificateMock(s.T())
+	certificate.GetRoleMock.Return(core.StaticRoleLightMaterial)
+
+	h := NewMessageHandler(&configuration.Ledger{}, certificate)
+	h.JetStorage = s.jetStorage
+	h.ObjectStorage = s.objectStorage
+	h.JetCoordinator = jc
+
+	// The object is in the merged root jet on the current pulse.
+	ctx := contextWithJet(s.ctx, rootJet)
+	parcel := &message.Parcel{
+		Msg: &message.GetReceipt{
+			Object:  *core.NewRecordRef(core.DomainID, objID),
+			Request: *core.NewRecordRef(core.DomainID, *reqID),
+		},
+		PulseNumber: requestPulse + 10,
+	}
+
+	s.T().Run("returns pending receipt", func(t *testing.T) {
+		rep, err := h.handleGetReceipt(ctx, parcel)
+		require.NoError(t, err)
+		assert.Equal(t, &reply.Receipt{}, rep)
+	})
+
+	s.T().Run("returns result from the jet of the request pulse", func(t *testing.T) {
+		resultID, err := s.objectStorage.SetRecord(s.ctx, rightJet, requestPulse, &record.ResultRecord{
+			Object:  objID,
+			Request: *core.NewRecordRef(core.DomainID, *reqID),
+			Payload: []byte{1, 2, 3},
+		})
+		require.NoError(t, err)
+		err = s.objectStorage.SetRequestResult(s.ctx, rightJet, reqID, resultID)
+		require.NoError(t, err)
+
+		rep, err := h.handleGetReceipt(ctx, parcel)
+		require.NoError(t, err)
+		assert.Equal(t, &reply.Receipt{Result: resultID, Payload: []byte{1, 2, 3}}, rep)
+	})
+
+	s.T().Run("rejects request made to another object", func(t *testing.T) {
+		otherID := objID
+		otherID[core.RecordIDSize-1]++
+		_, err := h.handleGetReceipt(ctx, &message.Parcel{
+			Msg: &message.GetReceipt{
+				Object:  *core.NewRecordRef(core.DomainID, otherID),
+				Request: *core.NewRecordRef(core.DomainID, *reqID),
+			},
+			PulseNumber: requestPulse + 10,
+		})
+		require.Error(t, err)
+	})
+}
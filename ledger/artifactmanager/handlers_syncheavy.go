@@ -30,10 +30,11 @@ import (
 func (h *MessageHandler) handleHeavyPayload(ctx context.Context, genericMsg core.Parcel) (core.Reply, error) {
 	msg := genericMsg.Message().(*message.HeavyPayload)
 
-	if err := h.HeavySync.Store(ctx, msg.JetID, msg.PulseNum, msg.Records); err != nil {
+	progress, err := h.HeavySync.Store(ctx, msg.JetID, msg.PulseNum, msg.Chunk, msg.Checksum, msg.Records)
+	if err != nil {
 		return heavyerrreply(err)
 	}
-	return &reply.OK{}, nil
+	return heavyprogressreply(msg.JetID, msg.PulseNum, progress), nil
 }
 
 func (h *MessageHandler) handleHeavyStartStop(ctx context.Context, genericMsg core.Parcel) (core.Reply, error) {
@@ -47,10 +48,20 @@ func (h *MessageHandler) handleHeavyStartStop(ctx context.Context, genericMsg co
 		return &reply.OK{}, nil
 	}
 	// start
-	if err := h.HeavySync.Start(ctx, msg.JetID, msg.PulseNum); err != nil {
+	progress, err := h.HeavySync.Start(ctx, msg.JetID, msg.PulseNum)
+	if err != nil {
 		return heavyerrreply(err)
 	}
-	return &reply.OK{}, nil
+	return heavyprogressreply(msg.JetID, msg.PulseNum, progress), nil
+}
+
+func heavyprogressreply(jetID core.RecordID, pn core.PulseNumber, progress core.HeavySyncProgress) core.Reply {
+	return &reply.HeavySyncProgress{
+		JetID:    jetID,
+		PulseNum: pn,
+		Chunks:   progress.Chunks,
+		Checksum: progress.Checksum,
+	}
 }
 
 func heavyerrreply(err error) (core.Reply, error) {
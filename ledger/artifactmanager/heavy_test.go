@@ -20,7 +20,6 @@ import (
 	"context"
 	"testing"
 
-	"github.com/dgraph-io/badger"
 	"github.com/insolar/insolar/component"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/ledger/recentstorage"
@@ -33,6 +32,7 @@ import (
 
 	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/core/message"
+	"github.com/insolar/insolar/core/reply"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -111,9 +111,11 @@ func (s *heavySuite) TestLedgerArtifactManager_handleHeavy() {
 
 	// prepare mock
 	heavysync := testutils.NewHeavySyncMock(s.T())
-	heavysync.StartMock.Return(nil)
-	heavysync.StoreMock.Set(func(ctx context.Context, jetID core.RecordID, pn core.PulseNumber, kvs []core.KV) error {
-		return s.db.StoreKeyValues(ctx, kvs)
+	heavysync.StartMock.Return(core.HeavySyncProgress{}, nil)
+	heavysync.StoreMock.Set(func(
+		ctx context.Context, jetID core.RecordID, pn core.PulseNumber, chunk uint32, checksum []byte, kvs []core.KV,
+	) (core.HeavySyncProgress, error) {
+		return core.HeavySyncProgress{Chunks: chunk + 1, Checksum: checksum}, s.db.StoreKeyValues(ctx, kvs)
 	})
 	heavysync.StopMock.Return(nil)
 
@@ -147,29 +149,27 @@ func (s *heavySuite) TestLedgerArtifactManager_handleHeavy() {
 
 	parcel := &message.Parcel{
 		Msg: &message.HeavyPayload{
-			JetID:   jetID,
-			Records: payload,
+			JetID:    jetID,
+			Checksum: core.KVChecksum(payload),
+			Records:  payload,
 		},
 	}
 
-	var err error
-	_, err = mh.handleHeavyPayload(s.ctx, parcel)
+	rep, err := mh.handleHeavyPayload(s.ctx, parcel)
 	require.NoError(s.T(), err)
-
-	badgerdb := s.db.GetBadgerDB()
-	err = badgerdb.View(func(tx *badger.Txn) error {
-		for _, kv := range payload {
-			item, err := tx.Get(kv.K)
-			if !assert.NoError(s.T(), err) {
-				continue
-			}
-			value, err := item.Value()
-			if !assert.NoError(s.T(), err) {
-				continue
-			}
-			assert.Equal(s.T(), kv.V, value)
+	require.Equal(s.T(), &reply.HeavySyncProgress{
+		JetID:    jetID,
+		Chunks:   1,
+		Checksum: core.KVChecksum(payload),
+	}, rep)
+
+	tx := s.db.GetKVStore().NewTransaction(false)
+	defer tx.Discard()
+	for _, kv := range payload {
+		value, err := tx.Get(kv.K)
+		if !assert.NoError(s.T(), err) {
+			continue
 		}
-		return nil
-	})
-	require.NoError(s.T(), err)
+		assert.Equal(s.T(), kv.V, value)
+	}
 }
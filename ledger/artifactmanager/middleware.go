@@ -118,8 +118,20 @@ func (m *middleware) checkJet(handler core.MessageHandler) core.MessageHandler {
 					return nil, errors.New("fetching children without child pointer is forbidden")
 				}
 				pulse = tm.FromChild.Pulse()
+			case *message.GetObjectHistory:
+				if tm.FromState == nil {
+					return nil, errors.New("fetching object history without state pointer is forbidden")
+				}
+				pulse = tm.FromState.Pulse()
+			case *message.GetEvents:
+				if tm.FromEvent == nil {
+					return nil, errors.New("fetching events without event pointer is forbidden")
+				}
+				pulse = tm.FromEvent.Pulse()
 			case *message.GetRequest:
 				pulse = tm.Request.Pulse()
+			case *message.GetReceipt:
+				pulse = tm.Request.Record().Pulse()
 			}
 			jetID, actual := m.jetStorage.FindJet(ctx, pulse, target)
 			if !actual {
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"math"
+	"sort"
 	"strconv"
 
 	"github.com/insolar/insolar/configuration"
@@ -27,6 +28,7 @@ import (
 	"github.com/insolar/insolar/core/message"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/jet"
 	"github.com/insolar/insolar/ledger/storage/record"
 	base58 "github.com/jbenet/go-base58"
 	"github.com/pkg/errors"
@@ -35,11 +37,13 @@ import (
 
 // Exporter provides methods for fetching data view from storage.
 type Exporter struct {
-	DB            storage.DBContext     `inject:""`
-	JetStorage    storage.JetStorage    `inject:""`
-	ObjectStorage storage.ObjectStorage `inject:""`
-	PulseTracker  storage.PulseTracker  `inject:""`
-	PulseStorage  core.PulseStorage     `inject:""`
+	DB             storage.DBContext      `inject:""`
+	JetStorage     storage.JetStorage     `inject:""`
+	DropStorage    storage.DropStorage    `inject:""`
+	ObjectStorage  storage.ObjectStorage  `inject:""`
+	ReplicaStorage storage.ReplicaStorage `inject:""`
+	PulseTracker   storage.PulseTracker   `inject:""`
+	PulseStorage   core.PulseStorage      `inject:""`
 
 	cfg configuration.Exporter
 }
@@ -119,11 +123,7 @@ func (e *Exporter) Export(ctx context.Context, fromPulse core.PulseNumber, size
 			return nil, errors.Wrap(err, "failed to fetch pulse data")
 		}
 
-		// We don't need data from current pulse, because of
-		// not all data for this pulse is persisted at this moment
-		// @sergey.morozov 20.01.18 - Blocks are synced to Heavy node with a lag.
-		// We can't reliably predict this lag so we add threshold of N seconds.
-		if pulse.Pulse.PulseNumber >= (currentPulse.PrevPulseNumber - core.PulseNumber(e.cfg.ExportLag)) {
+		if !e.isFinalized(pulse.Pulse.PulseNumber, currentPulse) {
 			iterPulse = nil
 			break
 		}
@@ -149,6 +149,164 @@ func (e *Exporter) Export(ctx context.Context, fromPulse core.PulseNumber, size
 	return &result, nil
 }
 
+// ExportAfter returns per-jet pulse data which follows provided cursor.
+//
+// Data is ordered by pulse and then by jet, so the cursor of the last returned chunk can be used to continue export
+// without gaps or duplicates. Only pulses which are synced to this node for every jet are exported, and only jets that
+// have a drop in the pulse produce a chunk.
+func (e *Exporter) ExportAfter(
+	ctx context.Context, cursor *core.StorageExportCursor, size int,
+) (*core.StorageExportStreamResult, error) {
+	result := core.StorageExportStreamResult{Data: []interface{}{}, Cursor: cursor}
+
+	jets, synced, syncedPulse, err := e.syncedJets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	iterPulse := core.GenesisPulse.PulseNumber
+	if cursor != nil {
+		iterPulse = cursor.Pulse
+	}
+
+	for len(result.Data) < size && iterPulse <= syncedPulse {
+		pulse, err := e.PulseTracker.GetPulse(ctx, iterPulse)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to fetch pulse data")
+		}
+
+		pulseJets, err := e.jetsOnPulse(ctx, jets, synced, pulse.Pulse.PulseNumber)
+		if err != nil {
+			return nil, err
+		}
+		for _, jetID := range pulseJets {
+			if len(result.Data) >= size {
+				break
+			}
+			// Skip chunks which were already exported.
+			if cursor != nil && pulse.Pulse.PulseNumber == cursor.Pulse && bytes.Compare(jetID[:], cursor.JetID[:]) <= 0 {
+				continue
+			}
+
+			data, err := e.exportPulse(ctx, jetID, &pulse.Pulse)
+			if err != nil {
+				return nil, err
+			}
+			result.Data = append(result.Data, data)
+			result.Cursor = &core.StorageExportCursor{Pulse: pulse.Pulse.PulseNumber, JetID: jetID}
+		}
+
+		if pulse.Next == nil {
+			break
+		}
+		iterPulse = *pulse.Next
+	}
+
+	return &result, nil
+}
+
+// syncedJets returns known jets in export order, their synced pulses and the latest pulse which is synced for all of
+// them. A jet which was split or merged is superseded by its descendant or ancestor synced after it, so it doesn't hold
+// export back.
+func (e *Exporter) syncedJets(
+	ctx context.Context,
+) ([]core.RecordID, map[core.RecordID]core.PulseNumber, core.PulseNumber, error) {
+	jetIDs, err := e.JetStorage.GetJets(ctx)
+	if err != nil {
+		return nil, nil, 0, errors.Wrap(err, "failed to fetch jets")
+	}
+	jets := make([]core.RecordID, 0, len(jetIDs))
+	synced := make(map[core.RecordID]core.PulseNumber, len(jetIDs))
+	for jetID := range jetIDs {
+		pn, err := e.ReplicaStorage.GetHeavySyncedPulse(ctx, jetID)
+		if err != nil {
+			return nil, nil, 0, errors.Wrap(err, "failed to fetch synced pulse")
+		}
+		jets = append(jets, jetID)
+		synced[jetID] = pn
+	}
+	sort.Slice(jets, func(i, j int) bool {
+		return bytes.Compare(jets[i][:], jets[j][:]) < 0
+	})
+
+	var syncedPulse core.PulseNumber
+	first := true
+	for _, jetID := range jets {
+		superseded := false
+		for _, other := range jets {
+			if synced[other] > synced[jetID] && isRelatedJet(jetID, other) {
+				superseded = true
+				break
+			}
+		}
+		if superseded {
+			continue
+		}
+		if first || synced[jetID] < syncedPulse {
+			syncedPulse = synced[jetID]
+			first = false
+		}
+	}
+
+	return jets, synced, syncedPulse, nil
+}
+
+// jetsOnPulse returns jets which have a drop in provided pulse. Drops are stored by jet prefix, so an ancestor and its
+// descendant share them. The jet which was synced up to the earliest pulse is the one that owned the drop.
+func (e *Exporter) jetsOnPulse(
+	ctx context.Context, jets []core.RecordID, synced map[core.RecordID]core.PulseNumber, pn core.PulseNumber,
+) ([]core.RecordID, error) {
+	owners := map[string]core.RecordID{}
+	for _, jetID := range jets {
+		if synced[jetID] < pn {
+			continue
+		}
+		_, err := e.DropStorage.GetDrop(ctx, jetID, pn)
+		if err == core.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to fetch jet drop")
+		}
+		_, prefix := jet.Jet(jetID)
+		owner, ok := owners[string(prefix)]
+		if !ok || synced[jetID] < synced[owner] {
+			owners[string(prefix)] = jetID
+		}
+	}
+
+	res := make([]core.RecordID, 0, len(owners))
+	for _, jetID := range jets {
+		_, prefix := jet.Jet(jetID)
+		if owner, ok := owners[string(prefix)]; ok && owner == jetID {
+			res = append(res, jetID)
+		}
+	}
+	return res, nil
+}
+
+// isRelatedJet checks if one of provided jets is an ancestor of another.
+func isRelatedJet(a, b core.RecordID) bool {
+	depthA, prefixA := jet.Jet(a)
+	depthB, prefixB := jet.Jet(b)
+	if depthA == depthB {
+		return false
+	}
+	depth := depthA
+	if depthB < depth {
+		depth = depthB
+	}
+	return bytes.Equal(jet.ResetBits(prefixA, depth), jet.ResetBits(prefixB, depth))
+}
+
+// We don't need data from current pulse, because of
+// not all data for this pulse is persisted at this moment
+// @sergey.morozov 20.01.18 - Blocks are synced to Heavy node with a lag.
+// We can't reliably predict this lag so we add threshold of N seconds.
+func (e *Exporter) isFinalized(pn core.PulseNumber, currentPulse *core.Pulse) bool {
+	return pn < (currentPulse.PrevPulseNumber - core.PulseNumber(e.cfg.ExportLag))
+}
+
 func (e *Exporter) exportPulse(ctx context.Context, jetID core.RecordID, pulse *core.Pulse) (*pulseData, error) {
 	records := recordsData{}
 	err := e.DB.IterateRecordsOnPulse(ctx, jetID, pulse.PulseNumber, func(id core.RecordID, rec record.Record) error {
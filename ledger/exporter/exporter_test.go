@@ -28,6 +28,7 @@ import (
 	"github.com/insolar/insolar/core/message"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/jet"
 	"github.com/insolar/insolar/ledger/storage/record"
 	"github.com/insolar/insolar/ledger/storage/storagetest"
 	"github.com/insolar/insolar/platformpolicy"
@@ -45,10 +46,12 @@ type exporterSuite struct {
 	ctx     context.Context
 	cleaner func()
 
-	pulseTracker  storage.PulseTracker
-	objectStorage storage.ObjectStorage
-	jetStorage    storage.JetStorage
-	pulseStorage  *storage.PulseStorage
+	pulseTracker   storage.PulseTracker
+	objectStorage  storage.ObjectStorage
+	jetStorage     storage.JetStorage
+	dropStorage    storage.DropStorage
+	replicaStorage storage.ReplicaStorage
+	pulseStorage   *storage.PulseStorage
 
 	exporter *Exporter
 	jetID    core.RecordID
@@ -75,6 +78,8 @@ func (s *exporterSuite) BeforeTest(suiteName, testName string) {
 	s.pulseTracker = storage.NewPulseTracker()
 	s.objectStorage = storage.NewObjectStorage()
 	s.jetStorage = storage.NewJetStorage()
+	s.dropStorage = storage.NewDropStorage(10)
+	s.replicaStorage = storage.NewReplicaStorage()
 	s.pulseStorage = storage.NewPulseStorage()
 	s.exporter = NewExporter(configuration.Exporter{ExportLag: 0})
 
@@ -84,6 +89,8 @@ func (s *exporterSuite) BeforeTest(suiteName, testName string) {
 		s.pulseTracker,
 		s.objectStorage,
 		s.jetStorage,
+		s.dropStorage,
+		s.replicaStorage,
 		s.pulseStorage,
 		s.exporter,
 	)
@@ -192,6 +199,107 @@ func (s *exporterSuite) TestExporter_Export() {
 	require.NoError(s.T(), err, "From-pulse should be smaller (or equal) current-pulse")
 }
 
+func (s *exporterSuite) TestExporter_ExportAfter() {
+	for i := 1; i <= 3; i++ {
+		err := s.pulseTracker.AddPulse(
+			s.ctx,
+			core.Pulse{
+				PulseNumber:     core.FirstPulseNumber + 10*core.PulseNumber(i),
+				PrevPulseNumber: core.FirstPulseNumber + 10*core.PulseNumber(i-1),
+				PulseTimestamp:  10 * int64(i+1),
+			},
+		)
+		require.NoError(s.T(), err)
+	}
+	pulse1 := core.PulseNumber(core.FirstPulseNumber + 10)
+	pulse2 := core.PulseNumber(core.FirstPulseNumber + 20)
+	pulse3 := core.PulseNumber(core.FirstPulseNumber + 30)
+
+	// The root jet was split into two jets in the second pulse.
+	rootJetID := *jet.NewID(0, nil)
+	leftJetID := *jet.NewID(1, nil)
+	rightJetID := *jet.NewID(1, []byte{1 << 7})
+	err := s.jetStorage.AddJets(s.ctx, rootJetID, leftJetID, rightJetID)
+	require.NoError(s.T(), err)
+	setDrop := func(jetID core.RecordID, pn core.PulseNumber) {
+		require.NoError(s.T(), s.dropStorage.SetDrop(s.ctx, jetID, &jet.JetDrop{Pulse: pn}))
+	}
+	setDrop(rootJetID, pulse1)
+	setDrop(leftJetID, pulse2)
+	setDrop(rightJetID, pulse2)
+	setDrop(rightJetID, pulse3)
+	require.NoError(s.T(), s.replicaStorage.SetHeavySyncedPulse(s.ctx, rootJetID, pulse1))
+	require.NoError(s.T(), s.replicaStorage.SetHeavySyncedPulse(s.ctx, leftJetID, pulse2))
+	require.NoError(s.T(), s.replicaStorage.SetHeavySyncedPulse(s.ctx, rightJetID, pulse3))
+
+	objectID, err := s.objectStorage.SetRecord(s.ctx, rightJetID, pulse2, &record.ObjectActivateRecord{
+		IsDelegate: true,
+	})
+	require.NoError(s.T(), err)
+
+	type chunkID struct {
+		pulse core.PulseNumber
+		jetID core.RecordID
+	}
+	// The third pulse is not synced for the left jet yet.
+	expected := []chunkID{{pulse1, rootJetID}, {pulse2, leftJetID}, {pulse2, rightJetID}}
+
+	result, err := s.exporter.ExportAfter(s.ctx, nil, 100)
+	require.NoError(s.T(), err)
+	var all []chunkID
+	for _, data := range result.Data {
+		chunk := data.(*pulseData)
+		all = append(all, chunkID{chunk.Pulse.PulseNumber, chunk.JetID})
+	}
+	assert.Equal(s.T(), expected, all)
+	assert.Equal(s.T(), &core.StorageExportCursor{Pulse: pulse2, JetID: rightJetID}, result.Cursor)
+	_, err = json.Marshal(result)
+	assert.NoError(s.T(), err)
+
+	var (
+		cursor *core.StorageExportCursor
+		chunks []*pulseData
+	)
+	for {
+		result, err := s.exporter.ExportAfter(s.ctx, cursor, 1)
+		require.NoError(s.T(), err)
+		if len(result.Data) == 0 {
+			assert.Equal(s.T(), cursor, result.Cursor)
+			break
+		}
+		require.Equal(s.T(), 1, len(result.Data))
+		chunk := result.Data[0].(*pulseData)
+		assert.Equal(s.T(), chunk.Pulse.PulseNumber, result.Cursor.Pulse)
+		assert.Equal(s.T(), chunk.JetID, result.Cursor.JetID)
+		chunks = append(chunks, chunk)
+		cursor = result.Cursor
+	}
+	require.Equal(s.T(), result.Data, func() []interface{} {
+		data := make([]interface{}, 0, len(chunks))
+		for _, chunk := range chunks {
+			data = append(data, chunk)
+		}
+		return data
+	}())
+
+	found := false
+	for _, chunk := range chunks {
+		if _, ok := chunk.Records[base58.Encode(objectID[:])]; ok {
+			assert.Equal(s.T(), rightJetID, chunk.JetID)
+			found = true
+		}
+	}
+	assert.True(s.T(), found, "object not found by ID")
+
+	// Export continues when the left jet is synced.
+	setDrop(leftJetID, pulse3)
+	require.NoError(s.T(), s.replicaStorage.SetHeavySyncedPulse(s.ctx, leftJetID, pulse3))
+	result, err = s.exporter.ExportAfter(s.ctx, cursor, 100)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 2, len(result.Data))
+	assert.Equal(s.T(), &core.StorageExportCursor{Pulse: pulse3, JetID: rightJetID}, result.Cursor)
+}
+
 func (s *exporterSuite) TestExporter_ExportGetBlobFailed() {
 	for i := 1; i <= 3; i++ {
 		err := s.pulseTracker.AddPulse(
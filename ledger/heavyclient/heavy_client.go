@@ -63,6 +63,10 @@ type JetClient struct {
 	muPulses    sync.Mutex
 	leftPulses  []core.PulseNumber
 	syncbackoff *backoff.Backoff
+	// chunks of the first left pulse accepted by heavy
+	syncedChunks uint32
+	// synced closes when there are no left pulses
+	synced chan struct{}
 }
 
 // NewJetClient heavy replication client constructor.
@@ -89,8 +93,10 @@ func NewJetClient(
 		syncbackoff:    backoffFromConfig(opts.BackoffConf),
 		signal:         make(chan struct{}, 1),
 		syncdone:       make(chan struct{}),
+		synced:         make(chan struct{}),
 		opts:           opts,
 	}
+	close(jsc.synced)
 	return jsc
 }
 
@@ -112,6 +118,13 @@ func (c *JetClient) updateLeftPulsesMetrics(ctx context.Context) {
 func (c *JetClient) addPulses(ctx context.Context, pns []core.PulseNumber) {
 	c.muPulses.Lock()
 	c.leftPulses = append(c.leftPulses, pns...)
+	if len(c.leftPulses) > 0 {
+		select {
+		case <-c.synced:
+			c.synced = make(chan struct{})
+		default:
+		}
+	}
 
 	if err := c.replicaStorage.SetSyncClientJetPulses(ctx, c.jetID, c.leftPulses); err != nil {
 		inslogger.FromContext(ctx).Errorf(
@@ -122,6 +135,13 @@ func (c *JetClient) addPulses(ctx context.Context, pns []core.PulseNumber) {
 	c.muPulses.Unlock()
 }
 
+// syncedSignal returns channel which closes when all left pulses are synced.
+func (c *JetClient) syncedSignal() <-chan struct{} {
+	c.muPulses.Lock()
+	defer c.muPulses.Unlock()
+	return c.synced
+}
+
 func (c *JetClient) pulsesLeft() int {
 	c.muPulses.Lock()
 	defer c.muPulses.Unlock()
@@ -137,11 +157,15 @@ func (c *JetClient) unshiftPulse(ctx context.Context) *core.PulseNumber {
 		return nil
 	}
 	result := c.leftPulses[0]
+	c.syncedChunks = 0
 
 	// shift array elements on one position to left
 	shifted := c.leftPulses[:len(c.leftPulses)-1]
 	copy(shifted, c.leftPulses[1:])
 	c.leftPulses = shifted
+	if len(c.leftPulses) == 0 {
+		close(c.synced)
+	}
 
 	if err := c.replicaStorage.SetSyncClientJetPulses(ctx, c.jetID, c.leftPulses); err != nil {
 		inslogger.FromContext(ctx).Errorf(
@@ -152,6 +176,24 @@ func (c *JetClient) unshiftPulse(ctx context.Context) *core.PulseNumber {
 	return &result
 }
 
+func (c *JetClient) setSyncedChunks(chunks uint32) {
+	c.muPulses.Lock()
+	c.syncedChunks = chunks
+	c.muPulses.Unlock()
+}
+
+// syncStatus returns jet's replication state without lag.
+func (c *JetClient) syncStatus() core.JetSyncStatus {
+	c.muPulses.Lock()
+	defer c.muPulses.Unlock()
+
+	return core.JetSyncStatus{
+		JetID:          c.jetID,
+		UnsyncedPulses: append([]core.PulseNumber(nil), c.leftPulses...),
+		SyncedChunks:   c.syncedChunks,
+	}
+}
+
 func (c *JetClient) nextPulseNumber() (core.PulseNumber, bool) {
 	c.muPulses.Lock()
 	defer c.muPulses.Unlock()
@@ -162,6 +204,14 @@ func (c *JetClient) nextPulseNumber() (core.PulseNumber, bool) {
 	return c.leftPulses[0], true
 }
 
+// notify wakes up sync loop waiting for new pulses.
+func (c *JetClient) notify() {
+	if len(c.signal) == 0 {
+		// send signal we have new pulse
+		c.signal <- struct{}{}
+	}
+}
+
 func (c *JetClient) runOnce(ctx context.Context) {
 	// retrydelay = m.syncbackoff.ForAttempt(attempt)
 	c.startOnce.Do(func() {
@@ -17,7 +17,9 @@
 package heavyclient
 
 import (
+	"bytes"
 	"context"
+	"sort"
 	"sync"
 	"time"
 
@@ -26,6 +28,7 @@ import (
 	"github.com/insolar/insolar/ledger/recentstorage"
 	"github.com/insolar/insolar/ledger/storage"
 	"github.com/insolar/insolar/ledger/storage/jet"
+	"github.com/pkg/errors"
 	"go.opencensus.io/stats"
 	"golang.org/x/sync/singleflight"
 )
@@ -117,14 +120,34 @@ func (scp *Pool) AddPulsesToSyncClient(
 
 	if shouldrun {
 		client.runOnce(ctx)
-		if len(client.signal) == 0 {
-			// send signal we have new pulse
-			client.signal <- struct{}{}
-		}
+		client.notify()
 	}
 	return client
 }
 
+// Flush starts all clients which have pulses to sync (if not already), wakes them up
+// and waits until all their pulses are synced or context is done.
+func (scp *Pool) Flush(ctx context.Context) error {
+	var waiting []*JetClient
+	for _, client := range scp.AllClients(ctx) {
+		if client.pulsesLeft() == 0 {
+			continue
+		}
+		client.runOnce(ctx)
+		client.notify()
+		waiting = append(waiting, client)
+	}
+
+	for _, client := range waiting {
+		select {
+		case <-client.syncedSignal():
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "jet %v has %d unsynced pulses", client.jetID.DebugString(), client.pulsesLeft())
+		}
+	}
+	return nil
+}
+
 // AllClients returns slice with all clients in Pool.
 func (scp *Pool) AllClients(ctx context.Context) []*JetClient {
 	scp.Lock()
@@ -136,6 +159,38 @@ func (scp *Pool) AllClients(ctx context.Context) []*JetClient {
 	return clients
 }
 
+// SyncStatus returns replication state of all jets in Pool.
+//
+// Jet's lag is an amount of pulses between current pulse and the first unsynced pulse.
+func (scp *Pool) SyncStatus(ctx context.Context) ([]core.JetSyncStatus, error) {
+	current, err := scp.pulseStorage.Current(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get current pulse")
+	}
+	currentPulse, err := scp.pulseTracker.GetPulse(ctx, current.PulseNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get current pulse")
+	}
+
+	allClients := scp.AllClients(ctx)
+	statuses := make([]core.JetSyncStatus, 0, len(allClients))
+	for _, c := range allClients {
+		status := c.syncStatus()
+		if len(status.UnsyncedPulses) > 0 {
+			first, err := scp.pulseTracker.GetPulse(ctx, status.UnsyncedPulses[0])
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get pulse %v", status.UnsyncedPulses[0])
+			}
+			status.Lag = currentPulse.SerialNumber - first.SerialNumber
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return bytes.Compare(statuses[i].JetID[:], statuses[j].JetID[:]) < 0
+	})
+	return statuses, nil
+}
+
 // LightCleanup starts async cleanup on all heavy synchronization clients (per jet cleanup).
 //
 // Waits until all cleanup will done and mesaures time.
@@ -26,7 +26,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/dgraph-io/badger"
 	"github.com/insolar/insolar/component"
 	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/core"
@@ -34,6 +33,7 @@ import (
 	"github.com/insolar/insolar/core/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/ledger/artifactmanager"
+	"github.com/insolar/insolar/ledger/heavyclient"
 	"github.com/insolar/insolar/ledger/pulsemanager"
 	"github.com/insolar/insolar/ledger/recentstorage"
 	"github.com/insolar/insolar/ledger/storage"
@@ -45,6 +45,7 @@ import (
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
 	"github.com/insolar/insolar/testutils/network"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -230,6 +231,10 @@ func sendToHeavy(s *heavySuite, withretry bool) {
 				keys: keys,
 			}
 			statMutex.Unlock()
+			return &reply.HeavySyncProgress{Chunks: heavymsg.Chunk + 1, Checksum: heavymsg.Checksum}, nil
+		}
+		if startstop, ok := msg.(*message.HeavyStartStop); ok && !startstop.Finished {
+			return &reply.HeavySyncProgress{}, nil
 		}
 		return nil, nil
 	}
@@ -332,7 +337,7 @@ func sendToHeavy(s *heavySuite, withretry bool) {
 
 	synckeys = uniqkeys(sortkeys(synckeys))
 
-	recs := getallkeys(s.db.GetBadgerDB())
+	recs := getallkeys(s.db.GetKVStore())
 	recs = filterkeys(recs, func(k key) bool {
 		return storage.Key(k).PulseNumber() != 0
 	})
@@ -341,6 +346,150 @@ func sendToHeavy(s *heavySuite, withretry bool) {
 	assert.Equal(s.T(), recs, synckeys, "synced keys are the same as records in storage")
 }
 
+func (s *heavySuite) TestJetClient_HeavySyncResume() {
+	jetID := jet.ZeroJetID
+	pn := core.PulseNumber(core.FirstPulseNumber + 1)
+	for i := 0; i < 30; i++ {
+		addRecords(s.ctx, s.T(), s.objectStorage, jetID, pn)
+	}
+
+	kb := 1 << 10
+	newClient := func(bus core.MessageBus) *heavyclient.JetClient {
+		return heavyclient.NewJetClient(
+			s.replicaStorage, bus, nil, s.pulseTracker, s.storageCleaner, s.db, jetID,
+			heavyclient.Options{SyncMessageLimit: kb},
+		)
+	}
+	// heavy mock, accepts chunks after the given progress
+	heavy := func(progress core.HeavySyncProgress, sent *[]*message.HeavyPayload) core.MessageBus {
+		busMock := testutils.NewMessageBusMock(s.T())
+		busMock.SendFunc = func(ctx context.Context, msg core.Message, ops *core.MessageSendOptions) (core.Reply, error) {
+			switch m := msg.(type) {
+			case *message.HeavyStartStop:
+				if !m.Finished {
+					return &reply.HeavySyncProgress{Chunks: progress.Chunks, Checksum: progress.Checksum}, nil
+				}
+				return &reply.OK{}, nil
+			case *message.HeavyPayload:
+				require.Equal(s.T(), core.KVChecksum(m.Records), m.Checksum)
+				if m.Chunk != 0 {
+					require.Equal(s.T(), progress.Chunks, m.Chunk, "chunk should follow accepted chunks")
+				}
+				progress = core.HeavySyncProgress{Chunks: m.Chunk + 1, Checksum: m.Checksum}
+				*sent = append(*sent, m)
+				return &reply.HeavySyncProgress{Chunks: progress.Chunks, Checksum: progress.Checksum}, nil
+			}
+			return nil, fmt.Errorf("unexpected message %T", msg)
+		}
+		return busMock
+	}
+
+	var full []*message.HeavyPayload
+	err := newClient(heavy(core.HeavySyncProgress{}, &full)).HeavySync(s.ctx, pn)
+	require.NoError(s.T(), err)
+	require.True(s.T(), len(full) > 2, "records should be sent in several chunks")
+
+	var resumed []*message.HeavyPayload
+	accepted := core.HeavySyncProgress{Chunks: 2, Checksum: full[1].Checksum}
+	err = newClient(heavy(accepted, &resumed)).HeavySync(s.ctx, pn)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), full[2:], resumed, "sync should resume from the last accepted chunk")
+
+	var restarted []*message.HeavyPayload
+	mismatched := core.HeavySyncProgress{Chunks: 2, Checksum: full[0].Checksum}
+	err = newClient(heavy(mismatched, &restarted)).HeavySync(s.ctx, pn)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), full, restarted, "sync should restart on chunks mismatch")
+}
+
+func (s *heavySuite) TestPool_SyncStatus() {
+	first := core.PulseNumber(core.FirstPulseNumber + 1)
+	for pn := first; pn < first+5; pn++ {
+		err := s.pulseTracker.AddPulse(s.ctx, core.Pulse{PulseNumber: pn})
+		require.NoError(s.T(), err)
+	}
+	ps := storage.NewPulseStorage()
+	ps.PulseTracker = s.pulseTracker
+	ps.Set(&core.Pulse{PulseNumber: first + 4})
+
+	pool := heavyclient.NewPool(
+		nil, ps, s.pulseTracker, s.replicaStorage, s.storageCleaner, s.db, heavyclient.Options{},
+	)
+	left, right := *jet.NewID(1, nil), *jet.NewID(1, []byte{0x80})
+	pool.AddPulsesToSyncClient(s.ctx, right, false, first+3)
+	pool.AddPulsesToSyncClient(s.ctx, left, false, first+1, first+2)
+	pool.AddPulsesToSyncClient(s.ctx, jet.ZeroJetID, false)
+
+	statuses, err := pool.SyncStatus(s.ctx)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []core.JetSyncStatus{
+		{JetID: jet.ZeroJetID},
+		{JetID: left, UnsyncedPulses: []core.PulseNumber{first + 1, first + 2}, Lag: 3},
+		{JetID: right, UnsyncedPulses: []core.PulseNumber{first + 3}, Lag: 1},
+	}, statuses)
+}
+
+func (s *heavySuite) TestPool_Flush() {
+	first := core.PulseNumber(core.FirstPulseNumber + 1)
+	for pn := first; pn < first+3; pn++ {
+		err := s.pulseTracker.AddPulse(s.ctx, core.Pulse{PulseNumber: pn})
+		require.NoError(s.T(), err)
+	}
+	ps := storage.NewPulseStorage()
+	ps.PulseTracker = s.pulseTracker
+	ps.Set(&core.Pulse{PulseNumber: first + 2})
+
+	var heavyUnavailable int32
+	busMock := testutils.NewMessageBusMock(s.T())
+	busMock.SendFunc = func(ctx context.Context, msg core.Message, ops *core.MessageSendOptions) (core.Reply, error) {
+		if atomic.LoadInt32(&heavyUnavailable) == 1 {
+			return &reply.HeavyError{Message: "heavy is busy", SubType: reply.ErrHeavySyncInProgress}, nil
+		}
+		switch m := msg.(type) {
+		case *message.HeavyStartStop:
+			if !m.Finished {
+				return &reply.HeavySyncProgress{}, nil
+			}
+			return &reply.OK{}, nil
+		case *message.HeavyPayload:
+			return &reply.HeavySyncProgress{Chunks: m.Chunk + 1, Checksum: m.Checksum}, nil
+		}
+		return nil, fmt.Errorf("unexpected message %T", msg)
+	}
+
+	pool := heavyclient.NewPool(
+		busMock, ps, s.pulseTracker, s.replicaStorage, s.storageCleaner, s.db,
+		heavyclient.Options{SyncMessageLimit: 1 << 10, PulsesDeltaLimit: 10},
+	)
+	defer pool.Stop(s.ctx)
+
+	// restored clients are not started until the next pulse
+	pool.AddPulsesToSyncClient(s.ctx, jet.ZeroJetID, false, first, first+1)
+	assert.Equal(s.T(), uint64(0), busMock.SendCounter)
+
+	ctx, cancel := context.WithTimeout(s.ctx, 5*time.Second)
+	defer cancel()
+	require.NoError(s.T(), pool.Flush(ctx))
+	statuses, err := pool.SyncStatus(s.ctx)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), statuses[0].UnsyncedPulses, "all pulses should be synced after flush")
+	assert.NotEqual(s.T(), uint64(0), busMock.SendCounter)
+
+	// flush doesn't wait forever if heavy doesn't accept pulses
+	atomic.StoreInt32(&heavyUnavailable, 1)
+	pool.AddPulsesToSyncClient(s.ctx, jet.ZeroJetID, false, first+2)
+	ctx, cancel = context.WithTimeout(s.ctx, 100*time.Millisecond)
+	defer cancel()
+	err = pool.Flush(ctx)
+	require.Error(s.T(), err)
+	assert.Equal(s.T(), context.DeadlineExceeded, errors.Cause(err))
+
+	atomic.StoreInt32(&heavyUnavailable, 0)
+	ctx, cancel = context.WithTimeout(s.ctx, 5*time.Second)
+	defer cancel()
+	require.NoError(s.T(), pool.Flush(ctx))
+}
+
 func setpulse(ctx context.Context, pm core.PulseManager, pulsenum int) error {
 	return pm.Set(ctx, core.Pulse{PulseNumber: core.PulseNumber(pulsenum)}, true)
 }
@@ -389,17 +538,13 @@ func (k key) String() string {
 	return storage.Key(k).String()
 }
 
-func getallkeys(db *badger.DB) (records []key) {
-	txn := db.NewTransaction(true)
+func getallkeys(store storage.KVStore) (records []key) {
+	txn := store.NewTransaction(false)
 	defer txn.Discard()
 
-	it := txn.NewIterator(badger.DefaultIteratorOptions)
-	defer it.Close()
-	for it.Rewind(); it.Valid(); it.Next() {
-		item := it.Item()
-		k := item.KeyCopy(nil)
+	txn.Iterate(nil, nil, func(k, _ []byte) error {
 		if storage.Key(k).PulseNumber() == 0 {
-			continue
+			return nil
 		}
 		switch k[0] {
 		case
@@ -409,7 +554,8 @@ func getallkeys(db *badger.DB) (records []key) {
 			scopeIDBlob:
 			records = append(records, k)
 		}
-	}
+		return nil
+	})
 	return
 }
 
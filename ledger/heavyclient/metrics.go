@@ -33,6 +33,7 @@ var (
 	statFirstUnsyncedPulse  = stats.Int64("heavyclient/unsynced/firstpulse", "First unsynced pulse number", stats.UnitDimensionless)
 
 	statSyncedPulsesCount = stats.Int64("heavyclient/synced/count", "How many pulses unsynced", stats.UnitDimensionless)
+	statSkippedChunks     = stats.Int64("heavyclient/synced/skipped", "How many chunks already accepted by heavy were skipped on resume", stats.UnitDimensionless)
 
 	statCleanLatencyDB = stats.Int64("lightcleanup/latency/db", "Light storage db cleanup time in milliseconds", stats.UnitMilliseconds)
 	statSyncedRetries  = stats.Int64("heavyserver/synced/retries", "Number of retries for sync", stats.UnitDimensionless)
@@ -61,6 +62,13 @@ func init() {
 			Aggregation: view.Count(),
 			TagKeys:     []tag.Key{tagJet},
 		},
+		&view.View{
+			Name:        statSkippedChunks.Name(),
+			Description: statSkippedChunks.Description(),
+			Measure:     statSkippedChunks,
+			Aggregation: view.Sum(),
+			TagKeys:     []tag.Key{tagJet},
+		},
 
 		&view.View{
 			Name:        statCleanLatencyDB.Name(),
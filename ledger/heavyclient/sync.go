@@ -17,15 +17,24 @@
 package heavyclient
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/core/message"
 	"github.com/insolar/insolar/core/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
 	"github.com/insolar/insolar/ledger/storage"
+	"go.opencensus.io/stats"
 )
 
+// errChunksMismatch is returned when chunks accepted by heavy differ from local ones,
+// e.g. if indexes were updated after previous sync attempt.
+var errChunksMismatch = errors.New("chunks accepted by heavy don't match local records")
+
 func messageToHeavy(ctx context.Context, bus core.MessageBus, msg core.Message) error {
 	busreply, buserr := bus.Send(ctx, msg, nil)
 	if buserr != nil {
@@ -40,9 +49,24 @@ func messageToHeavy(ctx context.Context, bus core.MessageBus, msg core.Message)
 	return nil
 }
 
+func progressFromHeavy(ctx context.Context, bus core.MessageBus, msg core.Message) (core.HeavySyncProgress, error) {
+	busreply, buserr := bus.Send(ctx, msg, nil)
+	if buserr != nil {
+		return core.HeavySyncProgress{}, buserr
+	}
+	switch r := busreply.(type) {
+	case *reply.HeavyError:
+		return core.HeavySyncProgress{}, r
+	case *reply.HeavySyncProgress:
+		return core.HeavySyncProgress{Chunks: r.Chunks, Checksum: r.Checksum}, nil
+	}
+	return core.HeavySyncProgress{}, fmt.Errorf("unexpected reply from heavy: %T", busreply)
+}
+
 // HeavySync syncs records from light to heavy node, returns last synced pulse and error.
 //
 // It syncs records from start to end of provided pulse numbers.
+// If heavy has already accepted some chunks of the pulse, sync resumes from the last accepted chunk.
 func (c *JetClient) HeavySync(
 	ctx context.Context,
 	pn core.PulseNumber,
@@ -56,14 +80,40 @@ func (c *JetClient) HeavySync(
 		JetID:    jetID,
 		PulseNum: pn,
 	}
-	if err := messageToHeavy(ctx, c.bus, signalMsg); err != nil {
+	progress, err := progressFromHeavy(ctx, c.bus, signalMsg)
+	if err != nil {
 		inslog.Error("synchronize: start failed")
 		return err
 	}
+	c.setSyncedChunks(progress.Chunks)
+
+	err = c.syncChunks(ctx, pn, progress)
+	if err == errChunksMismatch {
+		inslog.Warnf("synchronize: %v, restart sync from the first chunk", err)
+		err = c.syncChunks(ctx, pn, core.HeavySyncProgress{})
+	}
+	if err != nil {
+		inslog.Error("synchronize: payload failed")
+		return err
+	}
+
+	signalMsg.Finished = true
+	if err := messageToHeavy(ctx, c.bus, signalMsg); err != nil {
+		inslog.Error("synchronize: finish failed")
+		return err
+	}
+
+	return nil
+}
 
+// syncChunks sends records of the pulse to heavy in chunks, skipping chunks already accepted by heavy.
+//
+// Chunks are rebuilt from local storage, so the last skipped chunk is checked against checksum from heavy.
+func (c *JetClient) syncChunks(ctx context.Context, pn core.PulseNumber, progress core.HeavySyncProgress) error {
 	replicator := storage.NewReplicaIter(
-		ctx, c.db, jetID, pn, pn+1, c.opts.SyncMessageLimit)
-	for {
+		ctx, c.db, c.jetID, pn, pn+1, c.opts.SyncMessageLimit)
+	var chunk uint32
+	for ; ; chunk++ {
 		recs, err := replicator.NextRecords()
 		if err == storage.ErrReplicatorDone {
 			break
@@ -71,22 +121,32 @@ func (c *JetClient) HeavySync(
 		if err != nil {
 			panic(err)
 		}
+		checksum := core.KVChecksum(recs)
+		if chunk < progress.Chunks {
+			if chunk+1 == progress.Chunks && !bytes.Equal(checksum, progress.Checksum) {
+				return errChunksMismatch
+			}
+			stats.Record(
+				insmetrics.InsertTag(ctx, tagJet, c.jetID.DebugString()),
+				statSkippedChunks.M(1),
+			)
+			continue
+		}
 		msg := &message.HeavyPayload{
-			JetID:    jetID,
+			JetID:    c.jetID,
 			PulseNum: pn,
+			Chunk:    chunk,
+			Checksum: checksum,
 			Records:  recs,
 		}
-		if err := messageToHeavy(ctx, c.bus, msg); err != nil {
-			inslog.Error("synchronize: payload failed")
+		progress, err = progressFromHeavy(ctx, c.bus, msg)
+		if err != nil {
 			return err
 		}
+		c.setSyncedChunks(progress.Chunks)
 	}
-
-	signalMsg.Finished = true
-	if err := messageToHeavy(ctx, c.bus, signalMsg); err != nil {
-		inslog.Error("synchronize: finish failed")
-		return err
+	if chunk < progress.Chunks {
+		return errChunksMismatch
 	}
-
 	return nil
 }
@@ -17,6 +17,7 @@
 package heavyserver
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"sync"
@@ -44,14 +45,35 @@ func errSyncInProgress(jetID core.RecordID, pn core.PulseNumber) *reply.HeavyErr
 	}
 }
 
+func errChunkChecksum(jetID core.RecordID, pn core.PulseNumber, chunk uint32) *reply.HeavyError {
+	return &reply.HeavyError{
+		Message:  fmt.Sprintf("Heavy node sync chunk %v checksum mismatch", chunk),
+		SubType:  reply.ErrHeavySyncChecksum,
+		JetID:    jetID,
+		PulseNum: pn,
+	}
+}
+
+func errChunkOrder(jetID core.RecordID, pn core.PulseNumber, chunk uint32, progress core.HeavySyncProgress) *reply.HeavyError {
+	return &reply.HeavyError{
+		Message:  fmt.Sprintf("Heavy node sync chunk %v doesn't follow accepted chunks count %v", chunk, progress.Chunks),
+		SubType:  reply.ErrHeavySyncChunk,
+		JetID:    jetID,
+		PulseNum: pn,
+	}
+}
+
 // in testnet we start with only one jet
 type syncstate struct {
 	sync.Mutex
 	lastok core.PulseNumber
 	// insyncend core.PulseNumber
 	syncpulse *core.PulseNumber
+	syncjet   core.RecordID
 	insync    bool
 	timer     *time.Timer
+	// chunks of syncpulse accepted by heavy
+	progress core.HeavySyncProgress
 }
 
 func (s *syncstate) resetTimeout(ctx context.Context, timeout time.Duration) {
@@ -79,6 +101,8 @@ type jetprefix [core.JetPrefixSize]byte
 // Sync provides methods for syncing records to heavy storage.
 type Sync struct {
 	ReplicaStorage storage.ReplicaStorage `inject:""`
+	SecondaryIndex storage.SecondaryIndex `inject:""`
+	JetStorage     storage.JetStorage     `inject:""`
 	DBContext      storage.DBContext
 
 	sync.Mutex
@@ -120,6 +144,18 @@ func (s *Sync) checkIsNextPulse(ctx context.Context, jetID core.RecordID, jetsta
 	return nil
 }
 
+// loadProgress returns chunks of the pulse accepted before heavy restart or sync timeout.
+func (s *Sync) loadProgress(ctx context.Context, jetID core.RecordID, pn core.PulseNumber) (core.HeavySyncProgress, error) {
+	progresspn, progress, err := s.ReplicaStorage.GetHeavySyncProgress(ctx, jetID)
+	if err != nil {
+		return core.HeavySyncProgress{}, errors.Wrap(err, "heavyserver: GetHeavySyncProgress failed")
+	}
+	if progresspn != pn {
+		return core.HeavySyncProgress{}, nil
+	}
+	return progress, nil
+}
+
 func (s *Sync) getJetSyncState(ctx context.Context, jetID core.RecordID) *syncstate {
 	var jp jetprefix
 	_, jpBuf := jet.Jet(jetID)
@@ -135,39 +171,90 @@ func (s *Sync) getJetSyncState(ctx context.Context, jetID core.RecordID) *syncst
 }
 
 // Start try to start heavy sync for provided pulse.
-func (s *Sync) Start(ctx context.Context, jetID core.RecordID, pn core.PulseNumber) error {
+//
+// Returns chunks of the pulse already accepted by heavy, so client could resume sync from the last accepted chunk.
+// Start of the pulse which is in sync right now resumes it.
+func (s *Sync) Start(ctx context.Context, jetID core.RecordID, pn core.PulseNumber) (core.HeavySyncProgress, error) {
 	jetState := s.getJetSyncState(ctx, jetID)
 	jetState.Lock()
 	defer jetState.Unlock()
 
 	if jetState.syncpulse != nil {
+		if *jetState.syncpulse == pn && jetState.syncjet == jetID {
+			if jetState.insync {
+				return core.HeavySyncProgress{}, errSyncInProgress(jetID, pn)
+			}
+			s.resumed(ctx, jetID, pn, jetState.progress)
+			jetState.resetTimeout(ctx, defaultTimeout)
+			return jetState.progress, nil
+		}
 		if *jetState.syncpulse >= pn {
-			return fmt.Errorf("heavyserver: pulse %v is not greater than current in-sync pulse %v (jet=%v)",
+			return core.HeavySyncProgress{}, fmt.Errorf(
+				"heavyserver: pulse %v is not greater than current in-sync pulse %v (jet=%v)",
 				pn, *jetState.syncpulse, jetID)
 		}
-		return errSyncInProgress(jetID, pn)
+		return core.HeavySyncProgress{}, errSyncInProgress(jetID, pn)
 	}
 
 	if pn <= core.FirstPulseNumber {
-		return fmt.Errorf("heavyserver: sync pulse should be greater than first pulse %v (got %v)", core.FirstPulseNumber, pn)
+		return core.HeavySyncProgress{}, fmt.Errorf(
+			"heavyserver: sync pulse should be greater than first pulse %v (got %v)", core.FirstPulseNumber, pn)
 	}
 
 	if err := s.checkIsNextPulse(ctx, jetID, jetState, pn); err != nil {
-		return err
+		return core.HeavySyncProgress{}, err
+	}
+
+	progress, err := s.loadProgress(ctx, jetID, pn)
+	if err != nil {
+		return core.HeavySyncProgress{}, err
+	}
+	if progress.Chunks > 0 {
+		s.resumed(ctx, jetID, pn, progress)
 	}
 
 	jetState.syncpulse = &pn
+	jetState.syncjet = jetID
+	jetState.progress = progress
 	jetState.resetTimeout(ctx, defaultTimeout)
-	return nil
+	return progress, nil
+}
+
+func (s *Sync) resumed(ctx context.Context, jetID core.RecordID, pn core.PulseNumber, progress core.HeavySyncProgress) {
+	inslogger.FromContext(ctx).Debugf("heavyserver: Resume sync: jetID=%v, pulse=%v, chunks=%v",
+		jetID, pn, progress.Chunks)
+	ctx = insmetrics.InsertTag(ctx, tagJet, jetID.DebugString())
+	stats.Record(ctx, statSyncedResumed.M(1))
 }
 
 // Store stores recieved key/value pairs at heavy storage.
 //
+// Chunk should follow the last accepted chunk of the pulse, zero chunk restarts the pulse sync.
+// Retransmitted last accepted chunk is not stored twice.
+// Returns chunks of the pulse accepted by heavy.
+//
 // TODO: check actual jet and pulse in keys
-func (s *Sync) Store(ctx context.Context, jetID core.RecordID, pn core.PulseNumber, kvs []core.KV) error {
+func (s *Sync) Store(
+	ctx context.Context,
+	jetID core.RecordID,
+	pn core.PulseNumber,
+	chunk uint32,
+	checksum []byte,
+	kvs []core.KV,
+) (core.HeavySyncProgress, error) {
 	inslog := inslogger.FromContext(ctx)
 	jetState := s.getJetSyncState(ctx, jetID)
+	ctx = insmetrics.InsertTag(ctx, tagJet, jetID.DebugString())
+
+	if !bytes.Equal(core.KVChecksum(kvs), checksum) {
+		stats.Record(ctx, statSyncedRejected.M(1))
+		return core.HeavySyncProgress{}, errChunkChecksum(jetID, pn, chunk)
+	}
 
+	var (
+		progress  core.HeavySyncProgress
+		duplicate bool
+	)
 	err := func() error {
 		jetState.Lock()
 		defer jetState.Unlock()
@@ -181,23 +268,51 @@ func (s *Sync) Store(ctx context.Context, jetID core.RecordID, pn core.PulseNumb
 		if jetState.insync {
 			return errSyncInProgress(jetID, pn)
 		}
+
+		progress = jetState.progress
+		switch {
+		case chunk == 0 || chunk == progress.Chunks:
+		case chunk+1 == progress.Chunks && bytes.Equal(checksum, progress.Checksum):
+			duplicate = true
+			return nil
+		default:
+			stats.Record(ctx, statSyncedRejected.M(1))
+			return errChunkOrder(jetID, pn, chunk, progress)
+		}
+
 		jetState.insync = true
 		jetState.resetTimeout(ctx, defaultTimeout)
 		return nil
 	}()
 	if err != nil {
-		return err
+		return core.HeavySyncProgress{}, err
+	}
+	if duplicate {
+		inslog.Debugf("heavyserver: chunk %v is already accepted: jetID=%v, pulse=%v", chunk, jetID, pn)
+		return progress, nil
 	}
 
+	progress = core.HeavySyncProgress{Chunks: chunk + 1, Checksum: checksum}
 	defer func() {
 		jetState.Lock()
 		jetState.insync = false
+		if err == nil {
+			jetState.progress = progress
+		}
 		jetState.Unlock()
 	}()
 	// TODO: check jet in keys?
-	err = s.DBContext.StoreKeyValues(ctx, kvs)
+	err = s.DBContext.StoreKeyValues(ctx, append(storage.JetRecordLinks(jetID, kvs), kvs...))
+	if err != nil {
+		return core.HeavySyncProgress{}, errors.Wrapf(err, "heavyserver: store failed")
+	}
+	err = s.SecondaryIndex.IndexKeyValues(ctx, kvs)
+	if err != nil {
+		return core.HeavySyncProgress{}, errors.Wrapf(err, "heavyserver: indexing failed")
+	}
+	err = s.ReplicaStorage.SetHeavySyncProgress(ctx, jetID, pn, progress)
 	if err != nil {
-		return errors.Wrapf(err, "heavyserver: store failed")
+		return core.HeavySyncProgress{}, errors.Wrapf(err, "heavyserver: SetHeavySyncProgress failed")
 	}
 
 	// heavy stats
@@ -205,14 +320,13 @@ func (s *Sync) Store(ctx context.Context, jetID core.RecordID, pn core.PulseNumb
 	recordsSize := core.KVSize(kvs)
 	inslog.Debugf("heavy store stat: JetID=%v, recordsCount+=%v, recordsSize+=%v\n", jetID.DebugString(), recordsCount, recordsSize)
 
-	ctx = insmetrics.InsertTag(ctx, tagJet, jetID.DebugString())
 	stats.Record(ctx,
 		statSyncedCount.M(1),
 		statSyncedRecords.M(recordsCount),
 		statSyncedPulse.M(int64(pn)),
 		statSyncedBytes.M(recordsSize),
 	)
-	return nil
+	return progress, nil
 }
 
 // Stop successfully stops replication for specified pulse.
@@ -240,6 +354,11 @@ func (s *Sync) Stop(ctx context.Context, jetID core.RecordID, pn core.PulseNumbe
 	if err != nil {
 		return err
 	}
+	// Synced jets are used by exporter to find out which data is complete.
+	err = s.JetStorage.AddJets(ctx, jetID)
+	if err != nil {
+		return err
+	}
 	inslogger.FromContext(ctx).Debugf("heavyserver: Fin sync: jetID=%v, pulse=%v", jetID, pn)
 	jetState.lastok = pn
 	return nil
@@ -258,5 +377,6 @@ func (s *Sync) Reset(ctx context.Context, jetID core.RecordID, pn core.PulseNumb
 
 	inslogger.FromContext(ctx).Debugf("heavyserver: Reset sync: jetID=%v, pulse=%v", jetID, pn)
 	jetState.syncpulse = nil
-	return nil
+	jetState.progress = core.HeavySyncProgress{}
+	return s.ReplicaStorage.SetHeavySyncProgress(ctx, jetID, pn, core.HeavySyncProgress{})
 }
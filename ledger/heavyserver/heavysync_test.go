@@ -23,6 +23,7 @@ import (
 
 	"github.com/insolar/insolar/component"
 	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/core/reply"
 	"github.com/insolar/insolar/gen"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/ledger/storage"
@@ -30,6 +31,7 @@ import (
 	"github.com/insolar/insolar/ledger/storage/storagetest"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -45,6 +47,8 @@ type heavysyncSuite struct {
 
 	pulseTracker   storage.PulseTracker
 	replicaStorage storage.ReplicaStorage
+	secondaryIndex storage.SecondaryIndex
+	jetStorage     storage.JetStorage
 
 	sync *Sync
 }
@@ -70,12 +74,16 @@ func (s *heavysyncSuite) BeforeTest(suiteName, testName string) {
 	s.cleaner = cleaner
 	s.pulseTracker = storage.NewPulseTracker()
 	s.replicaStorage = storage.NewReplicaStorage()
+	s.secondaryIndex = storage.NewSecondaryIndex()
+	s.jetStorage = storage.NewJetStorage()
 
 	s.cm.Inject(
 		platformpolicy.NewPlatformCryptographyScheme(),
 		s.db,
 		s.pulseTracker,
 		s.replicaStorage,
+		s.secondaryIndex,
+		s.jetStorage,
 	)
 
 	err := s.cm.Init(s.ctx)
@@ -108,32 +116,34 @@ func (s *heavysyncSuite) TestHeavy_SyncBasic() {
 
 	sync := NewSync(s.db)
 	sync.ReplicaStorage = s.replicaStorage
-	err = sync.Start(s.ctx, jetID, pnum)
+	sync.SecondaryIndex = s.secondaryIndex
+	sync.JetStorage = s.jetStorage
+	_, err = sync.Start(s.ctx, jetID, pnum)
 	require.Error(s.T(), err, "start with zero pulse")
 
-	err = sync.Store(s.ctx, jetID, pnum, kvalues)
+	_, err = sync.Store(s.ctx, jetID, pnum, 0, core.KVChecksum(kvalues), kvalues)
 	require.Error(s.T(), err, "store values on non started sync")
 
 	err = sync.Stop(s.ctx, jetID, pnum)
 	require.Error(s.T(), err, "stop on non started sync")
 
 	pnum = 5
-	err = sync.Start(s.ctx, jetID, pnum)
+	_, err = sync.Start(s.ctx, jetID, pnum)
 	require.Error(s.T(), err, "last synced pulse is less when 'first pulse number'")
 
 	pnum = core.FirstPulseNumber
-	err = sync.Start(s.ctx, jetID, pnum)
+	_, err = sync.Start(s.ctx, jetID, pnum)
 	require.Error(s.T(), err, "start from first pulse on empty storage")
 
 	pnum = core.FirstPulseNumber + 1
-	err = sync.Start(s.ctx, jetID, pnum)
+	_, err = sync.Start(s.ctx, jetID, pnum)
 	require.NoError(s.T(), err, "start sync on empty heavy jet with non first pulse number")
 
-	err = sync.Start(s.ctx, jetID, pnum)
-	require.Error(s.T(), err, "double start")
+	_, err = sync.Start(s.ctx, jetID, pnum)
+	require.NoError(s.T(), err, "double start resumes sync")
 
 	pnumNext := pnum + 1
-	err = sync.Start(s.ctx, jetID, pnumNext)
+	_, err = sync.Start(s.ctx, jetID, pnumNext)
 	require.Error(s.T(), err, "start next pulse sync when previous not end")
 
 	// stop previous
@@ -142,7 +152,7 @@ func (s *heavysyncSuite) TestHeavy_SyncBasic() {
 
 	// start sparse next
 	pnumNextPlus := pnumNext + 1
-	err = sync.Start(s.ctx, jetID, pnumNextPlus)
+	_, err = sync.Start(s.ctx, jetID, pnumNextPlus)
 	require.NoError(s.T(), err, "sparse sync is ok")
 	err = sync.Stop(s.ctx, jetID, pnumNextPlus)
 	require.NoError(s.T(), err)
@@ -158,18 +168,18 @@ func (s *heavysyncSuite) TestHeavy_SyncBasic() {
 	preparepulse(pnum)
 	preparepulse(pnumNext) // should set correct next for previous pulse
 
-	err = sync.Start(s.ctx, jetID, pnumNext)
+	_, err = sync.Start(s.ctx, jetID, pnumNext)
 	require.NoError(s.T(), err, "start next pulse")
 
-	err = sync.Store(s.ctx, jetID, pnumNextPlus, kvalues)
+	_, err = sync.Store(s.ctx, jetID, pnumNextPlus, 0, core.KVChecksum(kvalues), kvalues)
 	require.Error(s.T(), err, "store from other pulse at the same jet")
 
 	err = sync.Stop(s.ctx, jetID, pnumNextPlus)
 	require.Error(s.T(), err, "stop from other pulse at the same jet")
 
-	err = sync.Store(s.ctx, jetID, pnumNext, kvalues)
+	_, err = sync.Store(s.ctx, jetID, pnumNext, 0, core.KVChecksum(kvalues), kvalues)
 	require.NoError(s.T(), err, "store on current range")
-	err = sync.Store(s.ctx, jetID, pnumNext, kvalues)
+	_, err = sync.Store(s.ctx, jetID, pnumNext, 0, core.KVChecksum(kvalues), kvalues)
 	require.NoError(s.T(), err, "store the same on current range")
 	err = sync.Stop(s.ctx, jetID, pnumNext)
 	require.NoError(s.T(), err, "stop current range")
@@ -177,9 +187,11 @@ func (s *heavysyncSuite) TestHeavy_SyncBasic() {
 	preparepulse(pnumNextPlus) // should set corret next for previous pulse
 	sync = NewSync(s.db)
 	sync.ReplicaStorage = s.replicaStorage
-	err = sync.Start(s.ctx, jetID, pnumNextPlus)
+	sync.SecondaryIndex = s.secondaryIndex
+	sync.JetStorage = s.jetStorage
+	_, err = sync.Start(s.ctx, jetID, pnumNextPlus)
 	require.NoError(s.T(), err, "start next+1 range on new sync instance (checkpoint check)")
-	err = sync.Store(s.ctx, jetID, pnumNextPlus, kvalues)
+	_, err = sync.Store(s.ctx, jetID, pnumNextPlus, 0, core.KVChecksum(kvalues), kvalues)
 	require.NoError(s.T(), err, "store next+1 pulse")
 	err = sync.Stop(s.ctx, jetID, pnumNextPlus)
 	require.NoError(s.T(), err, "stop next+1 range on new sync instance")
@@ -204,31 +216,38 @@ func (s *heavysyncSuite) TestHeavy_SyncByJet() {
 
 	sync := NewSync(s.db)
 	sync.ReplicaStorage = s.replicaStorage
+	sync.SecondaryIndex = s.secondaryIndex
+	sync.JetStorage = s.jetStorage
 
 	pnum = core.FirstPulseNumber + 1
 	pnumNext := pnum + 1
 	preparepulse(s, pnum)
 	preparepulse(s, pnumNext) // should set correct next for previous pulse
 
-	err = sync.Start(s.ctx, jetID1, core.FirstPulseNumber)
+	_, err = sync.Start(s.ctx, jetID1, core.FirstPulseNumber)
 	require.Error(s.T(), err)
 
-	err = sync.Start(s.ctx, jetID1, pnum)
+	_, err = sync.Start(s.ctx, jetID1, pnum)
 	require.NoError(s.T(), err, "start from first+1 pulse on empty storage, jet1")
 
-	err = sync.Start(s.ctx, jetID2, pnum)
+	_, err = sync.Start(s.ctx, jetID2, pnum)
 	require.NoError(s.T(), err, "start from first+1 pulse on empty storage, jet2")
 
-	err = sync.Store(s.ctx, jetID2, pnum, kvalues2)
+	_, err = sync.Store(s.ctx, jetID2, pnum, 0, core.KVChecksum(kvalues2), kvalues2)
 	require.NoError(s.T(), err, "store jet2 pulse")
 
-	err = sync.Store(s.ctx, jetID1, pnum, kvalues1)
+	_, err = sync.Store(s.ctx, jetID1, pnum, 0, core.KVChecksum(kvalues1), kvalues1)
 	require.NoError(s.T(), err, "store jet1 pulse")
 
 	// stop previous
 	err = sync.Stop(s.ctx, jetID1, pnum)
 	err = sync.Stop(s.ctx, jetID2, pnum)
 	require.NoError(s.T(), err)
+
+	jets, err := s.jetStorage.GetJets(s.ctx)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), jets.Has(jetID1), "synced jet1 is registered")
+	assert.True(s.T(), jets.Has(jetID2), "synced jet2 is registered")
 }
 
 func (s *heavysyncSuite) TestHeavy_SyncLockOnPrefix() {
@@ -241,23 +260,25 @@ func (s *heavysyncSuite) TestHeavy_SyncLockOnPrefix() {
 
 	sync := NewSync(s.db)
 	sync.ReplicaStorage = s.replicaStorage
+	sync.SecondaryIndex = s.secondaryIndex
+	sync.JetStorage = s.jetStorage
 
 	pnum = core.FirstPulseNumber + 2
 	// should set correct next for previous pulse
 	preparepulse(s, pnum-1)
 	preparepulse(s, pnum)
 
-	err = sync.Start(s.ctx, jetID1, pnum)
+	_, err = sync.Start(s.ctx, jetID1, pnum)
 	require.NoError(s.T(), err, "all should be ok")
 
-	err = sync.Start(s.ctx, jetID2, pnum)
+	_, err = sync.Start(s.ctx, jetID2, pnum)
 	require.Error(s.T(), err, "should not start on same prefix")
 
 	// stop previous sync (only prefix matters)
 	err = sync.Stop(s.ctx, jetID2, pnum)
 	require.NoError(s.T(), err)
 
-	err = sync.Start(s.ctx, jetID2, pnum+1)
+	_, err = sync.Start(s.ctx, jetID2, pnum+1)
 	require.NoError(s.T(), err, "should start after released lock")
 }
 
@@ -267,7 +288,9 @@ func (s *heavysyncSuite) TestHeavy_Timeout() {
 
 	sync := NewSync(s.db)
 	sync.ReplicaStorage = s.replicaStorage
-	err := sync.Start(s.ctx, jetID, pn)
+	sync.SecondaryIndex = s.secondaryIndex
+	sync.JetStorage = s.jetStorage
+	_, err := sync.Start(s.ctx, jetID, pn)
 	require.NoError(s.T(), err)
 	state := sync.getJetSyncState(s.ctx, jetID)
 	state.Lock()
@@ -282,8 +305,121 @@ func (s *heavysyncSuite) TestHeavy_Timeout() {
 	state.Unlock()
 }
 
+func (s *heavysyncSuite) TestHeavy_SyncResume() {
+	jetID := testutils.RandomJet()
+	pnum := core.PulseNumber(core.FirstPulseNumber + 1)
+	chunks := [][]core.KV{
+		{{K: []byte("1_11"), V: []byte("1_12")}},
+		{{K: []byte("2_21"), V: []byte("2_22")}},
+		{{K: []byte("3_31"), V: []byte("3_32")}},
+	}
+	checksum := func(chunk uint32) []byte {
+		return core.KVChecksum(chunks[chunk])
+	}
+	requireHeavyError := func(err error, subtype reply.ErrType) {
+		herr, ok := err.(*reply.HeavyError)
+		require.True(s.T(), ok, "expected heavy error, got %v", err)
+		assert.Equal(s.T(), subtype, herr.SubType)
+		assert.True(s.T(), herr.IsRetryable())
+	}
+
+	sync := NewSync(s.db)
+	sync.ReplicaStorage = s.replicaStorage
+	sync.SecondaryIndex = s.secondaryIndex
+	sync.JetStorage = s.jetStorage
+	progress, err := sync.Start(s.ctx, jetID, pnum)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), core.HeavySyncProgress{}, progress)
+
+	for chunk := uint32(0); chunk < 2; chunk++ {
+		progress, err = sync.Store(s.ctx, jetID, pnum, chunk, checksum(chunk), chunks[chunk])
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), core.HeavySyncProgress{Chunks: chunk + 1, Checksum: checksum(chunk)}, progress)
+	}
+
+	// heavy restart
+	sync = NewSync(s.db)
+	sync.ReplicaStorage = s.replicaStorage
+	sync.SecondaryIndex = s.secondaryIndex
+	sync.JetStorage = s.jetStorage
+	progress, err = sync.Start(s.ctx, jetID, pnum)
+	require.NoError(s.T(), err, "start resumes sync")
+	assert.Equal(s.T(), core.HeavySyncProgress{Chunks: 2, Checksum: checksum(1)}, progress)
+
+	_, err = sync.Store(s.ctx, jetID, pnum, 2, checksum(1), chunks[2])
+	requireHeavyError(err, reply.ErrHeavySyncChecksum)
+
+	_, err = sync.Store(s.ctx, jetID, pnum, 3, checksum(2), chunks[2])
+	requireHeavyError(err, reply.ErrHeavySyncChunk)
+
+	_, err = sync.Store(s.ctx, jetID, pnum, 1, checksum(2), chunks[2])
+	requireHeavyError(err, reply.ErrHeavySyncChunk)
+
+	progress, err = sync.Store(s.ctx, jetID, pnum, 1, checksum(1), chunks[1])
+	require.NoError(s.T(), err, "retransmitted chunk is accepted")
+	assert.Equal(s.T(), core.HeavySyncProgress{Chunks: 2, Checksum: checksum(1)}, progress)
+
+	// client restart
+	progress, err = sync.Start(s.ctx, jetID, pnum)
+	require.NoError(s.T(), err, "start resumes sync")
+	assert.Equal(s.T(), core.HeavySyncProgress{Chunks: 2, Checksum: checksum(1)}, progress)
+
+	progress, err = sync.Store(s.ctx, jetID, pnum, 2, checksum(2), chunks[2])
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), core.HeavySyncProgress{Chunks: 3, Checksum: checksum(2)}, progress)
+
+	err = sync.Stop(s.ctx, jetID, pnum)
+	require.NoError(s.T(), err)
+
+	tx := s.db.GetKVStore().NewTransaction(false)
+	for _, chunk := range chunks {
+		value, err := tx.Get(chunk[0].K)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), chunk[0].V, value)
+	}
+	tx.Discard()
+
+	// restart of the pulse from the first chunk
+	_, err = sync.Start(s.ctx, jetID, pnum+1)
+	require.NoError(s.T(), err)
+	_, err = sync.Store(s.ctx, jetID, pnum+1, 0, checksum(0), chunks[0])
+	require.NoError(s.T(), err)
+	progress, err = sync.Store(s.ctx, jetID, pnum+1, 0, checksum(1), chunks[1])
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), core.HeavySyncProgress{Chunks: 1, Checksum: checksum(1)}, progress)
+}
+
 func preparepulse(s *heavysyncSuite, pn core.PulseNumber) {
 	pulse := core.Pulse{PulseNumber: pn}
 	err := s.pulseTracker.AddPulse(s.ctx, pulse)
 	require.NoError(s.T(), err)
 }
+
+func (s *heavysyncSuite) TestHeavy_StoreIndexesRecords() {
+	jetID := testutils.RandomJet()
+	pnum := core.PulseNumber(core.FirstPulseNumber + 1)
+	kvalues := []core.KV{
+		{K: []byte("100"), V: []byte("500")},
+	}
+
+	var indexErr error
+	index := storage.NewSecondaryIndexMock(s.T())
+	index.IndexKeyValuesFunc = func(ctx context.Context, kvs []core.KV) error {
+		assert.Equal(s.T(), kvalues, kvs)
+		return indexErr
+	}
+
+	sync := NewSync(s.db)
+	sync.ReplicaStorage = s.replicaStorage
+	sync.SecondaryIndex = index
+	sync.JetStorage = s.jetStorage
+	_, err := sync.Start(s.ctx, jetID, pnum)
+	require.NoError(s.T(), err)
+	_, err = sync.Store(s.ctx, jetID, pnum, 0, core.KVChecksum(kvalues), kvalues)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), uint64(1), index.IndexKeyValuesCounter)
+
+	indexErr = errors.New("index failed")
+	_, err = sync.Store(s.ctx, jetID, pnum, 0, core.KVChecksum(kvalues), kvalues)
+	require.Error(s.T(), err, "indexing error fails store")
+}
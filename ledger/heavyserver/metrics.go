@@ -34,6 +34,9 @@ var (
 	statSyncedPulse   = stats.Int64("heavyserver/synced/pulse", "Last synced pulse", stats.UnitDimensionless)
 	statSyncedBytes   = stats.Int64("heavyserver/synced/bytes", "Amount of synced records in bytes", stats.UnitBytes)
 	statSyncedTimeout = stats.Int64("heavyserver/synced/timeout", "Number of timeouts on sync", stats.UnitDimensionless)
+
+	statSyncedResumed  = stats.Int64("heavyserver/synced/resumed", "Number of resumed pulse syncs", stats.UnitDimensionless)
+	statSyncedRejected = stats.Int64("heavyserver/synced/rejected", "Number of rejected chunks", stats.UnitDimensionless)
 )
 
 func init() {
@@ -74,6 +77,20 @@ func init() {
 			Aggregation: view.Count(),
 			TagKeys:     commontags,
 		},
+		&view.View{
+			Name:        statSyncedResumed.Name(),
+			Description: statSyncedResumed.Description(),
+			Measure:     statSyncedResumed,
+			Aggregation: view.Count(),
+			TagKeys:     commontags,
+		},
+		&view.View{
+			Name:        statSyncedRejected.Name(),
+			Description: statSyncedRejected.Description(),
+			Measure:     statSyncedRejected,
+			Aggregation: view.Count(),
+			TagKeys:     commontags,
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -98,6 +98,7 @@ func GetLedgerComponents(conf configuration.Ledger, certificate core.Certificate
 	return []interface{}{
 		db,
 		storage.NewCleaner(),
+		storage.NewArchiver(conf.Archive),
 		pulseTracker,
 		storage.NewPulseStorage(),
 		storage.NewJetStorage(),
@@ -105,6 +106,7 @@ func GetLedgerComponents(conf configuration.Ledger, certificate core.Certificate
 		node.NewStorage(),
 		storage.NewObjectStorage(),
 		storage.NewReplicaStorage(),
+		storage.NewSecondaryIndex(),
 		storage.NewGenesisInitializer(),
 		recentstorage.NewRecentStorageProvider(conf.RecentStorage.DefaultTTL),
 		artifactmanager.NewHotDataWaiterConcrete(),
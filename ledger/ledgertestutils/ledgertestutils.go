@@ -65,7 +65,9 @@ func TmpLedger(t *testing.T, dir string, handlersRole core.StaticRole, c core.Co
 	ns := node.NewStorage()
 	ds := storage.NewDropStorage(10)
 	rs := storage.NewReplicaStorage()
+	si := storage.NewSecondaryIndex()
 	cl := storage.NewCleaner()
+	ar := storage.NewArchiver(conf.Archive)
 
 	am := artifactmanager.NewArtifactManger()
 	am.PlatformCryptographyScheme = pcs
@@ -108,6 +110,7 @@ func TmpLedger(t *testing.T, dir string, handlersRole core.StaticRole, c core.Co
 	handler.DBContext = db
 	handler.ObjectStorage = os
 	handler.DropStorage = ds
+	handler.SecondaryIndex = si
 
 	handler.PlatformCryptographyScheme = pcs
 	handler.JetCoordinator = jc
@@ -127,7 +130,9 @@ func TmpLedger(t *testing.T, dir string, handlersRole core.StaticRole, c core.Co
 		gi,
 		am,
 		rs,
+		si,
 		cl,
+		ar,
 	)
 
 	err := cm.Init(ctx)
@@ -166,6 +171,7 @@ func TmpLedger(t *testing.T, dir string, handlersRole core.StaticRole, c core.Co
 	pm.PulseTracker = pt
 	pm.ReplicaStorage = rs
 	pm.StorageCleaner = cl
+	pm.Archiver = ar
 
 	hdw := artifactmanager.NewHotDataWaiterConcrete()
 
@@ -17,6 +17,7 @@
 package pulsemanager
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/rand"
@@ -42,6 +43,7 @@ import (
 	"github.com/insolar/insolar/ledger/storage"
 	"github.com/insolar/insolar/ledger/storage/index"
 	"github.com/insolar/insolar/ledger/storage/jet"
+	"github.com/insolar/insolar/network/merkle"
 )
 
 //go:generate minimock -i github.com/insolar/insolar/ledger/pulsemanager.ActiveListSwapper -o ../../testutils -s _mock.go
@@ -71,6 +73,7 @@ type PulseManager struct {
 	ReplicaStorage             storage.ReplicaStorage          `inject:""`
 	DBContext                  storage.DBContext               `inject:""`
 	StorageCleaner             storage.Cleaner                 `inject:""`
+	Archiver                   storage.Archiver                `inject:""`
 
 	// TODO: move clients pool to component - @nordicdyno - 18.Dec.2018
 	syncClientsPool *heavyclient.Pool
@@ -82,6 +85,10 @@ type PulseManager struct {
 	// saves PM stopping mode
 	stopped bool
 
+	// flushPulse is a pulse when pending pulses are flushed to heavy node (set by node leave)
+	flushPulse core.PulseNumber
+	flushLock  sync.Mutex
+
 	// stores pulse manager options
 	options pmOptions
 }
@@ -91,16 +98,21 @@ type jetInfo struct {
 	mineNext bool
 	left     *jetInfo
 	right    *jetInfo
+	// merged contains sibling jets that were merged into this jet.
+	merged []core.RecordID
 }
 
 // TODO: @andreyromancev. 15.01.19. Just store ledger configuration in PM. This is not required.
 type pmOptions struct {
 	enableSync            bool
 	splitThreshold        uint64
+	mergeThreshold        uint64
 	dropHistorySize       int
 	storeLightPulses      int
 	heavySyncMessageLimit int
 	lightChainLimit       int
+	archiveLag            int
+	archiveSegmentPulses  int
 }
 
 // NewPulseManager creates PulseManager instance.
@@ -112,10 +124,13 @@ func NewPulseManager(conf configuration.Ledger) *PulseManager {
 		options: pmOptions{
 			enableSync:            pmconf.HeavySyncEnabled,
 			splitThreshold:        pmconf.SplitThreshold,
+			mergeThreshold:        pmconf.MergeThreshold,
 			dropHistorySize:       conf.JetSizesHistoryDepth,
 			storeLightPulses:      conf.LightChainLimit,
 			heavySyncMessageLimit: pmconf.HeavySyncMessageLimit,
 			lightChainLimit:       conf.LightChainLimit,
+			archiveLag:            conf.Archive.Lag,
+			archiveSegmentPulses:  conf.Archive.SegmentPulses,
 		},
 	}
 	return pm
@@ -136,11 +151,6 @@ func (m *PulseManager) processEndPulse(
 		info := i
 
 		g.Go(func() error {
-			drop, dropSerialized, _, err := m.createDrop(ctx, info.id, prevPulseNumber, currentPulse.PulseNumber)
-			if err != nil {
-				return errors.Wrapf(err, "create drop on pulse %v failed", currentPulse.PulseNumber)
-			}
-
 			sender := func(msg message.HotData, jetID core.RecordID) {
 				ctx, span := instracer.StartSpan(ctx, "pulse.send_hot")
 				defer span.End()
@@ -159,6 +169,28 @@ func (m *PulseManager) processEndPulse(
 				}
 			}
 
+			if len(info.merged) > 0 {
+				msg, err := m.createMergedDrop(
+					ctx, info, prevPulseNumber, currentPulse.PulseNumber, newPulse.PulseNumber,
+				)
+				if err != nil {
+					return errors.Wrapf(err, "create merged drop on pulse %v failed", currentPulse.PulseNumber)
+				}
+				// Merge happened.
+				if !info.mineNext {
+					go sender(*msg, info.id)
+				}
+				for _, jetID := range info.merged {
+					m.RecentStorageProvider.RemovePendingStorage(ctx, jetID)
+				}
+				return nil
+			}
+
+			drop, dropSerialized, _, err := m.createDrop(ctx, info.id, prevPulseNumber, currentPulse.PulseNumber)
+			if err != nil {
+				return errors.Wrapf(err, "create drop on pulse %v failed", currentPulse.PulseNumber)
+			}
+
 			if info.left == nil && info.right == nil {
 				msg, err := m.getExecutorHotData(
 					ctx, info.id, newPulse.PulseNumber, drop, dropSerialized,
@@ -214,6 +246,22 @@ func (m *PulseManager) createDrop(
 	dropSerialized []byte,
 	messages [][]byte,
 	err error,
+) {
+	return m.createLinkedDrop(ctx, jetID, prevPulse, currentPulse, nil)
+}
+
+// createLinkedDrop creates drop of the jet. If link is provided, previous hash of the drop commits both to the previous
+// drop of the jet and to the link.
+func (m *PulseManager) createLinkedDrop(
+	ctx context.Context,
+	jetID core.RecordID,
+	prevPulse, currentPulse core.PulseNumber,
+	link []byte,
+) (
+	drop *jet.JetDrop,
+	dropSerialized []byte,
+	messages [][]byte,
+	err error,
 ) {
 	var prevDrop *jet.JetDrop
 	prevDrop, err = m.DropStorage.GetDrop(ctx, jetID, prevPulse)
@@ -236,7 +284,12 @@ func (m *PulseManager) createDrop(
 		return nil, nil, nil, errors.Wrap(err, "[ createDrop ] Can't GetDrop")
 	}
 
-	drop, messages, dropSize, err := m.DropStorage.CreateDrop(ctx, jetID, currentPulse, prevDrop.Hash)
+	prevHash := prevDrop.Hash
+	if link != nil {
+		prevHash = merkle.DropHash(m.PlatformCryptographyScheme, prevHash, link)
+	}
+
+	drop, messages, dropSize, err := m.DropStorage.CreateDrop(ctx, jetID, currentPulse, prevHash)
 	if err != nil {
 		return nil, nil, nil, errors.Wrap(err, "[ createDrop ] Can't CreateDrop")
 	}
@@ -254,6 +307,7 @@ func (m *PulseManager) createDrop(
 		JetID:    jetID,
 		PulseNo:  currentPulse,
 		DropSize: dropSize,
+		DropHash: drop.Hash,
 	}
 	hasher := m.PlatformCryptographyScheme.IntegrityHasher()
 	_, err = dropSizeData.WriteHashData(hasher)
@@ -275,6 +329,60 @@ func (m *PulseManager) createDrop(
 	return
 }
 
+// createMergedDrop creates drops of the merged jets. Left jet and the resulting one share storage keys, so drop of the
+// left jet becomes the drop of the resulting jet: its previous hash links previous left drop and the last right drop.
+// Returned hot data contains recent objects and pending requests of all merged jets.
+func (m *PulseManager) createMergedDrop(
+	ctx context.Context,
+	info jetInfo,
+	prevPulse, currentPulse, newPulse core.PulseNumber,
+) (*message.HotData, error) {
+	if len(info.merged) != 2 {
+		return nil, errors.New("[ createMergedDrop ] exactly two jets should be merged")
+	}
+	leftID, rightID := info.merged[0], info.merged[1]
+	_, parentPrefix := jet.Jet(info.id)
+	if _, prefix := jet.Jet(leftID); !bytes.Equal(prefix, parentPrefix) {
+		leftID, rightID = rightID, leftID
+	}
+
+	rightDrop, rightSerialized, _, err := m.createDrop(ctx, rightID, prevPulse, currentPulse)
+	if err != nil {
+		return nil, errors.Wrapf(err, "[ createMergedDrop ] Can't create drop for jet %v", rightID.DebugString())
+	}
+	hotData, err := m.getExecutorHotData(ctx, rightID, newPulse, rightDrop, rightSerialized)
+	if err != nil {
+		return nil, errors.Wrapf(err, "[ createMergedDrop ] getExecutorData failed for jet id %v", rightID)
+	}
+
+	drop, dropSerialized, _, err := m.createLinkedDrop(ctx, leftID, prevPulse, currentPulse, rightDrop.Hash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "[ createMergedDrop ] Can't create drop for jet %v", leftID.DebugString())
+	}
+	leftData, err := m.getExecutorHotData(ctx, leftID, newPulse, drop, dropSerialized)
+	if err != nil {
+		return nil, errors.Wrapf(err, "[ createMergedDrop ] getExecutorData failed for jet id %v", leftID)
+	}
+	for id, hotIndex := range leftData.RecentObjects {
+		hotData.RecentObjects[id] = hotIndex
+	}
+	for id, pending := range leftData.PendingRequests {
+		hotData.PendingRequests[id] = pending
+	}
+
+	// Sizes history of the merged jet is not relevant for the resulting one.
+	err = m.DropStorage.SetDropSizeHistory(ctx, info.id, jet.DropSizeHistory{})
+	if err != nil {
+		return nil, errors.Wrap(err, "[ createMergedDrop ] Can't SetDropSizeHistory")
+	}
+
+	hotData.Drop = *drop
+	hotData.DropJet = info.id
+	hotData.JetDropSizeHistory = jet.DropSizeHistory{}
+	hotData.Merged = true
+	return hotData, nil
+}
+
 func (m *PulseManager) getExecutorHotData(
 	ctx context.Context,
 	jetID core.RecordID,
@@ -361,7 +469,16 @@ func (m *PulseManager) processJets(ctx context.Context, currentPulse, newPulse c
 		"new_pulse":     newPulse,
 	})
 	indexToSplit := rand.Intn(len(jetIDs))
+	leaves := map[core.RecordID]struct{}{}
+	for _, jetID := range jetIDs {
+		leaves[jetID] = struct{}{}
+	}
 	for i, jetID := range jetIDs {
+		if _, ok := leaves[jetID]; !ok {
+			// Jet was merged with its sibling.
+			continue
+		}
+
 		wasExecutor := false
 		executor, err := m.JetCoordinator.LightExecutorForJet(ctx, jetID, currentPulse)
 		if err != nil && err != core.ErrNoNodes {
@@ -381,6 +498,7 @@ func (m *PulseManager) processJets(ctx context.Context, currentPulse, newPulse c
 		info := jetInfo{id: jetID}
 		if indexToSplit == i && splitCount > 0 {
 			splitCount--
+			delete(leaves, jetID)
 
 			leftJetID, rightJetID, err := m.JetStorage.SplitJetTree(
 				ctx,
@@ -426,6 +544,39 @@ func (m *PulseManager) processJets(ctx context.Context, currentPulse, newPulse c
 				"left_child":  leftJetID.DebugString(),
 				"right_child": rightJetID.DebugString(),
 			}).Info("jet split performed")
+		} else if m.shouldMerge(ctx, jetID, leaves, currentPulse) {
+			siblingID := jet.Sibling(jetID)
+			delete(leaves, jetID)
+			delete(leaves, siblingID)
+
+			parentID, err := m.JetStorage.MergeJetTree(ctx, newPulse, jetID)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to merge jet tree")
+			}
+			err = m.JetStorage.AddJets(ctx, *parentID)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to add jets")
+			}
+			// Set actual because we are the last executor for both merged jets.
+			m.JetStorage.UpdateJetTree(ctx, newPulse, true, *parentID)
+
+			info = jetInfo{id: *parentID, merged: []core.RecordID{jetID, siblingID}}
+			nextExecutor, err := m.JetCoordinator.LightExecutorForJet(ctx, *parentID, newPulse)
+			if err != nil {
+				return nil, err
+			}
+			if *nextExecutor == me {
+				info.mineNext = true
+				err := m.mergeHotData(ctx, jetID, siblingID, *parentID)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			logger.WithFields(map[string]interface{}{
+				"sibling": siblingID.DebugString(),
+				"parent":  parentID.DebugString(),
+			}).Info("jet merge performed")
 		} else {
 			// Set actual because we are the last executor for jet.
 			m.JetStorage.UpdateJetTree(ctx, newPulse, true, jetID)
@@ -443,7 +594,80 @@ func (m *PulseManager) processJets(ctx context.Context, currentPulse, newPulse c
 	return results, nil
 }
 
+// shouldMerge checks if the jet can be merged with its sibling. Jets are merged only if the node was executor for both
+// of them and all drops in their sizes history are smaller than merge threshold.
+func (m *PulseManager) shouldMerge(
+	ctx context.Context, jetID core.RecordID, leaves map[core.RecordID]struct{}, currentPulse core.PulseNumber,
+) bool {
+	if m.options.mergeThreshold == 0 {
+		return false
+	}
+	if depth, _ := jet.Jet(jetID); depth == 0 {
+		return false
+	}
+	siblingID := jet.Sibling(jetID)
+	if _, ok := leaves[siblingID]; !ok {
+		return false
+	}
+
+	logger := inslogger.FromContext(ctx)
+	executor, err := m.JetCoordinator.LightExecutorForJet(ctx, siblingID, currentPulse)
+	if err != nil {
+		logger.Error(errors.Wrap(err, "failed to calculate sibling executor"))
+		return false
+	}
+	if *executor != m.JetCoordinator.Me() {
+		return false
+	}
+
+	for _, id := range []core.RecordID{jetID, siblingID} {
+		history, err := m.DropStorage.GetDropSizeHistory(ctx, id)
+		if err != nil {
+			logger.Error(errors.Wrap(err, "failed to fetch drop sizes history"))
+			return false
+		}
+		// Not enough statistics. Jet was probably split recently.
+		if len(history) < m.options.dropHistorySize {
+			return false
+		}
+		for _, size := range history {
+			if size.DropSize >= m.options.mergeThreshold {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 func (m *PulseManager) rewriteHotData(ctx context.Context, fromJetID, toJetID core.RecordID) error {
+	err := m.rewriteIndexes(ctx, fromJetID, toJetID)
+	if err != nil {
+		return err
+	}
+
+	m.RecentStorageProvider.CloneIndexStorage(ctx, fromJetID, toJetID)
+	m.RecentStorageProvider.ClonePendingStorage(ctx, fromJetID, toJetID)
+
+	return nil
+}
+
+// mergeHotData is the reverse of rewriteHotData. It moves hot data of both merged jets to the resulting one.
+func (m *PulseManager) mergeHotData(ctx context.Context, leftJetID, rightJetID, toJetID core.RecordID) error {
+	for _, fromJetID := range []core.RecordID{leftJetID, rightJetID} {
+		err := m.rewriteIndexes(ctx, fromJetID, toJetID)
+		if err != nil {
+			return err
+		}
+	}
+
+	m.RecentStorageProvider.MergeIndexStorage(ctx, leftJetID, rightJetID, toJetID)
+	m.RecentStorageProvider.MergePendingStorage(ctx, leftJetID, rightJetID, toJetID)
+
+	return nil
+}
+
+func (m *PulseManager) rewriteIndexes(ctx context.Context, fromJetID, toJetID core.RecordID) error {
 	indexStorage := m.RecentStorageProvider.GetIndexStorage(ctx, fromJetID)
 
 	logger := inslogger.FromContext(ctx).WithFields(map[string]interface{}{
@@ -465,9 +689,6 @@ func (m *PulseManager) rewriteHotData(ctx context.Context, fromJetID, toJetID co
 		}
 	}
 
-	m.RecentStorageProvider.CloneIndexStorage(ctx, fromJetID, toJetID)
-	m.RecentStorageProvider.ClonePendingStorage(ctx, fromJetID, toJetID)
-
 	return nil
 }
 
@@ -506,9 +727,14 @@ func (m *PulseManager) Set(ctx context.Context, newPulse core.Pulse, persist boo
 		}
 		m.postProcessJets(ctx, newPulse, jets)
 		m.addSync(ctx, jets, oldPulse.PulseNumber)
+		m.flushOnPulse(ctx, newPulse.PulseNumber)
 		go m.cleanLightData(ctx, newPulse, jetIndexesRemoved)
 	}
 
+	if m.NodeNet.GetOrigin().Role() == core.StaticRoleHeavyMaterial && m.options.archiveLag > 0 {
+		go m.archiveHeavyData(ctx, newPulse)
+	}
+
 	err = m.Bus.OnPulse(ctx, newPulse)
 	if err != nil {
 		inslogger.FromContext(ctx).Error(errors.Wrap(err, "MessageBus OnPulse() returns error"))
@@ -644,10 +870,55 @@ func (m *PulseManager) addSync(ctx context.Context, jets []jetInfo, pulse core.P
 	}
 
 	for _, jInfo := range jets {
+		// Records of the pulse are stored in the merged jets.
+		for _, jetID := range jInfo.merged {
+			m.syncClientsPool.AddPulsesToSyncClient(ctx, jetID, true, pulse)
+		}
 		m.syncClientsPool.AddPulsesToSyncClient(ctx, jInfo.id, true, pulse)
 	}
 }
 
+// JetsSyncStatus returns replication state of jets synced to heavy.
+//
+// It's empty on nodes which are not light materials or have heavy sync disabled.
+func (m *PulseManager) JetsSyncStatus(ctx context.Context) ([]core.JetSyncStatus, error) {
+	if m.syncClientsPool == nil {
+		return []core.JetSyncStatus{}, nil
+	}
+	return m.syncClientsPool.SyncStatus(ctx)
+}
+
+// FlushHeavySync starts replication of pulses waiting for sync without waiting for the next pulse
+// and waits until it is finished or context is done.
+func (m *PulseManager) FlushHeavySync(ctx context.Context) error {
+	if m.syncClientsPool == nil {
+		return nil
+	}
+	return m.syncClientsPool.Flush(ctx)
+}
+
+// FlushHeavySyncOnPulse schedules replication of pulses waiting for sync when the pulse comes.
+func (m *PulseManager) FlushHeavySyncOnPulse(ctx context.Context, pn core.PulseNumber) {
+	m.flushLock.Lock()
+	m.flushPulse = pn
+	m.flushLock.Unlock()
+}
+
+func (m *PulseManager) flushOnPulse(ctx context.Context, pn core.PulseNumber) {
+	m.flushLock.Lock()
+	defer m.flushLock.Unlock()
+	if m.flushPulse == 0 || pn < m.flushPulse {
+		return
+	}
+	m.flushPulse = 0
+
+	go func() {
+		if err := m.FlushHeavySync(ctx); err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to flush heavy sync"))
+		}
+	}()
+}
+
 func (m *PulseManager) postProcessJets(ctx context.Context, newPulse core.Pulse, jets []jetInfo) {
 	ctx, span := instracer.StartSpan(ctx, "jets.post_process")
 	defer span.End()
@@ -698,6 +969,42 @@ func (m *PulseManager) cleanLightData(ctx context.Context, newPulse core.Pulse,
 	}
 }
 
+// archiveHeavyData archives heavy node data older than configured lag. Data is archived only when enough pulses for
+// a segment are collected.
+func (m *PulseManager) archiveHeavyData(ctx context.Context, newPulse core.Pulse) {
+	ctx, span := instracer.StartSpan(ctx, "pulse.archive")
+	defer span.End()
+	inslog := inslogger.FromContext(ctx)
+
+	until, err := m.PulseTracker.GetNthPrevPulse(ctx, uint(m.options.archiveLag), newPulse.PulseNumber)
+	if err != nil {
+		inslog.Debugf("Can't get %dth previous pulse: %s", m.options.archiveLag, err)
+		return
+	}
+	archived, err := m.Archiver.ArchivedPulse(ctx)
+	if err != nil {
+		inslog.Error(errors.Wrap(err, "can't get archived pulse"))
+		return
+	}
+	if archived != 0 {
+		segmentStart, err := m.PulseTracker.GetNthPrevPulse(
+			ctx, uint(m.options.archiveSegmentPulses), until.Pulse.PulseNumber,
+		)
+		if err != nil || segmentStart.Pulse.PulseNumber < archived {
+			return
+		}
+	}
+
+	_, err = m.Archiver.ArchiveUntil(ctx, until.Pulse.PulseNumber)
+	if err == storage.ErrArchiveInProgress {
+		inslog.Debug("previous archiving is not finished yet")
+		return
+	}
+	if err != nil {
+		inslog.Error(errors.Wrapf(err, "failed to archive data until pulse %v", until.Pulse.PulseNumber))
+	}
+}
+
 func (m *PulseManager) prepareArtifactManagerMessageHandlerForNextPulse(ctx context.Context, newPulse core.Pulse, jets []jetInfo) {
 	ctx, span := instracer.StartSpan(ctx, "early.close")
 	defer span.End()
@@ -707,7 +1014,7 @@ func (m *PulseManager) prepareArtifactManagerMessageHandlerForNextPulse(ctx cont
 	logger := inslogger.FromContext(ctx)
 	for _, jetInfo := range jets {
 		if jetInfo.left == nil && jetInfo.right == nil {
-			// No split happened.
+			// No split happened (or jets were merged).
 			if jetInfo.mineNext {
 				err := m.HotDataWaiter.Unlock(ctx, jetInfo.id)
 				if err != nil {
@@ -31,6 +31,7 @@ import (
 	"github.com/insolar/insolar/ledger/storage/jet"
 	"github.com/insolar/insolar/ledger/storage/record"
 	"github.com/insolar/insolar/ledger/storage/storagetest"
+	"github.com/insolar/insolar/network/merkle"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
 	"github.com/insolar/insolar/testutils/network"
@@ -47,6 +48,8 @@ type pulseManagerSuite struct {
 	cleaner func()
 
 	objectStorage storage.ObjectStorage
+	jetStorage    storage.JetStorage
+	dropStorage   storage.DropStorage
 }
 
 func NewPulseManagerSuite() *pulseManagerSuite {
@@ -67,11 +70,15 @@ func (s *pulseManagerSuite) BeforeTest(suiteName, testName string) {
 	db, cleaner := storagetest.TmpDB(s.ctx, s.T())
 	s.cleaner = cleaner
 	s.objectStorage = storage.NewObjectStorage()
+	s.jetStorage = storage.NewJetStorage()
+	s.dropStorage = storage.NewDropStorage(mergeHistoryDepth)
 
 	s.cm.Inject(
 		platformpolicy.NewPlatformCryptographyScheme(),
 		db,
 		s.objectStorage,
+		s.jetStorage,
+		s.dropStorage,
 	)
 
 	err := s.cm.Init(s.ctx)
@@ -228,3 +235,188 @@ func (s *pulseManagerSuite) TestPulseManager_Set_CheckHotIndexesSending() {
 	indexMock.MinimockFinish()
 	pendingMock.MinimockFinish()
 }
+
+const mergeHistoryDepth = 2
+
+// newMergePulseManager returns light pulse manager that is executor for all jets and merges jets with drops smaller
+// than 100 bytes.
+func (s *pulseManagerSuite) newMergePulseManager() *PulseManager {
+	me := testutils.RandomRef()
+
+	nodeMock := network.NewNodeMock(s.T())
+	nodeMock.RoleMock.Return(core.StaticRoleLightMaterial)
+	nodeNetworkMock := network.NewNodeNetworkMock(s.T())
+	nodeNetworkMock.GetOriginMock.Return(nodeMock)
+
+	jetCoordinatorMock := testutils.NewJetCoordinatorMock(s.T())
+	jetCoordinatorMock.MeMock.Return(me)
+	jetCoordinatorMock.LightExecutorForJetMock.Return(&me, nil)
+
+	indexMock := recentstorage.NewRecentIndexStorageMock(s.T())
+	indexMock.GetObjectsMock.Return(map[core.RecordID]int{})
+	pendingMock := recentstorage.NewPendingStorageMock(s.T())
+	pendingMock.GetRequestsMock.Return(map[core.RecordID]recentstorage.PendingObjectContext{})
+	providerMock := recentstorage.NewProviderMock(s.T())
+	providerMock.GetIndexStorageMock.Return(indexMock)
+	providerMock.GetPendingStorageMock.Return(pendingMock)
+	providerMock.MergeIndexStorageMock.Return()
+	providerMock.MergePendingStorageMock.Return()
+
+	cryptoServiceMock := testutils.NewCryptographyServiceMock(s.T())
+	cryptoServiceMock.SignFunc = func(p []byte) (*core.Signature, error) {
+		signature := core.SignatureFromBytes(nil)
+		return &signature, nil
+	}
+
+	pm := NewPulseManager(configuration.Ledger{
+		JetSizesHistoryDepth: mergeHistoryDepth,
+		PulseManager: configuration.PulseManager{
+			MergeThreshold: 100,
+		},
+	})
+	pm.NodeNet = nodeNetworkMock
+	pm.JetCoordinator = jetCoordinatorMock
+	pm.RecentStorageProvider = providerMock
+	pm.CryptographyService = cryptoServiceMock
+	pm.PlatformCryptographyScheme = platformpolicy.NewPlatformCryptographyScheme()
+	pm.JetStorage = s.jetStorage
+	pm.DropStorage = s.dropStorage
+	pm.ObjectStorage = s.objectStorage
+	return pm
+}
+
+func (s *pulseManagerSuite) setDropSizes(jetID core.RecordID, size uint64) {
+	for i := 0; i < mergeHistoryDepth; i++ {
+		err := s.dropStorage.AddDropSize(s.ctx, &jet.DropSize{
+			JetID:    jetID,
+			PulseNo:  core.FirstPulseNumber + core.PulseNumber(i),
+			DropSize: size,
+		})
+		require.NoError(s.T(), err)
+	}
+}
+
+func (s *pulseManagerSuite) TestPulseManager_processJets_Merge() {
+	defer func(count int) { splitCount = count }(splitCount)
+	splitCount = 0
+
+	currentPulse := core.PulseNumber(core.FirstPulseNumber + 10)
+	newPulse := currentPulse + 1
+	root := *jet.NewID(0, nil)
+	left := *jet.NewID(1, nil)
+	right := *jet.NewID(1, []byte{0x80}) // 10000000
+	objectID := core.NewRecordID(newPulse, []byte{0xFF})
+
+	s.T().Run("merges cold siblings", func(t *testing.T) {
+		pm := s.newMergePulseManager()
+		s.jetStorage.UpdateJetTree(s.ctx, currentPulse, true, left, right)
+		s.setDropSizes(left, 10)
+		s.setDropSizes(right, 10)
+
+		infos, err := pm.processJets(s.ctx, currentPulse, newPulse)
+		require.NoError(t, err)
+
+		require.Equal(t, 1, len(infos))
+		assert.Equal(t, root, infos[0].id)
+		assert.True(t, infos[0].mineNext)
+		assert.ElementsMatch(t, []core.RecordID{left, right}, infos[0].merged)
+
+		jetID, actual := s.jetStorage.FindJet(s.ctx, newPulse, *objectID)
+		assert.Equal(t, root, *jetID)
+		assert.True(t, actual)
+		// Tree of the previous pulse is not changed.
+		jetID, _ = s.jetStorage.FindJet(s.ctx, currentPulse, *objectID)
+		assert.Equal(t, right, *jetID)
+
+		jets, err := s.jetStorage.GetJets(s.ctx)
+		require.NoError(t, err)
+		assert.True(t, jets.Has(root))
+	})
+
+	s.T().Run("keeps hot siblings split", func(t *testing.T) {
+		pm := s.newMergePulseManager()
+		hotPulse := newPulse + 1
+		s.jetStorage.UpdateJetTree(s.ctx, newPulse, true, left, right)
+		s.setDropSizes(left, 10)
+		s.setDropSizes(right, 100)
+
+		infos, err := pm.processJets(s.ctx, newPulse, hotPulse)
+		require.NoError(t, err)
+
+		require.Equal(t, 2, len(infos))
+		for _, info := range infos {
+			assert.Empty(t, info.merged)
+		}
+		jetID, actual := s.jetStorage.FindJet(s.ctx, hotPulse, *objectID)
+		assert.Equal(t, right, *jetID)
+		assert.True(t, actual)
+	})
+}
+
+func (s *pulseManagerSuite) TestPulseManager_createMergedDrop() {
+	pm := s.newMergePulseManager()
+	prevPulse := core.PulseNumber(core.FirstPulseNumber + 10)
+	currentPulse := prevPulse + 1
+	newPulse := currentPulse + 1
+	root := *jet.NewID(0, nil)
+	left := *jet.NewID(1, nil)
+	right := *jet.NewID(1, []byte{0x80}) // 10000000
+
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	var prevDrops []storage.SnapshotDrop
+	for _, jetID := range []core.RecordID{left, right} {
+		drop := jet.JetDrop{
+			Pulse:       prevPulse,
+			RecordsRoot: jetID.Bytes(),
+			Hash:        merkle.DropHash(scheme, nil, jetID.Bytes()),
+		}
+		err := s.dropStorage.SetDrop(s.ctx, jetID, &drop)
+		require.NoError(s.T(), err)
+		prevDrops = append(prevDrops, storage.SnapshotDrop{
+			Pulse: drop.Pulse, RecordsRoot: drop.RecordsRoot, Hash: drop.Hash,
+		})
+	}
+
+	hotData, err := pm.createMergedDrop(s.ctx, jetInfo{
+		id:     root,
+		merged: []core.RecordID{left, right},
+	}, prevPulse, currentPulse, newPulse)
+	require.NoError(s.T(), err)
+
+	rightDrop, err := s.dropStorage.GetDrop(s.ctx, right, currentPulse)
+	require.NoError(s.T(), err)
+	rootDrop, err := s.dropStorage.GetDrop(s.ctx, root, currentPulse)
+	require.NoError(s.T(), err)
+
+	// Drop of the left jet is the drop of the resulting jet. It links previous left drop and the last right drop.
+	assert.Equal(s.T(), merkle.DropHash(scheme, prevDrops[0].Hash, rightDrop.Hash), rootDrop.PrevHash)
+	assert.Equal(s.T(), prevDrops[1].Hash, rightDrop.PrevHash)
+	assert.NoError(s.T(), storage.VerifyDropsChain(scheme, append(
+		prevDrops,
+		storage.SnapshotDrop{
+			Pulse: currentPulse, PrevHash: rightDrop.PrevHash, RecordsRoot: rightDrop.RecordsRoot, Hash: rightDrop.Hash,
+		},
+		storage.SnapshotDrop{
+			Pulse: currentPulse, PrevHash: rootDrop.PrevHash, RecordsRoot: rootDrop.RecordsRoot, Hash: rootDrop.Hash,
+		},
+	)))
+
+	assert.True(s.T(), hotData.Merged)
+	assert.Equal(s.T(), root, hotData.DropJet)
+	assert.Equal(s.T(), *rootDrop, hotData.Drop)
+	assert.Equal(s.T(), newPulse, hotData.PulseNumber)
+	assert.Empty(s.T(), hotData.JetDropSizeHistory)
+}
+
+func (s *pulseManagerSuite) TestPulseManager_FlushHeavySyncOnPulse() {
+	ETA := core.PulseNumber(core.FirstPulseNumber + 20)
+	pm := NewPulseManager(configuration.Ledger{})
+	pm.FlushHeavySyncOnPulse(s.ctx, ETA)
+
+	pm.flushOnPulse(s.ctx, ETA-10)
+	assert.Equal(s.T(), ETA, pm.flushPulse)
+
+	// flush is started once on the pulse
+	pm.flushOnPulse(s.ctx, ETA)
+	assert.Equal(s.T(), core.PulseNumber(0), pm.flushPulse)
+}
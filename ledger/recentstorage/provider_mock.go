@@ -50,6 +50,16 @@ type ProviderMock struct {
 	GetPendingStoragePreCounter uint64
 	GetPendingStorageMock       mProviderMockGetPendingStorage
 
+	MergeIndexStorageFunc       func(p context.Context, p1 core.RecordID, p2 core.RecordID, p3 core.RecordID)
+	MergeIndexStorageCounter    uint64
+	MergeIndexStoragePreCounter uint64
+	MergeIndexStorageMock       mProviderMockMergeIndexStorage
+
+	MergePendingStorageFunc       func(p context.Context, p1 core.RecordID, p2 core.RecordID, p3 core.RecordID)
+	MergePendingStorageCounter    uint64
+	MergePendingStoragePreCounter uint64
+	MergePendingStorageMock       mProviderMockMergePendingStorage
+
 	RemovePendingStorageFunc       func(p context.Context, p1 core.RecordID)
 	RemovePendingStorageCounter    uint64
 	RemovePendingStoragePreCounter uint64
@@ -70,6 +80,8 @@ func NewProviderMock(t minimock.Tester) *ProviderMock {
 	m.DecreaseIndexesTTLMock = mProviderMockDecreaseIndexesTTL{mock: m}
 	m.GetIndexStorageMock = mProviderMockGetIndexStorage{mock: m}
 	m.GetPendingStorageMock = mProviderMockGetPendingStorage{mock: m}
+	m.MergeIndexStorageMock = mProviderMockMergeIndexStorage{mock: m}
+	m.MergePendingStorageMock = mProviderMockMergePendingStorage{mock: m}
 	m.RemovePendingStorageMock = mProviderMockRemovePendingStorage{mock: m}
 
 	return m
@@ -902,6 +914,258 @@ func (m *ProviderMock) GetPendingStorageFinished() bool {
 	return true
 }
 
+type mProviderMockMergeIndexStorage struct {
+	mock              *ProviderMock
+	mainExpectation   *ProviderMockMergeIndexStorageExpectation
+	expectationSeries []*ProviderMockMergeIndexStorageExpectation
+}
+
+type ProviderMockMergeIndexStorageExpectation struct {
+	input *ProviderMockMergeIndexStorageInput
+}
+
+type ProviderMockMergeIndexStorageInput struct {
+	p  context.Context
+	p1 core.RecordID
+	p2 core.RecordID
+	p3 core.RecordID
+}
+
+//Expect specifies that invocation of Provider.MergeIndexStorage is expected from 1 to Infinity times
+func (m *mProviderMockMergeIndexStorage) Expect(p context.Context, p1 core.RecordID, p2 core.RecordID, p3 core.RecordID) *mProviderMockMergeIndexStorage {
+	m.mock.MergeIndexStorageFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ProviderMockMergeIndexStorageExpectation{}
+	}
+	m.mainExpectation.input = &ProviderMockMergeIndexStorageInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of Provider.MergeIndexStorage
+func (m *mProviderMockMergeIndexStorage) Return() *ProviderMock {
+	m.mock.MergeIndexStorageFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ProviderMockMergeIndexStorageExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Provider.MergeIndexStorage is expected once
+func (m *mProviderMockMergeIndexStorage) ExpectOnce(p context.Context, p1 core.RecordID, p2 core.RecordID, p3 core.RecordID) *ProviderMockMergeIndexStorageExpectation {
+	m.mock.MergeIndexStorageFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ProviderMockMergeIndexStorageExpectation{}
+	expectation.input = &ProviderMockMergeIndexStorageInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of Provider.MergeIndexStorage method
+func (m *mProviderMockMergeIndexStorage) Set(f func(p context.Context, p1 core.RecordID, p2 core.RecordID, p3 core.RecordID)) *ProviderMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.MergeIndexStorageFunc = f
+	return m.mock
+}
+
+//MergeIndexStorage implements github.com/insolar/insolar/ledger/recentstorage.Provider interface
+func (m *ProviderMock) MergeIndexStorage(p context.Context, p1 core.RecordID, p2 core.RecordID, p3 core.RecordID) {
+	counter := atomic.AddUint64(&m.MergeIndexStoragePreCounter, 1)
+	defer atomic.AddUint64(&m.MergeIndexStorageCounter, 1)
+
+	if len(m.MergeIndexStorageMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.MergeIndexStorageMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ProviderMock.MergeIndexStorage. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.MergeIndexStorageMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ProviderMockMergeIndexStorageInput{p, p1, p2, p3}, "Provider.MergeIndexStorage got unexpected parameters")
+
+		return
+	}
+
+	if m.MergeIndexStorageMock.mainExpectation != nil {
+
+		input := m.MergeIndexStorageMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ProviderMockMergeIndexStorageInput{p, p1, p2, p3}, "Provider.MergeIndexStorage got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.MergeIndexStorageFunc == nil {
+		m.t.Fatalf("Unexpected call to ProviderMock.MergeIndexStorage. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	m.MergeIndexStorageFunc(p, p1, p2, p3)
+}
+
+//MergeIndexStorageMinimockCounter returns a count of ProviderMock.MergeIndexStorageFunc invocations
+func (m *ProviderMock) MergeIndexStorageMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.MergeIndexStorageCounter)
+}
+
+//MergeIndexStorageMinimockPreCounter returns the value of ProviderMock.MergeIndexStorage invocations
+func (m *ProviderMock) MergeIndexStorageMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.MergeIndexStoragePreCounter)
+}
+
+//MergeIndexStorageFinished returns true if mock invocations count is ok
+func (m *ProviderMock) MergeIndexStorageFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.MergeIndexStorageMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.MergeIndexStorageCounter) == uint64(len(m.MergeIndexStorageMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.MergeIndexStorageMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.MergeIndexStorageCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.MergeIndexStorageFunc != nil {
+		return atomic.LoadUint64(&m.MergeIndexStorageCounter) > 0
+	}
+
+	return true
+}
+
+type mProviderMockMergePendingStorage struct {
+	mock              *ProviderMock
+	mainExpectation   *ProviderMockMergePendingStorageExpectation
+	expectationSeries []*ProviderMockMergePendingStorageExpectation
+}
+
+type ProviderMockMergePendingStorageExpectation struct {
+	input *ProviderMockMergePendingStorageInput
+}
+
+type ProviderMockMergePendingStorageInput struct {
+	p  context.Context
+	p1 core.RecordID
+	p2 core.RecordID
+	p3 core.RecordID
+}
+
+//Expect specifies that invocation of Provider.MergePendingStorage is expected from 1 to Infinity times
+func (m *mProviderMockMergePendingStorage) Expect(p context.Context, p1 core.RecordID, p2 core.RecordID, p3 core.RecordID) *mProviderMockMergePendingStorage {
+	m.mock.MergePendingStorageFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ProviderMockMergePendingStorageExpectation{}
+	}
+	m.mainExpectation.input = &ProviderMockMergePendingStorageInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of Provider.MergePendingStorage
+func (m *mProviderMockMergePendingStorage) Return() *ProviderMock {
+	m.mock.MergePendingStorageFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ProviderMockMergePendingStorageExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Provider.MergePendingStorage is expected once
+func (m *mProviderMockMergePendingStorage) ExpectOnce(p context.Context, p1 core.RecordID, p2 core.RecordID, p3 core.RecordID) *ProviderMockMergePendingStorageExpectation {
+	m.mock.MergePendingStorageFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ProviderMockMergePendingStorageExpectation{}
+	expectation.input = &ProviderMockMergePendingStorageInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of Provider.MergePendingStorage method
+func (m *mProviderMockMergePendingStorage) Set(f func(p context.Context, p1 core.RecordID, p2 core.RecordID, p3 core.RecordID)) *ProviderMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.MergePendingStorageFunc = f
+	return m.mock
+}
+
+//MergePendingStorage implements github.com/insolar/insolar/ledger/recentstorage.Provider interface
+func (m *ProviderMock) MergePendingStorage(p context.Context, p1 core.RecordID, p2 core.RecordID, p3 core.RecordID) {
+	counter := atomic.AddUint64(&m.MergePendingStoragePreCounter, 1)
+	defer atomic.AddUint64(&m.MergePendingStorageCounter, 1)
+
+	if len(m.MergePendingStorageMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.MergePendingStorageMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ProviderMock.MergePendingStorage. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.MergePendingStorageMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ProviderMockMergePendingStorageInput{p, p1, p2, p3}, "Provider.MergePendingStorage got unexpected parameters")
+
+		return
+	}
+
+	if m.MergePendingStorageMock.mainExpectation != nil {
+
+		input := m.MergePendingStorageMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ProviderMockMergePendingStorageInput{p, p1, p2, p3}, "Provider.MergePendingStorage got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.MergePendingStorageFunc == nil {
+		m.t.Fatalf("Unexpected call to ProviderMock.MergePendingStorage. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	m.MergePendingStorageFunc(p, p1, p2, p3)
+}
+
+//MergePendingStorageMinimockCounter returns a count of ProviderMock.MergePendingStorageFunc invocations
+func (m *ProviderMock) MergePendingStorageMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.MergePendingStorageCounter)
+}
+
+//MergePendingStorageMinimockPreCounter returns the value of ProviderMock.MergePendingStorage invocations
+func (m *ProviderMock) MergePendingStorageMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.MergePendingStoragePreCounter)
+}
+
+//MergePendingStorageFinished returns true if mock invocations count is ok
+func (m *ProviderMock) MergePendingStorageFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.MergePendingStorageMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.MergePendingStorageCounter) == uint64(len(m.MergePendingStorageMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.MergePendingStorageMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.MergePendingStorageCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.MergePendingStorageFunc != nil {
+		return atomic.LoadUint64(&m.MergePendingStorageCounter) > 0
+	}
+
+	return true
+}
+
 type mProviderMockRemovePendingStorage struct {
 	mock              *ProviderMock
 	mainExpectation   *ProviderMockRemovePendingStorageExpectation
@@ -1054,6 +1318,14 @@ func (m *ProviderMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ProviderMock.GetPendingStorage")
 	}
 
+	if !m.MergeIndexStorageFinished() {
+		m.t.Fatal("Expected call to ProviderMock.MergeIndexStorage")
+	}
+
+	if !m.MergePendingStorageFinished() {
+		m.t.Fatal("Expected call to ProviderMock.MergePendingStorage")
+	}
+
 	if !m.RemovePendingStorageFinished() {
 		m.t.Fatal("Expected call to ProviderMock.RemovePendingStorage")
 	}
@@ -1099,6 +1371,14 @@ func (m *ProviderMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ProviderMock.GetPendingStorage")
 	}
 
+	if !m.MergeIndexStorageFinished() {
+		m.t.Fatal("Expected call to ProviderMock.MergeIndexStorage")
+	}
+
+	if !m.MergePendingStorageFinished() {
+		m.t.Fatal("Expected call to ProviderMock.MergePendingStorage")
+	}
+
 	if !m.RemovePendingStorageFinished() {
 		m.t.Fatal("Expected call to ProviderMock.RemovePendingStorage")
 	}
@@ -1123,6 +1403,8 @@ func (m *ProviderMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.DecreaseIndexesTTLFinished()
 		ok = ok && m.GetIndexStorageFinished()
 		ok = ok && m.GetPendingStorageFinished()
+		ok = ok && m.MergeIndexStorageFinished()
+		ok = ok && m.MergePendingStorageFinished()
 		ok = ok && m.RemovePendingStorageFinished()
 
 		if ok {
@@ -1156,6 +1438,14 @@ func (m *ProviderMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ProviderMock.GetPendingStorage")
 			}
 
+			if !m.MergeIndexStorageFinished() {
+				m.t.Error("Expected call to ProviderMock.MergeIndexStorage")
+			}
+
+			if !m.MergePendingStorageFinished() {
+				m.t.Error("Expected call to ProviderMock.MergePendingStorage")
+			}
+
 			if !m.RemovePendingStorageFinished() {
 				m.t.Error("Expected call to ProviderMock.RemovePendingStorage")
 			}
@@ -1196,6 +1486,14 @@ func (m *ProviderMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.MergeIndexStorageFinished() {
+		return false
+	}
+
+	if !m.MergePendingStorageFinished() {
+		return false
+	}
+
 	if !m.RemovePendingStorageFinished() {
 		return false
 	}
@@ -32,6 +32,8 @@ type Provider interface {
 
 	CloneIndexStorage(ctx context.Context, fromJetID, toJetID core.RecordID)
 	ClonePendingStorage(ctx context.Context, fromJetID, toJetID core.RecordID)
+	MergeIndexStorage(ctx context.Context, leftJetID, rightJetID, toJetID core.RecordID)
+	MergePendingStorage(ctx context.Context, leftJetID, rightJetID, toJetID core.RecordID)
 
 	DecreaseIndexesTTL(ctx context.Context) map[core.RecordID][]core.RecordID
 
@@ -116,11 +116,68 @@ func (p *RecentStorageProvider) ClonePendingStorage(ctx context.Context, fromJet
 		return
 	}
 
-	fromStorage.lock.RLock()
 	toStorage := &PendingStorageConcrete{
 		jetID:    toJetID,
 		requests: map[core.RecordID]*lockedPendingObjectContext{},
 	}
+	copyPendingRequests(fromStorage, toStorage)
+
+	p.pendingLock.Lock()
+	p.pendingStorages[toJetID] = toStorage
+	p.pendingLock.Unlock()
+}
+
+// MergeIndexStorage merges indexes from two jets into another one (e.g. when sibling jets are merged into parent)
+func (p *RecentStorageProvider) MergeIndexStorage(ctx context.Context, leftJetID, rightJetID, toJetID core.RecordID) {
+	p.indexLock.Lock()
+	defer p.indexLock.Unlock()
+
+	toStorage := &RecentIndexStorageConcrete{
+		jetID:      toJetID,
+		indexes:    map[core.RecordID]recentObjectMeta{},
+		DefaultTTL: p.DefaultTTL,
+	}
+	for _, fromJetID := range []core.RecordID{leftJetID, rightJetID} {
+		fromStorage, ok := p.indexStorages[fromJetID]
+		if !ok {
+			continue
+		}
+		for k, v := range fromStorage.indexes {
+			clone := v
+			toStorage.indexes[k] = clone
+		}
+	}
+	p.indexStorages[toJetID] = toStorage
+}
+
+// MergePendingStorage merges pending requests from two jets into another one (e.g. when sibling jets are merged into
+// parent)
+func (p *RecentStorageProvider) MergePendingStorage(ctx context.Context, leftJetID, rightJetID, toJetID core.RecordID) {
+	p.pendingLock.Lock()
+	leftStorage, leftOk := p.pendingStorages[leftJetID]
+	rightStorage, rightOk := p.pendingStorages[rightJetID]
+	p.pendingLock.Unlock()
+
+	toStorage := &PendingStorageConcrete{
+		jetID:    toJetID,
+		requests: map[core.RecordID]*lockedPendingObjectContext{},
+	}
+	if leftOk {
+		copyPendingRequests(leftStorage, toStorage)
+	}
+	if rightOk {
+		copyPendingRequests(rightStorage, toStorage)
+	}
+
+	p.pendingLock.Lock()
+	p.pendingStorages[toJetID] = toStorage
+	p.pendingLock.Unlock()
+}
+
+func copyPendingRequests(fromStorage, toStorage *PendingStorageConcrete) {
+	fromStorage.lock.RLock()
+	defer fromStorage.lock.RUnlock()
+
 	for objID, pendingContext := range fromStorage.requests {
 		if len(pendingContext.Context.Requests) == 0 {
 			continue
@@ -138,11 +195,6 @@ func (p *RecentStorageProvider) ClonePendingStorage(ctx context.Context, fromJet
 
 		pendingContext.lock.Unlock()
 	}
-	fromStorage.lock.RUnlock()
-
-	p.pendingLock.Lock()
-	p.pendingStorages[toJetID] = toStorage
-	p.pendingLock.Unlock()
 }
 
 // DecreaseIndexesTTL decrease ttl of all indexes in all storages
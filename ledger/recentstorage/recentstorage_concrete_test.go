@@ -236,6 +236,55 @@ func TestRecentStorageProvider_GetStorage(t *testing.T) {
 	require.Equal(t, 8, len(provider.pendingStorages))
 }
 
+func TestRecentStorageProvider_MergeIndexStorage(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := inslogger.TestContext(t)
+	provider := NewRecentStorageProvider(8)
+	leftJetID := testutils.RandomJet()
+	rightJetID := testutils.RandomJet()
+	toJetID := testutils.RandomJet()
+	leftObj := testutils.RandomID()
+	rightObj := testutils.RandomID()
+	provider.GetIndexStorage(ctx, leftJetID).AddObject(ctx, leftObj)
+	provider.GetIndexStorage(ctx, rightJetID).AddObjectWithTLL(ctx, rightObj, 3)
+
+	// Act
+	provider.MergeIndexStorage(ctx, leftJetID, rightJetID, toJetID)
+
+	// Assert
+	objects := provider.GetIndexStorage(ctx, toJetID).GetObjects()
+	require.Equal(t, 2, len(objects))
+	require.Equal(t, 8, objects[leftObj])
+	require.Equal(t, 3, objects[rightObj])
+}
+
+func TestRecentStorageProvider_MergePendingStorage(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := inslogger.TestContext(t)
+	provider := NewRecentStorageProvider(8)
+	leftJetID := testutils.RandomJet()
+	rightJetID := testutils.RandomJet()
+	toJetID := testutils.RandomJet()
+	leftObj := testutils.RandomID()
+	rightObj := testutils.RandomID()
+	leftReq := testutils.RandomID()
+	rightReq := testutils.RandomID()
+	provider.GetPendingStorage(ctx, leftJetID).AddPendingRequest(ctx, leftObj, leftReq)
+	provider.GetPendingStorage(ctx, rightJetID).AddPendingRequest(ctx, rightObj, rightReq)
+
+	// Act
+	provider.MergePendingStorage(ctx, leftJetID, rightJetID, testutils.RandomJet())
+	provider.MergePendingStorage(ctx, leftJetID, rightJetID, toJetID)
+
+	// Assert
+	requests := provider.GetPendingStorage(ctx, toJetID).GetRequests()
+	require.Equal(t, 2, len(requests))
+	require.Equal(t, []core.RecordID{leftReq}, requests[leftObj].Requests)
+	require.Equal(t, []core.RecordID{rightReq}, requests[rightObj].Requests)
+}
+
 func TestRecentStorage_markForDelete(t *testing.T) {
 	t.Parallel()
 	candidates := make([]core.RecordID, 0, 100)
@@ -0,0 +1,372 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+)
+
+// archiveBatchSize is a number of archived values indexed in one transaction.
+const archiveBatchSize = 1000
+
+// archivedScopes contains scopes of values moved to archive.
+var archivedScopes = map[byte]string{
+	scopeIDRecord: "records",
+	scopeIDBlob:   "blobs",
+}
+
+// Archiver moves old heavy node data from the storage into compressed segment files. Archived values are still
+// available through DB (it reads them from segments), so archiving is transparent for storage users.
+//go:generate minimock -i github.com/insolar/insolar/ledger/storage.Archiver -o ./ -s _mock.go
+type Archiver interface {
+	// ArchiveUntil moves records and blobs of all pulses before provided one into archive segments.
+	ArchiveUntil(ctx context.Context, pn core.PulseNumber) (ArchiveStat, error)
+	// ArchivedPulse returns pulse data before which is archived. Zero pulse means nothing was archived yet.
+	ArchivedPulse(ctx context.Context) (core.PulseNumber, error)
+}
+
+// ArchiveStat holds archiving statistics.
+type ArchiveStat struct {
+	Segments int64
+	Entries  int64
+	// Size is a size of archived values.
+	Size int64
+	// StoredSize is a size of written segment files.
+	StoredSize int64
+}
+
+type archiver struct {
+	DB                         DBContext                       `inject:""`
+	PlatformCryptographyScheme core.PlatformCryptographyScheme `inject:""`
+
+	segments *segmentStore
+	level    int
+	running  int32
+}
+
+// NewArchiver creates Archiver which writes segments into configured archive directory.
+func NewArchiver(conf configuration.Archive) Archiver {
+	a := &archiver{level: conf.CompressionLevel}
+	if conf.Directory != "" {
+		a.segments = newArchiveSegments(conf.Directory)
+	}
+	return a
+}
+
+func newArchiveSegments(dir string) *segmentStore {
+	abs, err := filepath.Abs(dir)
+	if err == nil {
+		dir = abs
+	}
+	return newSegmentStore(dir)
+}
+
+// ArchivedPulse returns pulse data before which is archived.
+func (a *archiver) ArchivedPulse(ctx context.Context) (core.PulseNumber, error) {
+	buf, err := a.DB.get(ctx, prefixkey(scopeIDSystem, []byte{sysArchivedPulse}))
+	if err == core.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return core.NewPulseNumber(buf), nil
+}
+
+// ArchiveUntil moves records and blobs of pulses from the latest archived pulse until provided pulse into segments.
+// Data of every jet is written into its own segment. Values are removed from storage after segment is written.
+//
+// Only one archiving can run at a time, ErrArchiveInProgress is returned for concurrent calls.
+func (a *archiver) ArchiveUntil(ctx context.Context, pn core.PulseNumber) (ArchiveStat, error) {
+	var stat ArchiveStat
+	if a.segments == nil {
+		return stat, errors.New("archive directory is not configured")
+	}
+	if !atomic.CompareAndSwapInt32(&a.running, 0, 1) {
+		return stat, ErrArchiveInProgress
+	}
+	defer atomic.StoreInt32(&a.running, 0)
+
+	from, err := a.ArchivedPulse(ctx)
+	if err != nil {
+		return stat, errors.Wrap(err, "failed to get archived pulse")
+	}
+	if pn <= from {
+		return stat, nil
+	}
+
+	for _, scope := range []byte{scopeIDRecord, scopeIDBlob} {
+		name := archivedScopes[scope]
+		var scopeStat ArchiveStat
+		err := a.archiveScope(ctx, scope, from, pn, &scopeStat)
+
+		mctx := insmetrics.InsertTag(ctx, recordType, name)
+		stats.Record(mctx,
+			statArchiveEntries.M(scopeStat.Entries),
+			statArchiveSegments.M(scopeStat.Segments),
+			statArchiveStored.M(scopeStat.StoredSize),
+		)
+		stat.Segments += scopeStat.Segments
+		stat.Entries += scopeStat.Entries
+		stat.Size += scopeStat.Size
+		stat.StoredSize += scopeStat.StoredSize
+		if err != nil {
+			return stat, errors.Wrapf(err, "failed to archive %v", name)
+		}
+	}
+
+	err = a.DB.set(ctx, prefixkey(scopeIDSystem, []byte{sysArchivedPulse}), pn.Bytes())
+	if err != nil {
+		return stat, errors.Wrap(err, "failed to save archived pulse")
+	}
+	inslogger.FromContext(ctx).Infof(
+		"archived pulses [%v, %v): %v entries (%v bytes) in %v segments (%v bytes)",
+		from, pn, stat.Entries, stat.Size, stat.Segments, stat.StoredSize,
+	)
+	return stat, nil
+}
+
+// archiveScope archives scope values segment by segment. Every segment is scanned in its own transaction.
+func (a *archiver) archiveScope(
+	ctx context.Context, scope byte, from, until core.PulseNumber, stat *ArchiveStat,
+) error {
+	seek := []byte{scope}
+	for seek != nil {
+		var (
+			w    *segmentWriter
+			next []byte
+		)
+		err := kvView(a.DB.GetKVStore(), func(txn KVTransaction) error {
+			next = nil
+			return txn.Iterate([]byte{scope}, seek, func(k, v []byte) error {
+				jetPrefix, pulse := k[1:core.RecordHashSize], pulseFromKey(k)
+				if w == nil {
+					if pulse < from {
+						next = prefixkey(scope, jetPrefix, from.Bytes())
+						return ErrStopIteration
+					}
+					if pulse >= until {
+						next = nextJetKey(scope, jetPrefix)
+						return ErrStopIteration
+					}
+					var err error
+					w, err = a.segments.create(a.PlatformCryptographyScheme, a.level)
+					if err != nil {
+						return err
+					}
+				} else if !bytes.Equal(jetPrefix, w.keys[0][1:core.RecordHashSize]) || w.len() == segmentMaxEntries {
+					next = k
+					return ErrStopIteration
+				} else if pulse >= until {
+					next = nextJetKey(scope, jetPrefix)
+					return ErrStopIteration
+				}
+				return w.add(k, v)
+			})
+		})
+		if err != nil {
+			if w != nil {
+				w.abort()
+			}
+			return err
+		}
+		seek = next
+		if w == nil {
+			continue
+		}
+
+		refs, err := w.finish()
+		if err != nil {
+			return errors.Wrap(err, "failed to write segment")
+		}
+		err = a.index(w.keys, refs)
+		if err != nil {
+			return errors.Wrap(err, "failed to index segment")
+		}
+		stat.Segments++
+		stat.Entries += int64(w.len())
+		stat.Size += int64(w.size)
+		stat.StoredSize += int64(w.offset)
+	}
+	return nil
+}
+
+// index replaces values by references to segment.
+func (a *archiver) index(keys [][]byte, refs []*segmentRef) error {
+	for start := 0; start < len(keys); start += archiveBatchSize {
+		end := start + archiveBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		err := kvUpdate(a.DB.GetKVStore(), func(txn KVTransaction) error {
+			for i := start; i < end; i++ {
+				err := txn.Set(prefixkey(scopeIDArchive, keys[i]), refs[i].bytes())
+				if err != nil {
+					return err
+				}
+				err = txn.Delete(keys[i])
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextJetKey returns the first key of the next jet prefix in scope or nil if it is the last prefix.
+func nextJetKey(scope byte, jetPrefix []byte) []byte {
+	next := append([]byte(nil), jetPrefix...)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			return prefixkey(scope, next)
+		}
+	}
+	return nil
+}
+
+func isArchived(key []byte) bool {
+	if len(key) == 0 {
+		return false
+	}
+	_, ok := archivedScopes[key[0]]
+	return ok
+}
+
+// getArchived returns archived value by its storage key.
+func getArchived(txn KVTransaction, segments *segmentStore, key []byte) ([]byte, error) {
+	buf, err := txn.Get(prefixkey(scopeIDArchive, key))
+	if err != nil {
+		return nil, err
+	}
+	ref, err := decodeSegmentRef(buf)
+	if err != nil {
+		return nil, err
+	}
+	return segments.read(ref)
+}
+
+// iterateWithArchive calls handler for stored and archived values which keys have provided prefix. Values are passed
+// in ascending keys order. If value is both stored and archived, stored one is passed.
+func iterateWithArchive(
+	txn KVTransaction, segments *segmentStore, prefix []byte, handler func(k, v []byte) error,
+) error {
+	if segments == nil || !isArchived(prefix) {
+		return txn.Iterate(prefix, nil, handler)
+	}
+
+	archived := &archiveCursor{txn: txn, segments: segments, prefix: prefixkey(scopeIDArchive, prefix)}
+	stopped := false
+	err := txn.Iterate(prefix, nil, func(k, v []byte) error {
+		err := archived.emitBefore(k, handler)
+		if err == nil {
+			err = handler(k, v)
+		}
+		if err == ErrStopIteration {
+			stopped = true
+		}
+		return err
+	})
+	if err != nil || stopped {
+		return err
+	}
+	err = archived.emitBefore(nil, handler)
+	if err == ErrStopIteration {
+		return nil
+	}
+	return err
+}
+
+// archiveCursor reads archive index in batches to merge it with stored values.
+type archiveCursor struct {
+	txn      KVTransaction
+	segments *segmentStore
+	prefix   []byte
+
+	batch []core.KV
+	next  []byte
+	done  bool
+}
+
+func (c *archiveCursor) fetch() error {
+	if len(c.batch) > 0 || c.done {
+		return nil
+	}
+	c.done = true
+	return c.txn.Iterate(c.prefix, c.next, func(k, v []byte) error {
+		if len(c.batch) == archiveBatchSize {
+			c.done = false
+			c.next = k
+			return ErrStopIteration
+		}
+		c.batch = append(c.batch, core.KV{K: k[1:], V: v})
+		return nil
+	})
+}
+
+// emitBefore passes archived values with keys less than limit to handler. Archived value with limit key is skipped.
+// Nil limit passes all remaining values.
+func (c *archiveCursor) emitBefore(limit []byte, handler func(k, v []byte) error) error {
+	for {
+		err := c.fetch()
+		if err != nil {
+			return err
+		}
+		if len(c.batch) == 0 {
+			return nil
+		}
+		kv := c.batch[0]
+		if limit != nil {
+			cmp := bytes.Compare(kv.K, limit)
+			if cmp == 0 {
+				c.batch = c.batch[1:]
+			}
+			if cmp >= 0 {
+				return nil
+			}
+		}
+		c.batch = c.batch[1:]
+
+		ref, err := decodeSegmentRef(kv.V)
+		if err != nil {
+			return err
+		}
+		value, err := c.segments.read(ref)
+		if err != nil {
+			return err
+		}
+		err = handler(kv.K, value)
+		if err != nil {
+			return err
+		}
+	}
+}
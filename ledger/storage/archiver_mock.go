@@ -0,0 +1,439 @@
+package storage
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "Archiver" can be found in github.com/insolar/insolar/ledger/storage
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	core "github.com/insolar/insolar/core"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//ArchiverMock implements github.com/insolar/insolar/ledger/storage.Archiver
+type ArchiverMock struct {
+	t minimock.Tester
+
+	ArchiveUntilFunc       func(p context.Context, p1 core.PulseNumber) (r ArchiveStat, r1 error)
+	ArchiveUntilCounter    uint64
+	ArchiveUntilPreCounter uint64
+	ArchiveUntilMock       mArchiverMockArchiveUntil
+
+	ArchivedPulseFunc       func(p context.Context) (r core.PulseNumber, r1 error)
+	ArchivedPulseCounter    uint64
+	ArchivedPulsePreCounter uint64
+	ArchivedPulseMock       mArchiverMockArchivedPulse
+}
+
+//NewArchiverMock returns a mock for github.com/insolar/insolar/ledger/storage.Archiver
+func NewArchiverMock(t minimock.Tester) *ArchiverMock {
+	m := &ArchiverMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ArchiveUntilMock = mArchiverMockArchiveUntil{mock: m}
+	m.ArchivedPulseMock = mArchiverMockArchivedPulse{mock: m}
+
+	return m
+}
+
+type mArchiverMockArchiveUntil struct {
+	mock              *ArchiverMock
+	mainExpectation   *ArchiverMockArchiveUntilExpectation
+	expectationSeries []*ArchiverMockArchiveUntilExpectation
+}
+
+type ArchiverMockArchiveUntilExpectation struct {
+	input  *ArchiverMockArchiveUntilInput
+	result *ArchiverMockArchiveUntilResult
+}
+
+type ArchiverMockArchiveUntilInput struct {
+	p  context.Context
+	p1 core.PulseNumber
+}
+
+type ArchiverMockArchiveUntilResult struct {
+	r  ArchiveStat
+	r1 error
+}
+
+//Expect specifies that invocation of Archiver.ArchiveUntil is expected from 1 to Infinity times
+func (m *mArchiverMockArchiveUntil) Expect(p context.Context, p1 core.PulseNumber) *mArchiverMockArchiveUntil {
+	m.mock.ArchiveUntilFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArchiverMockArchiveUntilExpectation{}
+	}
+	m.mainExpectation.input = &ArchiverMockArchiveUntilInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of Archiver.ArchiveUntil
+func (m *mArchiverMockArchiveUntil) Return(r ArchiveStat, r1 error) *ArchiverMock {
+	m.mock.ArchiveUntilFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArchiverMockArchiveUntilExpectation{}
+	}
+	m.mainExpectation.result = &ArchiverMockArchiveUntilResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Archiver.ArchiveUntil is expected once
+func (m *mArchiverMockArchiveUntil) ExpectOnce(p context.Context, p1 core.PulseNumber) *ArchiverMockArchiveUntilExpectation {
+	m.mock.ArchiveUntilFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ArchiverMockArchiveUntilExpectation{}
+	expectation.input = &ArchiverMockArchiveUntilInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ArchiverMockArchiveUntilExpectation) Return(r ArchiveStat, r1 error) {
+	e.result = &ArchiverMockArchiveUntilResult{r, r1}
+}
+
+//Set uses given function f as a mock of Archiver.ArchiveUntil method
+func (m *mArchiverMockArchiveUntil) Set(f func(p context.Context, p1 core.PulseNumber) (r ArchiveStat, r1 error)) *ArchiverMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ArchiveUntilFunc = f
+	return m.mock
+}
+
+//ArchiveUntil implements github.com/insolar/insolar/ledger/storage.Archiver interface
+func (m *ArchiverMock) ArchiveUntil(p context.Context, p1 core.PulseNumber) (r ArchiveStat, r1 error) {
+	counter := atomic.AddUint64(&m.ArchiveUntilPreCounter, 1)
+	defer atomic.AddUint64(&m.ArchiveUntilCounter, 1)
+
+	if len(m.ArchiveUntilMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ArchiveUntilMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ArchiverMock.ArchiveUntil. %v %v", p, p1)
+			return
+		}
+
+		input := m.ArchiveUntilMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ArchiverMockArchiveUntilInput{p, p1}, "Archiver.ArchiveUntil got unexpected parameters")
+
+		result := m.ArchiveUntilMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArchiverMock.ArchiveUntil")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ArchiveUntilMock.mainExpectation != nil {
+
+		input := m.ArchiveUntilMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ArchiverMockArchiveUntilInput{p, p1}, "Archiver.ArchiveUntil got unexpected parameters")
+		}
+
+		result := m.ArchiveUntilMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArchiverMock.ArchiveUntil")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ArchiveUntilFunc == nil {
+		m.t.Fatalf("Unexpected call to ArchiverMock.ArchiveUntil. %v %v", p, p1)
+		return
+	}
+
+	return m.ArchiveUntilFunc(p, p1)
+}
+
+//ArchiveUntilMinimockCounter returns a count of ArchiverMock.ArchiveUntilFunc invocations
+func (m *ArchiverMock) ArchiveUntilMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ArchiveUntilCounter)
+}
+
+//ArchiveUntilMinimockPreCounter returns the value of ArchiverMock.ArchiveUntil invocations
+func (m *ArchiverMock) ArchiveUntilMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ArchiveUntilPreCounter)
+}
+
+//ArchiveUntilFinished returns true if mock invocations count is ok
+func (m *ArchiverMock) ArchiveUntilFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ArchiveUntilMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ArchiveUntilCounter) == uint64(len(m.ArchiveUntilMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ArchiveUntilMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ArchiveUntilCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ArchiveUntilFunc != nil {
+		return atomic.LoadUint64(&m.ArchiveUntilCounter) > 0
+	}
+
+	return true
+}
+
+type mArchiverMockArchivedPulse struct {
+	mock              *ArchiverMock
+	mainExpectation   *ArchiverMockArchivedPulseExpectation
+	expectationSeries []*ArchiverMockArchivedPulseExpectation
+}
+
+type ArchiverMockArchivedPulseExpectation struct {
+	input  *ArchiverMockArchivedPulseInput
+	result *ArchiverMockArchivedPulseResult
+}
+
+type ArchiverMockArchivedPulseInput struct {
+	p context.Context
+}
+
+type ArchiverMockArchivedPulseResult struct {
+	r  core.PulseNumber
+	r1 error
+}
+
+//Expect specifies that invocation of Archiver.ArchivedPulse is expected from 1 to Infinity times
+func (m *mArchiverMockArchivedPulse) Expect(p context.Context) *mArchiverMockArchivedPulse {
+	m.mock.ArchivedPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArchiverMockArchivedPulseExpectation{}
+	}
+	m.mainExpectation.input = &ArchiverMockArchivedPulseInput{p}
+	return m
+}
+
+//Return specifies results of invocation of Archiver.ArchivedPulse
+func (m *mArchiverMockArchivedPulse) Return(r core.PulseNumber, r1 error) *ArchiverMock {
+	m.mock.ArchivedPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArchiverMockArchivedPulseExpectation{}
+	}
+	m.mainExpectation.result = &ArchiverMockArchivedPulseResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Archiver.ArchivedPulse is expected once
+func (m *mArchiverMockArchivedPulse) ExpectOnce(p context.Context) *ArchiverMockArchivedPulseExpectation {
+	m.mock.ArchivedPulseFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ArchiverMockArchivedPulseExpectation{}
+	expectation.input = &ArchiverMockArchivedPulseInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ArchiverMockArchivedPulseExpectation) Return(r core.PulseNumber, r1 error) {
+	e.result = &ArchiverMockArchivedPulseResult{r, r1}
+}
+
+//Set uses given function f as a mock of Archiver.ArchivedPulse method
+func (m *mArchiverMockArchivedPulse) Set(f func(p context.Context) (r core.PulseNumber, r1 error)) *ArchiverMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ArchivedPulseFunc = f
+	return m.mock
+}
+
+//ArchivedPulse implements github.com/insolar/insolar/ledger/storage.Archiver interface
+func (m *ArchiverMock) ArchivedPulse(p context.Context) (r core.PulseNumber, r1 error) {
+	counter := atomic.AddUint64(&m.ArchivedPulsePreCounter, 1)
+	defer atomic.AddUint64(&m.ArchivedPulseCounter, 1)
+
+	if len(m.ArchivedPulseMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ArchivedPulseMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ArchiverMock.ArchivedPulse. %v", p)
+			return
+		}
+
+		input := m.ArchivedPulseMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ArchiverMockArchivedPulseInput{p}, "Archiver.ArchivedPulse got unexpected parameters")
+
+		result := m.ArchivedPulseMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArchiverMock.ArchivedPulse")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ArchivedPulseMock.mainExpectation != nil {
+
+		input := m.ArchivedPulseMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ArchiverMockArchivedPulseInput{p}, "Archiver.ArchivedPulse got unexpected parameters")
+		}
+
+		result := m.ArchivedPulseMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArchiverMock.ArchivedPulse")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ArchivedPulseFunc == nil {
+		m.t.Fatalf("Unexpected call to ArchiverMock.ArchivedPulse. %v", p)
+		return
+	}
+
+	return m.ArchivedPulseFunc(p)
+}
+
+//ArchivedPulseMinimockCounter returns a count of ArchiverMock.ArchivedPulseFunc invocations
+func (m *ArchiverMock) ArchivedPulseMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ArchivedPulseCounter)
+}
+
+//ArchivedPulseMinimockPreCounter returns the value of ArchiverMock.ArchivedPulse invocations
+func (m *ArchiverMock) ArchivedPulseMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ArchivedPulsePreCounter)
+}
+
+//ArchivedPulseFinished returns true if mock invocations count is ok
+func (m *ArchiverMock) ArchivedPulseFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ArchivedPulseMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ArchivedPulseCounter) == uint64(len(m.ArchivedPulseMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ArchivedPulseMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ArchivedPulseCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ArchivedPulseFunc != nil {
+		return atomic.LoadUint64(&m.ArchivedPulseCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *ArchiverMock) ValidateCallCounters() {
+
+	if !m.ArchiveUntilFinished() {
+		m.t.Fatal("Expected call to ArchiverMock.ArchiveUntil")
+	}
+
+	if !m.ArchivedPulseFinished() {
+		m.t.Fatal("Expected call to ArchiverMock.ArchivedPulse")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *ArchiverMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *ArchiverMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *ArchiverMock) MinimockFinish() {
+
+	if !m.ArchiveUntilFinished() {
+		m.t.Fatal("Expected call to ArchiverMock.ArchiveUntil")
+	}
+
+	if !m.ArchivedPulseFinished() {
+		m.t.Fatal("Expected call to ArchiverMock.ArchivedPulse")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *ArchiverMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *ArchiverMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.ArchiveUntilFinished()
+		ok = ok && m.ArchivedPulseFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.ArchiveUntilFinished() {
+				m.t.Error("Expected call to ArchiverMock.ArchiveUntil")
+			}
+
+			if !m.ArchivedPulseFinished() {
+				m.t.Error("Expected call to ArchiverMock.ArchivedPulse")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *ArchiverMock) AllMocksCalled() bool {
+
+	if !m.ArchiveUntilFinished() {
+		return false
+	}
+
+	if !m.ArchivedPulseFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,213 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/insolar/insolar/component"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/storage/jet"
+	"github.com/insolar/insolar/ledger/storage/record"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type archiveLedger struct {
+	db            DBContext
+	objectStorage ObjectStorage
+	archiver      Archiver
+	scheme        core.PlatformCryptographyScheme
+	dir           string
+}
+
+func newArchiveLedger(ctx context.Context, t *testing.T, dir string) *archiveLedger {
+	conf := configuration.NewLedger()
+	conf.Storage.Backend = BackendMemory
+	conf.Archive.Directory = dir
+
+	db, err := NewDB(conf, nil)
+	require.NoError(t, err)
+	l := &archiveLedger{
+		db:            db,
+		objectStorage: NewObjectStorage(),
+		archiver:      NewArchiver(conf.Archive),
+		scheme:        platformpolicy.NewPlatformCryptographyScheme(),
+		dir:           dir,
+	}
+	cm := &component.Manager{}
+	cm.Inject(l.scheme, l.db, l.objectStorage, l.archiver)
+	require.NoError(t, cm.Init(ctx))
+	return l
+}
+
+type archivedValue struct {
+	jetID core.RecordID
+	id    core.RecordID
+	rec   record.Record
+	blob  []byte
+}
+
+func (l *archiveLedger) fill(
+	ctx context.Context, t *testing.T, jets []core.RecordID, pulses []core.PulseNumber,
+) []archivedValue {
+	var values []archivedValue
+	for _, jetID := range jets {
+		for i, pn := range pulses {
+			rec := &record.ResultRecord{Payload: []byte{byte(i), byte(len(values))}}
+			id, err := l.objectStorage.SetRecord(ctx, jetID, pn, rec)
+			require.NoError(t, err)
+			blob := []byte(strings.Repeat("blob", i+1))
+			_, err = l.objectStorage.SetBlob(ctx, jetID, pn, blob)
+			require.NoError(t, err)
+			values = append(values, archivedValue{jetID: jetID, id: *id, rec: rec, blob: blob})
+		}
+	}
+	return values
+}
+
+func (l *archiveLedger) stored(t *testing.T, key []byte) bool {
+	err := kvView(l.db.GetKVStore(), func(txn KVTransaction) error {
+		_, err := txn.Get(key)
+		return err
+	})
+	if err == core.ErrNotFound {
+		return false
+	}
+	require.NoError(t, err)
+	return true
+}
+
+func TestArchiver_ArchiveUntil(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	dir, err := ioutil.TempDir("", "archive-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	l := newArchiveLedger(ctx, t, dir)
+	jets := []core.RecordID{*jet.NewID(1, nil), *jet.NewID(1, []byte{0x80})}
+	pulses := []core.PulseNumber{core.FirstPulseNumber + 1, core.FirstPulseNumber + 2, core.FirstPulseNumber + 3}
+	values := l.fill(ctx, t, jets, pulses)
+
+	stat, err := l.archiver.ArchiveUntil(ctx, pulses[2])
+	require.NoError(t, err)
+	// Records and blobs of two jets.
+	assert.Equal(t, int64(4), stat.Segments)
+	assert.Equal(t, int64(8), stat.Entries)
+	archived, err := l.archiver.ArchivedPulse(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, pulses[2], archived)
+
+	for _, v := range values {
+		_, prefix := jet.Jet(v.jetID)
+		key := prefixkey(scopeIDRecord, prefix, v.id[:])
+		assert.Equal(t, v.id.Pulse() < pulses[2], !l.stored(t, key))
+		assert.Equal(t, v.id.Pulse() < pulses[2], l.stored(t, prefixkey(scopeIDArchive, key)))
+
+		rec, err := l.objectStorage.GetRecord(ctx, v.jetID, &v.id)
+		require.NoError(t, err)
+		assert.Equal(t, v.rec, rec)
+		blobID := record.CalculateIDForBlob(l.scheme, v.id.Pulse(), v.blob)
+		blob, err := l.objectStorage.GetBlob(ctx, v.jetID, blobID)
+		require.NoError(t, err)
+		assert.Equal(t, v.blob, blob)
+	}
+
+	// Segments are named after their content hash.
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 4, len(files))
+	for _, f := range files {
+		content, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		require.NoError(t, err)
+		assert.Equal(t, hex.EncodeToString(l.scheme.IntegrityHasher().Hash(content))+".seg", f.Name())
+	}
+
+	stat, err = l.archiver.ArchiveUntil(ctx, pulses[1])
+	require.NoError(t, err)
+	assert.Equal(t, ArchiveStat{}, stat)
+}
+
+func TestArchiver_IterateRecordsOnPulse(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	dir, err := ioutil.TempDir("", "archive-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	l := newArchiveLedger(ctx, t, dir)
+	jetID := *jet.NewID(0, nil)
+	pn := core.PulseNumber(core.FirstPulseNumber + 1)
+	var ids []core.RecordID
+	for i := 0; i < 10; i++ {
+		id, err := l.objectStorage.SetRecord(ctx, jetID, pn, &record.ResultRecord{Payload: []byte{byte(i)}})
+		require.NoError(t, err)
+		ids = append(ids, *id)
+	}
+
+	_, err = l.archiver.ArchiveUntil(ctx, pn+1)
+	require.NoError(t, err)
+	// Late records stay in the storage and are merged with archived ones.
+	for i := 10; i < 20; i++ {
+		id, err := l.objectStorage.SetRecord(ctx, jetID, pn, &record.ResultRecord{Payload: []byte{byte(i)}})
+		require.NoError(t, err)
+		ids = append(ids, *id)
+	}
+
+	var iterated []core.RecordID
+	err = l.db.IterateRecordsOnPulse(ctx, jetID, pn, func(id core.RecordID, rec record.Record) error {
+		iterated = append(iterated, id)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, ids, iterated)
+	for i := 1; i < len(iterated); i++ {
+		assert.True(t, string(iterated[i-1][:]) < string(iterated[i][:]), "records are not ordered")
+	}
+}
+
+func TestArchiver_NotConfigured(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	l := newArchiveLedger(ctx, t, "")
+
+	_, err := l.archiver.ArchiveUntil(ctx, core.FirstPulseNumber)
+	assert.Error(t, err)
+}
+
+func TestSegmentRef(t *testing.T) {
+	ref := &segmentRef{Segment: []byte{1, 2, 3}, Offset: 1 << 40, Size: 42}
+	decoded, err := decodeSegmentRef(ref.bytes())
+	require.NoError(t, err)
+	assert.Equal(t, ref, decoded)
+
+	_, err = decodeSegmentRef(ref.bytes()[1:])
+	assert.Error(t, err)
+}
+
+func TestNextJetKey(t *testing.T) {
+	assert.Equal(t, []byte{scopeIDRecord, 0, 2}, nextJetKey(scopeIDRecord, []byte{0, 1}))
+	assert.Equal(t, []byte{scopeIDRecord, 1, 0}, nextJetKey(scopeIDRecord, []byte{0, 0xff}))
+	assert.Nil(t, nextJetKey(scopeIDRecord, []byte{0xff, 0xff}))
+}
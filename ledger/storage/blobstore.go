@@ -0,0 +1,243 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/insolar/insolar/core"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+)
+
+// Compression algorithms of blob contents selected by configuration.Storage.Compression.
+const (
+	// CompressionNone stores blob contents as is. It is the default.
+	CompressionNone = "none"
+	// CompressionSnappy compresses blob contents with snappy.
+	CompressionSnappy = "snappy"
+	// CompressionZstd compresses blob contents with zstd.
+	CompressionZstd = "zstd"
+)
+
+// Blobs are stored in content-addressed storage: blob key keeps blobRef marker and blob data lives under content
+// key built from blob hash (the hash part of blob id). The same data stored in different jets and pulses is kept once.
+//
+// Content value is a blobCodec byte followed by (possibly compressed) data. Contents are decoded by codec byte,
+// so compression can be changed without migration.
+//
+// Every content has a counter of blob keys referencing it. Counters are read and changed in the same key-value
+// transaction as blob keys, so concurrent store and removal of the same content can't lose it: Badger and in-memory
+// transactions conflict on commit (see KVTransaction.Commit) and bbolt runs them one by one. Content is removed with
+// the last blob referencing it.
+type blobCodec byte
+
+const (
+	blobCodecNone blobCodec = iota
+	blobCodecSnappy
+	blobCodecZstd
+)
+
+// blobRef is a value of blob key which data is stored in content storage.
+//
+// Blobs stored before content storage was introduced keep raw data. Raw blob equal to blobRef can't be told apart
+// from a reference, so it's read as missing if there is no such content.
+var blobRef = []byte{0xB1}
+
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+)
+
+func initZstd() {
+	zstdOnce.Do(func() {
+		var err error
+		zstdEncoder, err = zstd.NewWriter(nil)
+		if err != nil {
+			panic(err)
+		}
+		zstdDecoder, err = zstd.NewReader(nil)
+		if err != nil {
+			panic(err)
+		}
+	})
+}
+
+func blobCodecByName(name string) (blobCodec, error) {
+	switch name {
+	case "", CompressionNone:
+		return blobCodecNone, nil
+	case CompressionSnappy:
+		return blobCodecSnappy, nil
+	case CompressionZstd:
+		return blobCodecZstd, nil
+	default:
+		return 0, errors.Errorf("unknown blob compression %q", name)
+	}
+}
+
+// encode returns content value of blob data.
+func (c blobCodec) encode(blob []byte) []byte {
+	buf := []byte{byte(c)}
+	switch c {
+	case blobCodecSnappy:
+		return append(buf, snappy.Encode(nil, blob)...)
+	case blobCodecZstd:
+		initZstd()
+		return zstdEncoder.EncodeAll(blob, buf)
+	default:
+		return append(buf, blob...)
+	}
+}
+
+// decodeBlobContent returns blob data of content value.
+func decodeBlobContent(content []byte) ([]byte, error) {
+	if len(content) == 0 {
+		return nil, errors.New("empty blob content")
+	}
+	data := content[1:]
+	switch blobCodec(content[0]) {
+	case blobCodecNone:
+		return data, nil
+	case blobCodecSnappy:
+		return snappy.Decode(nil, data)
+	case blobCodecZstd:
+		initZstd()
+		return zstdDecoder.DecodeAll(data, nil)
+	default:
+		return nil, errors.Errorf("unknown blob codec %v", content[0])
+	}
+}
+
+// blobHash returns hash part of blob id from blob key.
+func blobHash(key []byte) []byte {
+	return key[len(key)-core.RecordHashSize:]
+}
+
+func blobContentKey(hash []byte) []byte {
+	return prefixkey(scopeIDBlobContent, hash)
+}
+
+func blobContentRefsKey(hash []byte) []byte {
+	return prefixkey(scopeIDBlobContentRefs, hash)
+}
+
+func getBlobContentRefs(txn KVTransaction, hash []byte) (uint64, error) {
+	buf, err := txn.Get(blobContentRefsKey(hash))
+	if err == core.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(buf) != 8 {
+		return 0, errors.Errorf("invalid blob content refs value %x", buf)
+	}
+	return binary.BigEndian.Uint64(buf), nil
+}
+
+func setBlobContentRefs(txn KVTransaction, hash []byte, refs uint64) error {
+	if refs == 0 {
+		return txn.Delete(blobContentRefsKey(hash))
+	}
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, refs)
+	return txn.Set(blobContentRefsKey(hash), buf)
+}
+
+// storeBlob points blob key to content of blob data. Content is written if it's not stored yet.
+//
+// Returns size of written content or zero if content was already stored.
+func storeBlob(txn KVTransaction, codec blobCodec, key, blob []byte) (int, error) {
+	hash := blobHash(key)
+	old, err := txn.Get(key)
+	if err != nil && err != core.ErrNotFound {
+		return 0, err
+	}
+	// Blob key already references the content.
+	if err == nil && bytes.Equal(old, blobRef) {
+		return 0, nil
+	}
+
+	refs, err := getBlobContentRefs(txn, hash)
+	if err != nil {
+		return 0, err
+	}
+	written := 0
+	if refs == 0 {
+		_, err = txn.Get(blobContentKey(hash))
+		if err == core.ErrNotFound {
+			content := codec.encode(blob)
+			written = len(content)
+			err = txn.Set(blobContentKey(hash), content)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	err = setBlobContentRefs(txn, hash, refs+1)
+	if err != nil {
+		return 0, err
+	}
+	return written, txn.Set(key, blobRef)
+}
+
+// releaseBlob is called when blob key with provided value is removed. It removes blob content if the key was its
+// last reference.
+func releaseBlob(txn KVTransaction, key, value []byte) error {
+	if !bytes.Equal(value, blobRef) {
+		return nil
+	}
+	hash := blobHash(key)
+	refs, err := getBlobContentRefs(txn, hash)
+	if err != nil || refs == 0 {
+		return err
+	}
+	err = setBlobContentRefs(txn, hash, refs-1)
+	if err != nil || refs > 1 {
+		return err
+	}
+	return txn.Delete(blobContentKey(hash))
+}
+
+// isBlobOf checks if blob key is built from hash of blob data.
+func isBlobOf(scheme core.PlatformCryptographyScheme, key, blob []byte) bool {
+	hasher := scheme.IntegrityHasher()
+	_, err := hasher.Write(blob)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(hasher.Sum(nil)[:core.RecordHashSize], blobHash(key))
+}
+
+// resolveBlob returns blob data by value of blob key. Get is used to read blob content.
+//
+// It returns core.ErrNotFound if blob content is missing.
+func resolveBlob(key, value []byte, get func(k []byte) ([]byte, error)) ([]byte, error) {
+	if !bytes.Equal(value, blobRef) {
+		return value, nil
+	}
+	content, err := get(blobContentKey(blobHash(key)))
+	if err != nil {
+		return nil, err
+	}
+	return decodeBlobContent(content)
+}
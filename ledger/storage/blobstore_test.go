@@ -0,0 +1,299 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/insolar/insolar/component"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/storage/jet"
+	"github.com/insolar/insolar/ledger/storage/record"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type blobLedger struct {
+	db            DBContext
+	objectStorage ObjectStorage
+	scheme        core.PlatformCryptographyScheme
+}
+
+func newBlobLedger(ctx context.Context, t *testing.T, compression string) *blobLedger {
+	db, err := NewDB(configuration.Ledger{
+		Storage: configuration.Storage{Backend: BackendMemory, Compression: compression},
+	}, nil)
+	require.NoError(t, err)
+	l := &blobLedger{
+		db:            db,
+		objectStorage: NewObjectStorage(),
+		scheme:        platformpolicy.NewPlatformCryptographyScheme(),
+	}
+	cm := &component.Manager{}
+	cm.Inject(l.scheme, l.db, l.objectStorage)
+	require.NoError(t, cm.Init(ctx))
+	return l
+}
+
+func (l *blobLedger) keys(t *testing.T, prefix ...byte) map[string][]byte {
+	values := map[string][]byte{}
+	err := kvView(l.db.GetKVStore(), func(txn KVTransaction) error {
+		return txn.Iterate(prefix, nil, func(k, v []byte) error {
+			values[string(k)] = append([]byte{}, v...)
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	return values
+}
+
+func TestBlobStore_Deduplication(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	l := newBlobLedger(ctx, t, "")
+	jets := []core.RecordID{*jet.NewID(1, nil), *jet.NewID(1, []byte{0x80})}
+	pulses := []core.PulseNumber{core.FirstPulseNumber + 1, core.FirstPulseNumber + 2}
+	blob := []byte("the same memory")
+
+	for _, jetID := range jets {
+		for _, pn := range pulses {
+			id, err := l.objectStorage.SetBlob(ctx, jetID, pn, blob)
+			require.NoError(t, err)
+			got, err := l.objectStorage.GetBlob(ctx, jetID, id)
+			require.NoError(t, err)
+			assert.Equal(t, blob, got)
+		}
+	}
+	_, err := l.objectStorage.SetBlob(ctx, jets[0], pulses[0], []byte("other memory"))
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, len(l.keys(t, scopeIDBlob)))
+	assert.Equal(t, 2, len(l.keys(t, scopeIDBlobContent)))
+}
+
+func TestBlobStore_Compression(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	blob := bytes.Repeat([]byte("compressible "), 100)
+
+	for _, compression := range []string{CompressionNone, CompressionSnappy, CompressionZstd} {
+		t.Run(compression, func(t *testing.T) {
+			l := newBlobLedger(ctx, t, compression)
+			id, err := l.objectStorage.SetBlob(ctx, core.TODOJetID, core.FirstPulseNumber, blob)
+			require.NoError(t, err)
+			got, err := l.objectStorage.GetBlob(ctx, core.TODOJetID, id)
+			require.NoError(t, err)
+			assert.Equal(t, blob, got)
+
+			content := l.keys(t, scopeIDBlobContent)[string(blobContentKey(id.Hash()))]
+			require.NotNil(t, content)
+			if compression == CompressionNone {
+				assert.Equal(t, len(blob)+1, len(content))
+			} else {
+				assert.True(t, len(content) < len(blob)/2, "content is compressed")
+			}
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := NewDB(configuration.Ledger{
+			Storage: configuration.Storage{Backend: BackendMemory, Compression: "lzma"},
+		}, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestBlobStore_DecodeAnyCodec(t *testing.T) {
+	blob := []byte("blob data")
+	for _, codec := range []blobCodec{blobCodecNone, blobCodecSnappy, blobCodecZstd} {
+		got, err := decodeBlobContent(codec.encode(blob))
+		require.NoError(t, err)
+		assert.Equal(t, blob, got)
+	}
+	_, err := decodeBlobContent([]byte{0xff, 1, 2})
+	assert.Error(t, err)
+	_, err = decodeBlobContent(nil)
+	assert.Error(t, err)
+}
+
+func TestBlobStore_RawValue(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	l := newBlobLedger(ctx, t, CompressionSnappy)
+
+	// Blobs stored before content storage keep raw data in blob key.
+	blob := []byte("raw blob")
+	id := record.CalculateIDForBlob(l.scheme, core.FirstPulseNumber, blob)
+	_, prefix := jet.Jet(core.TODOJetID)
+	require.NoError(t, l.db.set(ctx, prefixkey(scopeIDBlob, prefix, id[:]), blob))
+
+	got, err := l.objectStorage.GetBlob(ctx, core.TODOJetID, id)
+	require.NoError(t, err)
+	assert.Equal(t, blob, got)
+}
+
+func TestBlobStore_MissingContent(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	l := newBlobLedger(ctx, t, "")
+	id, err := l.objectStorage.SetBlob(ctx, core.TODOJetID, core.FirstPulseNumber, []byte("blob"))
+	require.NoError(t, err)
+	err = kvUpdate(l.db.GetKVStore(), func(txn KVTransaction) error {
+		return txn.Delete(blobContentKey(id.Hash()))
+	})
+	require.NoError(t, err)
+
+	_, err = l.objectStorage.GetBlob(ctx, core.TODOJetID, id)
+	assert.Equal(t, core.ErrNotFound, err)
+}
+
+func TestBlobStore_ContentRefs(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	l := newBlobLedger(ctx, t, "")
+	blob := []byte("blob")
+	jetID := *jet.NewID(0, nil)
+
+	blobID := record.CalculateIDForBlob(l.scheme, core.FirstPulseNumber, blob)
+	refs := func() uint64 {
+		var n uint64
+		err := kvView(l.db.GetKVStore(), func(txn KVTransaction) error {
+			var err error
+			n, err = getBlobContentRefs(txn, blobID.Hash())
+			return err
+		})
+		require.NoError(t, err)
+		return n
+	}
+
+	_, err := l.objectStorage.SetBlob(ctx, jetID, core.FirstPulseNumber+1, blob)
+	require.NoError(t, err)
+	// Overriding blob key doesn't add reference.
+	_, err = l.objectStorage.SetBlob(ctx, jetID, core.FirstPulseNumber+1, blob)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), refs())
+	id, err := l.objectStorage.SetBlob(ctx, jetID, core.FirstPulseNumber+2, blob)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), refs())
+
+	t.Run("concurrent removal conflicts", func(t *testing.T) {
+		// bbolt runs read-write transactions one by one, so store and removal can't interleave there.
+		forBackends(t, []string{BackendBadger, BackendMemory}, func(t *testing.T, store KVStore) {
+			codec := blobCodecNone
+			key := prefixkey(scopeIDBlob, make([]byte, core.RecordHashSize-1), id[:])
+			other := prefixkey(scopeIDBlob, []byte{1}, make([]byte, core.RecordHashSize-2), id[:])
+			require.NoError(t, kvUpdate(store, func(txn KVTransaction) error {
+				_, err := storeBlob(txn, codec, key, blob)
+				return err
+			}))
+
+			// Blob is stored while the last reference is being removed.
+			remove := store.NewTransaction(true)
+			defer remove.Discard()
+			require.NoError(t, remove.Delete(key))
+			require.NoError(t, releaseBlob(remove, key, blobRef))
+			require.NoError(t, kvUpdate(store, func(txn KVTransaction) error {
+				_, err := storeBlob(txn, codec, other, blob)
+				return err
+			}))
+			assert.Equal(t, ErrConflict, remove.Commit())
+
+			require.NoError(t, kvView(store, func(txn KVTransaction) error {
+				got, err := resolveBlob(other, blobRef, txn.Get)
+				assert.Equal(t, blob, got)
+				return err
+			}))
+		})
+	})
+}
+
+func TestBlobStore_Replication(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	light := newBlobLedger(ctx, t, CompressionZstd)
+	jetID := *jet.NewID(0, nil)
+	blobs := [][]byte{[]byte("first"), []byte("second"), []byte("first")}
+
+	var ids []*core.RecordID
+	for i, blob := range blobs {
+		id, err := light.objectStorage.SetBlob(ctx, jetID, core.FirstPulseNumber+core.PulseNumber(i), blob)
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	var kvs []core.KV
+	replicator := NewReplicaIter(ctx, light.db, jetID, core.FirstPulseNumber, core.FirstPulseNumber+10, 100500)
+	for {
+		recs, err := replicator.NextRecords()
+		if err == ErrReplicatorDone {
+			break
+		}
+		require.NoError(t, err)
+		kvs = append(kvs, recs...)
+	}
+	sent := map[string][]byte{}
+	for _, kv := range kvs {
+		if kv.K[0] == scopeIDBlob {
+			sent[string(kv.K)] = kv.V
+		}
+	}
+	// Heavy receives blobs data.
+	require.Equal(t, 3, len(sent))
+	for i, id := range ids {
+		assert.Equal(t, blobs[i], sent[string(prefixkey(scopeIDBlob, make([]byte, core.RecordHashSize-1), id[:]))])
+	}
+
+	heavy := newBlobLedger(ctx, t, CompressionSnappy)
+	require.NoError(t, heavy.db.StoreKeyValues(ctx, kvs))
+	assert.Equal(t, 2, len(heavy.keys(t, scopeIDBlobContent)))
+	for i, id := range ids {
+		got, err := heavy.objectStorage.GetBlob(ctx, jetID, id)
+		require.NoError(t, err)
+		assert.Equal(t, blobs[i], got)
+	}
+}
+
+func TestCleaner_RemoveJetBlobsUntil(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	l := newBlobLedger(ctx, t, "")
+	c := &cleaner{DB: l.db}
+	jets := []core.RecordID{*jet.NewID(1, nil), *jet.NewID(1, []byte{0x80})}
+	pn := core.PulseNumber(core.FirstPulseNumber + 1)
+
+	shared, err := l.objectStorage.SetBlob(ctx, jets[0], pn, []byte("shared"))
+	require.NoError(t, err)
+	_, err = l.objectStorage.SetBlob(ctx, jets[1], pn, []byte("shared"))
+	require.NoError(t, err)
+	own, err := l.objectStorage.SetBlob(ctx, jets[0], pn, []byte("own"))
+	require.NoError(t, err)
+
+	stat, err := c.RemoveJetBlobsUntil(ctx, jets[0], pn+1)
+	require.NoError(t, err)
+	assert.Equal(t, RmStat{Scanned: 2, Removed: 2}, stat)
+
+	contents := l.keys(t, scopeIDBlobContent)
+	assert.Contains(t, contents, string(blobContentKey(shared.Hash())))
+	assert.NotContains(t, contents, string(blobContentKey(own.Hash())))
+	assert.Equal(t, 1, len(l.keys(t, scopeIDBlobContentRefs)))
+	got, err := l.objectStorage.GetBlob(ctx, jets[1], shared)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("shared"), got)
+
+	_, err = c.RemoveJetBlobsUntil(ctx, jets[1], pn+1)
+	require.NoError(t, err)
+	assert.Empty(t, l.keys(t, scopeIDBlobContent))
+	assert.Empty(t, l.keys(t, scopeIDBlobContentRefs))
+}
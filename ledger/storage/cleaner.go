@@ -19,7 +19,6 @@ package storage
 import (
 	"context"
 
-	"github.com/dgraph-io/badger"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/instrumentation/insmetrics"
@@ -57,6 +56,9 @@ func NewCleaner() Cleaner {
 
 var rmScanFromPulse = core.PulseNumber(core.FirstPulseNumber + 1).Bytes()
 
+// cleanBatchSize is a number of keys removed in one transaction.
+const cleanBatchSize = 1000
+
 // RmStat holds removal statistics
 type RmStat struct {
 	Scanned int64
@@ -108,58 +110,90 @@ func (c *cleaner) CleanJetRecordsUntilPulse(
 	}
 	allstat["drops"] = stat
 
+	if stat, err = c.RemoveJetResultsUntil(ctx, jetID, pn); err != nil {
+		result = multierror.Append(result, errors.Wrap(err, "RemoveJetResultsUntil"))
+		stat.Errors = stat.Scanned
+		stat.Removed = 0
+	}
+	allstat["results"] = stat
+
 	recordCleanupMetrics(ctx, allstat)
 
 	return allstat, result
 }
 
 // RemoveJetBlobsUntil removes for provided JetID all blobs older than provided pulse number.
+//
+// Blob contents are removed with the last blobs referencing them.
 func (c *cleaner) RemoveJetBlobsUntil(ctx context.Context, jetID core.RecordID, pn core.PulseNumber) (RmStat, error) {
-	return c.removeJetRecordsUntil(ctx, scopeIDBlob, jetID, pn)
+	return c.removeJetRecordsUntil(ctx, scopeIDBlob, jetID, pn, releaseBlob)
 }
 
 // RemoveJetRecordsUntil removes for provided JetID all records older than provided pulse number.
 // In recods pending requests live, so we need recent storage here
 func (c *cleaner) RemoveJetRecordsUntil(ctx context.Context, jetID core.RecordID, pn core.PulseNumber) (RmStat, error) {
-	return c.removeJetRecordsUntil(ctx, scopeIDRecord, jetID, pn)
+	return c.removeJetRecordsUntil(ctx, scopeIDRecord, jetID, pn, nil)
 }
 
 // RemoveJetDropsUntil removes for provided JetID all jet drops older than provided pulse number.
 func (c *cleaner) RemoveJetDropsUntil(ctx context.Context, jetID core.RecordID, pn core.PulseNumber) (RmStat, error) {
-	return c.removeJetRecordsUntil(ctx, scopeIDJetDrop, jetID, pn)
+	return c.removeJetRecordsUntil(ctx, scopeIDJetDrop, jetID, pn, nil)
+}
+
+// RemoveJetResultsUntil removes for provided JetID all request results for requests older than provided pulse number.
+func (c *cleaner) RemoveJetResultsUntil(ctx context.Context, jetID core.RecordID, pn core.PulseNumber) (RmStat, error) {
+	return c.removeJetRecordsUntil(ctx, scopeIDResult, jetID, pn, nil)
 }
 
+// removeJetRecordsUntil removes keys of namespace in batches, every batch in its own transaction. Release is called
+// in the same transaction for every removed key if it's not nil.
 func (c *cleaner) removeJetRecordsUntil(
 	ctx context.Context,
 	namespace byte,
 	jetID core.RecordID,
 	pn core.PulseNumber,
+	release func(txn KVTransaction, key, value []byte) error,
 ) (RmStat, error) {
 	var stat RmStat
 	_, prefix := jet.Jet(jetID)
 	jetprefix := prefixkey(namespace, prefix)
 	startprefix := prefixkey(namespace, prefix, rmScanFromPulse)
 
-	return stat, c.DB.GetBadgerDB().Update(func(txn *badger.Txn) error {
-		opts := badger.DefaultIteratorOptions
-		opts.PrefetchSize = 0
-		opts.PrefetchValues = false
-		it := txn.NewIterator(opts)
-		defer it.Close()
-		for it.Seek(startprefix); it.ValidForPrefix(jetprefix); it.Next() {
-			key := it.Item().KeyCopy(nil)
-			if pulseFromKey(key) >= pn {
-				break
-			}
-			stat.Scanned++
-
-			if err := txn.Delete(key); err != nil {
-				return err
-			}
-			stat.Removed++
+	for startprefix != nil {
+		var batch RmStat
+		err := kvUpdate(c.DB.GetKVStore(), func(txn KVTransaction) error {
+			batch = RmStat{}
+			next := startprefix
+			startprefix = nil
+			return txn.Iterate(jetprefix, next, func(key, value []byte) error {
+				if pulseFromKey(key) >= pn {
+					return ErrStopIteration
+				}
+				if batch.Scanned == cleanBatchSize {
+					startprefix = key
+					return ErrStopIteration
+				}
+				batch.Scanned++
+
+				if err := txn.Delete(key); err != nil {
+					return err
+				}
+				if release != nil {
+					if err := release(txn, key, value); err != nil {
+						return err
+					}
+				}
+				batch.Removed++
+				return nil
+			})
+		})
+		stat.Scanned += batch.Scanned
+		if err != nil {
+			return stat, err
 		}
-		return nil
-	})
+		stat.Removed += batch.Removed
+	}
+	return stat, nil
 }
 
 // CleanJetIndexes removes indexes from candidates list,
@@ -179,7 +213,7 @@ func (c *cleaner) CleanJetIndexes(
 		for _, recID := range fordelete {
 			stat.Scanned++
 			key := prefixkey(scopeIDLifeline, prefix, recID[:])
-			err := c.DB.GetBadgerDB().Update(func(txn *badger.Txn) error {
+			err := kvUpdate(c.DB.GetKVStore(), func(txn KVTransaction) error {
 				return txn.Delete(key)
 			})
 			if err != nil {
@@ -18,7 +18,6 @@ package storage
 
 import (
 	"context"
-	"path/filepath"
 	"sync"
 
 	"github.com/dgraph-io/badger"
@@ -37,6 +36,16 @@ const (
 	scopeIDSystem   byte = 5
 	scopeIDMessage  byte = 6
 	scopeIDBlob     byte = 7
+	scopeIDResult   byte = 8
+	// scopeIDJetRecord links records stored on heavy node without jet prefix to their jets, see JetRecordLinks.
+	scopeIDJetRecord byte = 9
+	scopeIDArchive   byte = 10
+	// scopeIDSecondaryIndex is a heavy node index over records, see SecondaryIndex.
+	scopeIDSecondaryIndex byte = 11
+	// scopeIDBlobContent is a content-addressed storage of blobs data, see blobstore.go.
+	scopeIDBlobContent byte = 12
+	// scopeIDBlobContentRefs counts blob keys referencing blob content, see blobstore.go.
+	scopeIDBlobContentRefs byte = 13
 
 	sysGenesis                byte = 1
 	sysLatestPulse            byte = 2
@@ -44,6 +53,9 @@ const (
 	sysLastSyncedPulseOnHeavy byte = 4
 	sysJetList                byte = 5
 	sysDropSizeHistory        byte = 6
+	sysArchivedPulse          byte = 7
+	sysHeavySyncProgress      byte = 8
+	sysSecondaryIndexVersion  byte = 9
 )
 
 // DBContext provides base db methods
@@ -62,7 +74,7 @@ type DBContext interface {
 
 	StoreKeyValues(ctx context.Context, kvs []core.KV) error
 
-	GetBadgerDB() *badger.DB
+	GetKVStore() KVStore
 
 	Close() error
 
@@ -78,18 +90,22 @@ type DBContext interface {
 	) error
 }
 
-// DB represents BadgerDB storage implementation.
+// DB represents ledger storage implementation on top of key-value store.
 type DB struct {
 	PlatformCryptographyScheme core.PlatformCryptographyScheme `inject:""`
 
-	db *badger.DB
+	store KVStore
+	// segments holds archived values. It is nil if archive directory is not configured.
+	segments *segmentStore
+	// blobCodec compresses contents of new blobs.
+	blobCodec blobCodec
 
 	// dropLock protects dropWG from concurrent calls to Add and Wait
 	dropLock sync.Mutex
 	// dropWG guards inflight updates before jet drop calculated.
 	dropWG sync.WaitGroup
 
-	// for BadgerDB it is normal to have transaction conflicts
+	// for some backends (e.g. BadgerDB) it is normal to have transaction conflicts
 	// and these conflicts we should resolve by ourself
 	// so txretiries is our knob to tune up retry logic.
 	txretiries int
@@ -101,47 +117,34 @@ type DB struct {
 	isClosed  bool
 }
 
-func setOptions(o *badger.Options) *badger.Options {
-	newo := &badger.Options{}
-	if o != nil {
-		*newo = *o
-	} else {
-		*newo = badger.DefaultOptions
-	}
-	return newo
-}
-
-// NewDB returns storage.DB with BadgerDB instance initialized by opts.
-// Creates database in provided dir or in current directory if dir parameter is empty.
+// NewDB returns storage.DB with key-value store of the configured backend. Badger backend is initialized by opts.
+// Creates database in configured data directory or in current directory if it is empty. Values archived by Archiver
+// are read from configured archive directory.
 func NewDB(conf configuration.Ledger, opts *badger.Options) (DBContext, error) {
-	opts = setOptions(opts)
-	dir, err := filepath.Abs(conf.Storage.DataDirectory)
+	codec, err := blobCodecByName(conf.Storage.Compression)
 	if err != nil {
 		return nil, err
 	}
-
-	opts.Dir = dir
-	opts.ValueDir = dir
-
-	bdb, err := badger.Open(*opts)
+	store, err := NewKVStore(conf.Storage, opts)
 	if err != nil {
 		return nil, errors.Wrap(err, "local database open failed")
 	}
 
 	db := &DB{
-		db:                   bdb,
+		store:                store,
+		blobCodec:            codec,
 		txretiries:           conf.Storage.TxRetriesOnConflict,
 		idlocker:             NewIDLocker(),
 		jetHeavyClientLocker: NewIDLocker(),
 	}
+	if conf.Archive.Directory != "" {
+		db.segments = newArchiveSegments(conf.Archive.Directory)
+	}
 	return db, nil
 }
 
-// Close wraps BadgerDB Close method.
-//
-// From https://godoc.org/github.com/dgraph-io/badger#DB.Close:
-// «It's crucial to call it to ensure all the pending updates make their way to disk.
-// Calling DB.Close() multiple times is not safe and wouldcause panic.»
+// Close closes underlying key-value store. It's crucial to call it to ensure all the pending updates make their way
+// to disk.
 func (db *DB) Close() error {
 	db.closeLock.Lock()
 	defer db.closeLock.Unlock()
@@ -150,7 +153,7 @@ func (db *DB) Close() error {
 	}
 	db.isClosed = true
 
-	return db.db.Close()
+	return db.store.Close()
 }
 
 // Stop stops DB component.
@@ -209,7 +212,7 @@ func (db *DB) Update(ctx context.Context, fn func(*TransactionManager) error) er
 		if err == nil {
 			break
 		}
-		if err != badger.ErrConflict {
+		if err != ErrConflict {
 			break
 		}
 		if tries < 1 {
@@ -228,9 +231,9 @@ func (db *DB) Update(ctx context.Context, fn func(*TransactionManager) error) er
 	return err
 }
 
-// GetBadgerDB return badger.DB instance (for internal usage, like tests)
-func (db *DB) GetBadgerDB() *badger.DB {
-	return db.db
+// GetKVStore returns underlying key-value store (for internal usage, like tests)
+func (db *DB) GetKVStore() KVStore {
+	return db.store
 }
 
 // IterateRecordsOnPulse iterates over records on provided Jet ID and Pulse.
@@ -258,7 +261,13 @@ func (db *DB) IterateRecordsOnPulse(
 func (db *DB) StoreKeyValues(ctx context.Context, kvs []core.KV) error {
 	return db.Update(ctx, func(tx *TransactionManager) error {
 		for _, rec := range kvs {
-			err := tx.set(ctx, rec.K, rec.V)
+			var err error
+			// Replicated blobs are deduplicated like the local ones if their ids match data.
+			if len(rec.K) > core.RecordHashSize && rec.K[0] == scopeIDBlob && isBlobOf(db.PlatformCryptographyScheme, rec.K, rec.V) {
+				err = tx.setBlob(ctx, rec.K, rec.V)
+			} else {
+				err = tx.set(ctx, rec.K, rec.V)
+			}
 			if err != nil {
 				return err
 			}
@@ -305,21 +314,9 @@ func (db *DB) iterate(
 		return ErrClosed
 	}
 
-	return db.db.View(func(txn *badger.Txn) error {
-		it := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer it.Close()
-
-		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
-			key := it.Item().KeyCopy(nil)[len(prefix):]
-			value, err := it.Item().ValueCopy(nil)
-			if err != nil {
-				return err
-			}
-			err = handler(key, value)
-			if err != nil {
-				return err
-			}
-		}
-		return nil
+	return kvView(db.store, func(txn KVTransaction) error {
+		return iterateWithArchive(txn, db.segments, prefix, func(k, v []byte) error {
+			return handler(k[len(prefix):], v)
+		})
 	})
 }
@@ -10,7 +10,6 @@ import (
 	"sync/atomic"
 	"time"
 
-	badger "github.com/dgraph-io/badger"
 	"github.com/gojuno/minimock"
 	core "github.com/insolar/insolar/core"
 	record "github.com/insolar/insolar/ledger/storage/record"
@@ -32,10 +31,10 @@ type DBContextMock struct {
 	ClosePreCounter uint64
 	CloseMock       mDBContextMockClose
 
-	GetBadgerDBFunc       func() (r *badger.DB)
-	GetBadgerDBCounter    uint64
-	GetBadgerDBPreCounter uint64
-	GetBadgerDBMock       mDBContextMockGetBadgerDB
+	GetKVStoreFunc       func() (r KVStore)
+	GetKVStoreCounter    uint64
+	GetKVStorePreCounter uint64
+	GetKVStoreMock       mDBContextMockGetKVStore
 
 	IterateRecordsOnPulseFunc       func(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 func(p core.RecordID, p1 record.Record) (r error)) (r error)
 	IterateRecordsOnPulseCounter    uint64
@@ -88,7 +87,7 @@ func NewDBContextMock(t minimock.Tester) *DBContextMock {
 
 	m.BeginTransactionMock = mDBContextMockBeginTransaction{mock: m}
 	m.CloseMock = mDBContextMockClose{mock: m}
-	m.GetBadgerDBMock = mDBContextMockGetBadgerDB{mock: m}
+	m.GetKVStoreMock = mDBContextMockGetKVStore{mock: m}
 	m.IterateRecordsOnPulseMock = mDBContextMockIterateRecordsOnPulse{mock: m}
 	m.StoreKeyValuesMock = mDBContextMockStoreKeyValues{mock: m}
 	m.UpdateMock = mDBContextMockUpdate{mock: m}
@@ -385,82 +384,82 @@ func (m *DBContextMock) CloseFinished() bool {
 	return true
 }
 
-type mDBContextMockGetBadgerDB struct {
+type mDBContextMockGetKVStore struct {
 	mock              *DBContextMock
-	mainExpectation   *DBContextMockGetBadgerDBExpectation
-	expectationSeries []*DBContextMockGetBadgerDBExpectation
+	mainExpectation   *DBContextMockGetKVStoreExpectation
+	expectationSeries []*DBContextMockGetKVStoreExpectation
 }
 
-type DBContextMockGetBadgerDBExpectation struct {
-	result *DBContextMockGetBadgerDBResult
+type DBContextMockGetKVStoreExpectation struct {
+	result *DBContextMockGetKVStoreResult
 }
 
-type DBContextMockGetBadgerDBResult struct {
-	r *badger.DB
+type DBContextMockGetKVStoreResult struct {
+	r KVStore
 }
 
-//Expect specifies that invocation of DBContext.GetBadgerDB is expected from 1 to Infinity times
-func (m *mDBContextMockGetBadgerDB) Expect() *mDBContextMockGetBadgerDB {
-	m.mock.GetBadgerDBFunc = nil
+//Expect specifies that invocation of DBContext.GetKVStore is expected from 1 to Infinity times
+func (m *mDBContextMockGetKVStore) Expect() *mDBContextMockGetKVStore {
+	m.mock.GetKVStoreFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
-		m.mainExpectation = &DBContextMockGetBadgerDBExpectation{}
+		m.mainExpectation = &DBContextMockGetKVStoreExpectation{}
 	}
 
 	return m
 }
 
-//Return specifies results of invocation of DBContext.GetBadgerDB
-func (m *mDBContextMockGetBadgerDB) Return(r *badger.DB) *DBContextMock {
-	m.mock.GetBadgerDBFunc = nil
+//Return specifies results of invocation of DBContext.GetKVStore
+func (m *mDBContextMockGetKVStore) Return(r KVStore) *DBContextMock {
+	m.mock.GetKVStoreFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
-		m.mainExpectation = &DBContextMockGetBadgerDBExpectation{}
+		m.mainExpectation = &DBContextMockGetKVStoreExpectation{}
 	}
-	m.mainExpectation.result = &DBContextMockGetBadgerDBResult{r}
+	m.mainExpectation.result = &DBContextMockGetKVStoreResult{r}
 	return m.mock
 }
 
-//ExpectOnce specifies that invocation of DBContext.GetBadgerDB is expected once
-func (m *mDBContextMockGetBadgerDB) ExpectOnce() *DBContextMockGetBadgerDBExpectation {
-	m.mock.GetBadgerDBFunc = nil
+//ExpectOnce specifies that invocation of DBContext.GetKVStore is expected once
+func (m *mDBContextMockGetKVStore) ExpectOnce() *DBContextMockGetKVStoreExpectation {
+	m.mock.GetKVStoreFunc = nil
 	m.mainExpectation = nil
 
-	expectation := &DBContextMockGetBadgerDBExpectation{}
+	expectation := &DBContextMockGetKVStoreExpectation{}
 
 	m.expectationSeries = append(m.expectationSeries, expectation)
 	return expectation
 }
 
-func (e *DBContextMockGetBadgerDBExpectation) Return(r *badger.DB) {
-	e.result = &DBContextMockGetBadgerDBResult{r}
+func (e *DBContextMockGetKVStoreExpectation) Return(r KVStore) {
+	e.result = &DBContextMockGetKVStoreResult{r}
 }
 
-//Set uses given function f as a mock of DBContext.GetBadgerDB method
-func (m *mDBContextMockGetBadgerDB) Set(f func() (r *badger.DB)) *DBContextMock {
+//Set uses given function f as a mock of DBContext.GetKVStore method
+func (m *mDBContextMockGetKVStore) Set(f func() (r KVStore)) *DBContextMock {
 	m.mainExpectation = nil
 	m.expectationSeries = nil
 
-	m.mock.GetBadgerDBFunc = f
+	m.mock.GetKVStoreFunc = f
 	return m.mock
 }
 
-//GetBadgerDB implements github.com/insolar/insolar/ledger/storage.DBContext interface
-func (m *DBContextMock) GetBadgerDB() (r *badger.DB) {
-	counter := atomic.AddUint64(&m.GetBadgerDBPreCounter, 1)
-	defer atomic.AddUint64(&m.GetBadgerDBCounter, 1)
+//GetKVStore implements github.com/insolar/insolar/ledger/storage.DBContext interface
+func (m *DBContextMock) GetKVStore() (r KVStore) {
+	counter := atomic.AddUint64(&m.GetKVStorePreCounter, 1)
+	defer atomic.AddUint64(&m.GetKVStoreCounter, 1)
 
-	if len(m.GetBadgerDBMock.expectationSeries) > 0 {
-		if counter > uint64(len(m.GetBadgerDBMock.expectationSeries)) {
-			m.t.Fatalf("Unexpected call to DBContextMock.GetBadgerDB.")
+	if len(m.GetKVStoreMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetKVStoreMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DBContextMock.GetKVStore.")
 			return
 		}
 
-		result := m.GetBadgerDBMock.expectationSeries[counter-1].result
+		result := m.GetKVStoreMock.expectationSeries[counter-1].result
 		if result == nil {
-			m.t.Fatal("No results are set for the DBContextMock.GetBadgerDB")
+			m.t.Fatal("No results are set for the DBContextMock.GetKVStore")
 			return
 		}
 
@@ -469,11 +468,11 @@ func (m *DBContextMock) GetBadgerDB() (r *badger.DB) {
 		return
 	}
 
-	if m.GetBadgerDBMock.mainExpectation != nil {
+	if m.GetKVStoreMock.mainExpectation != nil {
 
-		result := m.GetBadgerDBMock.mainExpectation.result
+		result := m.GetKVStoreMock.mainExpectation.result
 		if result == nil {
-			m.t.Fatal("No results are set for the DBContextMock.GetBadgerDB")
+			m.t.Fatal("No results are set for the DBContextMock.GetKVStore")
 		}
 
 		r = result.r
@@ -481,39 +480,39 @@ func (m *DBContextMock) GetBadgerDB() (r *badger.DB) {
 		return
 	}
 
-	if m.GetBadgerDBFunc == nil {
-		m.t.Fatalf("Unexpected call to DBContextMock.GetBadgerDB.")
+	if m.GetKVStoreFunc == nil {
+		m.t.Fatalf("Unexpected call to DBContextMock.GetKVStore.")
 		return
 	}
 
-	return m.GetBadgerDBFunc()
+	return m.GetKVStoreFunc()
 }
 
-//GetBadgerDBMinimockCounter returns a count of DBContextMock.GetBadgerDBFunc invocations
-func (m *DBContextMock) GetBadgerDBMinimockCounter() uint64 {
-	return atomic.LoadUint64(&m.GetBadgerDBCounter)
+//GetKVStoreMinimockCounter returns a count of DBContextMock.GetKVStoreFunc invocations
+func (m *DBContextMock) GetKVStoreMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetKVStoreCounter)
 }
 
-//GetBadgerDBMinimockPreCounter returns the value of DBContextMock.GetBadgerDB invocations
-func (m *DBContextMock) GetBadgerDBMinimockPreCounter() uint64 {
-	return atomic.LoadUint64(&m.GetBadgerDBPreCounter)
+//GetKVStoreMinimockPreCounter returns the value of DBContextMock.GetKVStore invocations
+func (m *DBContextMock) GetKVStoreMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetKVStorePreCounter)
 }
 
-//GetBadgerDBFinished returns true if mock invocations count is ok
-func (m *DBContextMock) GetBadgerDBFinished() bool {
+//GetKVStoreFinished returns true if mock invocations count is ok
+func (m *DBContextMock) GetKVStoreFinished() bool {
 	// if expectation series were set then invocations count should be equal to expectations count
-	if len(m.GetBadgerDBMock.expectationSeries) > 0 {
-		return atomic.LoadUint64(&m.GetBadgerDBCounter) == uint64(len(m.GetBadgerDBMock.expectationSeries))
+	if len(m.GetKVStoreMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetKVStoreCounter) == uint64(len(m.GetKVStoreMock.expectationSeries))
 	}
 
 	// if main expectation was set then invocations count should be greater than zero
-	if m.GetBadgerDBMock.mainExpectation != nil {
-		return atomic.LoadUint64(&m.GetBadgerDBCounter) > 0
+	if m.GetKVStoreMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetKVStoreCounter) > 0
 	}
 
 	// if func was set then invocations count should be greater than zero
-	if m.GetBadgerDBFunc != nil {
-		return atomic.LoadUint64(&m.GetBadgerDBCounter) > 0
+	if m.GetKVStoreFunc != nil {
+		return atomic.LoadUint64(&m.GetKVStoreCounter) > 0
 	}
 
 	return true
@@ -1684,8 +1683,8 @@ func (m *DBContextMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to DBContextMock.Close")
 	}
 
-	if !m.GetBadgerDBFinished() {
-		m.t.Fatal("Expected call to DBContextMock.GetBadgerDB")
+	if !m.GetKVStoreFinished() {
+		m.t.Fatal("Expected call to DBContextMock.GetKVStore")
 	}
 
 	if !m.IterateRecordsOnPulseFinished() {
@@ -1745,8 +1744,8 @@ func (m *DBContextMock) MinimockFinish() {
 		m.t.Fatal("Expected call to DBContextMock.Close")
 	}
 
-	if !m.GetBadgerDBFinished() {
-		m.t.Fatal("Expected call to DBContextMock.GetBadgerDB")
+	if !m.GetKVStoreFinished() {
+		m.t.Fatal("Expected call to DBContextMock.GetKVStore")
 	}
 
 	if !m.IterateRecordsOnPulseFinished() {
@@ -1797,7 +1796,7 @@ func (m *DBContextMock) MinimockWait(timeout time.Duration) {
 		ok := true
 		ok = ok && m.BeginTransactionFinished()
 		ok = ok && m.CloseFinished()
-		ok = ok && m.GetBadgerDBFinished()
+		ok = ok && m.GetKVStoreFinished()
 		ok = ok && m.IterateRecordsOnPulseFinished()
 		ok = ok && m.StoreKeyValuesFinished()
 		ok = ok && m.UpdateFinished()
@@ -1822,8 +1821,8 @@ func (m *DBContextMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to DBContextMock.Close")
 			}
 
-			if !m.GetBadgerDBFinished() {
-				m.t.Error("Expected call to DBContextMock.GetBadgerDB")
+			if !m.GetKVStoreFinished() {
+				m.t.Error("Expected call to DBContextMock.GetKVStore")
 			}
 
 			if !m.IterateRecordsOnPulseFinished() {
@@ -1878,7 +1877,7 @@ func (m *DBContextMock) AllMocksCalled() bool {
 		return false
 	}
 
-	if !m.GetBadgerDBFinished() {
+	if !m.GetKVStoreFinished() {
 		return false
 	}
 
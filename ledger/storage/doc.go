@@ -14,5 +14,6 @@
  *    limitations under the License.
  */
 
-// Package storage contains ledger storage implementation on top of BadgerDB engine.
+// Package storage contains ledger storage implementation on top of key-value engine. Supported engines are BadgerDB
+// (default), bbolt and in-memory one (configured by configuration.Storage.Backend).
 package storage
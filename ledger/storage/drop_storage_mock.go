@@ -46,6 +46,11 @@ type DropStorageMock struct {
 	GetJetSizesHistoryDepthPreCounter uint64
 	GetJetSizesHistoryDepthMock       mDropStorageMockGetJetSizesHistoryDepth
 
+	GetRecordProofFunc       func(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 core.RecordID) (r *core.RecordProof, r1 error)
+	GetRecordProofCounter    uint64
+	GetRecordProofPreCounter uint64
+	GetRecordProofMock       mDropStorageMockGetRecordProof
+
 	SetDropFunc       func(p context.Context, p1 core.RecordID, p2 *jet.JetDrop) (r error)
 	SetDropCounter    uint64
 	SetDropPreCounter uint64
@@ -70,6 +75,7 @@ func NewDropStorageMock(t minimock.Tester) *DropStorageMock {
 	m.GetDropMock = mDropStorageMockGetDrop{mock: m}
 	m.GetDropSizeHistoryMock = mDropStorageMockGetDropSizeHistory{mock: m}
 	m.GetJetSizesHistoryDepthMock = mDropStorageMockGetJetSizesHistoryDepth{mock: m}
+	m.GetRecordProofMock = mDropStorageMockGetRecordProof{mock: m}
 	m.SetDropMock = mDropStorageMockSetDrop{mock: m}
 	m.SetDropSizeHistoryMock = mDropStorageMockSetDropSizeHistory{mock: m}
 
@@ -820,6 +826,159 @@ func (m *DropStorageMock) GetJetSizesHistoryDepthFinished() bool {
 	return true
 }
 
+type mDropStorageMockGetRecordProof struct {
+	mock              *DropStorageMock
+	mainExpectation   *DropStorageMockGetRecordProofExpectation
+	expectationSeries []*DropStorageMockGetRecordProofExpectation
+}
+
+type DropStorageMockGetRecordProofExpectation struct {
+	input  *DropStorageMockGetRecordProofInput
+	result *DropStorageMockGetRecordProofResult
+}
+
+type DropStorageMockGetRecordProofInput struct {
+	p  context.Context
+	p1 core.RecordID
+	p2 core.PulseNumber
+	p3 core.RecordID
+}
+
+type DropStorageMockGetRecordProofResult struct {
+	r  *core.RecordProof
+	r1 error
+}
+
+//Expect specifies that invocation of DropStorage.GetRecordProof is expected from 1 to Infinity times
+func (m *mDropStorageMockGetRecordProof) Expect(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 core.RecordID) *mDropStorageMockGetRecordProof {
+	m.mock.GetRecordProofFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DropStorageMockGetRecordProofExpectation{}
+	}
+	m.mainExpectation.input = &DropStorageMockGetRecordProofInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of DropStorage.GetRecordProof
+func (m *mDropStorageMockGetRecordProof) Return(r *core.RecordProof, r1 error) *DropStorageMock {
+	m.mock.GetRecordProofFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DropStorageMockGetRecordProofExpectation{}
+	}
+	m.mainExpectation.result = &DropStorageMockGetRecordProofResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of DropStorage.GetRecordProof is expected once
+func (m *mDropStorageMockGetRecordProof) ExpectOnce(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 core.RecordID) *DropStorageMockGetRecordProofExpectation {
+	m.mock.GetRecordProofFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &DropStorageMockGetRecordProofExpectation{}
+	expectation.input = &DropStorageMockGetRecordProofInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *DropStorageMockGetRecordProofExpectation) Return(r *core.RecordProof, r1 error) {
+	e.result = &DropStorageMockGetRecordProofResult{r, r1}
+}
+
+//Set uses given function f as a mock of DropStorage.GetRecordProof method
+func (m *mDropStorageMockGetRecordProof) Set(f func(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 core.RecordID) (r *core.RecordProof, r1 error)) *DropStorageMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetRecordProofFunc = f
+	return m.mock
+}
+
+//GetRecordProof implements github.com/insolar/insolar/ledger/storage.DropStorage interface
+func (m *DropStorageMock) GetRecordProof(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 core.RecordID) (r *core.RecordProof, r1 error) {
+	counter := atomic.AddUint64(&m.GetRecordProofPreCounter, 1)
+	defer atomic.AddUint64(&m.GetRecordProofCounter, 1)
+
+	if len(m.GetRecordProofMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetRecordProofMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DropStorageMock.GetRecordProof. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.GetRecordProofMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, DropStorageMockGetRecordProofInput{p, p1, p2, p3}, "DropStorage.GetRecordProof got unexpected parameters")
+
+		result := m.GetRecordProofMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the DropStorageMock.GetRecordProof")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetRecordProofMock.mainExpectation != nil {
+
+		input := m.GetRecordProofMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, DropStorageMockGetRecordProofInput{p, p1, p2, p3}, "DropStorage.GetRecordProof got unexpected parameters")
+		}
+
+		result := m.GetRecordProofMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the DropStorageMock.GetRecordProof")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetRecordProofFunc == nil {
+		m.t.Fatalf("Unexpected call to DropStorageMock.GetRecordProof. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.GetRecordProofFunc(p, p1, p2, p3)
+}
+
+//GetRecordProofMinimockCounter returns a count of DropStorageMock.GetRecordProofFunc invocations
+func (m *DropStorageMock) GetRecordProofMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetRecordProofCounter)
+}
+
+//GetRecordProofMinimockPreCounter returns the value of DropStorageMock.GetRecordProof invocations
+func (m *DropStorageMock) GetRecordProofMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetRecordProofPreCounter)
+}
+
+//GetRecordProofFinished returns true if mock invocations count is ok
+func (m *DropStorageMock) GetRecordProofFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetRecordProofMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetRecordProofCounter) == uint64(len(m.GetRecordProofMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetRecordProofMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetRecordProofCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetRecordProofFunc != nil {
+		return atomic.LoadUint64(&m.GetRecordProofCounter) > 0
+	}
+
+	return true
+}
+
 type mDropStorageMockSetDrop struct {
 	mock              *DropStorageMock
 	mainExpectation   *DropStorageMockSetDropExpectation
@@ -1142,6 +1301,10 @@ func (m *DropStorageMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to DropStorageMock.GetJetSizesHistoryDepth")
 	}
 
+	if !m.GetRecordProofFinished() {
+		m.t.Fatal("Expected call to DropStorageMock.GetRecordProof")
+	}
+
 	if !m.SetDropFinished() {
 		m.t.Fatal("Expected call to DropStorageMock.SetDrop")
 	}
@@ -1187,6 +1350,10 @@ func (m *DropStorageMock) MinimockFinish() {
 		m.t.Fatal("Expected call to DropStorageMock.GetJetSizesHistoryDepth")
 	}
 
+	if !m.GetRecordProofFinished() {
+		m.t.Fatal("Expected call to DropStorageMock.GetRecordProof")
+	}
+
 	if !m.SetDropFinished() {
 		m.t.Fatal("Expected call to DropStorageMock.SetDrop")
 	}
@@ -1214,6 +1381,7 @@ func (m *DropStorageMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.GetDropFinished()
 		ok = ok && m.GetDropSizeHistoryFinished()
 		ok = ok && m.GetJetSizesHistoryDepthFinished()
+		ok = ok && m.GetRecordProofFinished()
 		ok = ok && m.SetDropFinished()
 		ok = ok && m.SetDropSizeHistoryFinished()
 
@@ -1244,6 +1412,10 @@ func (m *DropStorageMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to DropStorageMock.GetJetSizesHistoryDepth")
 			}
 
+			if !m.GetRecordProofFinished() {
+				m.t.Error("Expected call to DropStorageMock.GetRecordProof")
+			}
+
 			if !m.SetDropFinished() {
 				m.t.Error("Expected call to DropStorageMock.SetDrop")
 			}
@@ -1284,6 +1456,10 @@ func (m *DropStorageMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.GetRecordProofFinished() {
+		return false
+	}
+
 	if !m.SetDropFinished() {
 		return false
 	}
@@ -20,9 +20,9 @@ import (
 	"context"
 	"sync"
 
-	"github.com/dgraph-io/badger"
 	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/ledger/storage/jet"
+	"github.com/insolar/insolar/network/merkle"
 	"github.com/pkg/errors"
 )
 
@@ -37,6 +37,7 @@ type DropStorage interface {
 	)
 	SetDrop(ctx context.Context, jetID core.RecordID, drop *jet.JetDrop) error
 	GetDrop(ctx context.Context, jetID core.RecordID, pulse core.PulseNumber) (*jet.JetDrop, error)
+	GetRecordProof(ctx context.Context, jetID core.RecordID, pulse core.PulseNumber, recordID core.RecordID) (*core.RecordProof, error)
 
 	AddDropSize(ctx context.Context, dropSize *jet.DropSize) error
 	SetDropSizeHistory(ctx context.Context, jetID core.RecordID, dropSizeHistory jet.DropSizeHistory) error
@@ -66,17 +67,9 @@ func (ds *dropStorage) CreateDrop(ctx context.Context, jetID core.RecordID, puls
 	uint64,
 	error,
 ) {
-	var err error
 	ds.DB.waitingFlight()
 
-	hw := ds.PlatformCryptographyScheme.ReferenceHasher()
-	_, err = hw.Write(prevHash)
-	if err != nil {
-		return nil, nil, 0, err
-	}
-
 	var messages [][]byte
-	_, jetPrefix := jet.Jet(jetID)
 	// messagesPrefix := prefixkey(scopeIDMessage, jetPrefix, pulse.Bytes())
 
 	// err = db.db.View(func(txn *badger.Txn) error {
@@ -96,38 +89,85 @@ func (ds *dropStorage) CreateDrop(ctx context.Context, jetID core.RecordID, puls
 	// 	return nil, nil, 0, err
 	// }
 
-	var dropSize uint64
-	recordPrefix := prefixkey(scopeIDRecord, jetPrefix, pulse.Bytes())
-
-	err = ds.DB.GetBadgerDB().View(func(txn *badger.Txn) error {
-		it := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer it.Close()
-
-		for it.Seek(recordPrefix); it.ValidForPrefix(recordPrefix); it.Next() {
-			val, err := it.Item().ValueCopy(nil)
-			if err != nil {
-				return err
-			}
-			_, err = hw.Write(val)
-			if err != nil {
-				return err
-			}
-			dropSize += uint64(len(val))
-		}
-		return nil
-	})
+	records, dropSize, err := ds.dropRecords(jetID, pulse)
 	if err != nil {
 		return nil, nil, 0, err
 	}
 
+	root := merkle.RecordsRoot(ds.PlatformCryptographyScheme, records)
 	drop := jet.JetDrop{
-		Pulse:    pulse,
-		PrevHash: prevHash,
-		Hash:     hw.Sum(nil),
+		Pulse:       pulse,
+		PrevHash:    prevHash,
+		RecordsRoot: root,
+		Hash:        merkle.DropHash(ds.PlatformCryptographyScheme, prevHash, root),
 	}
 	return &drop, messages, dropSize, nil
 }
 
+// GetRecordProof returns merkle proof of the record inclusion into the jet drop for a given pulse number.
+func (ds *dropStorage) GetRecordProof(
+	ctx context.Context, jetID core.RecordID, pulse core.PulseNumber, recordID core.RecordID,
+) (*core.RecordProof, error) {
+	drop, err := ds.GetDrop(ctx, jetID, pulse)
+	if err != nil {
+		return nil, err
+	}
+	records, _, err := ds.dropRecords(jetID, pulse)
+	if err != nil {
+		return nil, err
+	}
+
+	index := -1
+	for i, id := range records {
+		if id == recordID {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, core.ErrNotFound
+	}
+
+	path, err := merkle.RecordPath(ds.PlatformCryptographyScheme, records, index)
+	if err != nil {
+		return nil, err
+	}
+	return &core.RecordProof{
+		Jet:          jetID,
+		Pulse:        pulse,
+		Record:       recordID,
+		Index:        uint64(index),
+		Count:        uint64(len(records)),
+		Path:         path,
+		DropPrevHash: drop.PrevHash,
+		DropHash:     drop.Hash,
+	}, nil
+}
+
+// dropRecords returns IDs of all records of the jet drop in the storage order and their total size.
+func (ds *dropStorage) dropRecords(jetID core.RecordID, pulse core.PulseNumber) ([]core.RecordID, uint64, error) {
+	var (
+		records  []core.RecordID
+		dropSize uint64
+	)
+	_, jetPrefix := jet.Jet(jetID)
+	recordPrefix := prefixkey(scopeIDRecord, jetPrefix, pulse.Bytes())
+
+	err := kvView(ds.DB.GetKVStore(), func(txn KVTransaction) error {
+		return txn.Iterate(recordPrefix, nil, func(key, val []byte) error {
+			var id core.RecordID
+			copy(id[:], key[len(key)-core.RecordIDSize:])
+			records = append(records, id)
+			dropSize += uint64(len(val))
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return records, dropSize, nil
+}
+
 // SetDrop saves provided JetDrop in db.
 func (ds *dropStorage) SetDrop(ctx context.Context, jetID core.RecordID, drop *jet.JetDrop) error {
 	_, prefix := jet.Jet(jetID)
@@ -18,16 +18,17 @@ package storage
 
 import (
 	"errors"
-
-	"github.com/dgraph-io/badger"
 )
 
 var (
 	// ErrConflictRetriesOver is returned if Update transaction fails on all retry attempts.
 	ErrConflictRetriesOver = errors.New("transaction conflict retries limit exceeded")
 
-	// ErrConflict is the alias for badger.ErrConflict.
-	ErrConflict = badger.ErrConflict
+	// ErrConflict is returned if transaction conflicts with another one and should be retried.
+	ErrConflict = errors.New("transaction conflict")
+
+	// ErrStopIteration is returned by KVTransaction.Iterate handler to stop iteration.
+	ErrStopIteration = errors.New("stop iteration")
 
 	// ErrOverride is returned if something tries to update existing record.
 	ErrOverride = errors.New("records override is forbidden")
@@ -40,4 +41,7 @@ var (
 
 	// ErrBadPulse is returned when pulse less than latest
 	ErrBadPulse = errors.New("pulse should be bigger than latest")
+
+	// ErrArchiveInProgress is returned if archiving is started while another one is not finished.
+	ErrArchiveInProgress = errors.New("archiving is in progress")
 )
@@ -33,6 +33,8 @@ type ObjectLifeline struct {
 	Delegates           map[core.RecordRef]core.RecordRef
 	State               record.State
 	LatestUpdate        core.PulseNumber
+	LatestEvent         *core.RecordID            // Latest event record.
+	TopicEvents         map[string]*core.RecordID // Latest event record by topic.
 }
 
 // EncodeObjectLifeline converts lifeline index into binary format.
@@ -29,6 +29,9 @@ type JetDrop struct { //nolint: golint
 	// PrevHash is a hash of all record hashes belongs to previous pulse.
 	PrevHash []byte
 
-	// Hash is a hash of all record hashes belongs to one pulse and previous drop hash.
+	// RecordsRoot is a merkle root over IDs of all records belongs to one pulse.
+	RecordsRoot []byte
+
+	// Hash is a hash of records root and previous drop hash.
 	Hash []byte
 }
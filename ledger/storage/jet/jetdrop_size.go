@@ -39,6 +39,7 @@ type DropSize struct {
 	JetID     core.RecordID
 	PulseNo   core.PulseNumber
 	DropSize  uint64
+	DropHash  []byte
 	Signature []byte
 }
 
@@ -54,6 +55,7 @@ func (ds *DropSize) serializeDropSize() []byte {
 	result = append(result, buff...)
 
 	result = append(result, ds.JetID.Bytes()...)
+	result = append(result, ds.DropHash...)
 
 	return result
 }
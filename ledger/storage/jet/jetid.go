@@ -61,6 +61,21 @@ func Jet(id core.RecordID) (uint8, []byte) {
 	return id[core.PulseNumberSize], id[core.PulseNumberSize+1:]
 }
 
+// Sibling returns jet that has the same parent as provided one. Root jet is its own sibling.
+func Sibling(id core.RecordID) core.RecordID {
+	depth, prefix := Jet(id)
+	if depth == 0 {
+		return id
+	}
+
+	if getBit(prefix, depth-1) {
+		return *NewID(depth, ResetBits(prefix, depth-1))
+	}
+	siblingPrefix := ResetBits(prefix, depth)
+	setBit(siblingPrefix, depth-1)
+	return *NewID(depth, siblingPrefix)
+}
+
 func Parent(id core.RecordID) core.RecordID {
 	depth, prefix := Jet(id)
 	if depth == 0 {
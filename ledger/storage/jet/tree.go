@@ -282,6 +282,56 @@ func (t *Tree) Split(jetID core.RecordID) (*core.RecordID, *core.RecordID, error
 	return NewID(depth+1, leftPrefix), NewID(depth+1, rightPrefix), nil
 }
 
+// Collapse looks for provided jet and its sibling and merges them back into their parent (and returns it). If provided
+// jet or its sibling is not a leaf, an error will be returned.
+func (t *Tree) Collapse(jetID core.RecordID) (*core.RecordID, error) {
+	depth, prefix := Jet(jetID)
+	if depth == 0 {
+		return nil, errors.New("failed to collapse: root jet has no parent")
+	}
+	j, foundDepth := t.Head.Find(prefix, 0)
+	if depth != foundDepth {
+		return nil, errors.New("failed to collapse: incorrect jet provided")
+	}
+	sibling := Sibling(jetID)
+	_, siblingPrefix := Jet(sibling)
+	s, foundDepth := t.Head.Find(siblingPrefix, 0)
+	if depth != foundDepth || s.Left != nil || s.Right != nil || j.Left != nil || j.Right != nil {
+		return nil, errors.New("failed to collapse: sibling is not a leaf")
+	}
+
+	parent := Parent(jetID)
+	_, parentPrefix := Jet(parent)
+	p := t.Head
+	for i := uint8(0); i < depth-1; i++ {
+		if getBit(parentPrefix, i) {
+			p = p.Right
+		} else {
+			p = p.Left
+		}
+	}
+	p.Left = nil
+	p.Right = nil
+	return &parent, nil
+}
+
+// Prune adds missing tree branches for provided jet, marks it actual and removes its child branches. Use it only when
+// the jet is known to be a result of a merge, otherwise valid splits will be lost.
+func (t *Tree) Prune(jetID core.RecordID) {
+	maxDepth, prefix := Jet(jetID)
+	t.Head.Update(prefix, true, maxDepth, 0)
+	j := t.Head
+	for i := uint8(0); i < maxDepth; i++ {
+		if getBit(prefix, i) {
+			j = j.Right
+		} else {
+			j = j.Left
+		}
+	}
+	j.Left = nil
+	j.Right = nil
+}
+
 func (t *Tree) LeafIDs() []core.RecordID {
 	var ids []core.RecordID
 	t.Head.ExtractLeafIDs(&ids, make([]byte, core.RecordHashSize), 0)
@@ -91,6 +91,53 @@ func TestTree_Update(t *testing.T) {
 	assert.Equal(t, true, actual)
 }
 
+func TestTree_Update_KeepsSplitBranches(t *testing.T) {
+	tree := Tree{
+		Head: &jet{
+			Right: &jet{
+				Right: &jet{},
+				Left:  &jet{},
+			},
+			Left: &jet{},
+		},
+	}
+
+	tree.Update(*NewID(1, []byte{0x80}), true) // 10000000
+
+	treeOut := strings.Join([]string{
+		"root (level=0 actual=false)",
+		" 0 (level=1 actual=false)",
+		" 1 (level=1 actual=true)",
+		"  10 (level=2 actual=false)",
+		"  11 (level=2 actual=false)",
+	}, "\n") + "\n"
+	assert.Equal(t, treeOut, tree.String())
+}
+
+func TestTree_Prune(t *testing.T) {
+	tree := Tree{
+		Head: &jet{
+			Right: &jet{
+				Right: &jet{},
+				Left:  &jet{},
+			},
+			Left: &jet{},
+		},
+	}
+
+	tree.Prune(*NewID(1, []byte{0x80})) // 10000000
+	tree.Prune(*NewID(2, []byte{0x40})) // 01000000
+
+	treeOut := strings.Join([]string{
+		"root (level=0 actual=false)",
+		" 0 (level=1 actual=false)",
+		"  00 (level=2 actual=false)",
+		"  01 (level=2 actual=true)",
+		" 1 (level=1 actual=true)",
+	}, "\n") + "\n"
+	assert.Equal(t, treeOut, tree.String())
+}
+
 func TestTree_Split(t *testing.T) {
 	tree := Tree{
 		Head: &jet{
@@ -128,6 +175,51 @@ func TestTree_Split(t *testing.T) {
 	})
 }
 
+func TestTree_Collapse(t *testing.T) {
+	tree := Tree{
+		Head: &jet{
+			Right: &jet{
+				Right: &jet{},
+				Left:  &jet{},
+			},
+			Left: &jet{},
+		},
+	}
+
+	t.Run("root jet returns error", func(t *testing.T) {
+		_, err := tree.Collapse(*NewID(0, nil))
+		assert.Error(t, err)
+	})
+
+	t.Run("not existing jet returns error", func(t *testing.T) {
+		_, err := tree.Collapse(*NewID(3, []byte{0xD5}))
+		assert.Error(t, err)
+	})
+
+	t.Run("sibling is not a leaf returns error", func(t *testing.T) {
+		_, err := tree.Collapse(*NewID(1, nil))
+		assert.Error(t, err)
+	})
+
+	t.Run("collapses jet", func(t *testing.T) {
+		parent, err := tree.Collapse(*NewID(2, []byte{0xC0})) // 11000000
+		require.NoError(t, err)
+		assert.Equal(t, NewID(1, []byte{0x80}), parent) // 10000000
+		treeOut := strings.Join([]string{
+			"root (level=0 actual=false)",
+			" 0 (level=1 actual=false)",
+			" 1 (level=1 actual=false)",
+		}, "\n") + "\n"
+		assert.Equal(t, treeOut, tree.String())
+	})
+}
+
+func TestSibling(t *testing.T) {
+	assert.Equal(t, *NewID(0, nil), Sibling(*NewID(0, nil)))
+	assert.Equal(t, *NewID(3, []byte{0xC0}), Sibling(*NewID(3, []byte{0xE0}))) // 111 -> 110
+	assert.Equal(t, *NewID(3, []byte{0xE0}), Sibling(*NewID(3, []byte{0xC0}))) // 110 -> 111
+}
+
 func TestTree_String(t *testing.T) {
 	tree := Tree{
 		Head: &jet{
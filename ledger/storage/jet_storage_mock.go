@@ -46,6 +46,16 @@ type JetStorageMock struct {
 	GetJetsPreCounter uint64
 	GetJetsMock       mJetStorageMockGetJets
 
+	MergeJetTreeFunc       func(p context.Context, p1 core.PulseNumber, p2 core.RecordID) (r *core.RecordID, r1 error)
+	MergeJetTreeCounter    uint64
+	MergeJetTreePreCounter uint64
+	MergeJetTreeMock       mJetStorageMockMergeJetTree
+
+	PruneJetTreeFunc       func(p context.Context, p1 core.PulseNumber, p2 core.RecordID)
+	PruneJetTreeCounter    uint64
+	PruneJetTreePreCounter uint64
+	PruneJetTreeMock       mJetStorageMockPruneJetTree
+
 	SplitJetTreeFunc       func(p context.Context, p1 core.PulseNumber, p2 core.RecordID) (r *core.RecordID, r1 *core.RecordID, r2 error)
 	SplitJetTreeCounter    uint64
 	SplitJetTreePreCounter uint64
@@ -70,6 +80,8 @@ func NewJetStorageMock(t minimock.Tester) *JetStorageMock {
 	m.DeleteJetTreeMock = mJetStorageMockDeleteJetTree{mock: m}
 	m.FindJetMock = mJetStorageMockFindJet{mock: m}
 	m.GetJetsMock = mJetStorageMockGetJets{mock: m}
+	m.MergeJetTreeMock = mJetStorageMockMergeJetTree{mock: m}
+	m.PruneJetTreeMock = mJetStorageMockPruneJetTree{mock: m}
 	m.SplitJetTreeMock = mJetStorageMockSplitJetTree{mock: m}
 	m.UpdateJetTreeMock = mJetStorageMockUpdateJetTree{mock: m}
 
@@ -799,6 +811,283 @@ func (m *JetStorageMock) GetJetsFinished() bool {
 	return true
 }
 
+type mJetStorageMockMergeJetTree struct {
+	mock              *JetStorageMock
+	mainExpectation   *JetStorageMockMergeJetTreeExpectation
+	expectationSeries []*JetStorageMockMergeJetTreeExpectation
+}
+
+type JetStorageMockMergeJetTreeExpectation struct {
+	input  *JetStorageMockMergeJetTreeInput
+	result *JetStorageMockMergeJetTreeResult
+}
+
+type JetStorageMockMergeJetTreeInput struct {
+	p  context.Context
+	p1 core.PulseNumber
+	p2 core.RecordID
+}
+
+type JetStorageMockMergeJetTreeResult struct {
+	r  *core.RecordID
+	r1 error
+}
+
+//Expect specifies that invocation of JetStorage.MergeJetTree is expected from 1 to Infinity times
+func (m *mJetStorageMockMergeJetTree) Expect(p context.Context, p1 core.PulseNumber, p2 core.RecordID) *mJetStorageMockMergeJetTree {
+	m.mock.MergeJetTreeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JetStorageMockMergeJetTreeExpectation{}
+	}
+	m.mainExpectation.input = &JetStorageMockMergeJetTreeInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of JetStorage.MergeJetTree
+func (m *mJetStorageMockMergeJetTree) Return(r *core.RecordID, r1 error) *JetStorageMock {
+	m.mock.MergeJetTreeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JetStorageMockMergeJetTreeExpectation{}
+	}
+	m.mainExpectation.result = &JetStorageMockMergeJetTreeResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of JetStorage.MergeJetTree is expected once
+func (m *mJetStorageMockMergeJetTree) ExpectOnce(p context.Context, p1 core.PulseNumber, p2 core.RecordID) *JetStorageMockMergeJetTreeExpectation {
+	m.mock.MergeJetTreeFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &JetStorageMockMergeJetTreeExpectation{}
+	expectation.input = &JetStorageMockMergeJetTreeInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *JetStorageMockMergeJetTreeExpectation) Return(r *core.RecordID, r1 error) {
+	e.result = &JetStorageMockMergeJetTreeResult{r, r1}
+}
+
+//Set uses given function f as a mock of JetStorage.MergeJetTree method
+func (m *mJetStorageMockMergeJetTree) Set(f func(p context.Context, p1 core.PulseNumber, p2 core.RecordID) (r *core.RecordID, r1 error)) *JetStorageMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.MergeJetTreeFunc = f
+	return m.mock
+}
+
+//MergeJetTree implements github.com/insolar/insolar/ledger/storage.JetStorage interface
+func (m *JetStorageMock) MergeJetTree(p context.Context, p1 core.PulseNumber, p2 core.RecordID) (r *core.RecordID, r1 error) {
+	counter := atomic.AddUint64(&m.MergeJetTreePreCounter, 1)
+	defer atomic.AddUint64(&m.MergeJetTreeCounter, 1)
+
+	if len(m.MergeJetTreeMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.MergeJetTreeMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to JetStorageMock.MergeJetTree. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.MergeJetTreeMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, JetStorageMockMergeJetTreeInput{p, p1, p2}, "JetStorage.MergeJetTree got unexpected parameters")
+
+		result := m.MergeJetTreeMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the JetStorageMock.MergeJetTree")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.MergeJetTreeMock.mainExpectation != nil {
+
+		input := m.MergeJetTreeMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, JetStorageMockMergeJetTreeInput{p, p1, p2}, "JetStorage.MergeJetTree got unexpected parameters")
+		}
+
+		result := m.MergeJetTreeMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the JetStorageMock.MergeJetTree")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.MergeJetTreeFunc == nil {
+		m.t.Fatalf("Unexpected call to JetStorageMock.MergeJetTree. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.MergeJetTreeFunc(p, p1, p2)
+}
+
+//MergeJetTreeMinimockCounter returns a count of JetStorageMock.MergeJetTreeFunc invocations
+func (m *JetStorageMock) MergeJetTreeMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.MergeJetTreeCounter)
+}
+
+//MergeJetTreeMinimockPreCounter returns the value of JetStorageMock.MergeJetTree invocations
+func (m *JetStorageMock) MergeJetTreeMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.MergeJetTreePreCounter)
+}
+
+//MergeJetTreeFinished returns true if mock invocations count is ok
+func (m *JetStorageMock) MergeJetTreeFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.MergeJetTreeMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.MergeJetTreeCounter) == uint64(len(m.MergeJetTreeMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.MergeJetTreeMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.MergeJetTreeCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.MergeJetTreeFunc != nil {
+		return atomic.LoadUint64(&m.MergeJetTreeCounter) > 0
+	}
+
+	return true
+}
+
+type mJetStorageMockPruneJetTree struct {
+	mock              *JetStorageMock
+	mainExpectation   *JetStorageMockPruneJetTreeExpectation
+	expectationSeries []*JetStorageMockPruneJetTreeExpectation
+}
+
+type JetStorageMockPruneJetTreeExpectation struct {
+	input *JetStorageMockPruneJetTreeInput
+}
+
+type JetStorageMockPruneJetTreeInput struct {
+	p  context.Context
+	p1 core.PulseNumber
+	p2 core.RecordID
+}
+
+//Expect specifies that invocation of JetStorage.PruneJetTree is expected from 1 to Infinity times
+func (m *mJetStorageMockPruneJetTree) Expect(p context.Context, p1 core.PulseNumber, p2 core.RecordID) *mJetStorageMockPruneJetTree {
+	m.mock.PruneJetTreeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JetStorageMockPruneJetTreeExpectation{}
+	}
+	m.mainExpectation.input = &JetStorageMockPruneJetTreeInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of JetStorage.PruneJetTree
+func (m *mJetStorageMockPruneJetTree) Return() *JetStorageMock {
+	m.mock.PruneJetTreeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JetStorageMockPruneJetTreeExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of JetStorage.PruneJetTree is expected once
+func (m *mJetStorageMockPruneJetTree) ExpectOnce(p context.Context, p1 core.PulseNumber, p2 core.RecordID) *JetStorageMockPruneJetTreeExpectation {
+	m.mock.PruneJetTreeFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &JetStorageMockPruneJetTreeExpectation{}
+	expectation.input = &JetStorageMockPruneJetTreeInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of JetStorage.PruneJetTree method
+func (m *mJetStorageMockPruneJetTree) Set(f func(p context.Context, p1 core.PulseNumber, p2 core.RecordID)) *JetStorageMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.PruneJetTreeFunc = f
+	return m.mock
+}
+
+//PruneJetTree implements github.com/insolar/insolar/ledger/storage.JetStorage interface
+func (m *JetStorageMock) PruneJetTree(p context.Context, p1 core.PulseNumber, p2 core.RecordID) {
+	counter := atomic.AddUint64(&m.PruneJetTreePreCounter, 1)
+	defer atomic.AddUint64(&m.PruneJetTreeCounter, 1)
+
+	if len(m.PruneJetTreeMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.PruneJetTreeMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to JetStorageMock.PruneJetTree. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.PruneJetTreeMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, JetStorageMockPruneJetTreeInput{p, p1, p2}, "JetStorage.PruneJetTree got unexpected parameters")
+
+		return
+	}
+
+	if m.PruneJetTreeMock.mainExpectation != nil {
+
+		input := m.PruneJetTreeMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, JetStorageMockPruneJetTreeInput{p, p1, p2}, "JetStorage.PruneJetTree got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.PruneJetTreeFunc == nil {
+		m.t.Fatalf("Unexpected call to JetStorageMock.PruneJetTree. %v %v %v", p, p1, p2)
+		return
+	}
+
+	m.PruneJetTreeFunc(p, p1, p2)
+}
+
+//PruneJetTreeMinimockCounter returns a count of JetStorageMock.PruneJetTreeFunc invocations
+func (m *JetStorageMock) PruneJetTreeMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.PruneJetTreeCounter)
+}
+
+//PruneJetTreeMinimockPreCounter returns the value of JetStorageMock.PruneJetTree invocations
+func (m *JetStorageMock) PruneJetTreeMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.PruneJetTreePreCounter)
+}
+
+//PruneJetTreeFinished returns true if mock invocations count is ok
+func (m *JetStorageMock) PruneJetTreeFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.PruneJetTreeMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.PruneJetTreeCounter) == uint64(len(m.PruneJetTreeMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.PruneJetTreeMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.PruneJetTreeCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.PruneJetTreeFunc != nil {
+		return atomic.LoadUint64(&m.PruneJetTreeCounter) > 0
+	}
+
+	return true
+}
+
 type mJetStorageMockSplitJetTree struct {
 	mock              *JetStorageMock
 	mainExpectation   *JetStorageMockSplitJetTreeExpectation
@@ -1104,6 +1393,14 @@ func (m *JetStorageMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to JetStorageMock.GetJets")
 	}
 
+	if !m.MergeJetTreeFinished() {
+		m.t.Fatal("Expected call to JetStorageMock.MergeJetTree")
+	}
+
+	if !m.PruneJetTreeFinished() {
+		m.t.Fatal("Expected call to JetStorageMock.PruneJetTree")
+	}
+
 	if !m.SplitJetTreeFinished() {
 		m.t.Fatal("Expected call to JetStorageMock.SplitJetTree")
 	}
@@ -1149,6 +1446,14 @@ func (m *JetStorageMock) MinimockFinish() {
 		m.t.Fatal("Expected call to JetStorageMock.GetJets")
 	}
 
+	if !m.MergeJetTreeFinished() {
+		m.t.Fatal("Expected call to JetStorageMock.MergeJetTree")
+	}
+
+	if !m.PruneJetTreeFinished() {
+		m.t.Fatal("Expected call to JetStorageMock.PruneJetTree")
+	}
+
 	if !m.SplitJetTreeFinished() {
 		m.t.Fatal("Expected call to JetStorageMock.SplitJetTree")
 	}
@@ -1176,6 +1481,8 @@ func (m *JetStorageMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.DeleteJetTreeFinished()
 		ok = ok && m.FindJetFinished()
 		ok = ok && m.GetJetsFinished()
+		ok = ok && m.MergeJetTreeFinished()
+		ok = ok && m.PruneJetTreeFinished()
 		ok = ok && m.SplitJetTreeFinished()
 		ok = ok && m.UpdateJetTreeFinished()
 
@@ -1206,6 +1513,14 @@ func (m *JetStorageMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to JetStorageMock.GetJets")
 			}
 
+			if !m.MergeJetTreeFinished() {
+				m.t.Error("Expected call to JetStorageMock.MergeJetTree")
+			}
+
+			if !m.PruneJetTreeFinished() {
+				m.t.Error("Expected call to JetStorageMock.PruneJetTree")
+			}
+
 			if !m.SplitJetTreeFinished() {
 				m.t.Error("Expected call to JetStorageMock.SplitJetTree")
 			}
@@ -1246,6 +1561,14 @@ func (m *JetStorageMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.MergeJetTreeFinished() {
+		return false
+	}
+
+	if !m.PruneJetTreeFinished() {
+		return false
+	}
+
 	if !m.SplitJetTreeFinished() {
 		return false
 	}
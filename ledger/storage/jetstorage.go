@@ -33,6 +33,8 @@ type JetStorage interface {
 	UpdateJetTree(ctx context.Context, pulse core.PulseNumber, setActual bool, ids ...core.RecordID)
 	FindJet(ctx context.Context, pulse core.PulseNumber, id core.RecordID) (*core.RecordID, bool)
 	SplitJetTree(ctx context.Context, pulse core.PulseNumber, jetID core.RecordID) (*core.RecordID, *core.RecordID, error)
+	MergeJetTree(ctx context.Context, pulse core.PulseNumber, jetID core.RecordID) (*core.RecordID, error)
+	PruneJetTree(ctx context.Context, pulse core.PulseNumber, jetID core.RecordID)
 	CloneJetTree(ctx context.Context, from, to core.PulseNumber) *jet.Tree
 	DeleteJetTree(ctx context.Context, pulse core.PulseNumber)
 
@@ -98,6 +100,27 @@ func (js *jetStorage) SplitJetTree(
 	return left, right, nil
 }
 
+// MergeJetTree merges jet with its sibling and returns resulting jet id.
+func (js *jetStorage) MergeJetTree(
+	ctx context.Context, pulse core.PulseNumber, jetID core.RecordID,
+) (*core.RecordID, error) {
+	js.treesLock.Lock()
+	defer js.treesLock.Unlock()
+
+	tree := js.getJetTree(ctx, pulse)
+
+	return tree.Collapse(jetID)
+}
+
+// PruneJetTree marks jet actual and removes its branches. Use it for jets that are known to be merged.
+func (js *jetStorage) PruneJetTree(ctx context.Context, pulse core.PulseNumber, jetID core.RecordID) {
+	js.treesLock.Lock()
+	defer js.treesLock.Unlock()
+
+	tree := js.getJetTree(ctx, pulse)
+	tree.Prune(jetID)
+}
+
 // CloneJetTree copies tree from one pulse to another. Use it to copy past tree into new pulse.
 func (js *jetStorage) CloneJetTree(
 	ctx context.Context, from, to core.PulseNumber,
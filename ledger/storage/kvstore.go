@@ -0,0 +1,127 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package storage
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/dgraph-io/badger"
+	"github.com/insolar/insolar/configuration"
+	"github.com/pkg/errors"
+)
+
+// Supported key-value storage backends.
+const (
+	// BackendBadger stores data in BadgerDB. It is the default backend.
+	BackendBadger = "badger"
+	// BackendBolt stores data in a single bbolt file.
+	BackendBolt = "bolt"
+	// BackendMemory keeps data in memory. Data is lost on close.
+	BackendMemory = "memory"
+)
+
+// KVStore is a backend-neutral key-value storage engine DB works on top of.
+type KVStore interface {
+	// NewTransaction starts a new transaction. Read-only transaction can't modify data.
+	NewTransaction(update bool) KVTransaction
+	// Close flushes pending updates and releases all resources.
+	Close() error
+}
+
+// KVTransaction is a key-value storage transaction. It should always be discarded after usage (even if committed).
+type KVTransaction interface {
+	// Get returns a copy of the value stored by key. It returns core.ErrNotFound if there is no such key.
+	Get(key []byte) ([]byte, error)
+	// Set stores value by key.
+	Set(key, value []byte) error
+	// Delete removes value by key.
+	Delete(key []byte) error
+	// Iterate calls handler with copies of all key/value pairs which keys have provided prefix. Pairs are passed in
+	// ascending keys order starting from the start key (or from the prefix if start is nil).
+	//
+	// Iteration stops on the first handler error. ErrStopIteration stops iteration without error.
+	Iterate(prefix, start []byte, handler func(k, v []byte) error) error
+	// Commit applies transaction changes. It returns ErrConflict if transaction conflicts with another one.
+	Commit() error
+	// Discard releases transaction resources. Changes of not committed transaction are lost.
+	Discard()
+}
+
+// NewKVStore opens key-value store of the backend selected in configuration. Data of persistent backends is stored
+// in the configured data directory. Badger options opts are ignored by other backends.
+func NewKVStore(conf configuration.Storage, opts *badger.Options) (KVStore, error) {
+	switch conf.Backend {
+	case BackendMemory:
+		return NewMemoryStore(), nil
+	case BackendBolt:
+		dir, err := filepath.Abs(conf.DataDirectory)
+		if err != nil {
+			return nil, err
+		}
+		err = os.MkdirAll(dir, 0700)
+		if err != nil {
+			return nil, err
+		}
+		return NewBoltStore(filepath.Join(dir, "ledger.db"))
+	case "", BackendBadger:
+		dir, err := filepath.Abs(conf.DataDirectory)
+		if err != nil {
+			return nil, err
+		}
+		return NewBadgerStore(dir, opts)
+	default:
+		return nil, errors.Errorf("unknown storage backend %q", conf.Backend)
+	}
+}
+
+// NewReadOnlyKVStore opens existing key-value store of the backend selected in configuration for reading only. Such
+// store fails to commit any changes. Memory backend has no persistent data, so it can't be opened this way.
+func NewReadOnlyKVStore(conf configuration.Storage) (KVStore, error) {
+	dir, err := filepath.Abs(conf.DataDirectory)
+	if err != nil {
+		return nil, err
+	}
+	switch conf.Backend {
+	case BackendBolt:
+		return NewReadOnlyBoltStore(filepath.Join(dir, "ledger.db"))
+	case "", BackendBadger:
+		opts := badger.DefaultOptions
+		opts.ReadOnly = true
+		return NewBadgerStore(dir, &opts)
+	default:
+		return nil, errors.Errorf("storage backend %q can't be opened read-only", conf.Backend)
+	}
+}
+
+// kvView runs fn in read-only transaction.
+func kvView(store KVStore, fn func(txn KVTransaction) error) error {
+	txn := store.NewTransaction(false)
+	defer txn.Discard()
+	return fn(txn)
+}
+
+// kvUpdate runs fn in read-write transaction and commits it if fn succeeds.
+func kvUpdate(store KVStore, fn func(txn KVTransaction) error) error {
+	txn := store.NewTransaction(true)
+	defer txn.Discard()
+	err := fn(txn)
+	if err != nil {
+		return err
+	}
+	return txn.Commit()
+}
@@ -0,0 +1,269 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func forEachBackend(t *testing.T, fn func(t *testing.T, store KVStore)) {
+	forBackends(t, []string{BackendBadger, BackendBolt, BackendMemory}, fn)
+}
+
+func forBackends(t *testing.T, backends []string, fn func(t *testing.T, store KVStore)) {
+	for _, backend := range backends {
+		backend := backend
+		t.Run(backend, func(t *testing.T) {
+			tmpdir, err := ioutil.TempDir("", "kvstore-test-")
+			require.NoError(t, err)
+			defer os.RemoveAll(tmpdir)
+
+			store, err := NewKVStore(configuration.Storage{DataDirectory: tmpdir, Backend: backend}, nil)
+			require.NoError(t, err)
+			defer store.Close()
+
+			fn(t, store)
+		})
+	}
+}
+
+func TestNewKVStore_UnknownBackend(t *testing.T) {
+	_, err := NewKVStore(configuration.Storage{Backend: "unknown"}, nil)
+	assert.Error(t, err)
+}
+
+func TestNewKVStore_BoltInDataDirectory(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "kvstore-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+	dir := filepath.Join(tmpdir, "data")
+
+	store, err := NewKVStore(configuration.Storage{DataDirectory: dir, Backend: BackendBolt}, nil)
+	require.NoError(t, err)
+	defer store.Close()
+
+	_, err = os.Stat(filepath.Join(dir, "ledger.db"))
+	assert.NoError(t, err)
+}
+
+func TestNewReadOnlyKVStore(t *testing.T) {
+	for _, backend := range []string{BackendBadger, BackendBolt} {
+		backend := backend
+		t.Run(backend, func(t *testing.T) {
+			tmpdir, err := ioutil.TempDir("", "kvstore-test-")
+			require.NoError(t, err)
+			defer os.RemoveAll(tmpdir)
+			conf := configuration.Storage{DataDirectory: tmpdir, Backend: backend}
+
+			store, err := NewKVStore(conf, nil)
+			require.NoError(t, err)
+			err = kvUpdate(store, func(txn KVTransaction) error {
+				return txn.Set([]byte{1}, []byte{2})
+			})
+			require.NoError(t, err)
+			require.NoError(t, store.Close())
+
+			store, err = NewReadOnlyKVStore(conf)
+			require.NoError(t, err)
+			defer store.Close()
+			err = kvView(store, func(txn KVTransaction) error {
+				value, err := txn.Get([]byte{1})
+				assert.Equal(t, []byte{2}, value)
+				return err
+			})
+			assert.NoError(t, err)
+		})
+	}
+
+	_, err := NewReadOnlyKVStore(configuration.Storage{Backend: BackendMemory})
+	assert.Error(t, err)
+}
+
+func TestKVStore_SetGetDelete(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, store KVStore) {
+		err := kvUpdate(store, func(txn KVTransaction) error {
+			return txn.Set([]byte{1, 2}, []byte{3})
+		})
+		require.NoError(t, err)
+
+		err = kvView(store, func(txn KVTransaction) error {
+			value, err := txn.Get([]byte{1, 2})
+			require.NoError(t, err)
+			assert.Equal(t, []byte{3}, value)
+
+			_, err = txn.Get([]byte{1, 3})
+			assert.Equal(t, core.ErrNotFound, err)
+			return nil
+		})
+		require.NoError(t, err)
+
+		err = kvUpdate(store, func(txn KVTransaction) error {
+			return txn.Delete([]byte{1, 2})
+		})
+		require.NoError(t, err)
+
+		err = kvView(store, func(txn KVTransaction) error {
+			_, err := txn.Get([]byte{1, 2})
+			return err
+		})
+		assert.Equal(t, core.ErrNotFound, err)
+	})
+}
+
+func TestKVStore_Discard(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, store KVStore) {
+		txn := store.NewTransaction(true)
+		require.NoError(t, txn.Set([]byte{1}, []byte{1}))
+		value, err := txn.Get([]byte{1})
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1}, value)
+		txn.Discard()
+
+		err = kvView(store, func(txn KVTransaction) error {
+			_, err := txn.Get([]byte{1})
+			return err
+		})
+		assert.Equal(t, core.ErrNotFound, err)
+	})
+}
+
+func TestKVStore_Iterate(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, store KVStore) {
+		err := kvUpdate(store, func(txn KVTransaction) error {
+			for _, k := range [][]byte{{2, 3}, {1, 3}, {2, 1}, {2, 2}, {3, 1}} {
+				err := txn.Set(k, k[1:])
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+
+		collect := func(prefix, start []byte, limit int) (keys [][]byte, values [][]byte) {
+			err := kvView(store, func(txn KVTransaction) error {
+				return txn.Iterate(prefix, start, func(k, v []byte) error {
+					if len(keys) == limit {
+						return ErrStopIteration
+					}
+					keys = append(keys, k)
+					values = append(values, v)
+					return nil
+				})
+			})
+			require.NoError(t, err)
+			return
+		}
+
+		keys, values := collect([]byte{2}, nil, -1)
+		assert.Equal(t, [][]byte{{2, 1}, {2, 2}, {2, 3}}, keys)
+		assert.Equal(t, [][]byte{{1}, {2}, {3}}, values)
+
+		keys, _ = collect([]byte{2}, []byte{2, 2}, -1)
+		assert.Equal(t, [][]byte{{2, 2}, {2, 3}}, keys)
+
+		keys, _ = collect([]byte{2}, nil, 1)
+		assert.Equal(t, [][]byte{{2, 1}}, keys)
+
+		keys, _ = collect(nil, nil, -1)
+		assert.Equal(t, 5, len(keys))
+	})
+}
+
+func TestKVStore_DeleteWhileIterating(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, store KVStore) {
+		err := kvUpdate(store, func(txn KVTransaction) error {
+			for i := byte(0); i < 10; i++ {
+				err := txn.Set([]byte{1, i}, []byte{i})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+
+		var removed int
+		err = kvUpdate(store, func(txn KVTransaction) error {
+			return txn.Iterate([]byte{1}, nil, func(k, _ []byte) error {
+				removed++
+				return txn.Delete(k)
+			})
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 10, removed)
+
+		err = kvView(store, func(txn KVTransaction) error {
+			return txn.Iterate([]byte{1}, nil, func(k, _ []byte) error {
+				t.Errorf("key %v was not removed", k)
+				return nil
+			})
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestKVStore_Conflict(t *testing.T) {
+	// bbolt allows only one read-write transaction at a time, so its transactions never conflict.
+	forBackends(t, []string{BackendBadger, BackendMemory}, func(t *testing.T, store KVStore) {
+		err := kvUpdate(store, func(txn KVTransaction) error {
+			return txn.Set([]byte{1}, []byte{1})
+		})
+		require.NoError(t, err)
+
+		reader := store.NewTransaction(true)
+		defer reader.Discard()
+		value, err := reader.Get([]byte{1})
+		require.NoError(t, err)
+		_, err = reader.Get([]byte{2})
+		require.Equal(t, core.ErrNotFound, err)
+
+		blind := store.NewTransaction(true)
+		defer blind.Discard()
+		require.NoError(t, blind.Set([]byte{3}, []byte{3}))
+
+		err = kvUpdate(store, func(txn KVTransaction) error {
+			return txn.Set([]byte{1}, []byte{2})
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, reader.Set([]byte{2}, value))
+		assert.Equal(t, ErrConflict, reader.Commit())
+		// Transaction that didn't read changed keys is committed.
+		assert.NoError(t, blind.Commit())
+
+		// Creation of the key which was read as missing is a conflict too.
+		reader = store.NewTransaction(true)
+		defer reader.Discard()
+		_, err = reader.Get([]byte{4})
+		require.Equal(t, core.ErrNotFound, err)
+		err = kvUpdate(store, func(txn KVTransaction) error {
+			return txn.Set([]byte{4}, []byte{4})
+		})
+		require.NoError(t, err)
+		require.NoError(t, reader.Set([]byte{5}, []byte{5}))
+		assert.Equal(t, ErrConflict, reader.Commit())
+	})
+}
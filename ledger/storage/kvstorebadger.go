@@ -0,0 +1,122 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package storage
+
+import (
+	"github.com/dgraph-io/badger"
+	"github.com/insolar/insolar/core"
+)
+
+type badgerStore struct {
+	db *badger.DB
+}
+
+type badgerTransaction struct {
+	txn *badger.Txn
+}
+
+func setOptions(o *badger.Options) *badger.Options {
+	newo := &badger.Options{}
+	if o != nil {
+		*newo = *o
+	} else {
+		*newo = badger.DefaultOptions
+	}
+	return newo
+}
+
+// NewBadgerStore returns KVStore with BadgerDB instance initialized by opts in provided directory.
+func NewBadgerStore(dir string, opts *badger.Options) (KVStore, error) {
+	opts = setOptions(opts)
+	opts.Dir = dir
+	opts.ValueDir = dir
+
+	db, err := badger.Open(*opts)
+	if err != nil {
+		return nil, err
+	}
+	return &badgerStore{db: db}, nil
+}
+
+// NewTransaction starts BadgerDB transaction.
+func (s *badgerStore) NewTransaction(update bool) KVTransaction {
+	return &badgerTransaction{txn: s.db.NewTransaction(update)}
+}
+
+// Close wraps BadgerDB Close method.
+//
+// From https://godoc.org/github.com/dgraph-io/badger#DB.Close:
+// «It's crucial to call it to ensure all the pending updates make their way to disk.
+// Calling DB.Close() multiple times is not safe and wouldcause panic.»
+func (s *badgerStore) Close() error {
+	return s.db.Close()
+}
+
+func (t *badgerTransaction) Get(key []byte) ([]byte, error) {
+	item, err := t.txn.Get(key)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return item.ValueCopy(nil)
+}
+
+func (t *badgerTransaction) Set(key, value []byte) error {
+	return t.txn.Set(key, value)
+}
+
+func (t *badgerTransaction) Delete(key []byte) error {
+	return t.txn.Delete(key)
+}
+
+func (t *badgerTransaction) Iterate(prefix, start []byte, handler func(k, v []byte) error) error {
+	if start == nil {
+		start = prefix
+	}
+	it := t.txn.NewIterator(badger.DefaultIteratorOptions)
+	defer it.Close()
+
+	for it.Seek(start); it.ValidForPrefix(prefix); it.Next() {
+		item := it.Item()
+		value, err := item.ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+		err = handler(item.KeyCopy(nil), value)
+		if err == ErrStopIteration {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *badgerTransaction) Commit() error {
+	err := t.txn.Commit(nil)
+	if err == badger.ErrConflict {
+		return ErrConflict
+	}
+	return err
+}
+
+func (t *badgerTransaction) Discard() {
+	t.txn.Discard()
+}
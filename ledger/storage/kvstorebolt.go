@@ -0,0 +1,160 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package storage
+
+import (
+	"bytes"
+	"time"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/insolar/insolar/core"
+	"github.com/pkg/errors"
+)
+
+// boltBucket is the only bucket all ledger data is stored in.
+var boltBucket = []byte("ledger")
+
+type boltStore struct {
+	db *bolt.DB
+}
+
+type boltTransaction struct {
+	tx  *bolt.Tx
+	err error
+}
+
+// NewBoltStore returns KVStore with bbolt database in provided file.
+func NewBoltStore(path string) (KVStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boltBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &boltStore{db: db}, nil
+}
+
+// NewReadOnlyBoltStore returns KVStore with existing bbolt database in provided file opened in read-only mode.
+func NewReadOnlyBoltStore(path string) (KVStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	err = db.View(func(tx *bolt.Tx) error {
+		if tx.Bucket(boltBucket) == nil {
+			return errors.Errorf("bucket %q is not found in %s", boltBucket, path)
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &boltStore{db: db}, nil
+}
+
+// NewTransaction starts bbolt transaction. Note that bbolt allows only one read-write transaction at a time.
+func (s *boltStore) NewTransaction(update bool) KVTransaction {
+	tx, err := s.db.Begin(update)
+	return &boltTransaction{tx: tx, err: err}
+}
+
+// Close closes bbolt database. It waits for all transactions to finish.
+func (s *boltStore) Close() error {
+	return s.db.Close()
+}
+
+func (t *boltTransaction) Get(key []byte) ([]byte, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+	value := t.tx.Bucket(boltBucket).Get(key)
+	if value == nil {
+		return nil, core.ErrNotFound
+	}
+	return copyBytes(value), nil
+}
+
+func (t *boltTransaction) Set(key, value []byte) error {
+	if t.err != nil {
+		return t.err
+	}
+	return t.tx.Bucket(boltBucket).Put(key, value)
+}
+
+func (t *boltTransaction) Delete(key []byte) error {
+	if t.err != nil {
+		return t.err
+	}
+	return t.tx.Bucket(boltBucket).Delete(key)
+}
+
+func (t *boltTransaction) Iterate(prefix, start []byte, handler func(k, v []byte) error) error {
+	if t.err != nil {
+		return t.err
+	}
+	if start == nil {
+		start = prefix
+	}
+	c := t.tx.Bucket(boltBucket).Cursor()
+	for k, v := c.Seek(start); k != nil && bytes.HasPrefix(k, prefix); {
+		key := copyBytes(k)
+		err := handler(key, copyBytes(v))
+		if err == ErrStopIteration {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		// Handler can modify the bucket, so the cursor is positioned again after processed key.
+		k, v = c.Seek(key)
+		if bytes.Equal(k, key) {
+			k, v = c.Next()
+		}
+	}
+	return nil
+}
+
+func (t *boltTransaction) Commit() error {
+	if t.err != nil {
+		return t.err
+	}
+	if !t.tx.Writable() {
+		return nil
+	}
+	return t.tx.Commit()
+}
+
+func (t *boltTransaction) Discard() {
+	if t.err != nil {
+		return
+	}
+	// Rollback of committed transaction returns an error, but it is safe.
+	_ = t.tx.Rollback()
+}
+
+func copyBytes(b []byte) []byte {
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
@@ -0,0 +1,197 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/insolar/insolar/core"
+)
+
+var errReadOnlyTransaction = errors.New("transaction is read-only")
+
+type memoryStore struct {
+	lock sync.RWMutex
+	data map[string][]byte
+	// versions holds number of the last commit that changed the key. It is used to detect conflicts.
+	versions map[string]uint64
+	commits  uint64
+}
+
+// memoryTransaction buffers changes until commit. Reads see committed data of the store and own changes.
+//
+// Read-write transaction remembers versions of keys it has read. Commit fails with ErrConflict if any of them was
+// changed by another transaction since then.
+type memoryTransaction struct {
+	store   *memoryStore
+	update  bool
+	reads   map[string]uint64
+	updates map[string][]byte
+	deletes map[string]struct{}
+}
+
+// NewMemoryStore returns KVStore which keeps all data in memory.
+func NewMemoryStore() KVStore {
+	return &memoryStore{
+		data:     map[string][]byte{},
+		versions: map[string]uint64{},
+	}
+}
+
+// NewTransaction starts in-memory transaction.
+func (s *memoryStore) NewTransaction(update bool) KVTransaction {
+	return &memoryTransaction{
+		store:   s,
+		update:  update,
+		reads:   map[string]uint64{},
+		updates: map[string][]byte{},
+		deletes: map[string]struct{}{},
+	}
+}
+
+// Close does nothing, data is kept until the store is garbage collected.
+func (s *memoryStore) Close() error {
+	return nil
+}
+
+func (t *memoryTransaction) Get(key []byte) ([]byte, error) {
+	if value, ok := t.updates[string(key)]; ok {
+		return copyBytes(value), nil
+	}
+	if _, ok := t.deletes[string(key)]; ok {
+		return nil, core.ErrNotFound
+	}
+
+	t.store.lock.RLock()
+	defer t.store.lock.RUnlock()
+	t.trackRead(string(key))
+	value, ok := t.store.data[string(key)]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	return copyBytes(value), nil
+}
+
+func (t *memoryTransaction) Set(key, value []byte) error {
+	if !t.update {
+		return errReadOnlyTransaction
+	}
+	delete(t.deletes, string(key))
+	t.updates[string(key)] = copyBytes(value)
+	return nil
+}
+
+func (t *memoryTransaction) Delete(key []byte) error {
+	if !t.update {
+		return errReadOnlyTransaction
+	}
+	delete(t.updates, string(key))
+	t.deletes[string(key)] = struct{}{}
+	return nil
+}
+
+// Iterate iterates over a snapshot taken on call, so handler's changes are not visible during iteration.
+func (t *memoryTransaction) Iterate(prefix, start []byte, handler func(k, v []byte) error) error {
+	if start == nil {
+		start = prefix
+	}
+
+	snapshot := map[string][]byte{}
+	t.store.lock.RLock()
+	for k, v := range t.store.data {
+		if keyInRange(k, prefix, start) {
+			snapshot[k] = v
+			t.trackRead(k)
+		}
+	}
+	t.store.lock.RUnlock()
+	for k, v := range t.updates {
+		if keyInRange(k, prefix, start) {
+			snapshot[k] = v
+		}
+	}
+	for k := range t.deletes {
+		delete(snapshot, k)
+	}
+
+	keys := make([]string, 0, len(snapshot))
+	for k := range snapshot {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		err := handler([]byte(k), copyBytes(snapshot[k]))
+		if err == ErrStopIteration {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// trackRead remembers version of the committed key. It should be called with store lock held.
+func (t *memoryTransaction) trackRead(key string) {
+	if !t.update {
+		return
+	}
+	if _, ok := t.reads[key]; !ok {
+		t.reads[key] = t.store.versions[key]
+	}
+}
+
+func keyInRange(key string, prefix, start []byte) bool {
+	k := []byte(key)
+	return bytes.HasPrefix(k, prefix) && bytes.Compare(k, start) >= 0
+}
+
+func (t *memoryTransaction) Commit() error {
+	if len(t.updates) == 0 && len(t.deletes) == 0 {
+		return nil
+	}
+
+	t.store.lock.Lock()
+	defer t.store.lock.Unlock()
+	defer t.Discard()
+	for k, version := range t.reads {
+		if t.store.versions[k] != version {
+			return ErrConflict
+		}
+	}
+
+	t.store.commits++
+	for k := range t.deletes {
+		delete(t.store.data, k)
+		t.store.versions[k] = t.store.commits
+	}
+	for k, v := range t.updates {
+		t.store.data[k] = v
+		t.store.versions[k] = t.store.commits
+	}
+	return nil
+}
+
+func (t *memoryTransaction) Discard() {
+	t.reads = map[string]uint64{}
+	t.updates = map[string][]byte{}
+	t.deletes = map[string]struct{}{}
+}
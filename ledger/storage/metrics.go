@@ -35,6 +35,14 @@ var (
 
 	statPulseDeleted = stats.Int64("lightcleanup/pulses/removed/total", "How many pulses deleted from pulseTracker on LM cleanup", stats.UnitDimensionless)
 	statPulseAdded   = stats.Int64("lightcleanup/pulses/added/total", "How many pulses added to pulseTracker", stats.UnitDimensionless)
+
+	statArchiveEntries  = stats.Int64("heavyarchive/entries", "How many values have been moved to archive on HM", stats.UnitDimensionless)
+	statArchiveSegments = stats.Int64("heavyarchive/segments", "How many archive segments have been written on HM", stats.UnitDimensionless)
+	statArchiveStored   = stats.Int64("heavyarchive/stored", "Size of written archive segments", stats.UnitBytes)
+
+	statBlobStored        = stats.Int64("blobs/stored", "Size of stored blobs data before deduplication and compression", stats.UnitBytes)
+	statBlobContentStored = stats.Int64("blobs/content/stored", "Size of written blob contents after compression", stats.UnitBytes)
+	statBlobDeduplicated  = stats.Int64("blobs/deduplicated", "How many stored blobs have been found in content storage", stats.UnitDimensionless)
 )
 
 func init() {
@@ -72,6 +80,45 @@ func init() {
 			Measure:     statPulseAdded,
 			Aggregation: view.Count(),
 		},
+		&view.View{
+			Name:        statArchiveEntries.Name(),
+			Description: statArchiveEntries.Description(),
+			Measure:     statArchiveEntries,
+			Aggregation: view.Sum(),
+			TagKeys:     []tag.Key{recordType},
+		},
+		&view.View{
+			Name:        statArchiveSegments.Name(),
+			Description: statArchiveSegments.Description(),
+			Measure:     statArchiveSegments,
+			Aggregation: view.Sum(),
+			TagKeys:     []tag.Key{recordType},
+		},
+		&view.View{
+			Name:        statArchiveStored.Name(),
+			Description: statArchiveStored.Description(),
+			Measure:     statArchiveStored,
+			Aggregation: view.Sum(),
+			TagKeys:     []tag.Key{recordType},
+		},
+		&view.View{
+			Name:        statBlobStored.Name(),
+			Description: statBlobStored.Description(),
+			Measure:     statBlobStored,
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        statBlobContentStored.Name(),
+			Description: statBlobContentStored.Description(),
+			Measure:     statBlobContentStored,
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        statBlobDeduplicated.Name(),
+			Description: statBlobDeduplicated.Description(),
+			Measure:     statBlobDeduplicated,
+			Aggregation: view.Sum(),
+		},
 	)
 	if err != nil {
 		panic(err)
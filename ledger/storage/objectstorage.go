@@ -62,6 +62,19 @@ type ObjectStorage interface {
 		jetID core.RecordID,
 		ref *core.RecordID,
 	) error
+
+	GetRequestResult(
+		ctx context.Context,
+		jetID core.RecordID,
+		request *core.RecordID,
+	) (*core.RecordID, error)
+
+	SetRequestResult(
+		ctx context.Context,
+		jetID core.RecordID,
+		request *core.RecordID,
+		result *core.RecordID,
+	) error
 }
 
 type objectStorage struct {
@@ -220,3 +233,30 @@ func (os *objectStorage) RemoveObjectIndex(
 		return tx.RemoveObjectIndex(ctx, jetID, ref)
 	})
 }
+
+// GetRequestResult wraps matching transaction manager method.
+func (os *objectStorage) GetRequestResult(
+	ctx context.Context,
+	jetID core.RecordID,
+	request *core.RecordID,
+) (*core.RecordID, error) {
+	tx, err := os.DB.BeginTransaction(false)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Discard()
+
+	return tx.GetRequestResult(ctx, jetID, request)
+}
+
+// SetRequestResult wraps matching transaction manager method.
+func (os *objectStorage) SetRequestResult(
+	ctx context.Context,
+	jetID core.RecordID,
+	request *core.RecordID,
+	result *core.RecordID,
+) error {
+	return os.DB.Update(ctx, func(tx *TransactionManager) error {
+		return tx.SetRequestResult(ctx, jetID, request, result)
+	})
+}
@@ -235,9 +235,16 @@ func (b Key) PulseNumber() core.PulseNumber {
 	switch b[0] {
 	case scopeIDPulse:
 		from = 1
-	case scopeIDSystem:
+	case scopeIDArchive:
+		// archive index key is a key of archived value with archive scope prefix
+		from = core.RecordHashSize + 1
+	case scopeIDSecondaryIndex:
+		// secondary index key ends with indexed record id
+		from = 2 + core.RecordRefSize
+	case scopeIDSystem, scopeIDBlobContent, scopeIDBlobContentRefs:
 		// for specific system records is different rules
 		// pulse number could exist or not
+		// blob content is shared by blobs of different pulses
 		return 0
 	}
 	return pulseNumFromKey(from, b)
@@ -48,4 +48,5 @@ func init() {
 	register(303, new(ObjectActivateRecord))
 	register(304, new(ObjectAmendRecord))
 	register(305, new(DeactivationRecord))
+	register(306, new(EventRecord))
 }
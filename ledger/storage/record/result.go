@@ -62,6 +62,21 @@ func (r *ResultRecord) WriteHashData(w io.Writer) (int, error) {
 	return w.Write(SerializeRecord(r))
 }
 
+// EventRecord is an event emitted by a contract while executing a request.
+type EventRecord struct {
+	Object         core.RecordID
+	Request        core.RecordRef
+	Topic          string
+	Payload        []byte
+	PrevEvent      *core.RecordID // Previous event of the object.
+	PrevTopicEvent *core.RecordID // Previous event of the object with the same topic.
+}
+
+// WriteHashData writes record data to provided writer. This data is used to calculate record's hash.
+func (r *EventRecord) WriteHashData(w io.Writer) (int, error) {
+	return w.Write(SerializeRecord(r))
+}
+
 // SideEffectRecord is a record which is created in response to a request.
 type SideEffectRecord struct {
 	Domain  core.RecordRef
@@ -86,6 +101,7 @@ type CodeRecord struct {
 
 	Code        *core.RecordID
 	MachineType core.MachineType
+	ABI         *core.RecordID
 }
 
 // WriteHashData writes record data to provided writer. This data is used to calculate record's hash.
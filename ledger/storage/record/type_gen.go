@@ -25,6 +25,8 @@ func TypeFromRecord(generic Record) TypeID {
 		return 304
 	case *DeactivationRecord:
 		return 305
+	case *EventRecord:
+		return 306
 	default:
 		panic("record is not registered")
 	}
@@ -52,6 +54,8 @@ func RecordFromType(i TypeID) Record {
 		return new(ObjectAmendRecord)
 	case 305:
 		return new(DeactivationRecord)
+	case 306:
+		return new(EventRecord)
 	default:
 		panic("record is not registered")
 	}
@@ -79,6 +83,8 @@ func (i TypeID) String() string {
 		return "ObjectAmendRecord"
 	case 305:
 		return "DeactivationRecord"
+	case 306:
+		return "EventRecord"
 	default:
 		panic("record is not registered")
 	}
@@ -21,7 +21,6 @@ import (
 	"context"
 	"errors"
 
-	"github.com/dgraph-io/badger"
 	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/ledger/storage/jet"
 )
@@ -37,7 +36,7 @@ type iterstate struct {
 // required for replication to Heavy Material node in provided pulses range.
 //
 // "Required KV pairs" are all keys with namespace 'scopeIDRecord' (TODO: 'add scopeIDBlob')
-// in provided pulses range and all indexes from zero pulse to the end of provided range.
+// in provided pulses range and all indexes (including request results) from zero pulse to the end of provided range.
 //
 // "Partial" means it fetches data in chunks of the specified size.
 // After a chunk has been fetched, an iterator saves current position.
@@ -87,6 +86,7 @@ func NewReplicaIter(
 			newit(scopeIDRecord, jetID, start, end),
 			newit(scopeIDBlob, jetID, start, end),
 			newit(scopeIDLifeline, jetID, core.FirstPulseNumber, end),
+			newit(scopeIDResult, jetID, core.FirstPulseNumber, end),
 			newit(scopeIDJetDrop, jetID, start, end),
 		},
 	}
@@ -98,7 +98,7 @@ func (r *ReplicaIter) NextRecords() ([]core.KV, error) {
 		return nil, ErrReplicatorDone
 	}
 	fc := &fetchchunk{
-		db:    r.dbContext.GetBadgerDB(),
+		store: r.dbContext.GetKVStore(),
 		limit: r.limitBytes,
 	}
 	for _, is := range r.istates {
@@ -136,7 +136,7 @@ func (r *ReplicaIter) isDone() bool {
 }
 
 type fetchchunk struct {
-	db      *badger.DB
+	store   KVStore
 	records []core.KV
 	size    int
 	limit   int
@@ -154,42 +154,37 @@ func (fc *fetchchunk) fetch(
 
 	var nextstart []byte
 	var lastpulse core.PulseNumber
-	err := fc.db.View(func(txn *badger.Txn) error {
-		it := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer it.Close()
-
-		for it.Seek(start); it.ValidForPrefix(prefix); it.Next() {
-			item := it.Item()
-			if item == nil {
-				break
-			}
+	err := kvView(fc.store, func(txn KVTransaction) error {
+		return txn.Iterate(prefix, start, func(key, value []byte) error {
 			// key prefix < end
-			if bytes.Compare(item.Key()[:len(end)], end) != -1 {
-				break
+			if bytes.Compare(key[:len(end)], end) != -1 {
+				return ErrStopIteration
 			}
 
-			key := item.KeyCopy(nil)
 			if fc.size > fc.limit {
 				nextstart = key
 				// inslogger.FromContext(ctx).Warnf("size > r.limit: %v > %v (nextstart=%v)",
 				// 	fc.size, fc.limit, hex.EncodeToString(key))
-				return nil
+				return ErrStopIteration
+			}
+
+			// heavy node receives blobs data, content storage is local to the node
+			if key[0] == scopeIDBlob {
+				var err error
+				value, err = resolveBlob(key, value, txn.Get)
+				if err != nil {
+					return err
+				}
 			}
 
 			lastpulse = pulseFromKey(key)
 			// fmt.Printf("Replica> key: %v (pulse=%v)\n", hex.EncodeToString(key), lastpulse)
 
-			value, err := it.Item().ValueCopy(nil)
-			if err != nil {
-				return err
-			}
-
 			NullifyJetInKey(key)
 			fc.records = append(fc.records, core.KV{K: key, V: value})
 			fc.size += len(key) + len(value)
-		}
-		nextstart = nil
-		return nil
+			return nil
+		})
 	})
 	return nextstart, lastpulse, err
 }
@@ -205,3 +200,17 @@ func NullifyJetInKey(key []byte) {
 		key[i] = 0
 	}
 }
+
+// JetRecordLinks returns key/value pairs that link records from kvs to provided jet. Heavy node stores records without
+// jet prefix (see NullifyJetInKey), so these links are required to match records with jet drops.
+func JetRecordLinks(jetID core.RecordID, kvs []core.KV) []core.KV {
+	_, prefix := jet.Jet(jetID)
+	var links []core.KV
+	for _, kv := range kvs {
+		if len(kv.K) != core.RecordHashSize+core.RecordIDSize || kv.K[0] != scopeIDRecord {
+			continue
+		}
+		links = append(links, core.KV{K: prefixkey(scopeIDJetRecord, prefix, kv.K[core.RecordHashSize:]), V: []byte{}})
+	}
+	return links
+}
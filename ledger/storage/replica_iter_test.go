@@ -23,7 +23,6 @@ import (
 	"sort"
 	"testing"
 
-	"github.com/dgraph-io/badger"
 	"github.com/insolar/insolar/component"
 	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/instrumentation/inslogger"
@@ -158,7 +157,7 @@ func Test_StoreKeyValues(t *testing.T) {
 				allKVs = append(allKVs, recs...)
 			}
 		}
-		expectedrecs, expectedidxs = getallkeys(db.GetBadgerDB())
+		expectedrecs, expectedidxs = getallkeys(db.GetKVStore())
 		nullifyJetInKeys(expectedrecs)
 		nullifyJetInKeys(expectedidxs)
 		sortkeys(expectedrecs)
@@ -174,7 +173,7 @@ func Test_StoreKeyValues(t *testing.T) {
 		defer cleaner()
 		err := db.StoreKeyValues(ctx, allKVs)
 		require.NoError(t, err)
-		gotrecs, gotidxs = getallkeys(db.GetBadgerDB())
+		gotrecs, gotidxs = getallkeys(db.GetKVStore())
 	}()
 
 	assert.Equal(t, len(expectedrecs), len(gotrecs), "records counts are the same after restore")
@@ -210,7 +209,7 @@ func (s *replicaIterSuite) Test_ReplicaIter_FirstPulse() {
 	}
 
 	got = sortkeys(got)
-	all, idxs := getallkeys(s.db.GetBadgerDB())
+	all, idxs := getallkeys(s.db.GetKVStore())
 	all = append(all, idxs...)
 	all = sortkeys(all)
 
@@ -247,7 +246,7 @@ func Test_ReplicaIter_Base(t *testing.T) {
 	// it's easy to test simple case with zero Jet
 	jetID := *jet.NewID(0, nil)
 
-	recsBefore, idxBefore := getallkeys(db.GetBadgerDB())
+	recsBefore, idxBefore := getallkeys(db.GetKVStore())
 	require.Nil(t, recsBefore)
 	require.Nil(t, idxBefore)
 
@@ -261,17 +260,17 @@ func Test_ReplicaIter_Base(t *testing.T) {
 		addRecords(ctx, t, os, jetID, lastPulse)
 		setDrop(ctx, t, ds, jetID, lastPulse)
 
-		recs, _ := getallkeys(db.GetBadgerDB())
+		recs, _ := getallkeys(db.GetKVStore())
 		recKeys := getdelta(recsBefore, recs)
 		recsBefore = recs
 
-		_, idxAll := getallkeys(db.GetBadgerDB())
+		_, idxAll := getallkeys(db.GetKVStore())
 
 		recsPerPulse[i] = recKeys
 		ttPerPulse[i] = append(ttPerPulse[i], recKeys...)
 		ttPerPulse[i] = append(ttPerPulse[i], idxAll...)
 	}
-	_, idxsAfter := getallkeys(db.GetBadgerDB())
+	_, idxsAfter := getallkeys(db.GetKVStore())
 
 	for i := 0; i < pulsescount; i++ {
 		// in range should be all record from the next pulses
@@ -425,18 +424,14 @@ var (
 	scopeIDBlob     = byte(7)
 )
 
-func getallkeys(db *badger.DB) (records []key, indexes []key) {
-	txn := db.NewTransaction(true)
+func getallkeys(store storage.KVStore) (records []key, indexes []key) {
+	txn := store.NewTransaction(false)
 	defer txn.Discard()
 
-	it := txn.NewIterator(badger.DefaultIteratorOptions)
-	defer it.Close()
-	for it.Rewind(); it.Valid(); it.Next() {
-		item := it.Item()
-		k := item.KeyCopy(nil)
+	txn.Iterate(nil, nil, func(k, _ []byte) error {
 		pn := storage.Key(k).PulseNumber()
 		if pn == 0 {
-			continue
+			return nil
 		}
 
 		switch k[0] {
@@ -449,7 +444,8 @@ func getallkeys(db *badger.DB) (records []key, indexes []key) {
 		case scopeIDLifeline:
 			indexes = append(indexes, k)
 		}
-	}
+		return nil
+	})
 	return
 }
 
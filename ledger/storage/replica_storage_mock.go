@@ -30,6 +30,11 @@ type ReplicaStorageMock struct {
 	GetAllSyncClientJetsPreCounter uint64
 	GetAllSyncClientJetsMock       mReplicaStorageMockGetAllSyncClientJets
 
+	GetHeavySyncProgressFunc       func(p context.Context, p1 core.RecordID) (r core.PulseNumber, r1 core.HeavySyncProgress, r2 error)
+	GetHeavySyncProgressCounter    uint64
+	GetHeavySyncProgressPreCounter uint64
+	GetHeavySyncProgressMock       mReplicaStorageMockGetHeavySyncProgress
+
 	GetHeavySyncedPulseFunc       func(p context.Context, p1 core.RecordID) (r core.PulseNumber, r1 error)
 	GetHeavySyncedPulseCounter    uint64
 	GetHeavySyncedPulsePreCounter uint64
@@ -40,6 +45,11 @@ type ReplicaStorageMock struct {
 	GetSyncClientJetPulsesPreCounter uint64
 	GetSyncClientJetPulsesMock       mReplicaStorageMockGetSyncClientJetPulses
 
+	SetHeavySyncProgressFunc       func(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 core.HeavySyncProgress) (r error)
+	SetHeavySyncProgressCounter    uint64
+	SetHeavySyncProgressPreCounter uint64
+	SetHeavySyncProgressMock       mReplicaStorageMockSetHeavySyncProgress
+
 	SetHeavySyncedPulseFunc       func(p context.Context, p1 core.RecordID, p2 core.PulseNumber) (r error)
 	SetHeavySyncedPulseCounter    uint64
 	SetHeavySyncedPulsePreCounter uint64
@@ -61,8 +71,10 @@ func NewReplicaStorageMock(t minimock.Tester) *ReplicaStorageMock {
 
 	m.GetAllNonEmptySyncClientJetsMock = mReplicaStorageMockGetAllNonEmptySyncClientJets{mock: m}
 	m.GetAllSyncClientJetsMock = mReplicaStorageMockGetAllSyncClientJets{mock: m}
+	m.GetHeavySyncProgressMock = mReplicaStorageMockGetHeavySyncProgress{mock: m}
 	m.GetHeavySyncedPulseMock = mReplicaStorageMockGetHeavySyncedPulse{mock: m}
 	m.GetSyncClientJetPulsesMock = mReplicaStorageMockGetSyncClientJetPulses{mock: m}
+	m.SetHeavySyncProgressMock = mReplicaStorageMockSetHeavySyncProgress{mock: m}
 	m.SetHeavySyncedPulseMock = mReplicaStorageMockSetHeavySyncedPulse{mock: m}
 	m.SetSyncClientJetPulsesMock = mReplicaStorageMockSetSyncClientJetPulses{mock: m}
 
@@ -369,6 +381,160 @@ func (m *ReplicaStorageMock) GetAllSyncClientJetsFinished() bool {
 	return true
 }
 
+type mReplicaStorageMockGetHeavySyncProgress struct {
+	mock              *ReplicaStorageMock
+	mainExpectation   *ReplicaStorageMockGetHeavySyncProgressExpectation
+	expectationSeries []*ReplicaStorageMockGetHeavySyncProgressExpectation
+}
+
+type ReplicaStorageMockGetHeavySyncProgressExpectation struct {
+	input  *ReplicaStorageMockGetHeavySyncProgressInput
+	result *ReplicaStorageMockGetHeavySyncProgressResult
+}
+
+type ReplicaStorageMockGetHeavySyncProgressInput struct {
+	p  context.Context
+	p1 core.RecordID
+}
+
+type ReplicaStorageMockGetHeavySyncProgressResult struct {
+	r  core.PulseNumber
+	r1 core.HeavySyncProgress
+	r2 error
+}
+
+//Expect specifies that invocation of ReplicaStorage.GetHeavySyncProgress is expected from 1 to Infinity times
+func (m *mReplicaStorageMockGetHeavySyncProgress) Expect(p context.Context, p1 core.RecordID) *mReplicaStorageMockGetHeavySyncProgress {
+	m.mock.GetHeavySyncProgressFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ReplicaStorageMockGetHeavySyncProgressExpectation{}
+	}
+	m.mainExpectation.input = &ReplicaStorageMockGetHeavySyncProgressInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of ReplicaStorage.GetHeavySyncProgress
+func (m *mReplicaStorageMockGetHeavySyncProgress) Return(r core.PulseNumber, r1 core.HeavySyncProgress, r2 error) *ReplicaStorageMock {
+	m.mock.GetHeavySyncProgressFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ReplicaStorageMockGetHeavySyncProgressExpectation{}
+	}
+	m.mainExpectation.result = &ReplicaStorageMockGetHeavySyncProgressResult{r, r1, r2}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ReplicaStorage.GetHeavySyncProgress is expected once
+func (m *mReplicaStorageMockGetHeavySyncProgress) ExpectOnce(p context.Context, p1 core.RecordID) *ReplicaStorageMockGetHeavySyncProgressExpectation {
+	m.mock.GetHeavySyncProgressFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ReplicaStorageMockGetHeavySyncProgressExpectation{}
+	expectation.input = &ReplicaStorageMockGetHeavySyncProgressInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ReplicaStorageMockGetHeavySyncProgressExpectation) Return(r core.PulseNumber, r1 core.HeavySyncProgress, r2 error) {
+	e.result = &ReplicaStorageMockGetHeavySyncProgressResult{r, r1, r2}
+}
+
+//Set uses given function f as a mock of ReplicaStorage.GetHeavySyncProgress method
+func (m *mReplicaStorageMockGetHeavySyncProgress) Set(f func(p context.Context, p1 core.RecordID) (r core.PulseNumber, r1 core.HeavySyncProgress, r2 error)) *ReplicaStorageMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetHeavySyncProgressFunc = f
+	return m.mock
+}
+
+//GetHeavySyncProgress implements github.com/insolar/insolar/ledger/storage.ReplicaStorage interface
+func (m *ReplicaStorageMock) GetHeavySyncProgress(p context.Context, p1 core.RecordID) (r core.PulseNumber, r1 core.HeavySyncProgress, r2 error) {
+	counter := atomic.AddUint64(&m.GetHeavySyncProgressPreCounter, 1)
+	defer atomic.AddUint64(&m.GetHeavySyncProgressCounter, 1)
+
+	if len(m.GetHeavySyncProgressMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetHeavySyncProgressMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ReplicaStorageMock.GetHeavySyncProgress. %v %v", p, p1)
+			return
+		}
+
+		input := m.GetHeavySyncProgressMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ReplicaStorageMockGetHeavySyncProgressInput{p, p1}, "ReplicaStorage.GetHeavySyncProgress got unexpected parameters")
+
+		result := m.GetHeavySyncProgressMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ReplicaStorageMock.GetHeavySyncProgress")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.GetHeavySyncProgressMock.mainExpectation != nil {
+
+		input := m.GetHeavySyncProgressMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ReplicaStorageMockGetHeavySyncProgressInput{p, p1}, "ReplicaStorage.GetHeavySyncProgress got unexpected parameters")
+		}
+
+		result := m.GetHeavySyncProgressMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ReplicaStorageMock.GetHeavySyncProgress")
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.GetHeavySyncProgressFunc == nil {
+		m.t.Fatalf("Unexpected call to ReplicaStorageMock.GetHeavySyncProgress. %v %v", p, p1)
+		return
+	}
+
+	return m.GetHeavySyncProgressFunc(p, p1)
+}
+
+//GetHeavySyncProgressMinimockCounter returns a count of ReplicaStorageMock.GetHeavySyncProgressFunc invocations
+func (m *ReplicaStorageMock) GetHeavySyncProgressMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetHeavySyncProgressCounter)
+}
+
+//GetHeavySyncProgressMinimockPreCounter returns the value of ReplicaStorageMock.GetHeavySyncProgress invocations
+func (m *ReplicaStorageMock) GetHeavySyncProgressMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetHeavySyncProgressPreCounter)
+}
+
+//GetHeavySyncProgressFinished returns true if mock invocations count is ok
+func (m *ReplicaStorageMock) GetHeavySyncProgressFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetHeavySyncProgressMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetHeavySyncProgressCounter) == uint64(len(m.GetHeavySyncProgressMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetHeavySyncProgressMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetHeavySyncProgressCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetHeavySyncProgressFunc != nil {
+		return atomic.LoadUint64(&m.GetHeavySyncProgressCounter) > 0
+	}
+
+	return true
+}
+
 type mReplicaStorageMockGetHeavySyncedPulse struct {
 	mock              *ReplicaStorageMock
 	mainExpectation   *ReplicaStorageMockGetHeavySyncedPulseExpectation
@@ -671,6 +837,156 @@ func (m *ReplicaStorageMock) GetSyncClientJetPulsesFinished() bool {
 	return true
 }
 
+type mReplicaStorageMockSetHeavySyncProgress struct {
+	mock              *ReplicaStorageMock
+	mainExpectation   *ReplicaStorageMockSetHeavySyncProgressExpectation
+	expectationSeries []*ReplicaStorageMockSetHeavySyncProgressExpectation
+}
+
+type ReplicaStorageMockSetHeavySyncProgressExpectation struct {
+	input  *ReplicaStorageMockSetHeavySyncProgressInput
+	result *ReplicaStorageMockSetHeavySyncProgressResult
+}
+
+type ReplicaStorageMockSetHeavySyncProgressInput struct {
+	p  context.Context
+	p1 core.RecordID
+	p2 core.PulseNumber
+	p3 core.HeavySyncProgress
+}
+
+type ReplicaStorageMockSetHeavySyncProgressResult struct {
+	r error
+}
+
+//Expect specifies that invocation of ReplicaStorage.SetHeavySyncProgress is expected from 1 to Infinity times
+func (m *mReplicaStorageMockSetHeavySyncProgress) Expect(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 core.HeavySyncProgress) *mReplicaStorageMockSetHeavySyncProgress {
+	m.mock.SetHeavySyncProgressFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ReplicaStorageMockSetHeavySyncProgressExpectation{}
+	}
+	m.mainExpectation.input = &ReplicaStorageMockSetHeavySyncProgressInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of ReplicaStorage.SetHeavySyncProgress
+func (m *mReplicaStorageMockSetHeavySyncProgress) Return(r error) *ReplicaStorageMock {
+	m.mock.SetHeavySyncProgressFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ReplicaStorageMockSetHeavySyncProgressExpectation{}
+	}
+	m.mainExpectation.result = &ReplicaStorageMockSetHeavySyncProgressResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ReplicaStorage.SetHeavySyncProgress is expected once
+func (m *mReplicaStorageMockSetHeavySyncProgress) ExpectOnce(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 core.HeavySyncProgress) *ReplicaStorageMockSetHeavySyncProgressExpectation {
+	m.mock.SetHeavySyncProgressFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ReplicaStorageMockSetHeavySyncProgressExpectation{}
+	expectation.input = &ReplicaStorageMockSetHeavySyncProgressInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ReplicaStorageMockSetHeavySyncProgressExpectation) Return(r error) {
+	e.result = &ReplicaStorageMockSetHeavySyncProgressResult{r}
+}
+
+//Set uses given function f as a mock of ReplicaStorage.SetHeavySyncProgress method
+func (m *mReplicaStorageMockSetHeavySyncProgress) Set(f func(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 core.HeavySyncProgress) (r error)) *ReplicaStorageMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.SetHeavySyncProgressFunc = f
+	return m.mock
+}
+
+//SetHeavySyncProgress implements github.com/insolar/insolar/ledger/storage.ReplicaStorage interface
+func (m *ReplicaStorageMock) SetHeavySyncProgress(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 core.HeavySyncProgress) (r error) {
+	counter := atomic.AddUint64(&m.SetHeavySyncProgressPreCounter, 1)
+	defer atomic.AddUint64(&m.SetHeavySyncProgressCounter, 1)
+
+	if len(m.SetHeavySyncProgressMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.SetHeavySyncProgressMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ReplicaStorageMock.SetHeavySyncProgress. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.SetHeavySyncProgressMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ReplicaStorageMockSetHeavySyncProgressInput{p, p1, p2, p3}, "ReplicaStorage.SetHeavySyncProgress got unexpected parameters")
+
+		result := m.SetHeavySyncProgressMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ReplicaStorageMock.SetHeavySyncProgress")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.SetHeavySyncProgressMock.mainExpectation != nil {
+
+		input := m.SetHeavySyncProgressMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ReplicaStorageMockSetHeavySyncProgressInput{p, p1, p2, p3}, "ReplicaStorage.SetHeavySyncProgress got unexpected parameters")
+		}
+
+		result := m.SetHeavySyncProgressMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ReplicaStorageMock.SetHeavySyncProgress")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.SetHeavySyncProgressFunc == nil {
+		m.t.Fatalf("Unexpected call to ReplicaStorageMock.SetHeavySyncProgress. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.SetHeavySyncProgressFunc(p, p1, p2, p3)
+}
+
+//SetHeavySyncProgressMinimockCounter returns a count of ReplicaStorageMock.SetHeavySyncProgressFunc invocations
+func (m *ReplicaStorageMock) SetHeavySyncProgressMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.SetHeavySyncProgressCounter)
+}
+
+//SetHeavySyncProgressMinimockPreCounter returns the value of ReplicaStorageMock.SetHeavySyncProgress invocations
+func (m *ReplicaStorageMock) SetHeavySyncProgressMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.SetHeavySyncProgressPreCounter)
+}
+
+//SetHeavySyncProgressFinished returns true if mock invocations count is ok
+func (m *ReplicaStorageMock) SetHeavySyncProgressFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.SetHeavySyncProgressMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.SetHeavySyncProgressCounter) == uint64(len(m.SetHeavySyncProgressMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.SetHeavySyncProgressMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.SetHeavySyncProgressCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.SetHeavySyncProgressFunc != nil {
+		return atomic.LoadUint64(&m.SetHeavySyncProgressCounter) > 0
+	}
+
+	return true
+}
+
 type mReplicaStorageMockSetHeavySyncedPulse struct {
 	mock              *ReplicaStorageMock
 	mainExpectation   *ReplicaStorageMockSetHeavySyncedPulseExpectation
@@ -981,6 +1297,10 @@ func (m *ReplicaStorageMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ReplicaStorageMock.GetAllSyncClientJets")
 	}
 
+	if !m.GetHeavySyncProgressFinished() {
+		m.t.Fatal("Expected call to ReplicaStorageMock.GetHeavySyncProgress")
+	}
+
 	if !m.GetHeavySyncedPulseFinished() {
 		m.t.Fatal("Expected call to ReplicaStorageMock.GetHeavySyncedPulse")
 	}
@@ -989,6 +1309,10 @@ func (m *ReplicaStorageMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ReplicaStorageMock.GetSyncClientJetPulses")
 	}
 
+	if !m.SetHeavySyncProgressFinished() {
+		m.t.Fatal("Expected call to ReplicaStorageMock.SetHeavySyncProgress")
+	}
+
 	if !m.SetHeavySyncedPulseFinished() {
 		m.t.Fatal("Expected call to ReplicaStorageMock.SetHeavySyncedPulse")
 	}
@@ -1022,6 +1346,10 @@ func (m *ReplicaStorageMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ReplicaStorageMock.GetAllSyncClientJets")
 	}
 
+	if !m.GetHeavySyncProgressFinished() {
+		m.t.Fatal("Expected call to ReplicaStorageMock.GetHeavySyncProgress")
+	}
+
 	if !m.GetHeavySyncedPulseFinished() {
 		m.t.Fatal("Expected call to ReplicaStorageMock.GetHeavySyncedPulse")
 	}
@@ -1030,6 +1358,10 @@ func (m *ReplicaStorageMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ReplicaStorageMock.GetSyncClientJetPulses")
 	}
 
+	if !m.SetHeavySyncProgressFinished() {
+		m.t.Fatal("Expected call to ReplicaStorageMock.SetHeavySyncProgress")
+	}
+
 	if !m.SetHeavySyncedPulseFinished() {
 		m.t.Fatal("Expected call to ReplicaStorageMock.SetHeavySyncedPulse")
 	}
@@ -1054,8 +1386,10 @@ func (m *ReplicaStorageMock) MinimockWait(timeout time.Duration) {
 		ok := true
 		ok = ok && m.GetAllNonEmptySyncClientJetsFinished()
 		ok = ok && m.GetAllSyncClientJetsFinished()
+		ok = ok && m.GetHeavySyncProgressFinished()
 		ok = ok && m.GetHeavySyncedPulseFinished()
 		ok = ok && m.GetSyncClientJetPulsesFinished()
+		ok = ok && m.SetHeavySyncProgressFinished()
 		ok = ok && m.SetHeavySyncedPulseFinished()
 		ok = ok && m.SetSyncClientJetPulsesFinished()
 
@@ -1074,6 +1408,10 @@ func (m *ReplicaStorageMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ReplicaStorageMock.GetAllSyncClientJets")
 			}
 
+			if !m.GetHeavySyncProgressFinished() {
+				m.t.Error("Expected call to ReplicaStorageMock.GetHeavySyncProgress")
+			}
+
 			if !m.GetHeavySyncedPulseFinished() {
 				m.t.Error("Expected call to ReplicaStorageMock.GetHeavySyncedPulse")
 			}
@@ -1082,6 +1420,10 @@ func (m *ReplicaStorageMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ReplicaStorageMock.GetSyncClientJetPulses")
 			}
 
+			if !m.SetHeavySyncProgressFinished() {
+				m.t.Error("Expected call to ReplicaStorageMock.SetHeavySyncProgress")
+			}
+
 			if !m.SetHeavySyncedPulseFinished() {
 				m.t.Error("Expected call to ReplicaStorageMock.SetHeavySyncedPulse")
 			}
@@ -1110,6 +1452,10 @@ func (m *ReplicaStorageMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.GetHeavySyncProgressFinished() {
+		return false
+	}
+
 	if !m.GetHeavySyncedPulseFinished() {
 		return false
 	}
@@ -1118,6 +1464,10 @@ func (m *ReplicaStorageMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.SetHeavySyncProgressFinished() {
+		return false
+	}
+
 	if !m.SetHeavySyncedPulseFinished() {
 		return false
 	}
@@ -19,10 +19,10 @@ package storage
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"encoding/gob"
 	"io"
 
-	"github.com/dgraph-io/badger"
 	"github.com/insolar/insolar/core"
 	"github.com/pkg/errors"
 )
@@ -32,6 +32,8 @@ import (
 type ReplicaStorage interface {
 	SetHeavySyncedPulse(ctx context.Context, jetID core.RecordID, pulsenum core.PulseNumber) error
 	GetHeavySyncedPulse(ctx context.Context, jetID core.RecordID) (pn core.PulseNumber, err error)
+	SetHeavySyncProgress(ctx context.Context, jetID core.RecordID, pulsenum core.PulseNumber, progress core.HeavySyncProgress) error
+	GetHeavySyncProgress(ctx context.Context, jetID core.RecordID) (core.PulseNumber, core.HeavySyncProgress, error)
 	GetSyncClientJetPulses(ctx context.Context, jetID core.RecordID) ([]core.PulseNumber, error)
 	SetSyncClientJetPulses(ctx context.Context, jetID core.RecordID, pns []core.PulseNumber) error
 	GetAllSyncClientJets(ctx context.Context) (map[core.RecordID][]core.PulseNumber, error)
@@ -65,6 +67,42 @@ func (rs *replicaStorage) GetHeavySyncedPulse(ctx context.Context, jetID core.Re
 	return
 }
 
+// SetHeavySyncProgress saves chunks of the pulse accepted on heavy node.
+func (rs *replicaStorage) SetHeavySyncProgress(
+	ctx context.Context,
+	jetID core.RecordID,
+	pulsenum core.PulseNumber,
+	progress core.HeavySyncProgress,
+) error {
+	buf := make([]byte, core.PulseNumberSize+4, core.PulseNumberSize+4+len(progress.Checksum))
+	copy(buf, pulsenum.Bytes())
+	binary.BigEndian.PutUint32(buf[core.PulseNumberSize:], progress.Chunks)
+	buf = append(buf, progress.Checksum...)
+	return rs.DB.set(ctx, prefixkey(scopeIDSystem, jetID[:], []byte{sysHeavySyncProgress}), buf)
+}
+
+// GetHeavySyncProgress returns pulse being synced and its chunks accepted on heavy node.
+func (rs *replicaStorage) GetHeavySyncProgress(
+	ctx context.Context,
+	jetID core.RecordID,
+) (core.PulseNumber, core.HeavySyncProgress, error) {
+	buf, err := rs.DB.get(ctx, prefixkey(scopeIDSystem, jetID[:], []byte{sysHeavySyncProgress}))
+	if err == core.ErrNotFound {
+		return 0, core.HeavySyncProgress{}, nil
+	}
+	if err != nil {
+		return 0, core.HeavySyncProgress{}, err
+	}
+	if len(buf) < core.PulseNumberSize+4 {
+		return 0, core.HeavySyncProgress{}, errors.New("GetHeavySyncProgress: invalid value")
+	}
+	progress := core.HeavySyncProgress{
+		Chunks:   binary.BigEndian.Uint32(buf[core.PulseNumberSize:]),
+		Checksum: buf[core.PulseNumberSize+4:],
+	}
+	return core.NewPulseNumber(buf[:core.PulseNumberSize]), progress, nil
+}
+
 var sysHeavyClientStatePrefix = prefixkey(scopeIDSystem, []byte{sysHeavyClientState})
 
 func sysHeavyClientStateKeyForJet(jetID []byte) []byte {
@@ -104,20 +142,8 @@ func (rs *replicaStorage) SetSyncClientJetPulses(ctx context.Context, jetID core
 // GetAllSyncClientJets returns map of all jet's processed by node.
 func (rs *replicaStorage) GetAllSyncClientJets(ctx context.Context) (map[core.RecordID][]core.PulseNumber, error) {
 	jets := map[core.RecordID][]core.PulseNumber{}
-	err := rs.DB.GetBadgerDB().View(func(txn *badger.Txn) error {
-		it := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer it.Close()
-
-		for it.Seek(sysHeavyClientStatePrefix); it.ValidForPrefix(sysHeavyClientStatePrefix); it.Next() {
-			item := it.Item()
-			if item == nil {
-				break
-			}
-			key := item.Key()
-			value, err := it.Item().Value()
-			if err != nil {
-				return err
-			}
+	err := kvView(rs.DB.GetKVStore(), func(txn KVTransaction) error {
+		return txn.Iterate(sysHeavyClientStatePrefix, nil, func(key, value []byte) error {
 			syncPulses, err := decodePulsesList(bytes.NewReader(value))
 			if err != nil {
 				return err
@@ -127,8 +153,8 @@ func (rs *replicaStorage) GetAllSyncClientJets(ctx context.Context) (map[core.Re
 			offset := len(sysHeavyClientStatePrefix)
 			copy(jetID[:], key[offset:offset+len(jetID)])
 			jets[jetID] = syncPulses
-		}
-		return nil
+			return nil
+		})
 	})
 	if err != nil {
 		return nil, err
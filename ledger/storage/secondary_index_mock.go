@@ -0,0 +1,610 @@
+package storage
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "SecondaryIndex" can be found in github.com/insolar/insolar/ledger/storage
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	core "github.com/insolar/insolar/core"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//SecondaryIndexMock implements github.com/insolar/insolar/ledger/storage.SecondaryIndex
+type SecondaryIndexMock struct {
+	t minimock.Tester
+
+	FindFunc       func(p context.Context, p1 core.SecondaryIndex, p2 core.RecordRef, p3 *core.RecordID, p4 int) (r *core.IndexedRecords, r1 error)
+	FindCounter    uint64
+	FindPreCounter uint64
+	FindMock       mSecondaryIndexMockFind
+
+	IndexKeyValuesFunc       func(p context.Context, p1 []core.KV) (r error)
+	IndexKeyValuesCounter    uint64
+	IndexKeyValuesPreCounter uint64
+	IndexKeyValuesMock       mSecondaryIndexMockIndexKeyValues
+
+	ReindexFunc       func(p context.Context) (r error)
+	ReindexCounter    uint64
+	ReindexPreCounter uint64
+	ReindexMock       mSecondaryIndexMockReindex
+}
+
+//NewSecondaryIndexMock returns a mock for github.com/insolar/insolar/ledger/storage.SecondaryIndex
+func NewSecondaryIndexMock(t minimock.Tester) *SecondaryIndexMock {
+	m := &SecondaryIndexMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.FindMock = mSecondaryIndexMockFind{mock: m}
+	m.IndexKeyValuesMock = mSecondaryIndexMockIndexKeyValues{mock: m}
+	m.ReindexMock = mSecondaryIndexMockReindex{mock: m}
+
+	return m
+}
+
+type mSecondaryIndexMockFind struct {
+	mock              *SecondaryIndexMock
+	mainExpectation   *SecondaryIndexMockFindExpectation
+	expectationSeries []*SecondaryIndexMockFindExpectation
+}
+
+type SecondaryIndexMockFindExpectation struct {
+	input  *SecondaryIndexMockFindInput
+	result *SecondaryIndexMockFindResult
+}
+
+type SecondaryIndexMockFindInput struct {
+	p  context.Context
+	p1 core.SecondaryIndex
+	p2 core.RecordRef
+	p3 *core.RecordID
+	p4 int
+}
+
+type SecondaryIndexMockFindResult struct {
+	r  *core.IndexedRecords
+	r1 error
+}
+
+//Expect specifies that invocation of SecondaryIndex.Find is expected from 1 to Infinity times
+func (m *mSecondaryIndexMockFind) Expect(p context.Context, p1 core.SecondaryIndex, p2 core.RecordRef, p3 *core.RecordID, p4 int) *mSecondaryIndexMockFind {
+	m.mock.FindFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &SecondaryIndexMockFindExpectation{}
+	}
+	m.mainExpectation.input = &SecondaryIndexMockFindInput{p, p1, p2, p3, p4}
+	return m
+}
+
+//Return specifies results of invocation of SecondaryIndex.Find
+func (m *mSecondaryIndexMockFind) Return(r *core.IndexedRecords, r1 error) *SecondaryIndexMock {
+	m.mock.FindFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &SecondaryIndexMockFindExpectation{}
+	}
+	m.mainExpectation.result = &SecondaryIndexMockFindResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of SecondaryIndex.Find is expected once
+func (m *mSecondaryIndexMockFind) ExpectOnce(p context.Context, p1 core.SecondaryIndex, p2 core.RecordRef, p3 *core.RecordID, p4 int) *SecondaryIndexMockFindExpectation {
+	m.mock.FindFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &SecondaryIndexMockFindExpectation{}
+	expectation.input = &SecondaryIndexMockFindInput{p, p1, p2, p3, p4}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *SecondaryIndexMockFindExpectation) Return(r *core.IndexedRecords, r1 error) {
+	e.result = &SecondaryIndexMockFindResult{r, r1}
+}
+
+//Set uses given function f as a mock of SecondaryIndex.Find method
+func (m *mSecondaryIndexMockFind) Set(f func(p context.Context, p1 core.SecondaryIndex, p2 core.RecordRef, p3 *core.RecordID, p4 int) (r *core.IndexedRecords, r1 error)) *SecondaryIndexMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.FindFunc = f
+	return m.mock
+}
+
+//Find implements github.com/insolar/insolar/ledger/storage.SecondaryIndex interface
+func (m *SecondaryIndexMock) Find(p context.Context, p1 core.SecondaryIndex, p2 core.RecordRef, p3 *core.RecordID, p4 int) (r *core.IndexedRecords, r1 error) {
+	counter := atomic.AddUint64(&m.FindPreCounter, 1)
+	defer atomic.AddUint64(&m.FindCounter, 1)
+
+	if len(m.FindMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.FindMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to SecondaryIndexMock.Find. %v %v %v %v %v", p, p1, p2, p3, p4)
+			return
+		}
+
+		input := m.FindMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, SecondaryIndexMockFindInput{p, p1, p2, p3, p4}, "SecondaryIndex.Find got unexpected parameters")
+
+		result := m.FindMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the SecondaryIndexMock.Find")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.FindMock.mainExpectation != nil {
+
+		input := m.FindMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, SecondaryIndexMockFindInput{p, p1, p2, p3, p4}, "SecondaryIndex.Find got unexpected parameters")
+		}
+
+		result := m.FindMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the SecondaryIndexMock.Find")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.FindFunc == nil {
+		m.t.Fatalf("Unexpected call to SecondaryIndexMock.Find. %v %v %v %v %v", p, p1, p2, p3, p4)
+		return
+	}
+
+	return m.FindFunc(p, p1, p2, p3, p4)
+}
+
+//FindMinimockCounter returns a count of SecondaryIndexMock.FindFunc invocations
+func (m *SecondaryIndexMock) FindMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.FindCounter)
+}
+
+//FindMinimockPreCounter returns the value of SecondaryIndexMock.Find invocations
+func (m *SecondaryIndexMock) FindMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.FindPreCounter)
+}
+
+//FindFinished returns true if mock invocations count is ok
+func (m *SecondaryIndexMock) FindFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.FindMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.FindCounter) == uint64(len(m.FindMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.FindMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.FindCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.FindFunc != nil {
+		return atomic.LoadUint64(&m.FindCounter) > 0
+	}
+
+	return true
+}
+
+type mSecondaryIndexMockIndexKeyValues struct {
+	mock              *SecondaryIndexMock
+	mainExpectation   *SecondaryIndexMockIndexKeyValuesExpectation
+	expectationSeries []*SecondaryIndexMockIndexKeyValuesExpectation
+}
+
+type SecondaryIndexMockIndexKeyValuesExpectation struct {
+	input  *SecondaryIndexMockIndexKeyValuesInput
+	result *SecondaryIndexMockIndexKeyValuesResult
+}
+
+type SecondaryIndexMockIndexKeyValuesInput struct {
+	p  context.Context
+	p1 []core.KV
+}
+
+type SecondaryIndexMockIndexKeyValuesResult struct {
+	r error
+}
+
+//Expect specifies that invocation of SecondaryIndex.IndexKeyValues is expected from 1 to Infinity times
+func (m *mSecondaryIndexMockIndexKeyValues) Expect(p context.Context, p1 []core.KV) *mSecondaryIndexMockIndexKeyValues {
+	m.mock.IndexKeyValuesFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &SecondaryIndexMockIndexKeyValuesExpectation{}
+	}
+	m.mainExpectation.input = &SecondaryIndexMockIndexKeyValuesInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of SecondaryIndex.IndexKeyValues
+func (m *mSecondaryIndexMockIndexKeyValues) Return(r error) *SecondaryIndexMock {
+	m.mock.IndexKeyValuesFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &SecondaryIndexMockIndexKeyValuesExpectation{}
+	}
+	m.mainExpectation.result = &SecondaryIndexMockIndexKeyValuesResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of SecondaryIndex.IndexKeyValues is expected once
+func (m *mSecondaryIndexMockIndexKeyValues) ExpectOnce(p context.Context, p1 []core.KV) *SecondaryIndexMockIndexKeyValuesExpectation {
+	m.mock.IndexKeyValuesFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &SecondaryIndexMockIndexKeyValuesExpectation{}
+	expectation.input = &SecondaryIndexMockIndexKeyValuesInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *SecondaryIndexMockIndexKeyValuesExpectation) Return(r error) {
+	e.result = &SecondaryIndexMockIndexKeyValuesResult{r}
+}
+
+//Set uses given function f as a mock of SecondaryIndex.IndexKeyValues method
+func (m *mSecondaryIndexMockIndexKeyValues) Set(f func(p context.Context, p1 []core.KV) (r error)) *SecondaryIndexMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.IndexKeyValuesFunc = f
+	return m.mock
+}
+
+//IndexKeyValues implements github.com/insolar/insolar/ledger/storage.SecondaryIndex interface
+func (m *SecondaryIndexMock) IndexKeyValues(p context.Context, p1 []core.KV) (r error) {
+	counter := atomic.AddUint64(&m.IndexKeyValuesPreCounter, 1)
+	defer atomic.AddUint64(&m.IndexKeyValuesCounter, 1)
+
+	if len(m.IndexKeyValuesMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.IndexKeyValuesMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to SecondaryIndexMock.IndexKeyValues. %v %v", p, p1)
+			return
+		}
+
+		input := m.IndexKeyValuesMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, SecondaryIndexMockIndexKeyValuesInput{p, p1}, "SecondaryIndex.IndexKeyValues got unexpected parameters")
+
+		result := m.IndexKeyValuesMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the SecondaryIndexMock.IndexKeyValues")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.IndexKeyValuesMock.mainExpectation != nil {
+
+		input := m.IndexKeyValuesMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, SecondaryIndexMockIndexKeyValuesInput{p, p1}, "SecondaryIndex.IndexKeyValues got unexpected parameters")
+		}
+
+		result := m.IndexKeyValuesMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the SecondaryIndexMock.IndexKeyValues")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.IndexKeyValuesFunc == nil {
+		m.t.Fatalf("Unexpected call to SecondaryIndexMock.IndexKeyValues. %v %v", p, p1)
+		return
+	}
+
+	return m.IndexKeyValuesFunc(p, p1)
+}
+
+//IndexKeyValuesMinimockCounter returns a count of SecondaryIndexMock.IndexKeyValuesFunc invocations
+func (m *SecondaryIndexMock) IndexKeyValuesMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.IndexKeyValuesCounter)
+}
+
+//IndexKeyValuesMinimockPreCounter returns the value of SecondaryIndexMock.IndexKeyValues invocations
+func (m *SecondaryIndexMock) IndexKeyValuesMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.IndexKeyValuesPreCounter)
+}
+
+//IndexKeyValuesFinished returns true if mock invocations count is ok
+func (m *SecondaryIndexMock) IndexKeyValuesFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.IndexKeyValuesMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.IndexKeyValuesCounter) == uint64(len(m.IndexKeyValuesMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.IndexKeyValuesMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.IndexKeyValuesCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.IndexKeyValuesFunc != nil {
+		return atomic.LoadUint64(&m.IndexKeyValuesCounter) > 0
+	}
+
+	return true
+}
+
+type mSecondaryIndexMockReindex struct {
+	mock              *SecondaryIndexMock
+	mainExpectation   *SecondaryIndexMockReindexExpectation
+	expectationSeries []*SecondaryIndexMockReindexExpectation
+}
+
+type SecondaryIndexMockReindexExpectation struct {
+	input  *SecondaryIndexMockReindexInput
+	result *SecondaryIndexMockReindexResult
+}
+
+type SecondaryIndexMockReindexInput struct {
+	p context.Context
+}
+
+type SecondaryIndexMockReindexResult struct {
+	r error
+}
+
+//Expect specifies that invocation of SecondaryIndex.Reindex is expected from 1 to Infinity times
+func (m *mSecondaryIndexMockReindex) Expect(p context.Context) *mSecondaryIndexMockReindex {
+	m.mock.ReindexFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &SecondaryIndexMockReindexExpectation{}
+	}
+	m.mainExpectation.input = &SecondaryIndexMockReindexInput{p}
+	return m
+}
+
+//Return specifies results of invocation of SecondaryIndex.Reindex
+func (m *mSecondaryIndexMockReindex) Return(r error) *SecondaryIndexMock {
+	m.mock.ReindexFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &SecondaryIndexMockReindexExpectation{}
+	}
+	m.mainExpectation.result = &SecondaryIndexMockReindexResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of SecondaryIndex.Reindex is expected once
+func (m *mSecondaryIndexMockReindex) ExpectOnce(p context.Context) *SecondaryIndexMockReindexExpectation {
+	m.mock.ReindexFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &SecondaryIndexMockReindexExpectation{}
+	expectation.input = &SecondaryIndexMockReindexInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *SecondaryIndexMockReindexExpectation) Return(r error) {
+	e.result = &SecondaryIndexMockReindexResult{r}
+}
+
+//Set uses given function f as a mock of SecondaryIndex.Reindex method
+func (m *mSecondaryIndexMockReindex) Set(f func(p context.Context) (r error)) *SecondaryIndexMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ReindexFunc = f
+	return m.mock
+}
+
+//Reindex implements github.com/insolar/insolar/ledger/storage.SecondaryIndex interface
+func (m *SecondaryIndexMock) Reindex(p context.Context) (r error) {
+	counter := atomic.AddUint64(&m.ReindexPreCounter, 1)
+	defer atomic.AddUint64(&m.ReindexCounter, 1)
+
+	if len(m.ReindexMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ReindexMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to SecondaryIndexMock.Reindex. %v", p)
+			return
+		}
+
+		input := m.ReindexMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, SecondaryIndexMockReindexInput{p}, "SecondaryIndex.Reindex got unexpected parameters")
+
+		result := m.ReindexMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the SecondaryIndexMock.Reindex")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.ReindexMock.mainExpectation != nil {
+
+		input := m.ReindexMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, SecondaryIndexMockReindexInput{p}, "SecondaryIndex.Reindex got unexpected parameters")
+		}
+
+		result := m.ReindexMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the SecondaryIndexMock.Reindex")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.ReindexFunc == nil {
+		m.t.Fatalf("Unexpected call to SecondaryIndexMock.Reindex. %v", p)
+		return
+	}
+
+	return m.ReindexFunc(p)
+}
+
+//ReindexMinimockCounter returns a count of SecondaryIndexMock.ReindexFunc invocations
+func (m *SecondaryIndexMock) ReindexMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ReindexCounter)
+}
+
+//ReindexMinimockPreCounter returns the value of SecondaryIndexMock.Reindex invocations
+func (m *SecondaryIndexMock) ReindexMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ReindexPreCounter)
+}
+
+//ReindexFinished returns true if mock invocations count is ok
+func (m *SecondaryIndexMock) ReindexFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ReindexMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ReindexCounter) == uint64(len(m.ReindexMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ReindexMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ReindexCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ReindexFunc != nil {
+		return atomic.LoadUint64(&m.ReindexCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *SecondaryIndexMock) ValidateCallCounters() {
+
+	if !m.FindFinished() {
+		m.t.Fatal("Expected call to SecondaryIndexMock.Find")
+	}
+
+	if !m.IndexKeyValuesFinished() {
+		m.t.Fatal("Expected call to SecondaryIndexMock.IndexKeyValues")
+	}
+
+	if !m.ReindexFinished() {
+		m.t.Fatal("Expected call to SecondaryIndexMock.Reindex")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *SecondaryIndexMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *SecondaryIndexMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *SecondaryIndexMock) MinimockFinish() {
+
+	if !m.FindFinished() {
+		m.t.Fatal("Expected call to SecondaryIndexMock.Find")
+	}
+
+	if !m.IndexKeyValuesFinished() {
+		m.t.Fatal("Expected call to SecondaryIndexMock.IndexKeyValues")
+	}
+
+	if !m.ReindexFinished() {
+		m.t.Fatal("Expected call to SecondaryIndexMock.Reindex")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *SecondaryIndexMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *SecondaryIndexMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.FindFinished()
+		ok = ok && m.IndexKeyValuesFinished()
+		ok = ok && m.ReindexFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.FindFinished() {
+				m.t.Error("Expected call to SecondaryIndexMock.Find")
+			}
+
+			if !m.IndexKeyValuesFinished() {
+				m.t.Error("Expected call to SecondaryIndexMock.IndexKeyValues")
+			}
+
+			if !m.ReindexFinished() {
+				m.t.Error("Expected call to SecondaryIndexMock.Reindex")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *SecondaryIndexMock) AllMocksCalled() bool {
+
+	if !m.FindFinished() {
+		return false
+	}
+
+	if !m.IndexKeyValuesFinished() {
+		return false
+	}
+
+	if !m.ReindexFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,291 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package storage
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/core/message"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/storage/record"
+	"github.com/pkg/errors"
+)
+
+// SecondaryIndex maintains heavy node indexes over stored records.
+//go:generate minimock -i github.com/insolar/insolar/ledger/storage.SecondaryIndex -o ./ -s _mock.go
+type SecondaryIndex interface {
+	IndexKeyValues(ctx context.Context, kvs []core.KV) error
+	Find(ctx context.Context, index core.SecondaryIndex, key core.RecordRef, from *core.RecordID, limit int) (*core.IndexedRecords, error)
+	Reindex(ctx context.Context) error
+}
+
+// secondaryIndexVersion is increased when indexed entries change and stored indexes should be rebuilt.
+const secondaryIndexVersion = 1
+
+const reindexBatchSize = 1000
+
+type secondaryIndex struct {
+	DB DBContext `inject:""`
+}
+
+// NewSecondaryIndex creates new SecondaryIndex instance.
+func NewSecondaryIndex() SecondaryIndex {
+	return new(secondaryIndex)
+}
+
+// secondaryIndexKey is: scope + index type + indexed reference + record id.
+func secondaryIndexKey(index core.SecondaryIndex, key core.RecordRef, id *core.RecordID) []byte {
+	if id == nil {
+		return prefixkey(scopeIDSecondaryIndex, []byte{byte(index)}, key[:])
+	}
+	return prefixkey(scopeIDSecondaryIndex, []byte{byte(index)}, key[:], id[:])
+}
+
+// IndexKeyValues adds records from provided key/values to secondary indexes.
+//
+// Other key/values are ignored. Indexing is idempotent, so the same records can be indexed more than once.
+func (si *secondaryIndex) IndexKeyValues(ctx context.Context, kvs []core.KV) error {
+	return si.DB.Update(ctx, func(tx *TransactionManager) error {
+		for _, kv := range kvs {
+			if len(kv.K) < core.RecordIDSize || kv.K[0] != scopeIDRecord {
+				continue
+			}
+			var id core.RecordID
+			copy(id[:], kv.K[len(kv.K)-core.RecordIDSize:])
+			rec, err := decodeRecord(kv.V)
+			if err != nil {
+				return errors.Wrapf(err, "failed to decode record %v", id.DebugString())
+			}
+			for _, entry := range indexEntries(rec) {
+				err = tx.set(ctx, secondaryIndexKey(entry.index, entry.key, &id), entry.object[:])
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+type indexEntry struct {
+	index  core.SecondaryIndex
+	key    core.RecordRef
+	object core.RecordRef
+}
+
+func indexEntries(rec record.Record) []indexEntry {
+	switch r := rec.(type) {
+	case *record.ObjectActivateRecord:
+		var entries []indexEntry
+		if !r.Parent.IsEmpty() {
+			entries = append(entries, indexEntry{
+				index: core.SecondaryIndexObjectsByParent, key: r.Parent, object: r.Request,
+			})
+		}
+		// Prototype activation has code in image, object activation has prototype.
+		if !r.IsPrototype {
+			entries = append(entries, indexEntry{
+				index: core.SecondaryIndexObjectsByPrototype, key: r.Image, object: r.Request,
+			})
+		}
+		return entries
+	case *record.RequestRecord:
+		parcel, err := message.DeserializeParcel(bytes.NewBuffer(r.Parcel))
+		// Requests without parseable message or caller are not indexed.
+		if err != nil || parcel.Message() == nil {
+			return nil
+		}
+		caller := parcel.GetCaller()
+		if caller == nil || caller.IsEmpty() {
+			return nil
+		}
+		object := *core.NewRecordRef(core.RecordID{}, r.Object)
+		if target := parcel.DefaultTarget(); target != nil {
+			object = *target
+		}
+		return []indexEntry{
+			{index: core.SecondaryIndexRequestsByCaller, key: *caller, object: object},
+		}
+	case *record.ResultRecord:
+		return []indexEntry{
+			{index: core.SecondaryIndexResultsByRequest, key: r.Request, object: *core.NewRecordRef(*r.Request.Domain(), r.Object)},
+		}
+	}
+	return nil
+}
+
+// Find returns records of the index with provided key in ascending id order.
+//
+// Records start after "from" id (or from the first one if "from" is nil). Next is set if more records are available.
+func (si *secondaryIndex) Find(
+	ctx context.Context,
+	index core.SecondaryIndex,
+	key core.RecordRef,
+	from *core.RecordID,
+	limit int,
+) (*core.IndexedRecords, error) {
+	if limit <= 0 {
+		return nil, errors.Errorf("invalid limit %v", limit)
+	}
+
+	prefix := secondaryIndexKey(index, key, nil)
+	var start []byte
+	if from != nil {
+		start = secondaryIndexKey(index, key, from)
+	}
+
+	result := &core.IndexedRecords{}
+	err := kvView(si.DB.GetKVStore(), func(txn KVTransaction) error {
+		return txn.Iterate(prefix, start, func(k, v []byte) error {
+			if start != nil && bytes.Equal(k, start) {
+				return nil
+			}
+			if len(result.Records) == limit {
+				next := result.Records[limit-1].ID
+				result.Next = &next
+				return ErrStopIteration
+			}
+			var rec core.IndexedRecord
+			copy(rec.ID[:], k[len(prefix):])
+			copy(rec.Object[:], v)
+			result.Records = append(result.Records, rec)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Reindex rebuilds indexes from all stored and archived records if they were built by older index version.
+//
+// Objects by prototype index is removed before rebuilding because older versions indexed prototypes in it. Records
+// are processed in batches, every batch in its own transaction.
+func (si *secondaryIndex) Reindex(ctx context.Context) error {
+	versionKey := prefixkey(scopeIDSystem, []byte{sysSecondaryIndexVersion})
+	buf, err := si.DB.get(ctx, versionKey)
+	if err != nil && err != core.ErrNotFound {
+		return err
+	}
+	if len(buf) > 0 && buf[0] >= secondaryIndexVersion {
+		return nil
+	}
+
+	inslogger.FromContext(ctx).Info("rebuilding secondary indexes")
+	err = si.removeIndex(core.SecondaryIndexObjectsByPrototype)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove outdated index")
+	}
+	err = si.reindexScope(ctx, []byte{scopeIDRecord}, false)
+	if err != nil {
+		return errors.Wrap(err, "failed to index stored records")
+	}
+	err = si.reindexScope(ctx, []byte{scopeIDArchive, scopeIDRecord}, true)
+	if err != nil {
+		return errors.Wrap(err, "failed to index archived records")
+	}
+	return si.DB.set(ctx, versionKey, []byte{secondaryIndexVersion})
+}
+
+func (si *secondaryIndex) removeIndex(index core.SecondaryIndex) error {
+	prefix := prefixkey(scopeIDSecondaryIndex, []byte{byte(index)})
+	for {
+		var keys [][]byte
+		err := kvView(si.DB.GetKVStore(), func(txn KVTransaction) error {
+			return txn.Iterate(prefix, nil, func(k, v []byte) error {
+				if len(keys) == reindexBatchSize {
+					return ErrStopIteration
+				}
+				keys = append(keys, append([]byte(nil), k...))
+				return nil
+			})
+		})
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+		err = kvUpdate(si.DB.GetKVStore(), func(txn KVTransaction) error {
+			for _, k := range keys {
+				if err := txn.Delete(k); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// reindexScope indexes records with provided key prefix. Archived records are read from archive segments.
+func (si *secondaryIndex) reindexScope(ctx context.Context, prefix []byte, archived bool) error {
+	var segments *segmentStore
+	if archived {
+		db, ok := si.DB.(*DB)
+		if !ok || db.segments == nil {
+			return nil
+		}
+		segments = db.segments
+	}
+
+	var seek []byte
+	for {
+		var (
+			kvs  []core.KV
+			next []byte
+		)
+		err := kvView(si.DB.GetKVStore(), func(txn KVTransaction) error {
+			return txn.Iterate(prefix, seek, func(k, v []byte) error {
+				if len(kvs) == reindexBatchSize {
+					next = append([]byte(nil), k...)
+					return ErrStopIteration
+				}
+				kv := core.KV{K: append([]byte(nil), k...), V: append([]byte(nil), v...)}
+				if archived {
+					ref, err := decodeSegmentRef(v)
+					if err != nil {
+						return err
+					}
+					kv.K = kv.K[1:]
+					kv.V, err = segments.read(ref)
+					if err != nil {
+						return err
+					}
+				}
+				kvs = append(kvs, kv)
+				return nil
+			})
+		})
+		if err != nil {
+			return err
+		}
+		err = si.IndexKeyValues(ctx, kvs)
+		if err != nil {
+			return err
+		}
+		if next == nil {
+			return nil
+		}
+		seek = next
+	}
+}
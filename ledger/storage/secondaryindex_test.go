@@ -0,0 +1,238 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package storage_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/insolar/insolar/component"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/core/message"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/jet"
+	"github.com/insolar/insolar/ledger/storage/record"
+	"github.com/insolar/insolar/ledger/storage/storagetest"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func requestRecord(caller, target core.RecordRef) *record.RequestRecord {
+	msg := &message.CallMethod{ObjectRef: target}
+	msg.Caller = caller
+	hash := testutils.RandomID()
+	return &record.RequestRecord{
+		Parcel:      message.ParcelToBytes(&message.Parcel{Msg: msg}),
+		MessageHash: hash[:],
+		Object:      *target.Record(),
+	}
+}
+
+func TestSecondaryIndex(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	db, cleaner := storagetest.TmpDB(ctx, t)
+	defer cleaner()
+
+	os := storage.NewObjectStorage()
+	si := storage.NewSecondaryIndex()
+	cm := &component.Manager{}
+	cm.Inject(platformpolicy.NewPlatformCryptographyScheme(), db, os, si)
+	require.NoError(t, cm.Init(ctx))
+
+	jetID := *jet.NewID(0, nil)
+	prototype := testutils.RandomRef()
+	parent := testutils.RandomRef()
+	caller := testutils.RandomRef()
+	target := testutils.RandomRef()
+	request := testutils.RandomRef()
+
+	objects := map[core.RecordID]core.RecordRef{}
+	children := map[core.RecordID]core.RecordRef{}
+	for i := 0; i < 3; i++ {
+		head := testutils.RandomRef()
+		id, err := os.SetRecord(ctx, jetID, core.FirstPulseNumber, &record.ObjectActivateRecord{
+			SideEffectRecord:  record.SideEffectRecord{Request: head},
+			ObjectStateRecord: record.ObjectStateRecord{Image: prototype},
+			Parent:            parent,
+		})
+		require.NoError(t, err)
+		objects[*id] = head
+		children[*id] = head
+	}
+	// Prototype activation is indexed by parent only.
+	protoHead := testutils.RandomRef()
+	code := testutils.RandomRef()
+	id, err := os.SetRecord(ctx, jetID, core.FirstPulseNumber, &record.ObjectActivateRecord{
+		SideEffectRecord:  record.SideEffectRecord{Request: protoHead},
+		ObjectStateRecord: record.ObjectStateRecord{Image: code, IsPrototype: true},
+		Parent:            parent,
+	})
+	require.NoError(t, err)
+	children[*id] = protoHead
+
+	requests := map[core.RecordID]core.RecordRef{}
+	for i := 0; i < 2; i++ {
+		id, err := os.SetRecord(ctx, jetID, core.FirstPulseNumber, requestRecord(caller, target))
+		require.NoError(t, err)
+		requests[*id] = target
+	}
+	// Request without caller is not indexed.
+	_, err = os.SetRecord(ctx, jetID, core.FirstPulseNumber, requestRecord(core.RecordRef{}, target))
+	require.NoError(t, err)
+	resultID, err := os.SetRecord(ctx, jetID, core.FirstPulseNumber, &record.ResultRecord{
+		Object:  *target.Record(),
+		Request: request,
+	})
+	require.NoError(t, err)
+
+	var kvs []core.KV
+	replicator := storage.NewReplicaIter(ctx, db, jetID, core.FirstPulseNumber, core.FirstPulseNumber+1, 100500)
+	for {
+		recs, err := replicator.NextRecords()
+		if err == storage.ErrReplicatorDone {
+			break
+		}
+		require.NoError(t, err)
+		kvs = append(kvs, recs...)
+	}
+	require.NoError(t, si.IndexKeyValues(ctx, kvs))
+	// Indexing is idempotent.
+	require.NoError(t, si.IndexKeyValues(ctx, kvs))
+
+	find := func(index core.SecondaryIndex, key core.RecordRef) map[core.RecordID]core.RecordRef {
+		found, err := si.Find(ctx, index, key, nil, 100)
+		require.NoError(t, err)
+		assert.Nil(t, found.Next)
+		got := map[core.RecordID]core.RecordRef{}
+		for _, rec := range found.Records {
+			got[rec.ID] = rec.Object
+		}
+		return got
+	}
+
+	t.Run("objects by prototype", func(t *testing.T) {
+		assert.Equal(t, objects, find(core.SecondaryIndexObjectsByPrototype, prototype))
+	})
+	t.Run("prototype is not indexed by code", func(t *testing.T) {
+		assert.Empty(t, find(core.SecondaryIndexObjectsByPrototype, code))
+	})
+	t.Run("objects by parent", func(t *testing.T) {
+		assert.Equal(t, children, find(core.SecondaryIndexObjectsByParent, parent))
+	})
+	t.Run("requests by caller", func(t *testing.T) {
+		assert.Equal(t, requests, find(core.SecondaryIndexRequestsByCaller, caller))
+	})
+	t.Run("results by request", func(t *testing.T) {
+		got := find(core.SecondaryIndexResultsByRequest, request)
+		require.Len(t, got, 1)
+		object := got[*resultID]
+		assert.Equal(t, *target.Record(), *object.Record())
+	})
+	t.Run("unknown key", func(t *testing.T) {
+		assert.Empty(t, find(core.SecondaryIndexObjectsByPrototype, testutils.RandomRef()))
+	})
+	t.Run("pages", func(t *testing.T) {
+		first, err := si.Find(ctx, core.SecondaryIndexObjectsByPrototype, prototype, nil, 2)
+		require.NoError(t, err)
+		require.Len(t, first.Records, 2)
+		require.NotNil(t, first.Next)
+		assert.Equal(t, first.Records[1].ID, *first.Next)
+		assert.Equal(t, -1, bytes.Compare(first.Records[0].ID[:], first.Records[1].ID[:]))
+
+		second, err := si.Find(ctx, core.SecondaryIndexObjectsByPrototype, prototype, first.Next, 2)
+		require.NoError(t, err)
+		require.Len(t, second.Records, 1)
+		assert.Nil(t, second.Next)
+		assert.Equal(t, -1, bytes.Compare(first.Records[1].ID[:], second.Records[0].ID[:]))
+	})
+	t.Run("invalid limit", func(t *testing.T) {
+		_, err := si.Find(ctx, core.SecondaryIndexObjectsByPrototype, prototype, nil, 0)
+		assert.Error(t, err)
+	})
+}
+
+func TestSecondaryIndex_Reindex(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	dir, err := ioutil.TempDir("", "reindex-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	conf := configuration.NewLedger()
+	conf.Storage.Backend = storage.BackendMemory
+	conf.Archive.Directory = dir
+	db, err := storage.NewDB(conf, nil)
+	require.NoError(t, err)
+	objectStorage := storage.NewObjectStorage()
+	archiver := storage.NewArchiver(conf.Archive)
+	si := storage.NewSecondaryIndex()
+	cm := &component.Manager{}
+	cm.Inject(platformpolicy.NewPlatformCryptographyScheme(), db, objectStorage, archiver, si)
+	require.NoError(t, cm.Init(ctx))
+
+	jetID := *jet.NewID(0, nil)
+	prototype := testutils.RandomRef()
+	code := testutils.RandomRef()
+	activate := func(pn core.PulseNumber, image core.RecordRef, isPrototype bool) (core.RecordID, core.RecordRef) {
+		head := testutils.RandomRef()
+		id, err := objectStorage.SetRecord(ctx, jetID, pn, &record.ObjectActivateRecord{
+			SideEffectRecord:  record.SideEffectRecord{Request: head},
+			ObjectStateRecord: record.ObjectStateRecord{Image: image, IsPrototype: isPrototype},
+		})
+		require.NoError(t, err)
+		return *id, head
+	}
+
+	// Records stored before indexing, the first one is archived.
+	objects := map[core.RecordID]core.RecordRef{}
+	id, head := activate(core.FirstPulseNumber, prototype, false)
+	objects[id] = head
+	_, err = archiver.ArchiveUntil(ctx, core.FirstPulseNumber+1)
+	require.NoError(t, err)
+	id, head = activate(core.FirstPulseNumber+1, prototype, false)
+	objects[id] = head
+	protoID, protoHead := activate(core.FirstPulseNumber+1, code, true)
+
+	// Older index version indexed prototype activation by its code.
+	outdatedKey := append([]byte{10, byte(core.SecondaryIndexObjectsByPrototype)}, code[:]...)
+	outdatedKey = append(outdatedKey, protoID[:]...)
+	require.NoError(t, db.StoreKeyValues(ctx, []core.KV{{K: outdatedKey, V: protoHead[:]}}))
+
+	find := func(key core.RecordRef) map[core.RecordID]core.RecordRef {
+		found, err := si.Find(ctx, core.SecondaryIndexObjectsByPrototype, key, nil, 100)
+		require.NoError(t, err)
+		got := map[core.RecordID]core.RecordRef{}
+		for _, rec := range found.Records {
+			got[rec.ID] = rec.Object
+		}
+		return got
+	}
+
+	require.NoError(t, si.Reindex(ctx))
+	assert.Equal(t, objects, find(prototype))
+	assert.Empty(t, find(code))
+
+	// Indexes are rebuilt only once.
+	activate(core.FirstPulseNumber+1, prototype, false)
+	require.NoError(t, si.Reindex(ctx))
+	assert.Equal(t, objects, find(prototype))
+}
@@ -0,0 +1,210 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package storage
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/insolar/insolar/core"
+	"github.com/pkg/errors"
+)
+
+// segmentMagic starts every segment file.
+var segmentMagic = []byte("INSSEG\x01")
+
+// segmentMaxEntries limits number of values in a single segment. Keys of segment values are kept in memory until
+// segment is written.
+const segmentMaxEntries = 1 << 16
+
+// segmentRef points to a compressed value in archive segment.
+type segmentRef struct {
+	// Segment is a hash of segment file content.
+	Segment []byte
+	Offset  uint64
+	Size    uint32
+}
+
+func (r *segmentRef) bytes() []byte {
+	buf := make([]byte, 1+len(r.Segment)+8+4)
+	buf[0] = byte(len(r.Segment))
+	n := 1 + copy(buf[1:], r.Segment)
+	binary.BigEndian.PutUint64(buf[n:], r.Offset)
+	binary.BigEndian.PutUint32(buf[n+8:], r.Size)
+	return buf
+}
+
+func decodeSegmentRef(buf []byte) (*segmentRef, error) {
+	if len(buf) == 0 || len(buf) != 1+int(buf[0])+8+4 {
+		return nil, errors.New("invalid segment reference")
+	}
+	n := 1 + int(buf[0])
+	return &segmentRef{
+		Segment: append([]byte(nil), buf[1:n]...),
+		Offset:  binary.BigEndian.Uint64(buf[n:]),
+		Size:    binary.BigEndian.Uint32(buf[n+8:]),
+	}, nil
+}
+
+// segmentStore keeps immutable content-addressed segment files in a directory. Segment file is named after the
+// hash of its content.
+type segmentStore struct {
+	dir string
+}
+
+func newSegmentStore(dir string) *segmentStore {
+	return &segmentStore{dir: dir}
+}
+
+func (s *segmentStore) path(segment []byte) string {
+	return filepath.Join(s.dir, hex.EncodeToString(segment)+".seg")
+}
+
+// read returns decompressed value the reference points to.
+func (s *segmentStore) read(ref *segmentRef) ([]byte, error) {
+	f, err := os.Open(s.path(ref.Segment))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open archive segment")
+	}
+	defer f.Close()
+
+	r := flate.NewReader(io.NewSectionReader(f, int64(ref.Offset), int64(ref.Size)))
+	defer r.Close()
+	value, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read archive segment %x", ref.Segment)
+	}
+	return value, nil
+}
+
+// segmentWriter writes new segment into temporary file. Entry of segment file contains key length, key, compressed
+// value length and compressed value, so segment can be read without storage index.
+type segmentWriter struct {
+	store  *segmentStore
+	file   *os.File
+	hasher hash.Hash
+	out    io.Writer
+	level  int
+
+	offset uint64
+	keys   [][]byte
+	refs   []*segmentRef
+	size   uint64
+}
+
+func (s *segmentStore) create(scheme core.PlatformCryptographyScheme, level int) (*segmentWriter, error) {
+	err := os.MkdirAll(s.dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+	file, err := ioutil.TempFile(s.dir, "segment-")
+	if err != nil {
+		return nil, err
+	}
+	w := &segmentWriter{
+		store:  s,
+		file:   file,
+		hasher: scheme.IntegrityHasher(),
+		level:  level,
+	}
+	w.out = io.MultiWriter(file, w.hasher)
+	err = w.write(segmentMagic)
+	if err != nil {
+		w.abort()
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *segmentWriter) write(b []byte) error {
+	n, err := w.out.Write(b)
+	w.offset += uint64(n)
+	return err
+}
+
+func (w *segmentWriter) len() int {
+	return len(w.keys)
+}
+
+// add appends compressed value to segment.
+func (w *segmentWriter) add(key, value []byte) error {
+	var buf bytes.Buffer
+	fw, err := flate.NewWriter(&buf, w.level)
+	if err != nil {
+		return err
+	}
+	_, err = fw.Write(value)
+	if err != nil {
+		return err
+	}
+	err = fw.Close()
+	if err != nil {
+		return err
+	}
+
+	header := make([]byte, 2*binary.MaxVarintLen64+len(key))
+	n := binary.PutUvarint(header, uint64(len(key)))
+	n += copy(header[n:], key)
+	n += binary.PutUvarint(header[n:], uint64(buf.Len()))
+	err = w.write(header[:n])
+	if err != nil {
+		return err
+	}
+
+	w.keys = append(w.keys, key)
+	w.refs = append(w.refs, &segmentRef{Offset: w.offset, Size: uint32(buf.Len())})
+	w.size += uint64(len(value))
+	return w.write(buf.Bytes())
+}
+
+// finish syncs segment file and moves it to the name of its hash. References of added values are returned.
+func (w *segmentWriter) finish() ([]*segmentRef, error) {
+	err := w.file.Sync()
+	if err != nil {
+		w.abort()
+		return nil, err
+	}
+	err = w.file.Close()
+	if err != nil {
+		os.Remove(w.file.Name())
+		return nil, err
+	}
+
+	segment := w.hasher.Sum(nil)
+	err = os.Rename(w.file.Name(), w.store.path(segment))
+	if err != nil {
+		os.Remove(w.file.Name())
+		return nil, err
+	}
+	for _, ref := range w.refs {
+		ref.Segment = segment
+	}
+	return w.refs, nil
+}
+
+// abort removes not finished segment.
+func (w *segmentWriter) abort() {
+	w.file.Close()
+	os.Remove(w.file.Name())
+}
@@ -0,0 +1,528 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/gob"
+	"io"
+	"sort"
+
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/storage/index"
+	"github.com/insolar/insolar/ledger/storage/jet"
+	"github.com/insolar/insolar/ledger/storage/record"
+	"github.com/insolar/insolar/network/merkle"
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+)
+
+// SnapshotVersion is a version of the snapshot stream format.
+const SnapshotVersion = 1
+
+// snapshotBatchSize is a number of key/value pairs restored in one transaction.
+const snapshotBatchSize = 1000
+
+// SnapshotManifest describes snapshot content. It is written at the end of the snapshot stream.
+type SnapshotManifest struct {
+	Version uint32
+	// Pulse is the latest pulse included in snapshot.
+	Pulse core.PulseNumber
+	// Entries is a number of key/value pairs in snapshot.
+	Entries uint64
+	// Hash is a hash of all key/value pairs in snapshot order.
+	Hash []byte
+	// Drops contains hashes of all jet drops in snapshot.
+	Drops []SnapshotDrop
+}
+
+// SnapshotDrop is a jet drop entry of snapshot manifest.
+type SnapshotDrop struct {
+	JetPrefix   []byte
+	Pulse       core.PulseNumber
+	PrevHash    []byte
+	RecordsRoot []byte
+	Hash        []byte
+}
+
+type snapshotHeader struct {
+	Version uint32
+	Pulse   core.PulseNumber
+}
+
+// WriteSnapshot writes consistent snapshot of ledger data (records, blobs, indexes, drops, jets list, pulses) to w.
+// Only data up to provided pulse (the latest pulse if zero) is written. Object indexes updated after provided pulse are
+// rebuilt as they were on that pulse.
+//
+// Snapshot is streamed from a single read-only transaction, so it is safe to make it on working node.
+//
+// Values moved to archive segments by Archiver are not included, only their archive index is. Archive directory should
+// be copied along with the snapshot.
+func WriteSnapshot(
+	ctx context.Context,
+	db DBContext,
+	scheme core.PlatformCryptographyScheme,
+	pulse core.PulseNumber,
+	w io.Writer,
+) (*SnapshotManifest, error) {
+	manifest := &SnapshotManifest{Version: SnapshotVersion}
+	bw := bufio.NewWriter(w)
+	enc := gob.NewEncoder(bw)
+	hasher := scheme.IntegrityHasher()
+
+	err := kvView(db.GetKVStore(), func(txn KVTransaction) error {
+		latestKey := prefixkey(scopeIDSystem, []byte{sysLatestPulse})
+		latest, err := txn.Get(latestKey)
+		if err != nil {
+			return errors.Wrap(err, "failed to fetch latest pulse")
+		}
+		latestPulse, err := toPulse(latest)
+		if err != nil {
+			return err
+		}
+		if pulse == 0 || pulse > latestPulse.Pulse.PulseNumber {
+			pulse = latestPulse.Pulse.PulseNumber
+		}
+		// Latest pulse should point to the snapshot pulse.
+		latest, err = txn.Get(prefixkey(scopeIDPulse, pulse.Bytes()))
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch pulse %v", pulse)
+		}
+
+		manifest.Pulse = pulse
+		err = enc.Encode(snapshotHeader{Version: SnapshotVersion, Pulse: pulse})
+		if err != nil {
+			return err
+		}
+
+		rollback, err := newIndexRollback(txn, db, pulse)
+		if err != nil {
+			return err
+		}
+
+		err = txn.Iterate(nil, nil, func(k, v []byte) error {
+			if bytes.Equal(k, latestKey) {
+				v = latest
+			}
+			if Key(k).PulseNumber() > pulse {
+				return nil
+			}
+			switch k[0] {
+			case scopeIDLifeline:
+				idx, err := index.DecodeObjectLifeline(v)
+				if err != nil {
+					return errors.Wrapf(err, "failed to decode index %v", Key(k))
+				}
+				if idx.LatestUpdate > pulse {
+					idx, err = rollback.rebuild(k[1:core.RecordHashSize], idx)
+					if err != nil {
+						return errors.Wrapf(err, "failed to rebuild index %v", Key(k))
+					}
+					// Object had no state on snapshot pulse.
+					if idx == nil {
+						return nil
+					}
+					v, err = index.EncodeObjectLifeline(idx)
+					if err != nil {
+						return err
+					}
+				}
+			case scopeIDJetDrop:
+				drop, err := jet.Decode(v)
+				if err != nil {
+					return errors.Wrapf(err, "failed to decode drop %v", Key(k))
+				}
+				manifest.Drops = append(manifest.Drops, snapshotDrop(k, drop))
+			}
+
+			hashKV(hasher, k, v)
+			manifest.Entries++
+			return enc.Encode(core.KV{K: k, V: v})
+		})
+		if err != nil {
+			return err
+		}
+		// Empty pair terminates data section.
+		return enc.Encode(core.KV{})
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to write snapshot")
+	}
+
+	manifest.Hash = hasher.Sum(nil)
+	err = enc.Encode(manifest)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to write snapshot manifest")
+	}
+	err = bw.Flush()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to write snapshot")
+	}
+
+	inslogger.FromContext(ctx).Infof(
+		"snapshot on pulse %v is written: %v entries, %v drops", pulse, manifest.Entries, len(manifest.Drops),
+	)
+	return manifest, nil
+}
+
+// RestoreSnapshot restores ledger data from snapshot stream into empty db. Drops hash chain and snapshot integrity
+// are verified after data is restored, so db should not be used if error is returned.
+func RestoreSnapshot(
+	ctx context.Context,
+	db DBContext,
+	scheme core.PlatformCryptographyScheme,
+	r io.Reader,
+) (*SnapshotManifest, error) {
+	err := kvView(db.GetKVStore(), func(txn KVTransaction) error {
+		return txn.Iterate(nil, nil, func(k, v []byte) error {
+			return errors.New("db is not empty")
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dec := gob.NewDecoder(bufio.NewReader(r))
+	var header snapshotHeader
+	err = dec.Decode(&header)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read snapshot header")
+	}
+	if header.Version != SnapshotVersion {
+		return nil, errors.Errorf("unsupported snapshot version %v", header.Version)
+	}
+
+	var (
+		entries uint64
+		drops   []SnapshotDrop
+		batch   []core.KV
+	)
+	hasher := scheme.IntegrityHasher()
+	for {
+		var kv core.KV
+		err = dec.Decode(&kv)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read snapshot")
+		}
+		if len(kv.K) == 0 {
+			break
+		}
+		if kv.K[0] == scopeIDJetDrop {
+			drop, err := jet.Decode(kv.V)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to decode drop %v", Key(kv.K))
+			}
+			drops = append(drops, snapshotDrop(kv.K, drop))
+		}
+
+		hashKV(hasher, kv.K, kv.V)
+		entries++
+		batch = append(batch, kv)
+		if len(batch) == snapshotBatchSize {
+			err = db.StoreKeyValues(ctx, batch)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to store snapshot data")
+			}
+			batch = nil
+		}
+	}
+	err = db.StoreKeyValues(ctx, batch)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to store snapshot data")
+	}
+
+	var manifest SnapshotManifest
+	err = dec.Decode(&manifest)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read snapshot manifest")
+	}
+	if manifest.Pulse != header.Pulse || manifest.Entries != entries {
+		return nil, errors.New("snapshot manifest doesn't match snapshot data")
+	}
+	if !bytes.Equal(manifest.Hash, hasher.Sum(nil)) {
+		return nil, errors.New("snapshot hash mismatch")
+	}
+	if len(manifest.Drops) != len(drops) {
+		return nil, errors.New("snapshot manifest drops don't match snapshot data")
+	}
+	for i, drop := range drops {
+		if !bytes.Equal(drop.Hash, manifest.Drops[i].Hash) || !bytes.Equal(drop.JetPrefix, manifest.Drops[i].JetPrefix) {
+			return nil, errors.Errorf("snapshot manifest drop %v doesn't match snapshot data", i)
+		}
+	}
+	err = VerifyDropsChain(scheme, drops)
+	if err != nil {
+		return nil, err
+	}
+
+	inslogger.FromContext(ctx).Infof(
+		"snapshot on pulse %v is restored: %v entries, %v drops", manifest.Pulse, manifest.Entries, len(drops),
+	)
+	return &manifest, nil
+}
+
+// VerifyDropsChain checks that hash of every drop is calculated from its records root and previous hash, and that
+// previous hash refers to a drop of earlier pulse (or to both previous left drop and last right drop for merged jet).
+// Drops without previous hash are roots of the chain.
+func VerifyDropsChain(scheme core.PlatformCryptographyScheme, drops []SnapshotDrop) error {
+	var chainErr error
+	checkDropsChain(scheme, drops, func(drop SnapshotDrop, kind string, err error) bool {
+		chainErr = err
+		return false
+	})
+	return chainErr
+}
+
+// checkDropsChain passes every broken drop to report. Check stops when report returns false.
+func checkDropsChain(
+	scheme core.PlatformCryptographyScheme,
+	drops []SnapshotDrop,
+	report func(drop SnapshotDrop, kind string, err error) bool,
+) {
+	byPulse := map[core.PulseNumber][]SnapshotDrop{}
+	for _, drop := range drops {
+		byPulse[drop.Pulse] = append(byPulse[drop.Pulse], drop)
+	}
+	pulses := make([]core.PulseNumber, 0, len(byPulse))
+	for pn := range byPulse {
+		pulses = append(pulses, pn)
+	}
+	sort.Slice(pulses, func(i, j int) bool { return pulses[i] < pulses[j] })
+
+	known := map[string]struct{}{}
+	for _, pn := range pulses {
+		for _, drop := range byPulse[pn] {
+			// Empty drop is created when previous drop is not found.
+			if len(drop.Hash) == 0 && len(drop.PrevHash) == 0 {
+				continue
+			}
+			if !bytes.Equal(drop.Hash, merkle.DropHash(scheme, drop.PrevHash, drop.RecordsRoot)) {
+				err := errors.Errorf("hash mismatch for drop %x on pulse %v", drop.JetPrefix, pn)
+				if !report(drop, IssueDropHash, err) {
+					return
+				}
+				continue
+			}
+			if len(drop.PrevHash) == 0 {
+				continue
+			}
+			if _, ok := known[string(drop.PrevHash)]; !ok && !isMergedDrop(scheme, drop, known, byPulse[pn]) {
+				err := errors.Errorf("previous drop for drop %x on pulse %v is not found", drop.JetPrefix, pn)
+				if !report(drop, IssueDropChain, err) {
+					return
+				}
+			}
+		}
+		for _, drop := range byPulse[pn] {
+			known[string(drop.Hash)] = struct{}{}
+		}
+	}
+}
+
+// isMergedDrop checks if previous hash of the drop links a drop of earlier pulse (possibly missing) and a drop of the
+// same pulse. Such drops are created when jets are merged.
+func isMergedDrop(
+	scheme core.PlatformCryptographyScheme, drop SnapshotDrop, known map[string]struct{}, pulseDrops []SnapshotDrop,
+) bool {
+	for _, right := range pulseDrops {
+		if bytes.Equal(drop.PrevHash, merkle.DropHash(scheme, nil, right.Hash)) {
+			return true
+		}
+		for left := range known {
+			if bytes.Equal(drop.PrevHash, merkle.DropHash(scheme, []byte(left), right.Hash)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// indexRollback rebuilds object indexes as they were on provided pulse. Links to records created after the pulse are
+// replaced by following previous record links.
+type indexRollback struct {
+	txn      KVTransaction
+	segments *segmentStore
+	pulse    core.PulseNumber
+	// prefixes contains prefixes of all known jets. Records can be stored in other jet than index if jet was split.
+	prefixes [][]byte
+}
+
+func newIndexRollback(txn KVTransaction, db DBContext, pulse core.PulseNumber) (*indexRollback, error) {
+	r := &indexRollback{txn: txn, pulse: pulse}
+	if d, ok := db.(*DB); ok {
+		r.segments = d.segments
+	}
+
+	buf, err := txn.Get(prefixkey(scopeIDSystem, []byte{sysJetList}))
+	if err == core.ErrNotFound {
+		return r, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch jets")
+	}
+	var jets jet.IDSet
+	err = codec.NewDecoderBytes(buf, &codec.CborHandle{}).Decode(&jets)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode jets")
+	}
+	for id := range jets {
+		_, prefix := jet.Jet(id)
+		r.prefixes = append(r.prefixes, prefix)
+	}
+	return r, nil
+}
+
+// rebuild returns index as it was on rollback pulse. Nil is returned if object had no state on that pulse.
+func (r *indexRollback) rebuild(prefix []byte, idx *index.ObjectLifeline) (*index.ObjectLifeline, error) {
+	res := *idx
+	var err error
+
+	res.LatestState, err = r.before(prefix, idx.LatestState, func(rec record.Record) *core.RecordID {
+		if state, ok := rec.(record.ObjectState); ok {
+			return state.PrevStateID()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.LatestState == nil {
+		return nil, nil
+	}
+	if res.LatestState != idx.LatestState {
+		rec, err := r.record(prefix, *res.LatestState)
+		if err != nil {
+			return nil, err
+		}
+		state, ok := rec.(record.ObjectState)
+		if !ok {
+			return nil, errors.Errorf("record %v is not a state", res.LatestState.DebugString())
+		}
+		res.State = state.State()
+	}
+	// Approval history is not stored, so approval made after the pulse is dropped.
+	if res.LatestStateApproved != nil && res.LatestStateApproved.Pulse() > r.pulse {
+		res.LatestStateApproved = nil
+	}
+
+	res.ChildPointer, err = r.before(prefix, idx.ChildPointer, func(rec record.Record) *core.RecordID {
+		if child, ok := rec.(*record.ChildRecord); ok {
+			return child.PrevChild
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if idx.Delegates != nil {
+		res.Delegates = map[core.RecordRef]core.RecordRef{}
+	}
+	for asType, child := range idx.Delegates {
+		if child.Record().Pulse() <= r.pulse {
+			res.Delegates[asType] = child
+		}
+	}
+
+	res.LatestEvent, err = r.before(prefix, idx.LatestEvent, func(rec record.Record) *core.RecordID {
+		if event, ok := rec.(*record.EventRecord); ok {
+			return event.PrevEvent
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if idx.TopicEvents != nil {
+		res.TopicEvents = map[string]*core.RecordID{}
+	}
+	for topic, id := range idx.TopicEvents {
+		event, err := r.before(prefix, id, func(rec record.Record) *core.RecordID {
+			if event, ok := rec.(*record.EventRecord); ok {
+				return event.PrevTopicEvent
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if event != nil {
+			res.TopicEvents[topic] = event
+		}
+	}
+
+	res.LatestUpdate = 0
+	for _, id := range []*core.RecordID{res.LatestState, res.LatestStateApproved, res.ChildPointer, res.LatestEvent} {
+		if id != nil && id.Pulse() > res.LatestUpdate {
+			res.LatestUpdate = id.Pulse()
+		}
+	}
+	return &res, nil
+}
+
+// before follows previous record links starting from id until record of rollback pulse or earlier is found.
+func (r *indexRollback) before(
+	prefix []byte, id *core.RecordID, prev func(rec record.Record) *core.RecordID,
+) (*core.RecordID, error) {
+	for id != nil && id.Pulse() > r.pulse {
+		rec, err := r.record(prefix, *id)
+		if err != nil {
+			return nil, err
+		}
+		id = prev(rec)
+	}
+	return id, nil
+}
+
+func (r *indexRollback) record(prefix []byte, id core.RecordID) (record.Record, error) {
+	for _, p := range append([][]byte{prefix}, r.prefixes...) {
+		key := prefixkey(scopeIDRecord, p, id[:])
+		buf, err := r.txn.Get(key)
+		if err == core.ErrNotFound && r.segments != nil {
+			buf, err = getArchived(r.txn, r.segments, key)
+		}
+		if err == core.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return record.DeserializeRecord(buf), nil
+	}
+	return nil, errors.Errorf("record %v is not found", id.DebugString())
+}
+
+func snapshotDrop(key []byte, drop *jet.JetDrop) SnapshotDrop {
+	return SnapshotDrop{
+		JetPrefix:   append([]byte(nil), key[1:core.RecordHashSize]...),
+		Pulse:       drop.Pulse,
+		PrevHash:    drop.PrevHash,
+		RecordsRoot: drop.RecordsRoot,
+		Hash:        drop.Hash,
+	}
+}
+
+func hashKV(hasher io.Writer, k, v []byte) {
+	for _, b := range [][]byte{k, v} {
+		// Hasher never returns errors on write.
+		_ = binary.Write(hasher, binary.BigEndian, uint32(len(b)))
+		_, _ = hasher.Write(b)
+	}
+}
@@ -0,0 +1,284 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package storage_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/insolar/insolar/component"
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/index"
+	"github.com/insolar/insolar/ledger/storage/jet"
+	"github.com/insolar/insolar/ledger/storage/record"
+	"github.com/insolar/insolar/ledger/storage/storagetest"
+	"github.com/insolar/insolar/network/merkle"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type snapshotLedger struct {
+	db            storage.DBContext
+	objectStorage storage.ObjectStorage
+	dropStorage   storage.DropStorage
+	pulseTracker  storage.PulseTracker
+}
+
+func newSnapshotLedger(ctx context.Context, t *testing.T, options ...storagetest.Option) *snapshotLedger {
+	options = append(options, storagetest.Backend(storage.BackendMemory))
+	db, _ := storagetest.TmpDB(ctx, t, options...)
+	l := &snapshotLedger{
+		db:            db,
+		objectStorage: storage.NewObjectStorage(),
+		dropStorage:   storage.NewDropStorage(10),
+		pulseTracker:  storage.NewPulseTracker(),
+	}
+	cm := &component.Manager{}
+	cm.Inject(
+		platformpolicy.NewPlatformCryptographyScheme(),
+		l.db,
+		l.objectStorage,
+		l.dropStorage,
+		l.pulseTracker,
+	)
+	require.NoError(t, cm.Init(ctx))
+	return l
+}
+
+// fill adds pulses with records, drops and object index to the ledger. Drop of the last pulse refers to lastPrevHash
+// if it is provided.
+func (l *snapshotLedger) fill(
+	ctx context.Context, t *testing.T, jetID core.RecordID, pulses []core.PulseNumber, lastPrevHash []byte,
+) {
+	var prevHash []byte
+	for i, pn := range pulses {
+		require.NoError(t, l.pulseTracker.AddPulse(ctx, core.Pulse{PulseNumber: pn}))
+		obj, err := l.objectStorage.SetRecord(ctx, jetID, pn, &record.ResultRecord{Payload: []byte{byte(i)}})
+		require.NoError(t, err)
+		_, err = l.objectStorage.SetBlob(ctx, jetID, pn, []byte{byte(i)})
+		require.NoError(t, err)
+		err = l.objectStorage.SetObjectIndex(ctx, jetID, obj, &index.ObjectLifeline{LatestState: obj, LatestUpdate: pn})
+		require.NoError(t, err)
+
+		if i == len(pulses)-1 && lastPrevHash != nil {
+			prevHash = lastPrevHash
+		}
+		drop, _, _, err := l.dropStorage.CreateDrop(ctx, jetID, pn, prevHash)
+		require.NoError(t, err)
+		require.NoError(t, l.dropStorage.SetDrop(ctx, jetID, drop))
+		prevHash = drop.Hash
+	}
+}
+
+func allKeyValues(t *testing.T, db storage.DBContext) map[string][]byte {
+	kvs := map[string][]byte{}
+	txn := db.GetKVStore().NewTransaction(false)
+	defer txn.Discard()
+	err := txn.Iterate(nil, nil, func(k, v []byte) error {
+		kvs[string(k)] = v
+		return nil
+	})
+	require.NoError(t, err)
+	return kvs
+}
+
+func TestSnapshot_WriteRestore(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	jetID := *jet.NewID(0, nil)
+	pulses := []core.PulseNumber{core.FirstPulseNumber + 1, core.FirstPulseNumber + 2}
+
+	source := newSnapshotLedger(ctx, t)
+	source.fill(ctx, t, jetID, pulses, nil)
+
+	var buf bytes.Buffer
+	manifest, err := storage.WriteSnapshot(ctx, source.db, scheme, 0, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, pulses[1], manifest.Pulse)
+	// Genesis drop is also included.
+	assert.Equal(t, 3, len(manifest.Drops))
+
+	target := newSnapshotLedger(ctx, t, storagetest.DisableBootstrap())
+	restored, err := storage.RestoreSnapshot(ctx, target.db, scheme, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, manifest, restored)
+	assert.Equal(t, allKeyValues(t, source.db), allKeyValues(t, target.db))
+
+	_, err = storage.RestoreSnapshot(ctx, target.db, scheme, bytes.NewReader(buf.Bytes()))
+	assert.Error(t, err, "restore into not empty db")
+}
+
+func TestSnapshot_OnPulse(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	jetID := *jet.NewID(0, nil)
+	pulses := []core.PulseNumber{core.FirstPulseNumber + 1, core.FirstPulseNumber + 2}
+
+	source := newSnapshotLedger(ctx, t)
+	source.fill(ctx, t, jetID, pulses, nil)
+
+	var buf bytes.Buffer
+	manifest, err := storage.WriteSnapshot(ctx, source.db, scheme, pulses[0], &buf)
+	require.NoError(t, err)
+	assert.Equal(t, pulses[0], manifest.Pulse)
+	for _, drop := range manifest.Drops {
+		assert.True(t, drop.Pulse <= pulses[0])
+	}
+
+	target := newSnapshotLedger(ctx, t, storagetest.DisableBootstrap())
+	_, err = storage.RestoreSnapshot(ctx, target.db, scheme, &buf)
+	require.NoError(t, err)
+	latest, err := target.pulseTracker.GetLatestPulse(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, pulses[0], latest.Pulse.PulseNumber)
+	_, err = target.dropStorage.GetDrop(ctx, jetID, pulses[1])
+	assert.Equal(t, core.ErrNotFound, err)
+}
+
+func TestSnapshot_IndexUpdatedAfterPulse(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	jetID := *jet.NewID(0, nil)
+	pulses := []core.PulseNumber{core.FirstPulseNumber + 1, core.FirstPulseNumber + 2}
+
+	source := newSnapshotLedger(ctx, t)
+	source.fill(ctx, t, jetID, pulses, nil)
+	setRecord := func(pn core.PulseNumber, rec record.Record) *core.RecordID {
+		id, err := source.objectStorage.SetRecord(ctx, jetID, pn, rec)
+		require.NoError(t, err)
+		return id
+	}
+	// Object is activated before snapshot pulse and updated after it.
+	obj := core.NewRecordID(pulses[0], []byte{1})
+	activate := setRecord(pulses[0], &record.ObjectActivateRecord{IsDelegate: true})
+	amend := setRecord(pulses[1], &record.ObjectAmendRecord{PrevState: *activate})
+	firstEvent := setRecord(pulses[0], &record.EventRecord{Topic: "first"})
+	child := setRecord(pulses[1], &record.ChildRecord{})
+	event := setRecord(pulses[1], &record.EventRecord{Topic: "second", PrevEvent: firstEvent})
+	delegate := testutils.RandomRef()
+	err := source.objectStorage.SetObjectIndex(ctx, jetID, obj, &index.ObjectLifeline{
+		LatestState:         amend,
+		LatestStateApproved: amend,
+		ChildPointer:        child,
+		Delegates:           map[core.RecordRef]core.RecordRef{delegate: *core.NewRecordRef(*obj, *child)},
+		State:               record.StateAmend,
+		LatestUpdate:        pulses[1],
+		LatestEvent:         event,
+		TopicEvents:         map[string]*core.RecordID{"first": firstEvent, "second": event},
+	})
+	require.NoError(t, err)
+	// Object is activated after snapshot pulse.
+	newObj := core.NewRecordID(pulses[0], []byte{2})
+	newActivate := setRecord(pulses[1], &record.ObjectActivateRecord{})
+	err = source.objectStorage.SetObjectIndex(ctx, jetID, newObj, &index.ObjectLifeline{
+		LatestState:  newActivate,
+		State:        record.StateActivation,
+		LatestUpdate: pulses[1],
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	_, err = storage.WriteSnapshot(ctx, source.db, scheme, pulses[0], &buf)
+	require.NoError(t, err)
+
+	target := newSnapshotLedger(ctx, t, storagetest.DisableBootstrap())
+	_, err = storage.RestoreSnapshot(ctx, target.db, scheme, &buf)
+	require.NoError(t, err)
+
+	idx, err := target.objectStorage.GetObjectIndex(ctx, jetID, obj, false)
+	require.NoError(t, err)
+	assert.Equal(t, &index.ObjectLifeline{
+		LatestState:  activate,
+		Delegates:    map[core.RecordRef]core.RecordRef{},
+		State:        record.StateActivation,
+		LatestUpdate: pulses[0],
+		LatestEvent:  firstEvent,
+		TopicEvents:  map[string]*core.RecordID{"first": firstEvent},
+	}, idx)
+
+	_, err = target.objectStorage.GetObjectIndex(ctx, jetID, newObj, false)
+	assert.Equal(t, core.ErrNotFound, err)
+}
+
+func TestSnapshot_BrokenDropsChain(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	jetID := *jet.NewID(0, nil)
+	pulses := []core.PulseNumber{core.FirstPulseNumber + 1, core.FirstPulseNumber + 2}
+
+	source := newSnapshotLedger(ctx, t)
+	source.fill(ctx, t, jetID, pulses, []byte{1, 2, 3})
+
+	var buf bytes.Buffer
+	_, err := storage.WriteSnapshot(ctx, source.db, scheme, 0, &buf)
+	require.NoError(t, err)
+
+	target := newSnapshotLedger(ctx, t, storagetest.DisableBootstrap())
+	_, err = storage.RestoreSnapshot(ctx, target.db, scheme, &buf)
+	assert.Error(t, err)
+}
+
+func TestSnapshot_Corrupted(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	jetID := *jet.NewID(0, nil)
+
+	source := newSnapshotLedger(ctx, t)
+	source.fill(ctx, t, jetID, []core.PulseNumber{core.FirstPulseNumber + 1}, nil)
+
+	var buf bytes.Buffer
+	_, err := storage.WriteSnapshot(ctx, source.db, scheme, 0, &buf)
+	require.NoError(t, err)
+	data := buf.Bytes()
+	data[len(data)/2] ^= 0xFF
+
+	target := newSnapshotLedger(ctx, t, storagetest.DisableBootstrap())
+	_, err = storage.RestoreSnapshot(ctx, target.db, scheme, bytes.NewReader(data))
+	assert.Error(t, err)
+}
+
+func TestVerifyDropsChain(t *testing.T) {
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	drop := func(pn core.PulseNumber, root, prevHash []byte) storage.SnapshotDrop {
+		return storage.SnapshotDrop{
+			Pulse:       pn,
+			PrevHash:    prevHash,
+			RecordsRoot: root,
+			Hash:        merkle.DropHash(scheme, prevHash, root),
+		}
+	}
+
+	root := storage.SnapshotDrop{}
+	left := drop(1, []byte{1}, nil)
+	right := drop(2, []byte{2}, nil)
+	// Drop of the left jet becomes the merged drop. It links previous left drop and the last right drop.
+	merged := drop(2, []byte{4}, merkle.DropHash(scheme, left.Hash, right.Hash))
+	next := drop(3, []byte{3}, merged.Hash)
+	assert.NoError(t, storage.VerifyDropsChain(scheme, []storage.SnapshotDrop{next, merged, left, right, root}))
+
+	broken := next
+	broken.Hash = []byte{1}
+	assert.Error(t, storage.VerifyDropsChain(scheme, []storage.SnapshotDrop{broken, merged, left, right}))
+
+	assert.Error(t, storage.VerifyDropsChain(scheme, []storage.SnapshotDrop{next, left, right}))
+	assert.Error(t, storage.VerifyDropsChain(scheme, []storage.SnapshotDrop{next, merged, right}))
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/insolar/insolar/ledger/storage/jet"
 	"github.com/insolar/insolar/ledger/storage/record"
 	"github.com/insolar/insolar/ledger/storage/storagetest"
+	"github.com/insolar/insolar/network/merkle"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
 	"github.com/stretchr/testify/assert"
@@ -203,7 +204,7 @@ func (s *storageSuite) TestDB_CreateDrop() {
 	// TODO: messages collection was disabled in ab46d01, validation is not active ATM
 	require.Equal(s.T(), 0, len(messages))
 	require.Equal(s.T(), pulse, drop.Pulse)
-	require.Equal(s.T(), "2aCdao6DhZSWQNTrtrxJW7QQZRb6UJ1ssRi9cg", base58.Encode(drop.Hash))
+	require.Equal(s.T(), "55CQU7ropfEDeYzvFj5hYnsBBZZgtTbFdc3Lyg9LFrWXvjMfmhUkhxJm5kPognwJSgVvA476gXZMBSr459bjkxmo", base58.Encode(drop.Hash))
 
 	for _, rawMessage := range messages {
 		formatedMessage, err := message.Deserialize(bytes.NewBuffer(rawMessage))
@@ -212,6 +213,36 @@ func (s *storageSuite) TestDB_CreateDrop() {
 	}
 }
 
+func (s *storageSuite) TestDB_GetRecordProof() {
+	jetID := *jet.NewID(0, nil)
+	pulse := core.PulseNumber(core.FirstPulseNumber + 10)
+	cs := platformpolicy.NewPlatformCryptographyScheme()
+
+	var ids []core.RecordID
+	for i := 0; i < 5; i++ {
+		id, err := s.objectStorage.SetRecord(s.ctx, jetID, pulse, &record.ResultRecord{Payload: []byte{byte(i)}})
+		require.NoError(s.T(), err)
+		ids = append(ids, *id)
+	}
+
+	drop, _, _, err := s.dropStorage.CreateDrop(s.ctx, jetID, pulse, []byte{4, 5, 6})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), merkle.DropHash(cs, drop.PrevHash, drop.RecordsRoot), drop.Hash)
+	err = s.dropStorage.SetDrop(s.ctx, jetID, drop)
+	require.NoError(s.T(), err)
+
+	for _, id := range ids {
+		proof, err := s.dropStorage.GetRecordProof(s.ctx, jetID, pulse, id)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), uint64(len(ids)), proof.Count)
+		assert.Equal(s.T(), drop.Hash, proof.DropHash)
+		assert.True(s.T(), merkle.VerifyRecordProof(cs, proof, drop.Hash))
+	}
+
+	_, err = s.dropStorage.GetRecordProof(s.ctx, jetID, pulse, testutils.RandomID())
+	assert.Equal(s.T(), core.ErrNotFound, err)
+}
+
 func (s *storageSuite) TestDB_SetDrop() {
 	drop42 := jet.JetDrop{
 		Pulse: 42,
@@ -32,6 +32,8 @@ import (
 
 type tmpDBOptions struct {
 	dir         string
+	backend     string
+	compression string
 	nobootstrap bool
 }
 
@@ -45,6 +47,20 @@ func Dir(dir string) Option {
 	}
 }
 
+// Backend defines key-value storage backend for database. Temporary directory is not created for "memory" backend.
+func Backend(backend string) Option {
+	return func(opts *tmpDBOptions) {
+		opts.backend = backend
+	}
+}
+
+// Compression defines compression of blobs contents in database.
+func Compression(compression string) Option {
+	return func(opts *tmpDBOptions) {
+		opts.compression = compression
+	}
+}
+
 // DisableBootstrap skip bootstrap records creation.
 func DisableBootstrap() Option {
 	return func(opts *tmpDBOptions) {
@@ -52,21 +68,27 @@ func DisableBootstrap() Option {
 	}
 }
 
-// TmpDB returns BadgerDB's storage implementation and cleanup function.
+// TmpDB returns storage implementation and cleanup function.
 //
-// Creates BadgerDB in temporary directory and uses t for errors reporting.
+// Creates database (BadgerDB by default) in temporary directory and uses t for errors reporting.
 func TmpDB(ctx context.Context, t testing.TB, options ...Option) (storage.DBContext, func()) {
 	opts := &tmpDBOptions{}
 	for _, o := range options {
 		o(opts)
 	}
-	tmpdir, err := ioutil.TempDir(opts.dir, "bdb-test-")
-	assert.NoError(t, err)
+	var tmpdir string
+	if opts.backend != storage.BackendMemory {
+		var err error
+		tmpdir, err = ioutil.TempDir(opts.dir, "bdb-test-")
+		assert.NoError(t, err)
+	}
 
 	db, err := storage.NewDB(configuration.Ledger{
 		JetSizesHistoryDepth: 10,
 		Storage: configuration.Storage{
 			DataDirectory: tmpdir,
+			Backend:       opts.backend,
+			Compression:   opts.compression,
 		},
 	}, nil)
 	require.NoError(t, err)
@@ -97,6 +119,9 @@ func TmpDB(ctx context.Context, t testing.TB, options ...Option) (storage.DBCont
 	}
 
 	return db, func() {
+		if tmpdir == "" {
+			return
+		}
 		rmErr := os.RemoveAll(tmpdir)
 		if rmErr != nil {
 			t.Fatal("temporary db dir cleanup failed", rmErr)
@@ -0,0 +1,59 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package storagetest
+
+import (
+	"testing"
+
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/record"
+	"github.com/insolar/insolar/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTmpDB_Backends(t *testing.T) {
+	for _, backend := range []string{storage.BackendBadger, storage.BackendBolt, storage.BackendMemory} {
+		backend := backend
+		t.Run(backend, func(t *testing.T) {
+			ctx := inslogger.TestContext(t)
+			db, cleaner := TmpDB(ctx, t, Backend(backend))
+			defer cleaner()
+			defer db.Close()
+
+			jetID := testutils.RandomJet()
+			rec := &record.ResultRecord{Payload: []byte{1, 2, 3}}
+			var id *core.RecordID
+			err := db.Update(ctx, func(tx *storage.TransactionManager) error {
+				var err error
+				id, err = tx.SetRecord(ctx, jetID, core.FirstPulseNumber, rec)
+				return err
+			})
+			require.NoError(t, err)
+
+			err = db.View(ctx, func(tx *storage.TransactionManager) error {
+				got, err := tx.GetRecord(ctx, jetID, id)
+				require.NoError(t, err)
+				assert.Equal(t, rec, got)
+				return nil
+			})
+			require.NoError(t, err)
+		})
+	}
+}
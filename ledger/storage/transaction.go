@@ -19,16 +19,18 @@ package storage
 import (
 	"context"
 
-	"github.com/dgraph-io/badger"
 	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/ledger/storage/index"
 	"github.com/insolar/insolar/ledger/storage/jet"
 	"github.com/insolar/insolar/ledger/storage/record"
+	"go.opencensus.io/stats"
 )
 
 type keyval struct {
 	k []byte
 	v []byte
+	// blob is data of blob key which is written to content storage on commit, see storeBlob.
+	blob []byte
 }
 
 // TransactionManager is used to ensure persistent writes to disk.
@@ -56,10 +58,20 @@ func (m *TransactionManager) Commit() error {
 		return nil
 	}
 	var err error
-	tx := m.db.db.NewTransaction(m.update)
+	tx := m.db.store.NewTransaction(m.update)
 	defer tx.Discard()
+	var stored, deduplicated int64
 	for _, rec := range m.txupdates {
-		err = tx.Set(rec.k, rec.v)
+		if rec.blob == nil {
+			err = tx.Set(rec.k, rec.v)
+		} else {
+			var written int
+			written, err = storeBlob(tx, m.db.blobCodec, rec.k, rec.blob)
+			if written == 0 {
+				deduplicated++
+			}
+			stored += int64(written)
+		}
 		if err != nil {
 			break
 		}
@@ -67,7 +79,12 @@ func (m *TransactionManager) Commit() error {
 	if err != nil {
 		return err
 	}
-	return tx.Commit(nil)
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	stats.Record(context.Background(), statBlobContentStored.M(stored), statBlobDeduplicated.M(deduplicated))
+	return nil
 }
 
 // Discard terminates transaction without disk writes.
@@ -96,7 +113,16 @@ func (m *TransactionManager) GetRequest(ctx context.Context, jetID core.RecordID
 func (m *TransactionManager) GetBlob(ctx context.Context, jetID core.RecordID, id *core.RecordID) ([]byte, error) {
 	_, jetPrefix := jet.Jet(jetID)
 	k := prefixkey(scopeIDBlob, jetPrefix, id[:])
-	return m.get(ctx, k)
+	if kv, ok := m.txupdates[string(k)]; ok && kv.blob != nil {
+		return kv.blob, nil
+	}
+	value, err := m.get(ctx, k)
+	if err != nil {
+		return nil, err
+	}
+	return resolveBlob(k, value, func(key []byte) ([]byte, error) {
+		return m.get(ctx, key)
+	})
 }
 
 // SetBlob saves binary value for provided pulse.
@@ -117,13 +143,21 @@ func (m *TransactionManager) SetBlob(ctx context.Context, jetID core.RecordID, p
 	// 	return nil, ErrNotFound
 	// }
 
-	err := m.set(ctx, k, blob)
+	err := m.setBlob(ctx, k, blob)
 	if err != nil {
 		return nil, err
 	}
 	return id, nil
 }
 
+// setBlob points blob key to blob data in content storage. Content is checked and written on commit, in the same
+// key-value transaction as blob key.
+func (m *TransactionManager) setBlob(ctx context.Context, key, blob []byte) error {
+	stats.Record(ctx, statBlobStored.M(int64(len(blob))))
+	m.txupdates[string(key)] = keyval{k: key, v: blobRef, blob: blob}
+	return nil
+}
+
 // GetRecord returns record from BadgerDB by *record.Reference.
 //
 // It returns ErrNotFound if the DB does not contain the key.
@@ -145,14 +179,14 @@ func (m *TransactionManager) SetRecord(ctx context.Context, jetID core.RecordID,
 	id := record.NewRecordIDFromRecord(m.db.PlatformCryptographyScheme, pulseNumber, rec)
 	_, prefix := jet.Jet(jetID)
 	k := prefixkey(scopeIDRecord, prefix, id[:])
-	geterr := m.db.db.View(func(tx *badger.Txn) error {
+	geterr := kvView(m.db.store, func(tx KVTransaction) error {
 		_, err := tx.Get(k)
 		return err
 	})
 	if geterr == nil {
 		return id, ErrOverride
 	}
-	if geterr != badger.ErrKeyNotFound {
+	if geterr != core.ErrNotFound {
 		return nil, geterr
 	}
 
@@ -201,6 +235,37 @@ func (m *TransactionManager) SetObjectIndex(
 	return m.set(ctx, k, encoded)
 }
 
+// GetRequestResult returns id of the result record registered for provided request.
+//
+// It returns ErrNotFound if the request has no result yet.
+func (m *TransactionManager) GetRequestResult(
+	ctx context.Context,
+	jetID core.RecordID,
+	request *core.RecordID,
+) (*core.RecordID, error) {
+	_, prefix := jet.Jet(jetID)
+	k := prefixkey(scopeIDResult, prefix, request[:])
+	buf, err := m.get(ctx, k)
+	if err != nil {
+		return nil, err
+	}
+	var result core.RecordID
+	copy(result[:], buf)
+	return &result, nil
+}
+
+// SetRequestResult stores id of the result record registered for provided request.
+func (m *TransactionManager) SetRequestResult(
+	ctx context.Context,
+	jetID core.RecordID,
+	request *core.RecordID,
+	result *core.RecordID,
+) error {
+	_, prefix := jet.Jet(jetID)
+	k := prefixkey(scopeIDResult, prefix, request[:])
+	return m.set(ctx, k, result[:])
+}
+
 // RemoveObjectIndex removes an index of an object
 func (m *TransactionManager) RemoveObjectIndex(
 	ctx context.Context,
@@ -225,29 +290,20 @@ func (m *TransactionManager) get(ctx context.Context, key []byte) ([]byte, error
 		return kv.v, nil
 	}
 
-	txn := m.db.db.NewTransaction(false)
+	txn := m.db.store.NewTransaction(false)
 	defer txn.Discard()
-	item, err := txn.Get(key)
-	if err != nil {
-		if err == badger.ErrKeyNotFound {
-			return nil, core.ErrNotFound
-		}
-		return nil, err
+	value, err := txn.Get(key)
+	if err == core.ErrNotFound && m.db.segments != nil && isArchived(key) {
+		return getArchived(txn, m.db.segments, key)
 	}
-	return item.ValueCopy(nil)
+	return value, err
 }
 
 // removes value by key
 func (m *TransactionManager) remove(ctx context.Context, key []byte) error {
 	debugf(ctx, "get key %v", bytes2hex(key))
 
-	txn := m.db.db.NewTransaction(true)
-	defer txn.Discard()
-
-	err := txn.Delete(key)
-	if err != nil {
-		return err
-	}
-
-	return txn.Commit(nil)
+	return kvUpdate(m.db.store, func(txn KVTransaction) error {
+		return txn.Delete(key)
+	})
 }
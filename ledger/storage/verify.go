@@ -0,0 +1,512 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/ledger/storage/index"
+	"github.com/insolar/insolar/ledger/storage/jet"
+	"github.com/insolar/insolar/ledger/storage/record"
+	"github.com/insolar/insolar/network/merkle"
+	"github.com/pkg/errors"
+)
+
+// Kinds of inconsistencies reported by VerifyLedger.
+const (
+	// IssueDecode is reported for stored values that can't be decoded.
+	IssueDecode = "decode"
+	// IssueRecordHash is reported for records which id doesn't match record content.
+	IssueRecordHash = "record_hash"
+	// IssueDropHash is reported for drops which hash doesn't match previous hash and records root.
+	IssueDropHash = "drop_hash"
+	// IssueDropChain is reported for drops which previous drop is not found.
+	IssueDropChain = "drop_chain"
+	// IssueDropRecords is reported for drops which records root doesn't match stored records.
+	IssueDropRecords = "drop_records"
+	// IssueDropSize is reported for drop sizes with invalid signature or unknown drop.
+	IssueDropSize = "drop_size"
+	// IssueIndexState is reported for object indexes with missing or broken states chain.
+	IssueIndexState = "index_state"
+	// IssueArchive is reported for archived values that can't be read from archive segments.
+	IssueArchive = "archive"
+	// IssueBlob is reported for blobs which content is missing, can't be decoded or doesn't match blob id.
+	IssueBlob = "blob"
+)
+
+// VerifyIssue describes a single inconsistency found in ledger data.
+type VerifyIssue struct {
+	Kind string `json:"kind"`
+	// Key is a hex-encoded storage key of broken value.
+	Key     string           `json:"key"`
+	Pulse   core.PulseNumber `json:"pulse"`
+	Message string           `json:"message"`
+}
+
+// VerifyReport is a result of ledger verification.
+type VerifyReport struct {
+	Records int `json:"records"`
+	Blobs   int `json:"blobs"`
+	// Archived is a number of archived records and blobs.
+	Archived int `json:"archived"`
+	Indexes  int `json:"indexes"`
+	Drops    int `json:"drops"`
+	// UncheckedDrops is a number of drops which records root can't be recalculated. Heavy node stores records of all
+	// jets together, so records can be matched with drops only if they were linked to jets on heavy sync or if drop is
+	// the only drop of its pulse.
+	UncheckedDrops int `json:"unchecked_drops"`
+	DropSizes      int `json:"drop_sizes"`
+	// SignaturesChecked is false if no keys were provided and drop size signatures were not verified.
+	SignaturesChecked bool          `json:"signatures_checked"`
+	Issues            []VerifyIssue `json:"issues"`
+}
+
+// OK returns true if no inconsistencies were found.
+func (r *VerifyReport) OK() bool {
+	return len(r.Issues) == 0
+}
+
+type ledgerVerifier struct {
+	ctx      context.Context
+	scheme   core.PlatformCryptographyScheme
+	keys     []crypto.PublicKey
+	txn      KVTransaction
+	segments *segmentStore
+	report   *VerifyReport
+
+	// jetKeys is true if records are stored with jet prefix (light node layout).
+	jetKeys bool
+}
+
+// VerifyLedger checks integrity of ledger data in store. It checks that:
+//
+// - ids of records are hashes of their content;
+// - blobs contents are stored and match blob ids;
+// - jet drops form unbroken hash chains from genesis and their records roots match stored records;
+// - drop sizes are signed by one of provided keys and match stored drops;
+// - latest state of every object index exists and its previous states chain is unbroken.
+//
+// Archived records are read from segments in archiveDir (it may be empty if ledger was never archived).
+//
+// Found inconsistencies are collected into report. Error is returned only if store can't be read.
+func VerifyLedger(
+	ctx context.Context,
+	store KVStore,
+	archiveDir string,
+	scheme core.PlatformCryptographyScheme,
+	keys []crypto.PublicKey,
+) (*VerifyReport, error) {
+	v := &ledgerVerifier{
+		ctx:    ctx,
+		scheme: scheme,
+		keys:   keys,
+		report: &VerifyReport{
+			SignaturesChecked: len(keys) > 0,
+			Issues:            []VerifyIssue{},
+		},
+	}
+	if archiveDir != "" {
+		v.segments = newArchiveSegments(archiveDir)
+	}
+	err := kvView(store, func(txn KVTransaction) error {
+		v.txn = txn
+		checks := []func() error{v.checkRecords, v.checkBlobs, v.checkArchivedBlobs, v.checkDrops, v.checkDropSizes, v.checkIndexes}
+		for _, check := range checks {
+			if err := check(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "[ VerifyLedger ] failed to read ledger")
+	}
+	return v.report, nil
+}
+
+func (v *ledgerVerifier) issue(kind string, key []byte, pulse core.PulseNumber, format string, args ...interface{}) {
+	v.report.Issues = append(v.report.Issues, VerifyIssue{
+		Kind:    kind,
+		Key:     hex.EncodeToString(key),
+		Pulse:   pulse,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *ledgerVerifier) checkRecords() error {
+	err := v.txn.Iterate([]byte{scopeIDRecord}, nil, func(k, val []byte) error {
+		v.checkRecord(k, val)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return v.txn.Iterate([]byte{scopeIDArchive, scopeIDRecord}, nil, func(k, ref []byte) error {
+		v.report.Archived++
+		val, err := v.readArchived(ref)
+		if err != nil {
+			v.issue(IssueArchive, k, Key(k).PulseNumber(), "can't read archived record: %v", err)
+			return nil
+		}
+		v.checkRecord(k[1:], val)
+		return nil
+	})
+}
+
+func (v *ledgerVerifier) checkRecord(k, val []byte) {
+	v.report.Records++
+	if len(k) != core.RecordHashSize+core.RecordIDSize {
+		v.issue(IssueDecode, k, 0, "record key has wrong length %d", len(k))
+		return
+	}
+	if !bytes.Equal(k[1:core.RecordHashSize], make([]byte, core.RecordHashSize-1)) {
+		v.jetKeys = true
+	}
+
+	var id core.RecordID
+	copy(id[:], k[core.RecordHashSize:])
+	rec, err := decodeRecord(val)
+	if err != nil {
+		v.issue(IssueDecode, k, id.Pulse(), "can't decode record: %v", err)
+		return
+	}
+	if *record.NewRecordIDFromRecord(v.scheme, id.Pulse(), rec) != id {
+		v.issue(IssueRecordHash, k, id.Pulse(), "record content doesn't match id %v", id)
+	}
+}
+
+func (v *ledgerVerifier) checkBlobs() error {
+	return v.txn.Iterate([]byte{scopeIDBlob}, nil, func(k, val []byte) error {
+		v.report.Blobs++
+		v.checkBlob(k, val)
+		return nil
+	})
+}
+
+func (v *ledgerVerifier) checkArchivedBlobs() error {
+	return v.txn.Iterate([]byte{scopeIDArchive, scopeIDBlob}, nil, func(k, ref []byte) error {
+		v.report.Archived++
+		val, err := v.readArchived(ref)
+		if err != nil {
+			v.issue(IssueArchive, k, Key(k).PulseNumber(), "can't read archived blob: %v", err)
+			return nil
+		}
+		v.checkBlob(k, val)
+		return nil
+	})
+}
+
+func (v *ledgerVerifier) checkBlob(k, val []byte) {
+	blob, err := resolveBlob(k, val, v.txn.Get)
+	if err != nil {
+		v.issue(IssueBlob, k, Key(k).PulseNumber(), "can't read blob content: %v", err)
+		return
+	}
+	if !isBlobOf(v.scheme, k, blob) {
+		v.issue(IssueBlob, k, Key(k).PulseNumber(), "blob content doesn't match id")
+	}
+}
+
+func (v *ledgerVerifier) readArchived(buf []byte) ([]byte, error) {
+	if v.segments == nil {
+		return nil, errors.New("archive directory is not set")
+	}
+	ref, err := decodeSegmentRef(buf)
+	if err != nil {
+		return nil, err
+	}
+	return v.segments.read(ref)
+}
+
+func (v *ledgerVerifier) checkDrops() error {
+	var drops []SnapshotDrop
+	err := v.txn.Iterate([]byte{scopeIDJetDrop}, nil, func(k, val []byte) error {
+		drop, err := jet.Decode(val)
+		if err != nil {
+			v.issue(IssueDecode, k, pulseFromKey(k), "can't decode drop: %v", err)
+			return nil
+		}
+		drops = append(drops, snapshotDrop(k, drop))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	v.report.Drops = len(drops)
+
+	checkDropsChain(v.scheme, drops, func(drop SnapshotDrop, kind string, err error) bool {
+		v.issue(kind, dropKey(drop), drop.Pulse, err.Error())
+		return true
+	})
+
+	byPulse := map[core.PulseNumber][]SnapshotDrop{}
+	for _, drop := range drops {
+		if len(drop.Hash) == 0 && len(drop.PrevHash) == 0 {
+			continue
+		}
+		byPulse[drop.Pulse] = append(byPulse[drop.Pulse], drop)
+	}
+	for pn, pulseDrops := range byPulse {
+		var err error
+		if v.jetKeys {
+			for _, drop := range pulseDrops {
+				err = v.checkDropRecords(drop, func() ([]core.RecordID, error) {
+					return v.pulseRecords(scopeIDRecord, drop.JetPrefix, pn)
+				})
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		err = v.checkHeavyDrops(pn, pulseDrops)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkHeavyDrops checks drops of the pulse stored on heavy node. Records of all jets are stored there without jet
+// prefix, so records are grouped by jets with links made on heavy sync.
+func (v *ledgerVerifier) checkHeavyDrops(pn core.PulseNumber, drops []SnapshotDrop) error {
+	zeroPrefix := make([]byte, core.RecordHashSize-1)
+	records, err := v.pulseRecords(scopeIDRecord, zeroPrefix, pn)
+	if err != nil {
+		return err
+	}
+	if len(drops) == 1 {
+		return v.checkDropRecords(drops[0], func() ([]core.RecordID, error) { return records, nil })
+	}
+
+	linked := map[core.RecordID]struct{}{}
+	jetRecords := make([][]core.RecordID, len(drops))
+	for i, drop := range drops {
+		jetRecords[i], err = v.pulseRecords(scopeIDJetRecord, drop.JetPrefix, pn)
+		if err != nil {
+			return err
+		}
+		for _, id := range jetRecords[i] {
+			linked[id] = struct{}{}
+		}
+	}
+	// Records were synced before links were introduced.
+	if len(linked) == 0 && len(records) > 0 {
+		v.report.UncheckedDrops += len(drops)
+		return nil
+	}
+
+	for i, drop := range drops {
+		err = v.checkDropRecords(drop, func() ([]core.RecordID, error) { return jetRecords[i], nil })
+		if err != nil {
+			return err
+		}
+	}
+	for _, id := range records {
+		if _, ok := linked[id]; !ok {
+			v.issue(
+				IssueDropRecords, prefixkey(scopeIDRecord, zeroPrefix, id[:]), pn,
+				"record %v is not linked to any drop", id.DebugString(),
+			)
+		}
+	}
+	return nil
+}
+
+func (v *ledgerVerifier) checkDropRecords(drop SnapshotDrop, fetch func() ([]core.RecordID, error)) error {
+	records, err := fetch()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(merkle.RecordsRoot(v.scheme, records), drop.RecordsRoot) {
+		v.issue(
+			IssueDropRecords, dropKey(drop), drop.Pulse,
+			"records root of drop %x doesn't match %d stored records", drop.JetPrefix, len(records),
+		)
+	}
+	return nil
+}
+
+// pulseRecords returns ids of stored and archived records (or record links) of the pulse in storage order.
+func (v *ledgerVerifier) pulseRecords(scope byte, jetPrefix []byte, pulse core.PulseNumber) ([]core.RecordID, error) {
+	ids := map[core.RecordID]struct{}{}
+	prefix := prefixkey(scope, jetPrefix, pulse.Bytes())
+	for _, p := range [][]byte{prefix, prefixkey(scopeIDArchive, prefix)} {
+		err := v.txn.Iterate(p, nil, func(k, _ []byte) error {
+			var id core.RecordID
+			copy(id[:], k[len(k)-core.RecordIDSize:])
+			ids[id] = struct{}{}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	records := make([]core.RecordID, 0, len(ids))
+	for id := range ids {
+		records = append(records, id)
+	}
+	sort.Slice(records, func(i, j int) bool { return bytes.Compare(records[i][:], records[j][:]) < 0 })
+	return records, nil
+}
+
+func (v *ledgerVerifier) checkDropSizes() error {
+	return v.txn.Iterate([]byte{scopeIDSystem, sysDropSizeHistory}, nil, func(k, val []byte) error {
+		history, err := jet.DeserializeJetDropSizeHistory(v.ctx, val)
+		if err != nil {
+			v.issue(IssueDecode, k, 0, "can't decode drop size history: %v", err)
+			return nil
+		}
+		for _, size := range history {
+			v.report.DropSizes++
+			err := v.checkDropSize(k, size)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (v *ledgerVerifier) checkDropSize(key []byte, size jet.DropSize) error {
+	if size.JetID.Pulse() != core.PulseNumberJet {
+		v.issue(IssueDropSize, key, size.PulseNo, "drop size has invalid jet id %v", size.JetID)
+		return nil
+	}
+
+	_, prefix := jet.Jet(size.JetID)
+	buf, err := v.txn.Get(prefixkey(scopeIDJetDrop, prefix, size.PulseNo.Bytes()))
+	switch err {
+	case nil:
+		drop, err := jet.Decode(buf)
+		if err == nil && !bytes.Equal(drop.Hash, size.DropHash) {
+			v.issue(IssueDropSize, key, size.PulseNo, "drop size hash doesn't match drop of jet %v", size.JetID)
+		}
+	case core.ErrNotFound:
+		v.issue(IssueDropSize, key, size.PulseNo, "drop of jet %v is not found", size.JetID)
+	default:
+		return err
+	}
+
+	if len(v.keys) == 0 {
+		return nil
+	}
+	hasher := v.scheme.IntegrityHasher()
+	_, err = size.WriteHashData(hasher)
+	if err != nil {
+		return err
+	}
+	hash := hasher.Sum(nil)
+	signature := core.SignatureFromBytes(size.Signature)
+	for _, pk := range v.keys {
+		if v.scheme.Verifier(pk).Verify(signature, hash) {
+			return nil
+		}
+	}
+	v.issue(IssueDropSize, key, size.PulseNo, "drop size of jet %v is not signed by provided keys", size.JetID)
+	return nil
+}
+
+func (v *ledgerVerifier) checkIndexes() error {
+	return v.txn.Iterate([]byte{scopeIDLifeline}, nil, func(k, val []byte) error {
+		v.report.Indexes++
+		idx, err := index.DecodeObjectLifeline(val)
+		if err != nil {
+			v.issue(IssueDecode, k, 0, "can't decode object index: %v", err)
+			return nil
+		}
+		return v.checkStatesChain(k, idx)
+	})
+}
+
+// checkStatesChain walks object states from the latest one. States are looked up in the same jet as the index.
+func (v *ledgerVerifier) checkStatesChain(key []byte, idx *index.ObjectLifeline) error {
+	if idx.LatestState == nil {
+		v.issue(IssueIndexState, key, idx.LatestUpdate, "latest state is not set")
+		return nil
+	}
+
+	jetPrefix := key[1:core.RecordHashSize]
+	visited := map[core.RecordID]struct{}{}
+	for id := idx.LatestState; id != nil; {
+		if _, ok := visited[*id]; ok {
+			v.issue(IssueIndexState, key, idx.LatestUpdate, "states chain has a loop at state %v", id)
+			return nil
+		}
+		visited[*id] = struct{}{}
+
+		recordKey := prefixkey(scopeIDRecord, jetPrefix, id[:])
+		buf, err := v.txn.Get(recordKey)
+		if err == core.ErrNotFound {
+			buf, err = v.txn.Get(prefixkey(scopeIDArchive, recordKey))
+			if err == nil {
+				buf, err = v.readArchived(buf)
+				if err != nil {
+					// Broken archive is already reported by records check.
+					return nil
+				}
+			}
+		}
+		if err == core.ErrNotFound {
+			v.issue(IssueIndexState, key, idx.LatestUpdate, "state record %v is not found", id)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		rec, err := decodeRecord(buf)
+		if err != nil {
+			// Broken record is already reported by records check.
+			return nil
+		}
+		state, ok := rec.(record.ObjectState)
+		if !ok {
+			v.issue(IssueIndexState, key, idx.LatestUpdate, "record %v is not an object state", id)
+			return nil
+		}
+		id = state.PrevStateID()
+	}
+	return nil
+}
+
+// decodeRecord deserializes record without panic on broken data.
+func decodeRecord(buf []byte) (rec record.Record, err error) {
+	if len(buf) < record.TypeIDSize {
+		return nil, errors.New("record is too short")
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("%v", r)
+		}
+	}()
+	rec = record.DeserializeRecord(buf)
+	if rec == nil {
+		return nil, errors.New("unknown record type")
+	}
+	return rec, nil
+}
+
+func dropKey(drop SnapshotDrop) []byte {
+	return prefixkey(scopeIDJetDrop, drop.JetPrefix, drop.Pulse.Bytes())
+}
@@ -0,0 +1,308 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"crypto"
+	"testing"
+
+	"github.com/insolar/insolar/component"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/cryptography"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/storage/index"
+	"github.com/insolar/insolar/ledger/storage/jet"
+	"github.com/insolar/insolar/ledger/storage/record"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type verifyLedger struct {
+	db            DBContext
+	objectStorage ObjectStorage
+	dropStorage   DropStorage
+	scheme        core.PlatformCryptographyScheme
+	signer        core.CryptographyService
+	key           crypto.PublicKey
+
+	jetID  core.RecordID
+	pulses []core.PulseNumber
+	states []core.RecordID
+	object core.RecordID
+}
+
+// newVerifyLedger creates ledger with object amended on every pulse and signed drop sizes.
+func newVerifyLedger(ctx context.Context, t *testing.T) *verifyLedger {
+	db, err := NewDB(configuration.Ledger{Storage: configuration.Storage{Backend: BackendMemory}}, nil)
+	require.NoError(t, err)
+
+	kp := platformpolicy.NewKeyProcessor()
+	privateKey, err := kp.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	l := &verifyLedger{
+		db:            db,
+		objectStorage: NewObjectStorage(),
+		dropStorage:   NewDropStorage(10),
+		scheme:        platformpolicy.NewPlatformCryptographyScheme(),
+		signer:        cryptography.NewKeyBoundCryptographyService(privateKey),
+		key:           kp.ExtractPublicKey(privateKey),
+		jetID:         *jet.NewID(0, nil),
+		pulses:        []core.PulseNumber{core.FirstPulseNumber + 1, core.FirstPulseNumber + 2},
+	}
+	cm := &component.Manager{}
+	cm.Inject(l.scheme, l.db, l.objectStorage, l.dropStorage)
+	require.NoError(t, cm.Init(ctx))
+
+	var prevHash []byte
+	for i, pn := range l.pulses {
+		var state record.Record = &record.ObjectActivateRecord{}
+		if i > 0 {
+			state = &record.ObjectAmendRecord{PrevState: l.states[i-1]}
+		}
+		id, err := l.objectStorage.SetRecord(ctx, l.jetID, pn, state)
+		require.NoError(t, err)
+		l.states = append(l.states, *id)
+		if i == 0 {
+			l.object = *id
+		}
+		err = l.objectStorage.SetObjectIndex(ctx, l.jetID, &l.object, &index.ObjectLifeline{
+			LatestState:  id,
+			LatestUpdate: pn,
+		})
+		require.NoError(t, err)
+
+		drop, _, size, err := l.dropStorage.CreateDrop(ctx, l.jetID, pn, prevHash)
+		require.NoError(t, err)
+		require.NoError(t, l.dropStorage.SetDrop(ctx, l.jetID, drop))
+		prevHash = drop.Hash
+
+		l.addDropSize(ctx, t, l.signer, &jet.DropSize{JetID: l.jetID, PulseNo: pn, DropSize: size, DropHash: drop.Hash})
+	}
+	return l
+}
+
+func (l *verifyLedger) addDropSize(
+	ctx context.Context, t *testing.T, signer core.CryptographyService, dropSize *jet.DropSize,
+) {
+	hasher := l.scheme.IntegrityHasher()
+	_, err := dropSize.WriteHashData(hasher)
+	require.NoError(t, err)
+	signature, err := signer.Sign(hasher.Sum(nil))
+	require.NoError(t, err)
+	dropSize.Signature = signature.Bytes()
+	require.NoError(t, l.dropStorage.AddDropSize(ctx, dropSize))
+}
+
+func (l *verifyLedger) verify(ctx context.Context, t *testing.T) *VerifyReport {
+	report, err := VerifyLedger(ctx, l.db.GetKVStore(), "", l.scheme, []crypto.PublicKey{l.key})
+	require.NoError(t, err)
+	return report
+}
+
+func (l *verifyLedger) recordKey(id core.RecordID) []byte {
+	_, prefix := jet.Jet(l.jetID)
+	return prefixkey(scopeIDRecord, prefix, id[:])
+}
+
+func issueKinds(report *VerifyReport) []string {
+	var kinds []string
+	for _, issue := range report.Issues {
+		kinds = append(kinds, issue.Kind)
+	}
+	return kinds
+}
+
+func TestVerifyLedger_Consistent(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	l := newVerifyLedger(ctx, t)
+
+	report := l.verify(ctx, t)
+	assert.True(t, report.OK(), "issues: %v", report.Issues)
+	assert.Equal(t, 2, report.Records)
+	assert.Equal(t, 1, report.Indexes)
+	assert.Equal(t, 2, report.Drops)
+	assert.Equal(t, 2, report.DropSizes)
+	assert.Equal(t, 0, report.UncheckedDrops)
+	assert.True(t, report.SignaturesChecked)
+
+	report, err := VerifyLedger(ctx, l.db.GetKVStore(), "", l.scheme, nil)
+	require.NoError(t, err)
+	assert.True(t, report.OK())
+	assert.False(t, report.SignaturesChecked)
+}
+
+func TestVerifyLedger_HeavyLayout(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	right := *jet.NewID(1, []byte{0x80})
+	// newHeavyLedger adds drop of the second jet, so records of the first pulse should be split between jets.
+	newHeavyLedger := func(t *testing.T) *verifyLedger {
+		l := newVerifyLedger(ctx, t)
+		drop, _, _, err := l.dropStorage.CreateDrop(ctx, right, l.pulses[0], nil)
+		require.NoError(t, err)
+		require.NoError(t, l.dropStorage.SetDrop(ctx, right, drop))
+		return l
+	}
+	link := func(t *testing.T, l *verifyLedger, jetID core.RecordID, id core.RecordID) {
+		links := JetRecordLinks(jetID, []core.KV{{K: l.recordKey(id)}})
+		require.Equal(t, 1, len(links))
+		require.NoError(t, l.db.StoreKeyValues(ctx, links))
+	}
+
+	t.Run("records are not linked", func(t *testing.T) {
+		l := newHeavyLedger(t)
+		report := l.verify(ctx, t)
+		assert.True(t, report.OK(), "issues: %v", report.Issues)
+		assert.Equal(t, 2, report.UncheckedDrops)
+	})
+
+	t.Run("records are linked", func(t *testing.T) {
+		l := newHeavyLedger(t)
+		link(t, l, l.jetID, l.states[0])
+		report := l.verify(ctx, t)
+		assert.True(t, report.OK(), "issues: %v", report.Issues)
+		assert.Equal(t, 0, report.UncheckedDrops)
+	})
+
+	t.Run("record is linked to wrong jet", func(t *testing.T) {
+		l := newHeavyLedger(t)
+		link(t, l, right, l.states[0])
+		report := l.verify(ctx, t)
+		assert.Equal(t, []string{IssueDropRecords, IssueDropRecords}, issueKinds(report))
+		assert.Equal(t, 0, report.UncheckedDrops)
+	})
+
+	t.Run("record is not linked", func(t *testing.T) {
+		l := newHeavyLedger(t)
+		link(t, l, l.jetID, l.states[0])
+		_, err := l.objectStorage.SetRecord(ctx, l.jetID, l.pulses[0], &record.ResultRecord{})
+		require.NoError(t, err)
+		report := l.verify(ctx, t)
+		assert.Equal(t, []string{IssueDropRecords}, issueKinds(report))
+	})
+}
+
+func TestVerifyLedger_Corrupted(t *testing.T) {
+	tests := map[string]struct {
+		corrupt func(ctx context.Context, t *testing.T, l *verifyLedger)
+		kinds   []string
+	}{
+		"record content": {
+			corrupt: func(ctx context.Context, t *testing.T, l *verifyLedger) {
+				rec := &record.ObjectAmendRecord{
+					ObjectStateRecord: record.ObjectStateRecord{IsPrototype: true},
+					PrevState:         l.states[0],
+				}
+				err := l.db.set(ctx, l.recordKey(l.states[1]), record.SerializeRecord(rec))
+				require.NoError(t, err)
+			},
+			kinds: []string{IssueRecordHash},
+		},
+		"broken record": {
+			corrupt: func(ctx context.Context, t *testing.T, l *verifyLedger) {
+				require.NoError(t, l.db.set(ctx, l.recordKey(l.states[1]), []byte{1, 2, 3, 4, 5}))
+			},
+			kinds: []string{IssueDecode},
+		},
+		"missing previous state": {
+			corrupt: func(ctx context.Context, t *testing.T, l *verifyLedger) {
+				err := kvUpdate(l.db.GetKVStore(), func(txn KVTransaction) error {
+					return txn.Delete(l.recordKey(l.states[0]))
+				})
+				require.NoError(t, err)
+			},
+			kinds: []string{IssueDropRecords, IssueIndexState},
+		},
+		"latest state is not an object state": {
+			corrupt: func(ctx context.Context, t *testing.T, l *verifyLedger) {
+				id, err := l.objectStorage.SetRecord(ctx, l.jetID, l.pulses[1], &record.ResultRecord{})
+				require.NoError(t, err)
+				err = l.objectStorage.SetObjectIndex(ctx, l.jetID, &l.object, &index.ObjectLifeline{LatestState: id})
+				require.NoError(t, err)
+			},
+			kinds: []string{IssueDropRecords, IssueIndexState},
+		},
+		"blob content": {
+			corrupt: func(ctx context.Context, t *testing.T, l *verifyLedger) {
+				id, err := l.objectStorage.SetBlob(ctx, l.jetID, l.pulses[1], []byte("blob"))
+				require.NoError(t, err)
+				err = l.db.set(ctx, blobContentKey(id.Hash()), blobCodecNone.encode([]byte("corrupted")))
+				require.NoError(t, err)
+			},
+			kinds: []string{IssueBlob},
+		},
+		"missing blob content": {
+			corrupt: func(ctx context.Context, t *testing.T, l *verifyLedger) {
+				id, err := l.objectStorage.SetBlob(ctx, l.jetID, l.pulses[1], []byte("blob"))
+				require.NoError(t, err)
+				err = kvUpdate(l.db.GetKVStore(), func(txn KVTransaction) error {
+					return txn.Delete(blobContentKey(id.Hash()))
+				})
+				require.NoError(t, err)
+			},
+			kinds: []string{IssueBlob},
+		},
+		"drop hash": {
+			corrupt: func(ctx context.Context, t *testing.T, l *verifyLedger) {
+				drop, err := l.dropStorage.GetDrop(ctx, l.jetID, l.pulses[1])
+				require.NoError(t, err)
+				drop.Hash = l.scheme.IntegrityHasher().Hash([]byte("corrupted"))
+				_, prefix := jet.Jet(l.jetID)
+				buf, err := jet.Encode(drop)
+				require.NoError(t, err)
+				err = l.db.set(ctx, prefixkey(scopeIDJetDrop, prefix, drop.Pulse.Bytes()), buf)
+				require.NoError(t, err)
+			},
+			// Signed drop size refers to original hash.
+			kinds: []string{IssueDropHash, IssueDropSize},
+		},
+		"drop chain": {
+			corrupt: func(ctx context.Context, t *testing.T, l *verifyLedger) {
+				drop, _, _, err := l.dropStorage.CreateDrop(ctx, l.jetID, l.pulses[1]+1, []byte("unknown"))
+				require.NoError(t, err)
+				require.NoError(t, l.dropStorage.SetDrop(ctx, l.jetID, drop))
+			},
+			kinds: []string{IssueDropChain},
+		},
+		"drop size signature": {
+			corrupt: func(ctx context.Context, t *testing.T, l *verifyLedger) {
+				drop, err := l.dropStorage.GetDrop(ctx, l.jetID, l.pulses[1])
+				require.NoError(t, err)
+				privateKey, err := platformpolicy.NewKeyProcessor().GeneratePrivateKey()
+				require.NoError(t, err)
+				signer := cryptography.NewKeyBoundCryptographyService(privateKey)
+				l.addDropSize(ctx, t, signer, &jet.DropSize{JetID: l.jetID, PulseNo: l.pulses[1], DropHash: drop.Hash})
+			},
+			kinds: []string{IssueDropSize},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			ctx := inslogger.TestContext(t)
+			l := newVerifyLedger(ctx, t)
+			test.corrupt(ctx, t, l)
+
+			report := l.verify(ctx, t)
+			assert.Equal(t, test.kinds, issueKinds(report))
+		})
+	}
+}
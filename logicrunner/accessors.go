@@ -73,6 +73,26 @@ func (lr *LogicRunner) MustObjectState(ref Ref) *ObjectState {
 	return res
 }
 
+// ActiveExecutions returns the number of objects that have a request executing or queued on this node.
+func (lr *LogicRunner) ActiveExecutions(ctx context.Context) int {
+	lr.stateMutex.RLock()
+	defer lr.stateMutex.RUnlock()
+
+	count := 0
+	for _, state := range lr.state {
+		state.Lock()
+		if es := state.ExecutionState; es != nil {
+			es.Lock()
+			if es.Current != nil || es.haveSomeToProcess() {
+				count++
+			}
+			es.Unlock()
+		}
+		state.Unlock()
+	}
+	return count
+}
+
 func (lr *LogicRunner) pulse(ctx context.Context) *core.Pulse {
 	pulse, err := lr.PulseStorage.Current(ctx)
 	if err != nil {
@@ -18,6 +18,10 @@
 package foundation
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
 	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/logicrunner/goplugin/proxyctx"
 	"github.com/tylerb/gls"
@@ -77,6 +81,19 @@ func GetContext() *core.LogicCallContext {
 	}
 }
 
+// CheckRouteCaller checks that routed method is called by member that is passed to it as caller argument.
+// Any contract can call handler of route directly with any caller argument, so handler must check it before use.
+func CheckRouteCaller(member core.RecordRef, memberPrototype core.RecordRef) error {
+	ctx := GetContext()
+	if ctx.Caller == nil || *ctx.Caller != member {
+		return errors.New("routed method is called not by passed member")
+	}
+	if ctx.CallerPrototype == nil || *ctx.CallerPrototype != memberPrototype {
+		return errors.New("routed method is called not by member contract")
+	}
+	return nil
+}
+
 // GetImplementationFor finds delegate typed r in object and returns it
 func GetImplementationFor(object, ofType core.RecordRef) (core.RecordRef, error) {
 	return proxyctx.Current.GetDelegate(object, ofType)
@@ -87,6 +104,37 @@ func (bc *BaseContract) NewChildrenTypedIterator(childPrototype core.RecordRef)
 	return proxyctx.Current.GetObjChildrenIterator(bc.GetReference(), childPrototype, "")
 }
 
+// CallMethod calls method of object by reference and waits for result
+// Called method must return (interface{}, error)
+func CallMethod(ref core.RecordRef, method string, args ...interface{}) (interface{}, error) {
+	var argsSerialized []byte
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(ref, true, method, argsSerialized, core.RecordRef{})
+	if err != nil {
+		return nil, err
+	}
+
+	ret := [2]interface{}{}
+	var ret0 interface{}
+	ret[0] = &ret0
+	var ret1 *Error
+	ret[1] = &ret1
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return nil, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
 // GetObject create proxy by address
 // unimplemented
 func GetObject(ref core.RecordRef) ProxyInterface {
@@ -98,6 +146,30 @@ func (bc *BaseContract) SelfDestruct() error {
 	return proxyctx.Current.DeactivateObject(bc.GetReference())
 }
 
+// Emit emits event of current contract with provided topic, payload is serialized to JSON
+// Events are saved on ledger only if method call succeeds and can be queried by contract reference and topic
+func Emit(topic string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return proxyctx.Current.Emit(topic, data)
+}
+
+// GetObjectStateAtPulse reads state of the object as of provided pulse into "into"
+// The latest object state not newer than the pulse is used, so the result doesn't change with later calls of the object
+// Pulse should be older than the pulse of the current call, later states could be not saved yet
+func GetObjectStateAtPulse(ref core.RecordRef, pulse core.PulseNumber, into interface{}) error {
+	if current := GetContext().Pulse.PulseNumber; pulse >= current {
+		return fmt.Errorf("pulse %v is not older than current pulse %v", pulse, current)
+	}
+	memory, err := proxyctx.Current.GetObjectMemoryAtPulse(ref, pulse)
+	if err != nil {
+		return err
+	}
+	return proxyctx.Current.Deserialize(memory, into)
+}
+
 // Error elementary string based error struct satisfying builtin error interface
 //    foundation.Error{"some err"}
 type Error struct {
@@ -0,0 +1,48 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package foundation
+
+import (
+	"testing"
+
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/tylerb/gls"
+)
+
+func TestCheckRouteCaller(t *testing.T) {
+	defer gls.Cleanup()
+
+	member := testutils.RandomRef()
+	memberPrototype := testutils.RandomRef()
+	call := func(caller core.RecordRef, callerPrototype core.RecordRef) error {
+		gls.Set("callCtx", &core.LogicCallContext{Caller: &caller, CallerPrototype: &callerPrototype})
+		return CheckRouteCaller(member, memberPrototype)
+	}
+
+	assert.NoError(t, call(member, memberPrototype), "call routed by member")
+
+	// Contract calls handler directly and passes reference of another member as caller.
+	attacker := testutils.RandomRef()
+	assert.Error(t, call(attacker, testutils.RandomRef()), "forged caller")
+	assert.Error(t, call(attacker, memberPrototype), "forged caller from another member")
+	assert.Error(t, call(member, testutils.RandomRef()), "not member prototype")
+
+	gls.Set("callCtx", &core.LogicCallContext{})
+	assert.Error(t, CheckRouteCaller(member, memberPrototype), "no caller")
+}
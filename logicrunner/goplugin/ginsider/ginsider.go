@@ -466,6 +466,58 @@ func (gi *GoInsider) DeactivateObject(object core.RecordRef) error {
 	return nil
 }
 
+// Emit sends event emitted by the current contract to the logic runner
+func (gi *GoInsider) Emit(topic string, payload []byte) error {
+	client, err := gi.Upstream()
+	if err != nil {
+		return err
+	}
+
+	req := rpctypes.UpEmitReq{
+		UpBaseReq: MakeUpBaseReq(),
+		Topic:     topic,
+		Payload:   payload,
+	}
+
+	res := rpctypes.UpEmitResp{}
+	err = client.Call("RPC.Emit", req, &res)
+	if err != nil {
+		if err == rpc.ErrShutdown {
+			log.Error("Insgorund can't connect to Insolard")
+			os.Exit(0)
+		}
+		return errors.Wrap(err, "[ Emit ] on calling main API")
+	}
+
+	return nil
+}
+
+// GetObjectMemoryAtPulse returns memory of the latest object state not newer than provided pulse
+func (gi *GoInsider) GetObjectMemoryAtPulse(object core.RecordRef, pulse core.PulseNumber) ([]byte, error) {
+	client, err := gi.Upstream()
+	if err != nil {
+		return nil, err
+	}
+
+	req := rpctypes.UpGetObjectMemoryAtPulseReq{
+		UpBaseReq: MakeUpBaseReq(),
+		Object:    object,
+		Pulse:     pulse,
+	}
+
+	res := rpctypes.UpGetObjectMemoryAtPulseResp{}
+	err = client.Call("RPC.GetObjectMemoryAtPulse", req, &res)
+	if err != nil {
+		if err == rpc.ErrShutdown {
+			log.Error("Insgorund can't connect to Insolard")
+			os.Exit(0)
+		}
+		return nil, errors.Wrap(err, "[ GetObjectMemoryAtPulse ] on calling main API")
+	}
+
+	return res.Memory, nil
+}
+
 // Serialize - CBOR serializer wrapper: `what` -> `to`
 func (gi *GoInsider) Serialize(what interface{}, to *[]byte) error {
 	ch := new(codec.CborHandle)
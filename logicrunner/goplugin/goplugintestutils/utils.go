@@ -60,6 +60,7 @@ func WriteFile(dir string, name string, text string) error {
 type TestCodeDescriptor struct {
 	ARef         core.RecordRef
 	ACode        []byte
+	AABI         []byte
 	AMachineType core.MachineType
 }
 
@@ -78,6 +79,11 @@ func (t *TestCodeDescriptor) Code() ([]byte, error) {
 	return t.ACode, nil
 }
 
+// ABI implementation for tests
+func (t *TestCodeDescriptor) ABI() ([]byte, error) {
+	return t.AABI, nil
+}
+
 // TestObjectDescriptor implementation for tests
 type TestObjectDescriptor struct {
 	AM                *TestArtifactManager
@@ -170,6 +176,42 @@ func (t *TestArtifactManager) GetChildren(ctx context.Context, parent core.Recor
 	panic("implement me")
 }
 
+// GetObjectHistory implementation for tests
+func (t *TestArtifactManager) GetObjectHistory(ctx context.Context, head core.RecordRef, fromPulse, toPulse *core.PulseNumber) (core.ObjectHistoryIterator, error) {
+	panic("implement me")
+}
+
+// RegisterEvent implementation for tests
+func (t *TestArtifactManager) RegisterEvent(ctx context.Context, object, request core.RecordRef, topic string, payload []byte) (*core.RecordID, error) {
+	id := testutils.RandomID()
+	return &id, nil
+}
+
+// GetEvents implementation for tests
+func (t *TestArtifactManager) GetEvents(ctx context.Context, object core.RecordRef, topic string, fromPulse, toPulse *core.PulseNumber) (core.EventIterator, error) {
+	panic("implement me")
+}
+
+// GetReceipt implementation for tests
+func (t *TestArtifactManager) GetReceipt(ctx context.Context, object, request core.RecordRef) (*core.RequestReceipt, error) {
+	panic("implement me")
+}
+
+// GetRecordProof implementation for tests
+func (t *TestArtifactManager) GetRecordProof(ctx context.Context, jet core.RecordID, pulse core.PulseNumber, record core.RecordID) (*core.RecordProof, error) {
+	panic("implement me")
+}
+
+// FindObjectsByPrototype implementation for tests
+func (t *TestArtifactManager) FindObjectsByPrototype(ctx context.Context, prototype core.RecordRef, from *core.RecordID, limit int) (*core.IndexedRecords, error) {
+	panic("implement me")
+}
+
+// FindRequestsByCaller implementation for tests
+func (t *TestArtifactManager) FindRequestsByCaller(ctx context.Context, caller core.RecordRef, from *core.RecordID, limit int) (*core.IndexedRecords, error) {
+	panic("implement me")
+}
+
 // NewTestArtifactManager implementation for tests
 func NewTestArtifactManager() *TestArtifactManager {
 	return &TestArtifactManager{
@@ -204,6 +246,11 @@ func (t *TestArtifactManager) GetObject(ctx context.Context, object core.RecordR
 	return res, nil
 }
 
+// GetObjectAtPulse implementation for tests
+func (t *TestArtifactManager) GetObjectAtPulse(ctx context.Context, object core.RecordRef, pulse core.PulseNumber) (core.ObjectDescriptor, error) {
+	return t.GetObject(ctx, object, nil, false)
+}
+
 // GetDelegate implementation for tests
 func (t *TestArtifactManager) GetDelegate(ctx context.Context, head, asClass core.RecordRef) (*core.RecordRef, error) {
 	obj, ok := t.Objects[head]
@@ -225,12 +272,13 @@ func (t *TestArtifactManager) DeclareType(ctx context.Context, domain core.Recor
 }
 
 // DeployCode implementation for tests
-func (t *TestArtifactManager) DeployCode(ctx context.Context, domain core.RecordRef, request core.RecordRef, code []byte, mt core.MachineType) (*core.RecordID, error) {
+func (t *TestArtifactManager) DeployCode(ctx context.Context, domain core.RecordRef, request core.RecordRef, code []byte, mt core.MachineType, abi []byte) (*core.RecordID, error) {
 	ref := testutils.RandomRef()
 
 	t.Codes[ref] = &TestCodeDescriptor{
 		ARef:         ref,
 		ACode:        code,
+		AABI:         abi,
 		AMachineType: core.MachineTypeGoPlugin,
 	}
 	id := ref.Record()
@@ -391,7 +439,7 @@ func AMPublishCode(
 ) {
 	ctx := context.TODO()
 	codeID, err := am.DeployCode(
-		ctx, domain, request, code, mtype,
+		ctx, domain, request, code, mtype, nil,
 	)
 	assert.NoError(t, err, "create code on ledger")
 	codeRef = &core.RecordRef{}
@@ -504,7 +552,7 @@ func (cb *ContractsBuilder) Build(contracts map[string]string) error {
 		codeID, err := cb.ArtifactManager.DeployCode(
 			ctx,
 			core.RecordRef{}, *core.NewRecordRef(core.RecordID{}, *codeReq),
-			pluginBinary, core.MachineTypeGoPlugin,
+			pluginBinary, core.MachineTypeGoPlugin, nil,
 		)
 		codeRef := &core.RecordRef{}
 		codeRef.SetRecord(*codeID)
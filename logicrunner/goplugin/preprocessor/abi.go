@@ -0,0 +1,268 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package preprocessor
+
+import (
+	"encoding/json"
+	"go/ast"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Method annotations. An annotation is a separate line of method's doc comment, e.g. "//ins:immutable".
+const (
+	// annotationImmutable marks a method which doesn't change object's state.
+	annotationImmutable = "ins:immutable"
+	// annotationNoWait marks a method which is supposed to be called without waiting for results.
+	annotationNoWait = "ins:nowait"
+)
+
+// ABI type kinds.
+const (
+	ABIKindBool      = "bool"
+	ABIKindInt       = "int"
+	ABIKindUint      = "uint"
+	ABIKindFloat     = "float"
+	ABIKindString    = "string"
+	ABIKindBytes     = "bytes"
+	ABIKindReference = "reference"
+	ABIKindError     = "error"
+	ABIKindArray     = "array"
+	ABIKindMap       = "map"
+	ABIKindStruct    = "struct"
+	ABIKindAny       = "any"
+)
+
+// ABIType is a language-neutral description of a value type. Values are CBOR-encoded according to their types.
+type ABIType struct {
+	// Kind is one of ABIKind constants.
+	Kind string `json:"kind"`
+	// Bits is a size of numeric types.
+	Bits int `json:"bits,omitempty"`
+	// Nullable is true if the value can be null.
+	Nullable bool `json:"nullable,omitempty"`
+	// Name is a name of struct type or of a type that can't be described.
+	Name string `json:"name,omitempty"`
+	// Elem is a type of array elements or map values.
+	Elem *ABIType `json:"elem,omitempty"`
+	// Key is a type of map keys.
+	Key *ABIType `json:"key,omitempty"`
+	// Fields are struct fields. They are omitted for recursive structs and structs declared outside of the contract.
+	Fields []ABIArgument `json:"fields,omitempty"`
+}
+
+// ABIArgument describes a single argument or result of contract's function.
+type ABIArgument struct {
+	// Name is an argument name. It's empty for unnamed results.
+	Name string  `json:"name,omitempty"`
+	Type ABIType `json:"type"`
+}
+
+// ABIFunction describes contract's constructor or method.
+type ABIFunction struct {
+	Name      string        `json:"name"`
+	Arguments []ABIArgument `json:"arguments"`
+	Results   []ABIArgument `json:"results"`
+	// NoWait is true if the method is supposed to be called without waiting for results.
+	NoWait bool `json:"noWait"`
+	// Immutable is true if the method doesn't change object's state.
+	Immutable bool `json:"immutable"`
+}
+
+// ABI is a machine-readable description of a contract.
+type ABI struct {
+	Contract     string        `json:"contract"`
+	Constructors []ABIFunction `json:"constructors"`
+	Methods      []ABIFunction `json:"methods"`
+}
+
+// ABI returns description of contract's constructors and methods.
+func (pf *ParsedFile) ABI() *ABI {
+	return &ABI{
+		Contract:     pf.contract,
+		Constructors: pf.functionInfoForABI(pf.constructors[pf.contract]),
+		Methods:      pf.functionInfoForABI(pf.methods[pf.contract]),
+	}
+}
+
+// WriteABI generates and writes into `out` JSON ABI of the contract
+func (pf *ParsedFile) WriteABI(out io.Writer) error {
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	err := enc.Encode(pf.ABI())
+	if err != nil {
+		return errors.Wrap(err, "couldn't write ABI")
+	}
+	return nil
+}
+
+func (pf *ParsedFile) functionInfoForABI(list []*ast.FuncDecl) []ABIFunction {
+	res := []ABIFunction{}
+	for _, fun := range list {
+		annotations := functionAnnotations(fun)
+		res = append(res, ABIFunction{
+			Name:      fun.Name.Name,
+			Arguments: pf.abiArguments(fun.Type.Params),
+			Results:   pf.abiArguments(fun.Type.Results),
+			NoWait:    annotations[annotationNoWait],
+			Immutable: annotations[annotationImmutable],
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
+
+func functionAnnotations(fun *ast.FuncDecl) map[string]bool {
+	res := map[string]bool{}
+	if fun.Doc == nil {
+		return res
+	}
+	for _, c := range fun.Doc.List {
+		text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+		if strings.HasPrefix(text, "ins:") {
+			res[text] = true
+		}
+	}
+	return res
+}
+
+func (pf *ParsedFile) abiArguments(list *ast.FieldList) []ABIArgument {
+	res := []ABIArgument{}
+	if list == nil {
+		return res
+	}
+	for _, field := range list.List {
+		t := pf.abiType(field.Type, map[string]bool{})
+		if len(field.Names) == 0 {
+			res = append(res, ABIArgument{Type: t})
+			continue
+		}
+		for _, name := range field.Names {
+			res = append(res, ABIArgument{Name: name.Name, Type: t})
+		}
+	}
+	return res
+}
+
+var abiBasicTypes = map[string]ABIType{
+	"bool":    {Kind: ABIKindBool},
+	"string":  {Kind: ABIKindString},
+	"error":   {Kind: ABIKindError},
+	"int":     {Kind: ABIKindInt, Bits: 64},
+	"int8":    {Kind: ABIKindInt, Bits: 8},
+	"int16":   {Kind: ABIKindInt, Bits: 16},
+	"int32":   {Kind: ABIKindInt, Bits: 32},
+	"rune":    {Kind: ABIKindInt, Bits: 32},
+	"int64":   {Kind: ABIKindInt, Bits: 64},
+	"uint":    {Kind: ABIKindUint, Bits: 64},
+	"uint8":   {Kind: ABIKindUint, Bits: 8},
+	"byte":    {Kind: ABIKindUint, Bits: 8},
+	"uint16":  {Kind: ABIKindUint, Bits: 16},
+	"uint32":  {Kind: ABIKindUint, Bits: 32},
+	"uint64":  {Kind: ABIKindUint, Bits: 64},
+	"float32": {Kind: ABIKindFloat, Bits: 32},
+	"float64": {Kind: ABIKindFloat, Bits: 64},
+}
+
+// abiExternalTypes are types declared outside of contracts that have known encoding.
+var abiExternalTypes = map[string]ABIType{
+	"core.RecordRef":   {Kind: ABIKindReference},
+	"core.PulseNumber": {Kind: ABIKindUint, Bits: 32},
+}
+
+// abiType describes Go type of the contract. Structs that are being described are tracked in `seen` to stop on
+// recursive types.
+func (pf *ParsedFile) abiType(expr ast.Expr, seen map[string]bool) ABIType {
+	switch t := expr.(type) {
+	case *ast.ParenExpr:
+		return pf.abiType(t.X, seen)
+	case *ast.StarExpr:
+		res := pf.abiType(t.X, seen)
+		res.Nullable = true
+		return res
+	case *ast.ArrayType:
+		if ident, ok := t.Elt.(*ast.Ident); ok && (ident.Name == "byte" || ident.Name == "uint8") {
+			return ABIType{Kind: ABIKindBytes}
+		}
+		elem := pf.abiType(t.Elt, seen)
+		return ABIType{Kind: ABIKindArray, Elem: &elem}
+	case *ast.MapType:
+		key := pf.abiType(t.Key, seen)
+		elem := pf.abiType(t.Value, seen)
+		return ABIType{Kind: ABIKindMap, Key: &key, Elem: &elem}
+	case *ast.InterfaceType:
+		return ABIType{Kind: ABIKindAny}
+	case *ast.StructType:
+		return ABIType{Kind: ABIKindStruct, Fields: pf.abiFields(t.Fields, seen)}
+	case *ast.SelectorExpr:
+		name := pf.codeOfNode(t)
+		if res, ok := abiExternalTypes[name]; ok {
+			return res
+		}
+		return ABIType{Kind: ABIKindAny, Name: name}
+	case *ast.Ident:
+		if res, ok := abiBasicTypes[t.Name]; ok {
+			return res
+		}
+		spec, ok := pf.types[t.Name]
+		if !ok {
+			return ABIType{Kind: ABIKindAny, Name: t.Name}
+		}
+		if _, ok := spec.Type.(*ast.StructType); !ok {
+			return pf.abiType(spec.Type, seen)
+		}
+		if seen[t.Name] {
+			return ABIType{Kind: ABIKindStruct, Name: t.Name}
+		}
+		seen[t.Name] = true
+		defer delete(seen, t.Name)
+		res := pf.abiType(spec.Type, seen)
+		res.Name = t.Name
+		return res
+	}
+	return ABIType{Kind: ABIKindAny, Name: pf.codeOfNode(expr)}
+}
+
+func (pf *ParsedFile) abiFields(list *ast.FieldList, seen map[string]bool) []ABIArgument {
+	res := []ABIArgument{}
+	for _, field := range list.List {
+		t := pf.abiType(field.Type, seen)
+		if len(field.Names) == 0 {
+			// Embedded field is encoded under its type name.
+			name := pf.typeName(field.Type)
+			if i := strings.LastIndex(name, "."); i >= 0 {
+				name = name[i+1:]
+			}
+			if ast.IsExported(name) {
+				res = append(res, ABIArgument{Name: name, Type: t})
+			}
+			continue
+		}
+		for _, name := range field.Names {
+			// Only exported fields are encoded.
+			if name.IsExported() {
+				res = append(res, ABIArgument{Name: name.Name, Type: t})
+			}
+		}
+	}
+	return res
+}
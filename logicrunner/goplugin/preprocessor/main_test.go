@@ -18,6 +18,7 @@ package preprocessor
 
 import (
 	"bytes"
+	"encoding/json"
 	"io/ioutil"
 	"os"
 	"os/exec"
@@ -72,6 +73,8 @@ func (hw *HelloWorlder) Fail() (string, error) {
 	return "", errors.New("We failed 2")
 }
 
+// Echo returns its argument.
+//ins:nowait
 func (hw *HelloWorlder) Echo(s string) (string, error) {
 	hw.Greeted++
 	return s, nil
@@ -101,6 +104,7 @@ func (hw *HelloWorlder) MultiArgs(Name FullName, s string, i int) (*PersonalGree
 	}, nil
 }
 
+//ins:immutable
 func (hw HelloWorlder) ConstEcho(s string) (string, error) {
 	return s, nil
 }
@@ -145,6 +149,52 @@ func (s *PreprocessorSuite) TestBasicGeneration() {
 		a.NoError(err)
 		a.NotEmpty(code)
 	})
+
+	s.T().Run("abi", func(t *testing.T) {
+		t.Parallel()
+		a := assert.New(t)
+
+		buf := bytes.Buffer{}
+		err := parsed.WriteABI(&buf)
+		a.NoError(err)
+
+		abi := ABI{}
+		err = json.Unmarshal(buf.Bytes(), &abi)
+		a.NoError(err)
+		a.Equal("HelloWorlder", abi.Contract)
+		a.Empty(abi.Constructors)
+		a.Len(abi.Methods, 7)
+
+		methods := map[string]ABIFunction{}
+		for _, m := range abi.Methods {
+			methods[m.Name] = m
+		}
+
+		fullName := ABIType{Kind: ABIKindStruct, Name: "FullName", Fields: []ABIArgument{
+			{"First", ABIType{Kind: ABIKindString}},
+			{"Last", ABIType{Kind: ABIKindString}},
+		}}
+		multiArgs := methods["MultiArgs"]
+		a.False(multiArgs.NoWait)
+		a.False(multiArgs.Immutable)
+		a.Equal([]ABIArgument{
+			{"Name", fullName},
+			{"s", ABIType{Kind: ABIKindString}},
+			{"i", ABIType{Kind: ABIKindInt, Bits: 64}},
+		}, multiArgs.Arguments)
+		a.Equal([]ABIArgument{
+			{"", ABIType{Kind: ABIKindStruct, Name: "PersonalGreeting", Nullable: true, Fields: []ABIArgument{
+				{"Name", fullName},
+				{"Message", ABIType{Kind: ABIKindString}},
+			}}},
+			{"", ABIType{Kind: ABIKindError}},
+		}, multiArgs.Results)
+
+		a.True(methods["Echo"].NoWait)
+		a.False(methods["Echo"].Immutable)
+		a.True(methods["ConstEcho"].Immutable)
+		a.False(methods["ConstEcho"].NoWait)
+	})
 }
 
 func (s *PreprocessorSuite) TestConstructorsParsing() {
@@ -28,6 +28,8 @@ type ProxyHelper interface {
 	SaveAsDelegate(parentRef, classRef core.RecordRef, constructorName string, argsSerialized []byte) (core.RecordRef, error)
 	GetDelegate(object, ofType core.RecordRef) (core.RecordRef, error)
 	DeactivateObject(object core.RecordRef) error
+	Emit(topic string, payload []byte) error
+	GetObjectMemoryAtPulse(object core.RecordRef, pulse core.PulseNumber) ([]byte, error)
 	Serialize(what interface{}, to *[]byte) error
 	Deserialize(from []byte, into interface{}) error
 	MakeErrorSerializable(error) error
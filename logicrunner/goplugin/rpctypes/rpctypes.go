@@ -159,3 +159,26 @@ type UpDeactivateObjectReq struct {
 // UpDeactivateObjectResp is response from DeactivateObject RPC in goplugin
 type UpDeactivateObjectResp struct {
 }
+
+// UpEmitReq is a set of arguments for Emit RPC in goplugin
+type UpEmitReq struct {
+	UpBaseReq
+	Topic   string
+	Payload []byte
+}
+
+// UpEmitResp is response from Emit RPC in goplugin
+type UpEmitResp struct {
+}
+
+// UpGetObjectMemoryAtPulseReq is a set of arguments for GetObjectMemoryAtPulse RPC in goplugin
+type UpGetObjectMemoryAtPulseReq struct {
+	UpBaseReq
+	Object core.RecordRef
+	Pulse  core.PulseNumber
+}
+
+// UpGetObjectMemoryAtPulseResp is response from GetObjectMemoryAtPulse RPC in goplugin
+type UpGetObjectMemoryAtPulseResp struct {
+	Memory []byte
+}
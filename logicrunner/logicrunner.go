@@ -44,6 +44,9 @@ import (
 
 const maxQueueLength = 10
 
+// drainCheckInterval is how often Leave checks that execution queues are empty.
+const drainCheckInterval = 100 * time.Millisecond
+
 type Ref = core.RecordRef
 
 // Context of one contract execution
@@ -63,6 +66,13 @@ type CurrentExecution struct {
 	RequesterNode *Ref
 	ReturnMode    message.MethodReturnMode
 	SentResult    bool
+	Events        []ContractEvent
+}
+
+// ContractEvent is an event emitted by a contract during current execution.
+type ContractEvent struct {
+	Topic   string
+	Payload []byte
 }
 
 type ExecutionQueueResult struct {
@@ -145,6 +155,10 @@ type LogicRunner struct {
 	state      map[Ref]*ObjectState // if object exists, we are validating or executing it right now
 	stateMutex sync.RWMutex
 
+	// leaving is set when node leave is scheduled, new executions are not accepted after it
+	leaving   bool
+	leaveLock sync.RWMutex
+
 	sock net.Listener
 }
 
@@ -223,6 +237,41 @@ func (lr *LogicRunner) Stop(ctx context.Context) error {
 	return reterr
 }
 
+// Leave stops accepting new executions and waits until queued requests are processed or the ETA pulse comes.
+// Requests left on the ETA pulse are handed off to the next executor by OnPulse.
+func (lr *LogicRunner) Leave(ctx context.Context, ETA core.PulseNumber) error {
+	lr.leaveLock.Lock()
+	lr.leaving = true
+	lr.leaveLock.Unlock()
+
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+	for {
+		if lr.ActiveExecutions(ctx) == 0 {
+			return nil
+		}
+		pulse, err := lr.PulseStorage.Current(ctx)
+		if err != nil {
+			return errors.Wrap(err, "[ Leave ] failed to get current pulse")
+		}
+		if pulse.PulseNumber >= ETA {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "[ Leave ] %d objects still have requests in progress", lr.ActiveExecutions(ctx))
+		}
+	}
+}
+
+func (lr *LogicRunner) isLeaving() bool {
+	lr.leaveLock.RLock()
+	defer lr.leaveLock.RUnlock()
+	return lr.leaving
+}
+
 func (lr *LogicRunner) CheckOurRole(ctx context.Context, msg core.Message, role core.DynamicRole) error {
 	// TODO do map of supported objects for pulse, go to jetCoordinator only if map is empty for ref
 	target := msg.DefaultTarget()
@@ -307,6 +356,11 @@ func (lr *LogicRunner) executeActual(ctx context.Context, parcel core.Parcel, ms
 		return nil, errors.Wrap(err, "[ Execute ] can't play role")
 	}
 
+	if lr.isLeaving() {
+		es.Unlock()
+		return nil, errors.New("[ Execute ] node is leaving the network")
+	}
+
 	if lr.CheckExecutionLoop(ctx, es, parcel) {
 		es.Unlock()
 		return nil, os.WrapError(nil, "loop detected")
@@ -835,6 +889,18 @@ func (lr *LogicRunner) executeMethodCall(ctx context.Context, es *ExecutionState
 		}
 		es.objectbody.objDescriptor = od
 	}
+	if len(es.Current.Events) > 0 {
+		failed, err := resultHasError(result)
+		if err != nil {
+			return nil, es.WrapError(err, "couldn't check method result")
+		}
+		if !failed {
+			err = lr.registerEvents(ctx, es, m.ObjectRef, *current.Request)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	_, err = am.RegisterResult(ctx, m.ObjectRef, *current.Request, result)
 	if err != nil {
 		return nil, es.WrapError(err, "couldn't save results")
@@ -845,6 +911,28 @@ func (lr *LogicRunner) executeMethodCall(ctx context.Context, es *ExecutionState
 	return &reply.CallMethod{Result: result, Request: *current.Request}, nil
 }
 
+// registerEvents saves events emitted during current execution on ledger
+func (lr *LogicRunner) registerEvents(ctx context.Context, es *ExecutionState, obj Ref, request Ref) error {
+	for _, event := range es.Current.Events {
+		_, err := lr.ArtifactManager.RegisterEvent(ctx, obj, request, event.Topic, event.Payload)
+		if err != nil {
+			return es.WrapError(err, "couldn't register event")
+		}
+	}
+	return nil
+}
+
+// resultHasError checks if serialized method result carries contract error,
+// contract methods return error as the last value
+func resultHasError(result []byte) (bool, error) {
+	var values []interface{}
+	err := core.Deserialize(result, &values)
+	if err != nil {
+		return false, errors.Wrap(err, "couldn't deserialize method result")
+	}
+	return len(values) > 0 && values[len(values)-1] != nil, nil
+}
+
 func (lr *LogicRunner) getDescriptorsByPrototypeRef(
 	ctx context.Context, protoRef Ref,
 ) (
@@ -930,6 +1018,10 @@ func (lr *LogicRunner) executeConstructorCall(
 		if err != nil {
 			return nil, es.WrapError(err, "couldn't activate object")
 		}
+		err = lr.registerEvents(ctx, es, *current.Request, *current.Request)
+		if err != nil {
+			return nil, err
+		}
 		_, err = lr.ArtifactManager.RegisterResult(ctx, *current.Request, *current.Request, nil)
 		if err != nil {
 			return nil, es.WrapError(err, "couldn't save results")
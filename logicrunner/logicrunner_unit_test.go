@@ -35,6 +35,7 @@ import (
 	"github.com/insolar/insolar/core/message"
 	"github.com/insolar/insolar/core/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
 	"github.com/insolar/insolar/testutils"
 	"github.com/insolar/insolar/testutils/network"
 )
@@ -494,6 +495,91 @@ func (suite *LogicRunnerTestSuite) TestNoExcessiveAmends() {
 	suite.Require().Equal(uint64(1), suite.am.UpdateObjectCounter)
 }
 
+func (suite *LogicRunnerTestSuite) TestEventsRegisteredOnlyOnSuccess() {
+	objRef := testutils.RandomRef()
+	reqRef := testutils.RandomRef()
+
+	es := &ExecutionState{}
+	es.objectbody = &ObjectBody{Object: []byte{1}, CodeMachineType: core.MachineTypeBuiltin, CodeRef: &objRef}
+	es.Current = &CurrentExecution{
+		LogicContext: &core.LogicCallContext{},
+		Request:      &reqRef,
+		Events:       []ContractEvent{{Topic: "transfer", Payload: []byte("{}")}},
+	}
+
+	mle := testutils.NewMachineLogicExecutorMock(suite.mc)
+	suite.lr.Executors[core.MachineTypeBuiltin] = mle
+	suite.am.RegisterResultMock.Return(nil, nil)
+	suite.am.RegisterEventMock.Set(func(
+		ctx context.Context, obj core.RecordRef, request core.RecordRef, topic string, payload []byte,
+	) (*core.RecordID, error) {
+		suite.Equal(objRef, obj)
+		suite.Equal(reqRef, request)
+		suite.Equal("transfer", topic)
+		return nil, nil
+	})
+
+	msg := &message.CallMethod{ObjectRef: objRef, Method: "some"}
+
+	failed, err := core.Serialize([]interface{}{nil, map[string]string{"S": "contract error"}})
+	suite.Require().NoError(err)
+	mle.CallMethodMock.Return([]byte{1}, failed, nil)
+
+	_, err = suite.lr.executeMethodCall(suite.ctx, es, msg)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(0), suite.am.RegisterEventCounter)
+
+	succeeded, err := core.Serialize([]interface{}{1, nil})
+	suite.Require().NoError(err)
+	mle.CallMethodMock.Return([]byte{1}, succeeded, nil)
+
+	_, err = suite.lr.executeMethodCall(suite.ctx, es, msg)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(1), suite.am.RegisterEventCounter)
+}
+
+func (suite *LogicRunnerTestSuite) TestConstructorEventsRegistered() {
+	protoRef := testutils.RandomRef()
+	codeRef := testutils.RandomRef()
+	reqRef := testutils.RandomRef()
+	callerRef := testutils.RandomRef()
+
+	protoDesc := testutils.NewObjectDescriptorMock(suite.mc)
+	protoDesc.CodeMock.Return(&codeRef, nil)
+	protoDesc.HeadRefMock.Return(&protoRef)
+	codeDesc := testutils.NewCodeDescriptorMock(suite.mc)
+	codeDesc.MachineTypeMock.Return(core.MachineTypeBuiltin)
+	codeDesc.RefMock.Return(&codeRef)
+	suite.am.GetObjectMock.Return(protoDesc, nil)
+	suite.am.GetCodeMock.Return(codeDesc, nil)
+
+	mle := testutils.NewMachineLogicExecutorMock(suite.mc)
+	suite.lr.Executors[core.MachineTypeBuiltin] = mle
+	mle.CallConstructorMock.Return([]byte{1}, nil)
+
+	suite.am.ActivateObjectMock.Return(nil, nil)
+	suite.am.RegisterResultMock.Return(nil, nil)
+	suite.am.RegisterEventMock.Set(func(
+		ctx context.Context, obj core.RecordRef, request core.RecordRef, topic string, payload []byte,
+	) (*core.RecordID, error) {
+		suite.Equal(reqRef, obj)
+		suite.Equal(reqRef, request)
+		return nil, nil
+	})
+
+	es := &ExecutionState{}
+	es.Current = &CurrentExecution{
+		LogicContext: &core.LogicCallContext{Caller: &callerRef},
+		Request:      &reqRef,
+		Events:       []ContractEvent{{Topic: "created", Payload: []byte("{}")}},
+	}
+	msg := &message.CallConstructor{PrototypeRef: protoRef, SaveAs: message.Child}
+
+	_, err := suite.lr.executeConstructorCall(suite.ctx, es, msg)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(1), suite.am.RegisterEventCounter)
+}
+
 func (suite *LogicRunnerTestSuite) TestHandleAbandonedRequestsNotificationMessage() {
 	objectId := testutils.RandomID()
 	msg := &message.AbandonedRequestsNotification{Object: objectId}
@@ -581,6 +667,73 @@ func (suite *LogicRunnerTestSuite) TestNewLogicRunner() {
 	suite.Require().NotNil(lr)
 }
 
+func (suite *LogicRunnerTestSuite) TestActiveExecutions() {
+	suite.Equal(0, suite.lr.ActiveExecutions(suite.ctx))
+
+	idle := suite.lr.UpsertObjectState(testutils.RandomRef())
+	idle.ExecutionState = &ExecutionState{}
+
+	executing := suite.lr.UpsertObjectState(testutils.RandomRef())
+	executing.ExecutionState = &ExecutionState{Current: &CurrentExecution{}}
+
+	queued := suite.lr.UpsertObjectState(testutils.RandomRef())
+	queued.ExecutionState = &ExecutionState{Queue: []ExecutionQueueElement{{}}}
+
+	suite.lr.UpsertObjectState(testutils.RandomRef())
+
+	suite.Equal(2, suite.lr.ActiveExecutions(suite.ctx))
+}
+
+func (suite *LogicRunnerTestSuite) TestLeave() {
+	ETA := core.PulseNumber(core.FirstPulseNumber + 20)
+	current := core.PulseNumber(core.FirstPulseNumber)
+	suite.ps.CurrentFunc = func(context.Context) (*core.Pulse, error) {
+		return &core.Pulse{PulseNumber: current}, nil
+	}
+
+	// nothing to drain
+	suite.Require().NoError(suite.lr.Leave(suite.ctx, ETA))
+
+	// new executions are not accepted
+	objectRef := testutils.RandomRef()
+	suite.jc.MeMock.Return(testutils.RandomRef())
+	suite.jc.IsAuthorizedMock.Return(true, nil)
+	parcel := testutils.NewParcelMock(suite.mc)
+	parcel.DefaultTargetMock.Return(&objectRef)
+	parcel.MessageMock.Return(&message.CallMethod{ObjectRef: objectRef})
+	_, err := suite.lr.Execute(suite.ctx, parcel)
+	suite.Require().EqualError(err, "[ Execute ] node is leaving the network")
+
+	setQueue := func(es *ExecutionState, queue []ExecutionQueueElement) {
+		es.Lock()
+		es.Queue = queue
+		es.Unlock()
+	}
+
+	// waits until queue is processed
+	es := &ExecutionState{Queue: []ExecutionQueueElement{{}}}
+	suite.lr.UpsertObjectState(testutils.RandomRef()).ExecutionState = es
+	go func() {
+		time.Sleep(2 * drainCheckInterval)
+		setQueue(es, nil)
+	}()
+	suite.Require().NoError(suite.lr.Leave(suite.ctx, ETA))
+	suite.Equal(0, suite.lr.ActiveExecutions(suite.ctx))
+
+	// gives up when context is done
+	setQueue(es, []ExecutionQueueElement{{}})
+	ctx, cancel := context.WithTimeout(suite.ctx, 2*drainCheckInterval)
+	defer cancel()
+	err = suite.lr.Leave(ctx, ETA)
+	suite.Require().Error(err)
+	suite.Equal(context.DeadlineExceeded, errors.Cause(err))
+
+	// queue is left for the next executor on the ETA pulse
+	current = ETA
+	suite.Require().NoError(suite.lr.Leave(suite.ctx, ETA))
+	suite.Equal(1, suite.lr.ActiveExecutions(suite.ctx))
+}
+
 func (suite *LogicRunnerTestSuite) TestStartStop() {
 	lr, err := NewLogicRunner(&configuration.LogicRunner{
 		BuiltIn: &configuration.BuiltIn{},
@@ -710,6 +863,42 @@ func (suite *LogicRunnerTestSuite) TestConcurrency() {
 	wg.Wait()
 }
 
+func (suite *LogicRunnerTestSuite) TestGetObjectMemoryAtPulse() {
+	objRef := testutils.RandomRef()
+	target := testutils.RandomRef()
+	current := core.PulseNumber(core.FirstPulseNumber + 10)
+
+	es := suite.lr.UpsertObjectState(objRef)
+	es.ExecutionState = &ExecutionState{
+		Current: &CurrentExecution{
+			Context:      suite.ctx,
+			LogicContext: &core.LogicCallContext{Pulse: core.Pulse{PulseNumber: current}},
+		},
+	}
+
+	rpc := &RPC{lr: suite.lr, ps: suite.ps}
+	req := rpctypes.UpGetObjectMemoryAtPulseReq{
+		UpBaseReq: rpctypes.UpBaseReq{Mode: "execution", Callee: objRef},
+		Object:    target,
+	}
+
+	for _, pn := range []core.PulseNumber{current, current + 1} {
+		req.Pulse = pn
+		err := rpc.GetObjectMemoryAtPulse(req, &rpctypes.UpGetObjectMemoryAtPulseResp{})
+		suite.Require().Error(err)
+	}
+	suite.Require().Zero(suite.am.GetObjectAtPulseCounter)
+
+	desc := testutils.NewObjectDescriptorMock(suite.mc)
+	desc.MemoryMock.Return([]byte{1, 2, 3})
+	suite.am.GetObjectAtPulseMock.ExpectOnce(suite.ctx, target, current-1).Return(desc, nil)
+	req.Pulse = current - 1
+	resp := rpctypes.UpGetObjectMemoryAtPulseResp{}
+	err := rpc.GetObjectMemoryAtPulse(req, &resp)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]byte{1, 2, 3}, resp.Memory)
+}
+
 func TestLogicRunner(t *testing.T) {
 	t.Parallel()
 	suite.Run(t, new(LogicRunnerTestSuite))
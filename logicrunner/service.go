@@ -284,3 +284,43 @@ func (gpr *RPC) DeactivateObject(req rpctypes.UpDeactivateObjectReq, rep *rpctyp
 	es.deactivate = true
 	return nil
 }
+
+// Emit is an RPC saving event emitted by a contract, events are registered on ledger after successful execution
+func (gpr *RPC) Emit(req rpctypes.UpEmitReq, rep *rpctypes.UpEmitResp) (err error) {
+	defer recoverRPC(&err)
+
+	if req.Topic == "" {
+		return errors.New("event topic is empty")
+	}
+
+	os := gpr.lr.MustObjectState(req.Callee)
+	es := os.MustModeState(req.Mode)
+	if es.Current == nil {
+		return errors.New("no current execution to emit event from")
+	}
+	es.Current.Events = append(es.Current.Events, ContractEvent{Topic: req.Topic, Payload: req.Payload})
+	return nil
+}
+
+// GetObjectMemoryAtPulse is an RPC returning memory of the latest object state not newer than provided pulse
+func (gpr *RPC) GetObjectMemoryAtPulse(
+	req rpctypes.UpGetObjectMemoryAtPulseReq, rep *rpctypes.UpGetObjectMemoryAtPulseResp,
+) (err error) {
+	defer recoverRPC(&err)
+
+	os := gpr.lr.MustObjectState(req.Callee)
+	es := os.MustModeState(req.Mode)
+	ctx := es.Current.Context
+
+	// States of the current and later pulses can change, so they can't be read deterministically.
+	if current := es.Current.LogicContext.Pulse.PulseNumber; req.Pulse >= current {
+		return errors.Errorf("[ GetObjectMemoryAtPulse ] pulse %v is not older than current pulse %v", req.Pulse, current)
+	}
+
+	desc, err := gpr.lr.ArtifactManager.GetObjectAtPulse(ctx, req.Object, req.Pulse)
+	if err != nil {
+		return errors.Wrap(err, "[ GetObjectMemoryAtPulse ] Can't get object")
+	}
+	rep.Memory = desc.Memory()
+	return nil
+}
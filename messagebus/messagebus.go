@@ -199,6 +199,10 @@ func (mb *MessageBus) SendParcel(
 
 	stats.Record(ctx, statParcelsSentTotal.M(1))
 
+	if len(nodes) > 1 && !deliveredToAll(parcel.Type()) {
+		nodes = mb.Network.PreferHealthy(nodes)[:1]
+	}
+
 	if len(nodes) > 1 {
 		cascade := core.Cascade{
 			NodeIds:           nodes,
@@ -224,6 +228,12 @@ func (mb *MessageBus) SendParcel(
 	return reply.Deserialize(bytes.NewBuffer(res))
 }
 
+// deliveredToAll returns true for messages which are delivered to every node of the role by cascade.
+// Other messages are sent to the healthiest node when the role has several nodes.
+func deliveredToAll(msgType core.MessageType) bool {
+	return msgType == core.TypeValidateCaseBind
+}
+
 type serializableError struct {
 	S string
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/core/message"
+	"github.com/insolar/insolar/core/reply"
 	"github.com/insolar/insolar/testutils"
 	"github.com/insolar/insolar/testutils/network"
 )
@@ -173,3 +174,55 @@ func TestMessageBus_doDeliver_TwoAheadPulses(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, core.PulseNumber(102), pulse.PulseNumber)
 }
+
+type healthNetwork struct {
+	core.Network
+	healthy  core.RecordRef
+	sentTo   []core.RecordRef
+	cascaded bool
+}
+
+func (n *healthNetwork) PreferHealthy(nodes []core.RecordRef) []core.RecordRef {
+	result := []core.RecordRef{n.healthy}
+	for _, ref := range nodes {
+		if ref != n.healthy {
+			result = append(result, ref)
+		}
+	}
+	return result
+}
+
+func (n *healthNetwork) SendMessage(nodeID core.RecordRef, method string, msg core.Parcel) ([]byte, error) {
+	n.sentTo = append(n.sentTo, nodeID)
+	return reply.ToBytes(&reply.OK{}), nil
+}
+
+func (n *healthNetwork) SendCascadeMessage(data core.Cascade, method string, msg core.Parcel) error {
+	n.cascaded = true
+	return nil
+}
+
+func TestMessageBus_Send_PrefersHealthyNode(t *testing.T) {
+	ctx := context.Background()
+	mb, _, _ := prepare(t, ctx, 100, 100)
+
+	validators := []core.RecordRef{testutils.RandomRef(), testutils.RandomRef(), testutils.RandomRef()}
+	net := &healthNetwork{healthy: validators[2]}
+	mb.Network = net
+	mb.JetCoordinator.(*testutils.JetCoordinatorMock).QueryRoleMock.Return(validators, nil)
+	mb.ParcelFactory.(*parcelFactory).Cryptography.(*testutils.CryptographyServiceMock).SignMock.Return(
+		&core.Signature{}, nil,
+	)
+
+	rep, err := mb.Send(ctx, &message.ValidationResults{RecordRef: testutils.RandomRef()}, nil)
+	require.NoError(t, err)
+	require.Equal(t, &reply.OK{}, rep)
+	require.Equal(t, []core.RecordRef{validators[2]}, net.sentTo)
+	require.False(t, net.cascaded)
+
+	// case bind is validated by all validators
+	_, err = mb.Send(ctx, &message.ValidateCaseBind{RecordRef: testutils.RandomRef()}, nil)
+	require.NoError(t, err)
+	require.True(t, net.cascaded)
+	require.Len(t, net.sentTo, 1)
+}
@@ -18,8 +18,8 @@
 package bootstrap
 
 import (
+	"bytes"
 	"context"
-	"encoding/gob"
 	"fmt"
 	"time"
 
@@ -31,6 +31,7 @@ import (
 	"github.com/insolar/insolar/instrumentation/instracer"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/controller/common"
+	"github.com/insolar/insolar/network/transport/packet"
 	"github.com/insolar/insolar/network/transport/packet/types"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/pkg/errors"
@@ -49,9 +50,10 @@ type AuthorizationController interface {
 }
 
 type authorizationController struct {
-	NodeKeeper         network.NodeKeeper      `inject:""`
-	NetworkCoordinator core.NetworkCoordinator `inject:""`
-	SessionManager     SessionManager          `inject:""`
+	NodeKeeper         network.NodeKeeper       `inject:""`
+	NetworkCoordinator core.NetworkCoordinator  `inject:""`
+	SessionManager     SessionManager           `inject:""`
+	Cryptography       core.CryptographyService `inject:""`
 
 	options   *common.Options
 	transport network.InternalTransport
@@ -91,11 +93,82 @@ type RegistrationResponse struct {
 	Error   string
 }
 
+// Encode implements packet.Payload interface.
+func (r *AuthorizationRequest) Encode(e *packet.Encoder) {
+	e.WriteBytes(r.Certificate)
+}
+
+// Decode implements packet.Payload interface.
+func (r *AuthorizationRequest) Decode(d *packet.Decoder) {
+	r.Certificate = d.ReadBytes()
+}
+
+// Encode implements packet.Payload interface.
+func (r *AuthorizationResponse) Encode(e *packet.Encoder) {
+	e.WriteUint8(uint8(r.Code))
+	e.WriteString(r.Error)
+	e.WriteUint64(uint64(r.SessionID))
+}
+
+// Decode implements packet.Payload interface.
+func (r *AuthorizationResponse) Decode(d *packet.Decoder) {
+	r.Code = OperationCode(d.ReadUint8())
+	r.Error = d.ReadString()
+	r.SessionID = SessionID(d.ReadUint64())
+}
+
+// Encode implements packet.Payload interface.
+func (r *RegistrationRequest) Encode(e *packet.Encoder) {
+	e.WriteUint64(uint64(r.SessionID))
+	e.WriteString(r.Version)
+	e.WriteBool(r.JoinClaim != nil)
+	if r.JoinClaim == nil {
+		return
+	}
+	claim, err := r.JoinClaim.Serialize()
+	if err != nil {
+		e.Fail(errors.Wrap(err, "failed to serialize join claim"))
+		return
+	}
+	e.WriteBytes(claim)
+}
+
+// Decode implements packet.Payload interface.
+func (r *RegistrationRequest) Decode(d *packet.Decoder) {
+	r.SessionID = SessionID(d.ReadUint64())
+	r.Version = d.ReadString()
+	if !d.ReadBool() {
+		return
+	}
+	claim := d.ReadBytes()
+	if d.Err() != nil {
+		return
+	}
+	r.JoinClaim = &packets.NodeJoinClaim{}
+	if err := r.JoinClaim.Deserialize(bytes.NewReader(claim)); err != nil {
+		d.Fail(errors.Wrap(err, "failed to deserialize join claim"))
+	}
+}
+
+// Encode implements packet.Payload interface.
+func (r *RegistrationResponse) Encode(e *packet.Encoder) {
+	e.WriteUint8(uint8(r.Code))
+	e.WriteInt64(int64(r.RetryIn))
+	e.WriteString(r.Error)
+}
+
+// Decode implements packet.Payload interface.
+func (r *RegistrationResponse) Decode(d *packet.Decoder) {
+	r.Code = OperationCode(d.ReadUint8())
+	r.RetryIn = time.Duration(d.ReadInt64())
+	r.Error = d.ReadString()
+}
+
 func init() {
-	gob.Register(&AuthorizationRequest{})
-	gob.Register(&AuthorizationResponse{})
-	gob.Register(&RegistrationRequest{})
-	gob.Register(&RegistrationResponse{})
+	packet.RegisterRequest(types.Authorize, func() packet.Payload { return &AuthorizationRequest{} })
+	packet.RegisterResponse(types.Authorize, func() packet.Payload { return &AuthorizationResponse{} })
+	packet.RegisterRequest(types.Register, func() packet.Payload { return &RegistrationRequest{} })
+	packet.RegisterResponse(types.Register, func() packet.Payload { return &RegistrationResponse{} })
 }
 
 // Authorize node on the discovery node (step 2 of the bootstrap process)
@@ -201,10 +274,29 @@ func (ac *authorizationController) getSession(sessionID SessionID, claim *packet
 	if !claim.NodeRef.Equal(session.NodeID) {
 		return nil, errors.New("Claim node ID is not equal to session node ID")
 	}
-	// TODO: check claim signature
+	err = ac.checkClaimSignature(claim)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to check claim signature")
+	}
 	return session, nil
 }
 
+// checkClaimSignature checks join claim signature, so the claim can be safely sent to other nodes in consensus.
+func (ac *authorizationController) checkClaimSignature(claim *packets.NodeJoinClaim) error {
+	key, err := claim.GetPublicKey()
+	if err != nil {
+		return errors.Wrap(err, "Failed to import public key from claim")
+	}
+	rawClaim, err := claim.SerializeRaw()
+	if err != nil {
+		return errors.Wrap(err, "Failed to serialize claim")
+	}
+	if !ac.Cryptography.Verify(key, core.SignatureFromBytes(claim.GetSignature()), rawClaim) {
+		return errors.New("Claim signature verification failed")
+	}
+	return nil
+}
+
 func (ac *authorizationController) processRegisterRequest(ctx context.Context, request network.Request) (network.Response, error) {
 	data := request.GetData().(*RegistrationRequest)
 	if data.Version != ac.NodeKeeper.GetOrigin().Version() {
@@ -19,7 +19,6 @@ package bootstrap
 
 import (
 	"context"
-	"encoding/gob"
 	"fmt"
 	"math"
 	"strings"
@@ -36,6 +35,7 @@ import (
 	"github.com/insolar/insolar/network/controller/pinger"
 	"github.com/insolar/insolar/network/nodenetwork"
 	"github.com/insolar/insolar/network/transport/host"
+	"github.com/insolar/insolar/network/transport/packet"
 	"github.com/insolar/insolar/network/transport/packet/types"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/pkg/errors"
@@ -100,12 +100,18 @@ func (bc *bootstrapper) setRequest(ref core.RecordRef, req *GenesisRequest) {
 	bc.genesisRequestsReceived[ref] = req
 }
 
-type NodeBootstrapRequest struct{}
+type NodeBootstrapRequest struct {
+	// MinProtocolVersion and ProtocolVersion are the range of packet protocol versions supported by the node
+	MinProtocolVersion uint16
+	ProtocolVersion    uint16
+}
 
 type NodeBootstrapResponse struct {
 	Code         Code
 	RedirectHost string
 	RejectReason string
+	// ProtocolVersion is the packet protocol version chosen by the discovery node
+	ProtocolVersion uint16
 	// FirstPulseTimeUnix int64
 }
 
@@ -171,13 +177,87 @@ const (
 	ReconnectRequired
 )
 
+// Encode implements packet.Payload interface.
+func (r *NodeBootstrapRequest) Encode(e *packet.Encoder) {
+	e.WriteUint16(r.MinProtocolVersion)
+	e.WriteUint16(r.ProtocolVersion)
+}
+
+// Decode implements packet.Payload interface.
+func (r *NodeBootstrapRequest) Decode(d *packet.Decoder) {
+	r.MinProtocolVersion = d.ReadUint16()
+	r.ProtocolVersion = d.ReadUint16()
+}
+
+// Encode implements packet.Payload interface.
+func (r *NodeBootstrapResponse) Encode(e *packet.Encoder) {
+	e.WriteUint8(uint8(r.Code))
+	e.WriteString(r.RedirectHost)
+	e.WriteString(r.RejectReason)
+	e.WriteUint16(r.ProtocolVersion)
+}
+
+// Decode implements packet.Payload interface.
+func (r *NodeBootstrapResponse) Decode(d *packet.Decoder) {
+	r.Code = Code(d.ReadUint8())
+	r.RedirectHost = d.ReadString()
+	r.RejectReason = d.ReadString()
+	r.ProtocolVersion = d.ReadUint16()
+}
+
+// Encode implements packet.Payload interface.
+func (r *GenesisRequest) Encode(e *packet.Encoder) {
+	e.WriteUint32(uint32(r.LastPulse))
+	e.WriteBool(r.Discovery != nil)
+	if r.Discovery != nil {
+		r.Discovery.encode(e)
+	}
+}
+
+// Decode implements packet.Payload interface.
+func (r *GenesisRequest) Decode(d *packet.Decoder) {
+	r.LastPulse = core.PulseNumber(d.ReadUint32())
+	if d.ReadBool() {
+		r.Discovery = &NodeStruct{}
+		r.Discovery.decode(d)
+	}
+}
+
+// Encode implements packet.Payload interface.
+func (r *GenesisResponse) Encode(e *packet.Encoder) {
+	r.Response.Encode(e)
+	e.WriteString(r.Error)
+}
+
+// Decode implements packet.Payload interface.
+func (r *GenesisResponse) Decode(d *packet.Decoder) {
+	r.Response.Decode(d)
+	r.Error = d.ReadString()
+}
+
+func (n *NodeStruct) encode(e *packet.Encoder) {
+	e.WriteRef(n.ID)
+	e.WriteUint32(uint32(n.SID))
+	e.WriteUint32(uint32(n.Role))
+	e.WriteBytes(n.PK)
+	e.WriteString(n.Address)
+	e.WriteString(n.Version)
+}
+
+func (n *NodeStruct) decode(d *packet.Decoder) {
+	n.ID = d.ReadRef()
+	n.SID = core.ShortNodeID(d.ReadUint32())
+	n.Role = core.StaticRole(d.ReadUint32())
+	n.PK = d.ReadBytes()
+	n.Address = d.ReadString()
+	n.Version = d.ReadString()
+}
+
 func init() {
-	gob.Register(&NodeBootstrapRequest{})
-	gob.Register(&NodeBootstrapResponse{})
-	gob.Register(&StartSessionRequest{})
-	gob.Register(&StartSessionResponse{})
-	gob.Register(&GenesisRequest{})
-	gob.Register(&GenesisResponse{})
+	packet.RegisterRequest(types.Bootstrap, func() packet.Payload { return &NodeBootstrapRequest{} })
+	packet.RegisterResponse(types.Bootstrap, func() packet.Payload { return &NodeBootstrapResponse{} })
+	packet.RegisterRequest(types.Genesis, func() packet.Payload { return &GenesisRequest{} })
+	packet.RegisterResponse(types.Genesis, func() packet.Payload { return &GenesisResponse{} })
 }
 
 // Bootstrap on the discovery node (step 1 of the bootstrap process)
@@ -480,7 +560,10 @@ func (bc *bootstrapper) startBootstrap(ctx context.Context, address string) (*ne
 	if err != nil {
 		return nil, errors.Wrapf(err, "Failed to ping address %s", address)
 	}
-	request := bc.transport.NewRequestBuilder().Type(types.Bootstrap).Data(&NodeBootstrapRequest{}).Build()
+	request := bc.transport.NewRequestBuilder().Type(types.Bootstrap).Data(&NodeBootstrapRequest{
+		MinProtocolVersion: packet.MinProtocolVersion,
+		ProtocolVersion:    packet.ProtocolVersion,
+	}).Build()
 	future, err := bc.transport.SendRequestPacket(ctx, request, bootstrapHost)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Failed to send bootstrap request to address %s", address)
@@ -496,6 +579,10 @@ func (bc *bootstrapper) startBootstrap(ctx context.Context, address string) (*ne
 	case Redirected:
 		return bootstrap(ctx, data.RedirectHost, bc.options, bc.startBootstrap)
 	}
+	if !packet.IsSupportedVersion(data.ProtocolVersion) {
+		return nil, errors.Errorf("Discovery node %s chose unsupported protocol version %d", address, data.ProtocolVersion)
+	}
+	bc.transport.SetProtocolVersion(bootstrapHost.Address.String(), data.ProtocolVersion)
 	return &network.BootstrapResult{
 		// FirstPulseTime:    time.Unix(data.FirstPulseTimeUnix, 0),
 		Host:              response.GetSenderHost(),
@@ -504,6 +591,16 @@ func (bc *bootstrapper) startBootstrap(ctx context.Context, address string) (*ne
 }
 
 func (bc *bootstrapper) processBootstrap(ctx context.Context, request network.Request) (network.Response, error) {
+	data := request.GetData().(*NodeBootstrapRequest)
+	version, err := packet.NegotiateVersion(data.MinProtocolVersion, data.ProtocolVersion)
+	if err != nil {
+		inslogger.FromContext(ctx).Warnf("Rejecting bootstrap of %s: %s", request.GetSender(), err.Error())
+		return bc.transport.BuildResponse(ctx, request, &NodeBootstrapResponse{
+			Code:         Rejected,
+			RejectReason: err.Error(),
+		}), nil
+	}
+	bc.transport.SetProtocolVersion(request.GetSenderHost().Address.String(), version)
 	// TODO: redirect logic
 	var code Code
 	if bc.NetworkSwitcher.GetState() == core.CompleteNetworkState {
@@ -513,7 +610,8 @@ func (bc *bootstrapper) processBootstrap(ctx context.Context, request network.Re
 	}
 	return bc.transport.BuildResponse(ctx, request,
 		&NodeBootstrapResponse{
-			Code: code,
+			Code:            code,
+			ProtocolVersion: version,
 			// FirstPulseTimeUnix: bc.firstPulseTime.Unix(),
 		}), nil
 }
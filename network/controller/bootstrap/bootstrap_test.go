@@ -18,14 +18,22 @@
 package bootstrap
 
 import (
+	"bytes"
 	"context"
 	"testing"
 	"time"
 
+	"github.com/insolar/insolar/consensus/packets"
+	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/controller/common"
+	"github.com/insolar/insolar/network/transport/host"
+	"github.com/insolar/insolar/network/transport/packet"
+	"github.com/insolar/insolar/network/transport/packet/types"
+	"github.com/insolar/insolar/testutils"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func getOptions(infinity bool) *common.Options {
@@ -68,3 +76,105 @@ func TestBootstrap(t *testing.T) {
 	assert.NoError(t, err)
 	assert.WithinDuration(t, expectedTime.Round(time.Millisecond), endTime.Round(time.Millisecond), time.Millisecond*100)
 }
+
+func TestPayloads_RoundTrip(t *testing.T) {
+	sender, err := host.NewHostN("127.0.0.1:31337", testutils.RandomRef())
+	require.NoError(t, err)
+	builder := packet.NewBuilder(sender).Receiver(sender)
+
+	discovery := &NodeStruct{
+		ID:      testutils.RandomRef(),
+		SID:     12,
+		Role:    core.StaticRoleVirtual,
+		PK:      []byte{1, 2, 3},
+		Address: "127.0.0.1:31337",
+		Version: "v1",
+	}
+	claim := &packets.NodeJoinClaim{
+		ShortNodeID:   12,
+		JoinsAfter:    5,
+		NodeRoleRecID: core.StaticRoleVirtual,
+		NodeRef:       testutils.RandomRef(),
+	}
+	claim.NodePK[0] = 1
+	claim.Signature[0] = 2
+
+	msgs := []*packet.Packet{
+		builder.Type(types.Bootstrap).Request(&NodeBootstrapRequest{MinProtocolVersion: 1, ProtocolVersion: 2}).Build(),
+		builder.Type(types.Bootstrap).Response(&NodeBootstrapResponse{Code: Redirected, RedirectHost: "host", ProtocolVersion: 1}).Build(),
+		builder.Type(types.Genesis).Request(&GenesisRequest{LastPulse: core.FirstPulseNumber, Discovery: discovery}).Build(),
+		builder.Type(types.Genesis).Response(&GenesisResponse{Response: GenesisRequest{Discovery: discovery}}).Build(),
+		builder.Type(types.Authorize).Request(&AuthorizationRequest{Certificate: []byte("cert")}).Build(),
+		builder.Type(types.Authorize).Response(&AuthorizationResponse{Code: OpConfirmed, SessionID: 42}).Build(),
+		builder.Type(types.Register).Request(&RegistrationRequest{SessionID: 42, Version: "v1", JoinClaim: claim}).Build(),
+		builder.Type(types.Register).Response(&RegistrationResponse{Code: OpRetry, RetryIn: time.Second}).Build(),
+		builder.Type(types.Challenge1).Request(&ChallengeRequest{SessionID: 42, Nonce: Nonce{1}}).Build(),
+		builder.Type(types.Challenge1).Response(&SignedChallengeResponse{
+			Header:  ChallengeResponseHeader{Success: true},
+			Payload: &SignedChallengePayload{SignedNonce: SignedNonce{1}, XorDiscoveryNonce: Nonce{2}, DiscoveryNonce: Nonce{3}},
+		}).Build(),
+		builder.Type(types.Challenge2).Request(&SignedChallengeRequest{SessionID: 42, SignedDiscoveryNonce: SignedNonce{1}}).Build(),
+		builder.Type(types.Challenge2).Response(&ChallengeResponse{Header: ChallengeResponseHeader{Error: "error"}}).Build(),
+	}
+	for _, msg := range msgs {
+		serialized, err := packet.SerializePacket(msg)
+		require.NoError(t, err)
+		deserialized, err := packet.DeserializePacket(bytes.NewReader(serialized))
+		require.NoError(t, err)
+		assert.Equal(t, msg, deserialized)
+	}
+}
+
+type versionTransport struct {
+	network.InternalTransport
+	versions map[string]uint16
+}
+
+func (t *versionTransport) SetProtocolVersion(address string, version uint16) {
+	t.versions[address] = version
+}
+
+func (t *versionTransport) BuildResponse(ctx context.Context, request network.Request, responseData interface{}) network.Response {
+	return &testPacket{data: responseData}
+}
+
+type testPacket struct {
+	sender *host.Host
+	data   interface{}
+}
+
+func (p *testPacket) GetSender() core.RecordRef       { return p.sender.NodeID }
+func (p *testPacket) GetSenderHost() *host.Host       { return p.sender }
+func (p *testPacket) GetType() types.PacketType       { return types.Bootstrap }
+func (p *testPacket) GetData() interface{}            { return p.data }
+func (p *testPacket) GetRequestID() network.RequestID { return 1 }
+
+func TestBootstrapper_processBootstrap_StoresVersion(t *testing.T) {
+	ctx := context.Background()
+	tp := &versionTransport{versions: make(map[string]uint16)}
+	switcher := testutils.NewNetworkSwitcherMock(t)
+	switcher.GetStateMock.Return(core.NoNetworkState)
+	bc := &bootstrapper{transport: tp, NetworkSwitcher: switcher}
+
+	sender, err := host.NewHostN("127.0.0.1:31337", testutils.RandomRef())
+	require.NoError(t, err)
+	response, err := bc.processBootstrap(ctx, &testPacket{sender: sender, data: &NodeBootstrapRequest{
+		MinProtocolVersion: packet.MinProtocolVersion,
+		ProtocolVersion:    packet.ProtocolVersion + 1,
+	}})
+	require.NoError(t, err)
+	data := response.GetData().(*NodeBootstrapResponse)
+	assert.Equal(t, Accepted, data.Code)
+	assert.Equal(t, packet.ProtocolVersion, data.ProtocolVersion)
+	assert.Equal(t, map[string]uint16{"127.0.0.1:31337": packet.ProtocolVersion}, tp.versions)
+
+	unsupported, err := host.NewHostN("127.0.0.1:31338", testutils.RandomRef())
+	require.NoError(t, err)
+	response, err = bc.processBootstrap(ctx, &testPacket{sender: unsupported, data: &NodeBootstrapRequest{
+		MinProtocolVersion: packet.ProtocolVersion + 1,
+		ProtocolVersion:    packet.ProtocolVersion + 2,
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, Rejected, response.GetData().(*NodeBootstrapResponse).Code)
+	assert.NotContains(t, tp.versions, "127.0.0.1:31338")
+}
@@ -19,7 +19,6 @@ package bootstrap
 
 import (
 	"context"
-	"encoding/gob"
 
 	"github.com/insolar/insolar/component"
 	"github.com/insolar/insolar/core"
@@ -29,6 +28,7 @@ import (
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/controller/common"
 	"github.com/insolar/insolar/network/transport/host"
+	"github.com/insolar/insolar/network/transport/packet"
 	"github.com/insolar/insolar/network/transport/packet/types"
 	base58 "github.com/jbenet/go-base58"
 	"github.com/pkg/errors"
@@ -99,11 +99,87 @@ type ChallengePayload struct {
 	AssignShortID core.ShortNodeID
 }
 
+func (h *ChallengeResponseHeader) encode(e *packet.Encoder) {
+	e.WriteBool(h.Success)
+	e.WriteString(h.Error)
+}
+
+func (h *ChallengeResponseHeader) decode(d *packet.Decoder) {
+	h.Success = d.ReadBool()
+	h.Error = d.ReadString()
+}
+
+// Encode implements packet.Payload interface.
+func (r *ChallengeRequest) Encode(e *packet.Encoder) {
+	e.WriteUint64(uint64(r.SessionID))
+	e.WriteBytes(r.Nonce)
+}
+
+// Decode implements packet.Payload interface.
+func (r *ChallengeRequest) Decode(d *packet.Decoder) {
+	r.SessionID = SessionID(d.ReadUint64())
+	r.Nonce = d.ReadBytes()
+}
+
+// Encode implements packet.Payload interface.
+func (r *SignedChallengeResponse) Encode(e *packet.Encoder) {
+	r.Header.encode(e)
+	e.WriteBool(r.Payload != nil)
+	if r.Payload != nil {
+		e.WriteBytes(r.Payload.SignedNonce)
+		e.WriteBytes(r.Payload.XorDiscoveryNonce)
+		e.WriteBytes(r.Payload.DiscoveryNonce)
+	}
+}
+
+// Decode implements packet.Payload interface.
+func (r *SignedChallengeResponse) Decode(d *packet.Decoder) {
+	r.Header.decode(d)
+	if d.ReadBool() {
+		r.Payload = &SignedChallengePayload{
+			SignedNonce:       d.ReadBytes(),
+			XorDiscoveryNonce: d.ReadBytes(),
+			DiscoveryNonce:    d.ReadBytes(),
+		}
+	}
+}
+
+// Encode implements packet.Payload interface.
+func (r *SignedChallengeRequest) Encode(e *packet.Encoder) {
+	e.WriteUint64(uint64(r.SessionID))
+	e.WriteBytes(r.SignedDiscoveryNonce)
+	e.WriteBytes(r.XorNonce)
+}
+
+// Decode implements packet.Payload interface.
+func (r *SignedChallengeRequest) Decode(d *packet.Decoder) {
+	r.SessionID = SessionID(d.ReadUint64())
+	r.SignedDiscoveryNonce = d.ReadBytes()
+	r.XorNonce = d.ReadBytes()
+}
+
+// Encode implements packet.Payload interface.
+func (r *ChallengeResponse) Encode(e *packet.Encoder) {
+	r.Header.encode(e)
+	e.WriteBool(r.Payload != nil)
+	if r.Payload != nil {
+		e.WriteUint32(uint32(r.Payload.AssignShortID))
+	}
+}
+
+// Decode implements packet.Payload interface.
+func (r *ChallengeResponse) Decode(d *packet.Decoder) {
+	r.Header.decode(d)
+	if d.ReadBool() {
+		r.Payload = &ChallengePayload{AssignShortID: core.ShortNodeID(d.ReadUint32())}
+	}
+}
+
 func init() {
-	gob.Register(&ChallengeRequest{})
-	gob.Register(&SignedChallengeResponse{})
-	gob.Register(&SignedChallengeRequest{})
-	gob.Register(&ChallengeResponse{})
+	packet.RegisterRequest(types.Challenge1, func() packet.Payload { return &ChallengeRequest{} })
+	packet.RegisterResponse(types.Challenge1, func() packet.Payload { return &SignedChallengeResponse{} })
+	packet.RegisterRequest(types.Challenge2, func() packet.Payload { return &SignedChallengeRequest{} })
+	packet.RegisterResponse(types.Challenge2, func() packet.Payload { return &ChallengeResponse{} })
 }
 
 func (cr *challengeResponseController) processChallenge1(ctx context.Context, request network.Request) (network.Response, error) {
@@ -19,7 +19,6 @@ package controller
 
 import (
 	"context"
-	"encoding/gob"
 	"fmt"
 	"strings"
 	"time"
@@ -37,6 +36,7 @@ import (
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/cascade"
 	"github.com/insolar/insolar/network/controller/common"
+	"github.com/insolar/insolar/network/transport/packet"
 	"github.com/insolar/insolar/network/transport/packet/types"
 )
 
@@ -81,11 +81,86 @@ type ResponseCascade struct {
 	Error   string
 }
 
+// Encode implements packet.Payload interface.
+func (r *RequestRPC) Encode(e *packet.Encoder) {
+	e.WriteString(r.Method)
+	e.WriteCount(len(r.Data))
+	for _, data := range r.Data {
+		e.WriteBytes(data)
+	}
+}
+
+// Decode implements packet.Payload interface.
+func (r *RequestRPC) Decode(d *packet.Decoder) {
+	r.Method = d.ReadString()
+	count := d.ReadCount()
+	if count == 0 {
+		return
+	}
+	r.Data = make([][]byte, count)
+	for i := 0; i < count && d.Err() == nil; i++ {
+		r.Data[i] = d.ReadBytes()
+	}
+}
+
+// Encode implements packet.Payload interface.
+func (r *ResponseRPC) Encode(e *packet.Encoder) {
+	e.WriteBool(r.Success)
+	e.WriteBytes(r.Result)
+	e.WriteString(r.Error)
+}
+
+// Decode implements packet.Payload interface.
+func (r *ResponseRPC) Decode(d *packet.Decoder) {
+	r.Success = d.ReadBool()
+	r.Result = d.ReadBytes()
+	r.Error = d.ReadString()
+}
+
+// Encode implements packet.Payload interface.
+func (r *RequestCascade) Encode(e *packet.Encoder) {
+	e.WriteString(r.TraceID)
+	r.RPC.Encode(e)
+	e.WriteCount(len(r.Cascade.NodeIds))
+	for _, id := range r.Cascade.NodeIds {
+		e.WriteRef(id)
+	}
+	e.WriteFixed(r.Cascade.Entropy[:])
+	e.WriteUint64(uint64(r.Cascade.ReplicationFactor))
+}
+
+// Decode implements packet.Payload interface.
+func (r *RequestCascade) Decode(d *packet.Decoder) {
+	r.TraceID = d.ReadString()
+	r.RPC.Decode(d)
+	count := d.ReadCount()
+	if count > 0 {
+		r.Cascade.NodeIds = make([]core.RecordRef, count)
+		for i := 0; i < count && d.Err() == nil; i++ {
+			r.Cascade.NodeIds[i] = d.ReadRef()
+		}
+	}
+	d.ReadFixed(r.Cascade.Entropy[:])
+	r.Cascade.ReplicationFactor = uint(d.ReadUint64())
+}
+
+// Encode implements packet.Payload interface.
+func (r *ResponseCascade) Encode(e *packet.Encoder) {
+	e.WriteBool(r.Success)
+	e.WriteString(r.Error)
+}
+
+// Decode implements packet.Payload interface.
+func (r *ResponseCascade) Decode(d *packet.Decoder) {
+	r.Success = d.ReadBool()
+	r.Error = d.ReadString()
+}
+
 func init() {
-	gob.Register(&RequestRPC{})
-	gob.Register(&ResponseRPC{})
-	gob.Register(&RequestCascade{})
-	gob.Register(&ResponseCascade{})
+	packet.RegisterRequest(types.RPC, func() packet.Payload { return &RequestRPC{} })
+	packet.RegisterResponse(types.RPC, func() packet.Payload { return &ResponseRPC{} })
+	packet.RegisterRequest(types.Cascade, func() packet.Payload { return &RequestCascade{} })
+	packet.RegisterResponse(types.Cascade, func() packet.Payload { return &ResponseCascade{} })
 }
 
 func (rpc *rpcController) IAmRPCController() {
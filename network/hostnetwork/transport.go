@@ -152,8 +152,9 @@ func (h *hostTransport) BuildResponse(ctx context.Context, request network.Reque
 	return (*packetWrapper)(p)
 }
 
-func NewInternalTransport(conf configuration.Configuration, nodeRef string) (network.InternalTransport, error) {
-	tp, err := transport.NewTransport(conf.Host.Transport, relay.NewProxy())
+// NewInternalTransport creates host transport, identity is used if TLS is enabled in transport configuration.
+func NewInternalTransport(conf configuration.Configuration, nodeRef string, identity *transport.TLSIdentity) (network.InternalTransport, error) {
+	tp, err := transport.NewSecureTransport(conf.Host.Transport, relay.NewProxy(), identity)
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating transport")
 	}
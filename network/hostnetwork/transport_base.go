@@ -29,6 +29,7 @@ import (
 	"github.com/insolar/insolar/network/transport"
 	"github.com/insolar/insolar/network/transport/host"
 	"github.com/insolar/insolar/network/transport/packet"
+	"github.com/insolar/insolar/network/transport/pool"
 	"github.com/pkg/errors"
 )
 
@@ -83,6 +84,16 @@ func (h *transportBase) buildRequest(ctx context.Context, request network.Reques
 		Request(request.GetData()).TraceID(inslogger.TraceID(ctx)).Build()
 }
 
+// SetProtocolVersion sets packet protocol version negotiated with the remote address.
+func (h *transportBase) SetProtocolVersion(address string, version uint16) {
+	h.transport.SetProtocolVersion(address, version)
+}
+
+// PeerStats returns health state of connection to the remote address.
+func (h *transportBase) PeerStats(address string) (pool.PeerStats, bool) {
+	return h.transport.PeerStats(address)
+}
+
 // PublicAddress returns public address that can be published for all nodes.
 func (h *transportBase) PublicAddress() string {
 	return h.origin.Address.String()
@@ -19,7 +19,6 @@ package hostnetwork
 
 import (
 	"context"
-	"encoding/gob"
 	"sync"
 	"testing"
 	"time"
@@ -29,6 +28,7 @@ import (
 	"github.com/insolar/insolar/log"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/transport/host"
+	"github.com/insolar/insolar/network/transport/packet"
 	"github.com/insolar/insolar/network/transport/packet/types"
 	"github.com/insolar/insolar/network/utils"
 	"github.com/pkg/errors"
@@ -37,6 +37,8 @@ import (
 
 const (
 	InvalidPacket types.PacketType = 1024
+	// DataPacket carries Data payload in tests only
+	DataPacket types.PacketType = 1025
 
 	ID1       = "4K2V1kpVycZ6qSFsNdz2FtpNxnJs17eBNzf9rdCMcKoe"
 	ID2       = "4NwnA4HWZurKyXWNowJwYmb9CwX4gBKzwQKov1ExMf8M"
@@ -108,10 +110,10 @@ func mockConfiguration(address string) configuration.Configuration {
 
 func TestNewInternalTransport(t *testing.T) {
 	// broken address
-	_, err := NewInternalTransport(mockConfiguration("abirvalg"), ID1+DOMAIN)
+	_, err := NewInternalTransport(mockConfiguration("abirvalg"), ID1+DOMAIN, nil)
 	require.Error(t, err)
 	address := "127.0.0.1:0"
-	tp, err := NewInternalTransport(mockConfiguration(address), ID1+DOMAIN)
+	tp, err := NewInternalTransport(mockConfiguration(address), ID1+DOMAIN, nil)
 	require.NoError(t, err)
 	defer tp.Stop()
 	// require that new address with correct port has been assigned
@@ -123,7 +125,7 @@ func TestNewInternalTransport(t *testing.T) {
 
 func TestNewInternalTransport2(t *testing.T) {
 	ctx := context.Background()
-	tp, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN)
+	tp, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN, nil)
 	require.NoError(t, err)
 	go tp.Start(ctx)
 	time.Sleep(time.Millisecond)
@@ -137,12 +139,12 @@ func TestNewInternalTransport2(t *testing.T) {
 func createTwoHostNetworks(id1, id2 string) (t1, t2 network.HostNetwork, err error) {
 	m := newMockResolver()
 
-	i1, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN)
+	i1, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 	tr1 := NewHostTransport(i1, m)
-	i2, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID2+DOMAIN)
+	i2, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID2+DOMAIN, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -161,7 +163,7 @@ func createTwoHostNetworks(id1, id2 string) (t1, t2 network.HostNetwork, err err
 }
 
 func TestNewInternalTransport3(t *testing.T) {
-	_, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), "")
+	_, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), "", nil)
 	require.Error(t, err)
 }
 
@@ -210,7 +212,7 @@ func TestHostTransport_SendRequestPacket(t *testing.T) {
 	m := newMockResolver()
 	ctx := context.Background()
 
-	i1, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN)
+	i1, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN, nil)
 	require.NoError(t, err)
 	t1 := NewHostTransport(i1, m)
 	t1.Start(ctx)
@@ -278,23 +280,35 @@ func TestHostTransport_SendRequestPacket2(t *testing.T) {
 	require.True(t, success)
 }
 
+type Data struct {
+	Number int
+}
+
+func (d *Data) Encode(e *packet.Encoder) {
+	e.WriteInt64(int64(d.Number))
+}
+
+func (d *Data) Decode(dec *packet.Decoder) {
+	d.Number = int(dec.ReadInt64())
+}
+
+func init() {
+	packet.RegisterRequest(DataPacket, func() packet.Payload { return &Data{} })
+	packet.RegisterResponse(DataPacket, func() packet.Payload { return &Data{} })
+}
+
 func TestHostTransport_SendRequestPacket3(t *testing.T) {
 	t1, t2, err := createTwoHostNetworks(ID1+DOMAIN, ID2+DOMAIN)
 	require.NoError(t, err)
 	ctx := context.Background()
 	ctx2 := context.Background()
 
-	type Data struct {
-		Number int
-	}
-	gob.Register(&Data{})
-
 	handler := func(ctx context.Context, r network.Request) (network.Response, error) {
 		log.Info("handler triggered")
 		d := r.GetData().(*Data)
 		return t2.BuildResponse(ctx, r, &Data{Number: d.Number + 1}), nil
 	}
-	t2.RegisterRequestHandler(types.Ping, handler)
+	t2.RegisterRequestHandler(DataPacket, handler)
 
 	t2.Start(ctx)
 	t1.Start(ctx2)
@@ -304,7 +318,7 @@ func TestHostTransport_SendRequestPacket3(t *testing.T) {
 	}()
 
 	magicNumber := 42
-	request := t1.NewRequestBuilder().Type(types.Ping).Data(&Data{Number: magicNumber}).Build()
+	request := t1.NewRequestBuilder().Type(DataPacket).Data(&Data{Number: magicNumber}).Build()
 	ref, err := core.NewRefFromBase58(ID2 + DOMAIN)
 	require.NoError(t, err)
 	f, err := t1.SendRequest(ctx, request, *ref)
@@ -318,7 +332,7 @@ func TestHostTransport_SendRequestPacket3(t *testing.T) {
 	require.Equal(t, magicNumber+1, d.Number)
 
 	magicNumber = 666
-	request = t1.NewRequestBuilder().Type(types.Ping).Data(&Data{Number: magicNumber}).Build()
+	request = t1.NewRequestBuilder().Type(DataPacket).Data(&Data{Number: magicNumber}).Build()
 	f, err = t1.SendRequest(ctx, request, *ref)
 	require.NoError(t, err)
 
@@ -397,7 +411,7 @@ func TestHostTransport_WrongHandler(t *testing.T) {
 
 func TestDoubleStart(t *testing.T) {
 	ctx := context.Background()
-	tp, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN)
+	tp, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN, nil)
 	require.NoError(t, err)
 	wg := sync.WaitGroup{}
 	wg.Add(2)
@@ -26,6 +26,7 @@ import (
 	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/network/transport/host"
 	"github.com/insolar/insolar/network/transport/packet/types"
+	"github.com/insolar/insolar/network/transport/pool"
 )
 
 type BootstrapResult struct {
@@ -264,6 +265,10 @@ type InternalTransport interface {
 	NewRequestBuilder() RequestBuilder
 	// BuildResponse create response to an incoming request with Data set to responseData.
 	BuildResponse(ctx context.Context, request Request, responseData interface{}) Response
+	// SetProtocolVersion sets packet protocol version negotiated with the remote address.
+	SetProtocolVersion(address string, version uint16)
+	// PeerStats returns health state of connection to the remote address.
+	PeerStats(address string) (pool.PeerStats, bool)
 }
 
 // ClaimQueue is the queue that contains consensus claims.
@@ -25,7 +25,6 @@ import (
 
 	"github.com/insolar/insolar/component"
 	"github.com/insolar/insolar/core"
-	"github.com/insolar/insolar/ledger/ledgertestutils"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/pulsar/pulsartestutils"
 	"github.com/insolar/insolar/testutils"
@@ -128,9 +127,6 @@ func (t *calculatorErrorSuite) TestGetCloudProofCalculateError() {
 }
 
 func TestCalculatorError(t *testing.T) {
-	// FIXME: TmpLedger is deprecated. Use mocks instead.
-	l, _, clean := ledgertestutils.TmpLedger(t, "", core.StaticRoleLightMaterial, core.Components{}, true)
-
 	calculator := &calculator{}
 
 	cm := component.Manager{}
@@ -154,7 +150,10 @@ func TestCalculatorError(t *testing.T) {
 
 	jc := testutils.NewJetCoordinatorMock(t)
 
-	cm.Inject(th, nk, jc, l.ArtifactManager, calculator, service, scheme, pulseManager)
+	am := testutils.NewArtifactManagerMock(t)
+	am.StateMock.Return([]byte{1, 2, 3}, nil)
+
+	cm.Inject(th, nk, jc, am, calculator, service, scheme, pulseManager)
 
 	require.NotNil(t, calculator.ArtifactManager)
 	require.NotNil(t, calculator.NodeNetwork)
@@ -178,8 +177,6 @@ func TestCalculatorError(t *testing.T) {
 		service:     service,
 	}
 	suite.Run(t, s)
-
-	clean()
 }
 
 func TestCalculatorLedgerError(t *testing.T) {
@@ -0,0 +1,119 @@
+/*
+ * The Clear BSD License
+ *
+ * Copyright (c) 2019 Insolar Technologies
+ *
+ * All rights reserved.
+ *
+ * Redistribution and use in source and binary forms, with or without modification, are permitted (subject to the limitations in the disclaimer below) provided that the following conditions are met:
+ *
+ *  Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+ *  Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+ *  Neither the name of Insolar Technologies nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+ *
+ * NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ */
+
+package merkle
+
+import (
+	"bytes"
+
+	"github.com/insolar/insolar/core"
+	"github.com/pkg/errors"
+)
+
+// RecordsRoot returns merkle root over provided record IDs. Empty list has nil root.
+//
+// Leaves are hashes of record IDs. Odd node of the level is promoted to the next level as is.
+func RecordsRoot(scheme core.PlatformCryptographyScheme, records []core.RecordID) []byte {
+	mh := newMerkleHelper(scheme)
+	level := mh.recordLeaves(records)
+	if len(level) == 0 {
+		return nil
+	}
+	for len(level) > 1 {
+		level = mh.nextLevel(level)
+	}
+	return level[0]
+}
+
+// RecordPath returns sibling hashes required to calculate records root from the record with provided index.
+func RecordPath(scheme core.PlatformCryptographyScheme, records []core.RecordID, index int) ([][]byte, error) {
+	if index < 0 || index >= len(records) {
+		return nil, errors.Errorf("[ RecordPath ] Index %d is out of range [0, %d)", index, len(records))
+	}
+
+	mh := newMerkleHelper(scheme)
+	level := mh.recordLeaves(records)
+	var path [][]byte
+	for len(level) > 1 {
+		if index%2 == 1 {
+			path = append(path, level[index-1])
+		} else if index+1 < len(level) {
+			path = append(path, level[index+1])
+		}
+		level = mh.nextLevel(level)
+		index /= 2
+	}
+	return path, nil
+}
+
+// DropHash returns jet drop hash that commits to the previous drop hash and the drop records root.
+func DropHash(scheme core.PlatformCryptographyScheme, prevHash, recordsRoot []byte) []byte {
+	return newMerkleHelper(scheme).doubleSliceHash(prevHash, recordsRoot)
+}
+
+// VerifyRecordProof checks that the record from the proof is included into the drop with provided hash.
+//
+// Drop hash should be obtained from a trusted source (e.g. signed drop size of the jet).
+func VerifyRecordProof(scheme core.PlatformCryptographyScheme, proof *core.RecordProof, dropHash []byte) bool {
+	if proof == nil || proof.Index >= proof.Count {
+		return false
+	}
+
+	mh := newMerkleHelper(scheme)
+	hash := mh.leafHasher.Hash(proof.Record.Bytes())
+	index, width := proof.Index, proof.Count
+	path := proof.Path
+	for width > 1 {
+		if index%2 == 1 || index+1 < width {
+			if len(path) == 0 {
+				return false
+			}
+			if index%2 == 1 {
+				hash = mh.doubleSliceHash(path[0], hash)
+			} else {
+				hash = mh.doubleSliceHash(hash, path[0])
+			}
+			path = path[1:]
+		}
+		index /= 2
+		width = (width + 1) / 2
+	}
+	if len(path) != 0 {
+		return false
+	}
+
+	return bytes.Equal(mh.doubleSliceHash(proof.DropPrevHash, hash), dropHash)
+}
+
+func (mh *merkleHelper) recordLeaves(records []core.RecordID) [][]byte {
+	leaves := make([][]byte, 0, len(records))
+	for _, id := range records {
+		leaves = append(leaves, mh.leafHasher.Hash(id.Bytes()))
+	}
+	return leaves
+}
+
+func (mh *merkleHelper) nextLevel(level [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i+1 < len(level); i += 2 {
+		next = append(next, mh.doubleSliceHash(level[i], level[i+1]))
+	}
+	if len(level)%2 == 1 {
+		next = append(next, level[len(level)-1])
+	}
+	return next
+}
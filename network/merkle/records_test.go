@@ -0,0 +1,85 @@
+/*
+ * The Clear BSD License
+ *
+ * Copyright (c) 2019 Insolar Technologies
+ *
+ * All rights reserved.
+ *
+ * Redistribution and use in source and binary forms, with or without modification, are permitted (subject to the limitations in the disclaimer below) provided that the following conditions are met:
+ *
+ *  Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+ *  Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+ *  Neither the name of Insolar Technologies nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+ *
+ * NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ */
+
+package merkle
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordProof(t *testing.T) {
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	prevHash := []byte{1, 2, 3}
+
+	for count := 1; count <= 9; count++ {
+		records := make([]core.RecordID, count)
+		for i := range records {
+			records[i] = randomID()
+		}
+		dropHash := DropHash(scheme, prevHash, RecordsRoot(scheme, records))
+
+		for i, id := range records {
+			path, err := RecordPath(scheme, records, i)
+			require.NoError(t, err)
+			proof := &core.RecordProof{
+				Record:       id,
+				Index:        uint64(i),
+				Count:        uint64(count),
+				Path:         path,
+				DropPrevHash: prevHash,
+			}
+			assert.True(t, VerifyRecordProof(scheme, proof, dropHash), "count %d, index %d", count, i)
+
+			proof.Record = randomID()
+			assert.False(t, VerifyRecordProof(scheme, proof, dropHash))
+		}
+	}
+}
+
+func TestRecordProof_Invalid(t *testing.T) {
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	records := []core.RecordID{randomID(), randomID(), randomID()}
+	dropHash := DropHash(scheme, nil, RecordsRoot(scheme, records))
+
+	_, err := RecordPath(scheme, records, 3)
+	require.Error(t, err)
+
+	path, err := RecordPath(scheme, records, 0)
+	require.NoError(t, err)
+
+	assert.False(t, VerifyRecordProof(scheme, nil, dropHash))
+	assert.False(t, VerifyRecordProof(scheme, &core.RecordProof{Record: records[0], Index: 3, Count: 3, Path: path}, dropHash))
+	assert.False(t, VerifyRecordProof(scheme, &core.RecordProof{Record: records[0], Index: 1, Count: 3, Path: path}, dropHash))
+	assert.False(t, VerifyRecordProof(scheme, &core.RecordProof{Record: records[0], Count: 3, Path: path[:1]}, dropHash))
+	assert.False(t, VerifyRecordProof(scheme, &core.RecordProof{Record: records[0], Count: 3, Path: path, DropPrevHash: []byte{1}}, dropHash))
+	assert.Nil(t, RecordsRoot(scheme, nil))
+}
+
+func randomID() core.RecordID {
+	var id core.RecordID
+	_, err := rand.Read(id[:])
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
@@ -195,6 +195,14 @@ func mergeClaim(nodes map[core.RecordRef]core.Node, claim consensus.ReferendumCl
 		if t.ETA == 0 || !node.Leaving() {
 			node.SetLeavingETA(t.ETA)
 		}
+	case *consensus.NodeViolationBlame:
+		for ref, node := range nodes {
+			if node.ShortID() == t.GetBlameNodeID() {
+				log.Warnf("[ mergeClaim ] Evicting node %s blamed for %s", ref, t.Violation())
+				delete(nodes, ref)
+				break
+			}
+		}
 	}
 
 	return isJoinClaim, nil
@@ -62,6 +62,10 @@ func (cm *CommunicatorMock) ExchangePhase1(
 	return pckts, nil
 }
 
+func (cm *CommunicatorMock) GetConflictingPhase1Packets() map[core.RecordRef]*packets.Phase1Packet {
+	return cm.communicator.GetConflictingPhase1Packets()
+}
+
 func (cm *CommunicatorMock) ExchangePhase2(ctx context.Context, list network.UnsyncList, participants []core.Node, packet *packets.Phase2Packet) (map[core.RecordRef]*packets.Phase2Packet, error) {
 	pckts, err := cm.communicator.ExchangePhase2(ctx, list, participants, packet)
 	if err != nil {
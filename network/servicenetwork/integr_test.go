@@ -147,7 +147,8 @@ func (s *testSuite) TestNodeLeave() {
 	activeNodes = s.fixture().bootstrapNodes[0].serviceNetwork.NodeKeeper.GetWorkingNodes()
 	s.Equal(s.getNodesCount()+1, len(activeNodes))
 
-	testNode.serviceNetwork.Leave(context.Background(), 0)
+	err := testNode.serviceNetwork.Leave(context.Background(), 0)
+	s.NoError(err)
 
 	s.waitForConsensus(2)
 
@@ -183,7 +184,8 @@ func (s *testSuite) TestNodeLeaveAtETA() {
 	s.NoError(err)
 
 	// next pulse will be last for this node
-	testNode.serviceNetwork.Leave(s.fixture().ctx, pulse.NextPulseNumber)
+	err = testNode.serviceNetwork.Leave(s.fixture().ctx, pulse.NextPulseNumber)
+	s.NoError(err)
 
 	// node still active and working
 	s.waitForConsensus(1)
@@ -225,7 +227,8 @@ func (s *testSuite) TestNodeComeAfterAnotherNodeSendLeaveETA() {
 
 	// leaving in 3 pulses
 	pulseDelta := pulse.NextPulseNumber - pulse.PulseNumber
-	leavingNode.serviceNetwork.Leave(s.fixture().ctx, pulse.PulseNumber+3*pulseDelta)
+	err = leavingNode.serviceNetwork.Leave(s.fixture().ctx, pulse.PulseNumber+3*pulseDelta)
+	s.NoError(err)
 
 	// wait for leavingNode will be marked as leaving
 	s.waitForConsensus(1)
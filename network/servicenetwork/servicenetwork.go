@@ -19,6 +19,7 @@ package servicenetwork
 
 import (
 	"context"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -38,6 +39,7 @@ import (
 	"github.com/insolar/insolar/network/hostnetwork"
 	"github.com/insolar/insolar/network/merkle"
 	"github.com/insolar/insolar/network/routing"
+	"github.com/insolar/insolar/network/transport/pool"
 	"github.com/insolar/insolar/network/utils"
 	"github.com/pkg/errors"
 	"go.opencensus.io/trace"
@@ -48,8 +50,9 @@ type ServiceNetwork struct {
 	cfg configuration.Configuration
 	cm  *component.Manager
 
-	hostNetwork  network.HostNetwork  // TODO: should be injected
-	routingTable network.RoutingTable // TODO: should be injected
+	hostNetwork       network.HostNetwork       // TODO: should be injected
+	routingTable      network.RoutingTable      // TODO: should be injected
+	internalTransport network.InternalTransport // TODO: should be injected
 
 	// dependencies
 	CertificateManager  core.CertificateManager         `inject:""`
@@ -61,6 +64,7 @@ type ServiceNetwork struct {
 	NodeKeeper          network.NodeKeeper              `inject:""`
 	NetworkSwitcher     core.NetworkSwitcher            `inject:""`
 	TerminationHandler  core.TerminationHandler         `inject:""`
+	KeyStore            core.KeyStore                   `inject:""`
 
 	// subcomponents
 	PhaseManager phases.PhaseManager `inject:"subcomponent"`
@@ -94,6 +98,42 @@ func (n *ServiceNetwork) RemoteProcedureRegister(name string, method core.Remote
 	n.Controller.RemoteProcedureRegister(name, method)
 }
 
+// PreferHealthy returns nodes ordered from the healthiest peer by connection statistics.
+// Unhealthy peers go last, nodes without statistics are considered healthy, order of equal nodes is kept.
+func (n *ServiceNetwork) PreferHealthy(nodes []core.RecordRef) []core.RecordRef {
+	stats := make([]pool.PeerStats, len(nodes))
+	for i, ref := range nodes {
+		stats[i] = n.peerStats(ref)
+	}
+
+	order := make([]int, len(nodes))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := stats[order[i]], stats[order[j]]
+		if a.Healthy() != b.Healthy() {
+			return a.Healthy()
+		}
+		return a.Score() > b.Score()
+	})
+
+	result := make([]core.RecordRef, len(nodes))
+	for i, idx := range order {
+		result[i] = nodes[idx]
+	}
+	return result
+}
+
+func (n *ServiceNetwork) peerStats(ref core.RecordRef) pool.PeerStats {
+	h, err := n.routingTable.Resolve(ref)
+	if err != nil {
+		return pool.PeerStats{}
+	}
+	stats, _ := n.internalTransport.PeerStats(h.Address.String())
+	return stats
+}
+
 // incrementPort increments port number if it not equals 0
 func incrementPort(address string) (string, error) {
 	parts := strings.Split(address, ":")
@@ -116,7 +156,11 @@ func incrementPort(address string) (string, error) {
 // Start implements component.Initer
 func (n *ServiceNetwork) Init(ctx context.Context) error {
 	n.routingTable = &routing.Table{}
-	internalTransport, err := hostnetwork.NewInternalTransport(n.cfg, n.CertificateManager.GetCertificate().GetNodeRef().String())
+	identity, err := n.tlsIdentity()
+	if err != nil {
+		return errors.Wrap(err, "Failed to create TLS identity")
+	}
+	internalTransport, err := hostnetwork.NewInternalTransport(n.cfg, n.CertificateManager.GetCertificate().GetNodeRef().String(), identity)
 	if err != nil {
 		return errors.Wrap(err, "Failed to create internal transport")
 	}
@@ -142,6 +186,7 @@ func (n *ServiceNetwork) Init(ctx context.Context) error {
 		return errors.Wrap(err, "Failed to create consensus network.")
 	}
 
+	n.internalTransport = internalTransport
 	n.hostNetwork = hostnetwork.NewHostTransport(internalTransport, n.routingTable)
 	options := controller.ConfigureOptions(n.cfg)
 
@@ -199,11 +244,14 @@ func (n *ServiceNetwork) Start(ctx context.Context) error {
 	return nil
 }
 
-func (n *ServiceNetwork) Leave(ctx context.Context, ETA core.PulseNumber) {
+func (n *ServiceNetwork) Leave(ctx context.Context, ETA core.PulseNumber) error {
 	logger := inslogger.FromContext(ctx)
 	logger.Info("Gracefully stopping service network")
 
-	n.NodeKeeper.AddPendingClaim(&packets.NodeLeaveClaim{ETA: ETA})
+	if !n.NodeKeeper.AddPendingClaim(&packets.NodeLeaveClaim{ETA: ETA}) {
+		return errors.New("Failed to add leave claim to the claim queue")
+	}
+	return nil
 }
 
 // Stop implements core.Component
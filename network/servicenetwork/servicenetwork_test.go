@@ -18,9 +18,22 @@
 package servicenetwork
 
 import (
+	"crypto"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/insolar/insolar/certificate"
+	"github.com/insolar/insolar/core"
+	insnetwork "github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/nodenetwork"
+	"github.com/insolar/insolar/network/routing"
+	"github.com/insolar/insolar/network/transport/pool"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/testutils"
+	"github.com/insolar/insolar/testutils/network"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewServiceNetwork_incrementPort(t *testing.T) {
@@ -44,3 +57,90 @@ func TestNewServiceNetwork_incrementPort(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, "127.0.0.1:port", addr)
 }
+
+func generatePublicKey(t *testing.T) crypto.PublicKey {
+	keyProcessor := platformpolicy.NewKeyProcessor()
+	key, err := keyProcessor.GeneratePrivateKey()
+	require.NoError(t, err)
+	return keyProcessor.ExtractPublicKey(key)
+}
+
+func TestServiceNetwork_verifyPeer(t *testing.T) {
+	activeKey := generatePublicKey(t)
+	pulsarKey := generatePublicKey(t)
+	joinerKey := generatePublicKey(t)
+	unknownKey := generatePublicKey(t)
+
+	nodeKeeper := network.NewNodeKeeperMock(t)
+	nodeKeeper.GetActiveNodesFunc = func() []core.Node {
+		return []core.Node{nodenetwork.NewNode(testutils.RandomRef(), core.StaticRoleVirtual, activeKey, "127.0.0.1:1", "")}
+	}
+	cert := testutils.NewCertificateMock(t)
+	cert.GetDiscoveryNodesFunc = func() []core.DiscoveryNode { return nil }
+	cert.GetPulsarPublicKeysFunc = func() []crypto.PublicKey { return []crypto.PublicKey{pulsarKey} }
+	certManager := testutils.NewCertificateManagerMock(t)
+	certManager.GetCertificateFunc = func() core.Certificate { return cert }
+	certManager.VerifyAuthorizationCertificateFunc = func(authCert core.AuthorizationCertificate) (bool, error) {
+		return *authCert.GetNodeRef() == core.RecordRef{1}, nil
+	}
+
+	n := &ServiceNetwork{NodeKeeper: nodeKeeper, CertificateManager: certManager}
+
+	assert.NoError(t, n.verifyPeer(activeKey, nil))
+	assert.NoError(t, n.verifyPeer(pulsarKey, nil))
+	assert.Error(t, n.verifyPeer(unknownKey, nil))
+
+	serializeCert := func(key crypto.PublicKey, ref core.RecordRef) []byte {
+		pem, err := platformpolicy.NewKeyProcessor().ExportPublicKeyPEM(key)
+		require.NoError(t, err)
+		raw, err := certificate.Serialize(&certificate.AuthorizationCertificate{PublicKey: string(pem), Reference: ref.String()})
+		require.NoError(t, err)
+		return raw
+	}
+	assert.NoError(t, n.verifyPeer(joinerKey, serializeCert(joinerKey, core.RecordRef{1})))
+	// certificate is not signed by discovery nodes
+	assert.Error(t, n.verifyPeer(joinerKey, serializeCert(joinerKey, core.RecordRef{2})))
+	// certificate of the other node
+	assert.Error(t, n.verifyPeer(unknownKey, serializeCert(joinerKey, core.RecordRef{1})))
+}
+
+type peerStatsTransport struct {
+	insnetwork.InternalTransport
+	stats map[string]pool.PeerStats
+}
+
+func (t *peerStatsTransport) PeerStats(address string) (pool.PeerStats, bool) {
+	stats, ok := t.stats[address]
+	return stats, ok
+}
+
+func TestServiceNetwork_PreferHealthy(t *testing.T) {
+	nodes := make(map[core.RecordRef]core.Node)
+	refs := make([]core.RecordRef, 0)
+	for i := 1; i <= 5; i++ {
+		ref := testutils.RandomRef()
+		nodes[ref] = nodenetwork.NewNode(ref, core.StaticRoleVirtual, nil, "127.0.0.1:"+strconv.Itoa(i), "")
+		refs = append(refs, ref)
+	}
+	unknown := testutils.RandomRef()
+	nodeKeeper := network.NewNodeKeeperMock(t)
+	nodeKeeper.GetActiveNodeFunc = func(ref core.RecordRef) core.Node {
+		if node, ok := nodes[ref]; ok {
+			return node
+		}
+		return nil
+	}
+
+	n := &ServiceNetwork{
+		routingTable: &routing.Table{NodeKeeper: nodeKeeper},
+		internalTransport: &peerStatsTransport{stats: map[string]pool.PeerStats{
+			"127.0.0.1:1": {Unreachable: true},
+			"127.0.0.1:2": {RTT: 200 * time.Millisecond},
+			"127.0.0.1:3": {ErrorRate: 0.9},
+			"127.0.0.1:4": {RTT: 10 * time.Millisecond},
+		}},
+	}
+
+	result := n.PreferHealthy([]core.RecordRef{refs[0], refs[1], refs[2], unknown, refs[3], refs[4]})
+	assert.Equal(t, []core.RecordRef{unknown, refs[4], refs[3], refs[1], refs[2], refs[0]}, result)
+}
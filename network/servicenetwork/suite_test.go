@@ -381,6 +381,14 @@ func (p *pulseManagerMock) Set(ctx context.Context, pulse core.Pulse, persist bo
 	return p.keeper.MoveSyncToActive(ctx)
 }
 
+type testKeyStore struct {
+	key crypto.PrivateKey
+}
+
+func (ks *testKeyStore) GetPrivateKey(string) (crypto.PrivateKey, error) {
+	return ks.key, nil
+}
+
 // preInitNode inits previously created node with mocks and external dependencies
 func (s *testSuite) preInitNode(node *networkNode) {
 	cfg := configuration.NewConfiguration()
@@ -422,7 +430,7 @@ func (s *testSuite) preInitNode(node *networkNode) {
 	}
 
 	node.componentManager.Register(terminationHandler, realKeeper, newPulseManagerMock(realKeeper), netCoordinator, amMock)
-	node.componentManager.Register(certManager, cryptographyService)
+	node.componentManager.Register(certManager, cryptographyService, &testKeyStore{key: node.privateKey})
 	node.componentManager.Inject(serviceNetwork, NewTestNetworkSwitcher())
 	node.serviceNetwork = serviceNetwork
 }
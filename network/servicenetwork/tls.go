@@ -0,0 +1,100 @@
+/*
+ * The Clear BSD License
+ *
+ * Copyright (c) 2019 Insolar Technologies
+ *
+ * All rights reserved.
+ *
+ * Redistribution and use in source and binary forms, with or without modification, are permitted (subject to the limitations in the disclaimer below) provided that the following conditions are met:
+ *
+ *  Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+ *  Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+ *  Neither the name of Insolar Technologies nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+ *
+ * NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ */
+
+package servicenetwork
+
+import (
+	"bytes"
+	"crypto"
+
+	"github.com/insolar/insolar/certificate"
+	"github.com/insolar/insolar/network/transport"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/pkg/errors"
+)
+
+// tlsIdentity returns node identity for TLS transport or nil if TLS is disabled.
+func (n *ServiceNetwork) tlsIdentity() (*transport.TLSIdentity, error) {
+	if !n.cfg.Host.Transport.TLS {
+		return nil, nil
+	}
+
+	privateKey, err := n.KeyStore.GetPrivateKey("")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get node private key")
+	}
+	cert, err := certificate.Serialize(n.CertificateManager.GetCertificate())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize node certificate")
+	}
+
+	return &transport.TLSIdentity{
+		PrivateKey:  privateKey,
+		Certificate: cert,
+		VerifyPeer:  n.verifyPeer,
+	}, nil
+}
+
+// verifyPeer allows connections from active nodes, discovery nodes and pulsars.
+// Node that is not active yet is allowed if it has authorization certificate signed by discovery nodes.
+func (n *ServiceNetwork) verifyPeer(key crypto.PublicKey, authCert []byte) error {
+	keyProcessor := platformpolicy.NewKeyProcessor()
+	rawKey, err := keyProcessor.ExportPublicKeyBinary(key)
+	if err != nil {
+		return errors.Wrap(err, "failed to export peer key")
+	}
+	isKnown := func(known crypto.PublicKey) bool {
+		rawKnown, err := keyProcessor.ExportPublicKeyBinary(known)
+		return err == nil && bytes.Equal(rawKnown, rawKey)
+	}
+
+	for _, node := range n.NodeKeeper.GetActiveNodes() {
+		if isKnown(node.PublicKey()) {
+			return nil
+		}
+	}
+	cert := n.CertificateManager.GetCertificate()
+	for _, node := range cert.GetDiscoveryNodes() {
+		if isKnown(node.GetPublicKey()) {
+			return nil
+		}
+	}
+	for _, pulsarKey := range cert.GetPulsarPublicKeys() {
+		if isKnown(pulsarKey) {
+			return nil
+		}
+	}
+
+	if len(authCert) == 0 {
+		return errors.New("unknown peer key")
+	}
+	peerCert, err := certificate.Deserialize(authCert, keyProcessor)
+	if err != nil {
+		return errors.Wrap(err, "failed to deserialize peer certificate")
+	}
+	if !isKnown(peerCert.GetPublicKey()) {
+		return errors.New("peer certificate is issued for other key")
+	}
+	valid, err := n.CertificateManager.VerifyAuthorizationCertificate(peerCert)
+	if err != nil {
+		return errors.Wrap(err, "failed to verify peer certificate")
+	}
+	if !valid {
+		return errors.New("peer certificate is not signed by discovery nodes")
+	}
+	return nil
+}
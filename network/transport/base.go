@@ -26,19 +26,20 @@ import (
 	"github.com/insolar/insolar/metrics"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/transport/packet"
+	"github.com/insolar/insolar/network/transport/pool"
 	"github.com/insolar/insolar/network/transport/relay"
 	"github.com/pkg/errors"
 )
 
 type transportSerializer interface {
-	SerializePacket(q *packet.Packet) ([]byte, error)
+	SerializePacket(q *packet.Packet, version uint16) ([]byte, error)
 	DeserializePacket(conn io.Reader) (*packet.Packet, error)
 }
 
 type baseSerializer struct{}
 
-func (b *baseSerializer) SerializePacket(q *packet.Packet) ([]byte, error) {
-	return packet.SerializePacket(q)
+func (b *baseSerializer) SerializePacket(q *packet.Packet, version uint16) ([]byte, error) {
+	return packet.SerializePacketVersion(q, version)
 }
 
 func (b *baseSerializer) DeserializePacket(conn io.Reader) (*packet.Packet, error) {
@@ -50,25 +51,31 @@ type baseTransport struct {
 	serializer    transportSerializer
 	proxy         relay.Proxy
 	packetHandler packetHandler
+	peers         peerTracker
 
 	disconnectStarted  chan bool
 	disconnectFinished chan bool
 
 	mutex *sync.RWMutex
 
+	versions     map[string]uint16
+	versionsLock sync.RWMutex
+
 	publicAddress string
 	sendFunc      func(recvAddress string, data []byte) error
 }
 
-func newBaseTransport(proxy relay.Proxy, publicAddress string) baseTransport {
-	futureManager := newFutureManager()
+func newBaseTransport(proxy relay.Proxy, publicAddress string, peers peerTracker) baseTransport {
+	futureManager := newFutureManager(peers)
 	return baseTransport{
 		futureManager: futureManager,
 		packetHandler: newPacketHandler(futureManager),
+		peers:         peers,
 		proxy:         proxy,
 		serializer:    &baseSerializer{},
 
-		mutex: &sync.RWMutex{},
+		mutex:    &sync.RWMutex{},
+		versions: make(map[string]uint16),
 
 		disconnectStarted:  make(chan bool, 1),
 		disconnectFinished: make(chan bool, 1),
@@ -122,6 +129,11 @@ func (t *baseTransport) prepareDisconnect() {
 	close(t.disconnectStarted)
 }
 
+// PeerStats returns health state of the peer with address.
+func (t *baseTransport) PeerStats(address string) (pool.PeerStats, bool) {
+	return t.peers.PeerStats(address)
+}
+
 // PublicAddress returns transport public ip address
 func (t *baseTransport) PublicAddress() string {
 	return t.publicAddress
@@ -136,7 +148,7 @@ func (t *baseTransport) SendPacket(ctx context.Context, p *packet.Packet) error
 		recvAddress = p.Receiver.Address.String()
 	}
 
-	data, err := t.serializer.SerializePacket(p)
+	data, err := t.serializer.SerializePacket(p, t.protocolVersion(p.Receiver.Address.String()))
 	if err != nil {
 		return errors.Wrap(err, "Failed to serialize packet")
 	}
@@ -144,3 +156,22 @@ func (t *baseTransport) SendPacket(ctx context.Context, p *packet.Packet) error
 	inslogger.FromContext(ctx).Debugf("Send %s packet to %s with RequestID = %d", p.Type, recvAddress, p.RequestID)
 	return t.sendFunc(recvAddress, data)
 }
+
+// SetProtocolVersion sets packet protocol version negotiated with the peer with address.
+func (t *baseTransport) SetProtocolVersion(address string, version uint16) {
+	t.versionsLock.Lock()
+	defer t.versionsLock.Unlock()
+
+	t.versions[address] = version
+}
+
+// protocolVersion returns packet protocol version negotiated with the peer or the latest version if there was no negotiation.
+func (t *baseTransport) protocolVersion(address string) uint16 {
+	t.versionsLock.RLock()
+	defer t.versionsLock.RUnlock()
+
+	if version, ok := t.versions[address]; ok {
+		return version
+	}
+	return packet.ProtocolVersion
+}
@@ -70,10 +70,16 @@ type future struct {
 	requestID      network.RequestID
 	cancelCallback CancelCallback
 	finished       uint32
+	// answered is set by SetResult before cancelCallback is called
+	answered bool
 }
 
 // NewFuture creates new Future.
 func NewFuture(requestID network.RequestID, actor *host.Host, msg *packet.Packet, cancelCallback CancelCallback) Future {
+	return newFuture(requestID, actor, msg, cancelCallback)
+}
+
+func newFuture(requestID network.RequestID, actor *host.Host, msg *packet.Packet, cancelCallback CancelCallback) *future {
 	metrics.NetworkFutures.WithLabelValues(msg.Type.String()).Inc()
 	return &future{
 		result:         make(chan *packet.Packet, 1),
@@ -107,6 +113,7 @@ func (future *future) Result() <-chan *packet.Packet {
 // SetResult write packet to the result channel.
 func (future *future) SetResult(msg *packet.Packet) {
 	if atomic.CompareAndSwapUint32(&future.finished, 0, 1) {
+		future.answered = true
 		future.result <- msg
 		future.finish()
 	}
@@ -19,8 +19,10 @@ package transport
 
 import (
 	"context"
+	"time"
 
 	"github.com/insolar/insolar/network/transport/packet"
+	"github.com/insolar/insolar/network/transport/pool"
 )
 
 type futureManager interface {
@@ -28,8 +30,26 @@ type futureManager interface {
 	Create(msg *packet.Packet) Future
 }
 
-func newFutureManager() futureManager {
-	return newFutureManagerImpl()
+func newFutureManager(peers peerTracker) futureManager {
+	return newFutureManagerImpl(peers)
+}
+
+// peerTracker collects health statistics of remote peers.
+type peerTracker interface {
+	RequestStarted(address string)
+	RequestFinished(address string, ok bool)
+	ObserveRTT(address string, rtt time.Duration)
+	PeerStats(address string) (pool.PeerStats, bool)
+}
+
+// noopPeerTracker is used by transports without connection pool.
+type noopPeerTracker struct{}
+
+func (noopPeerTracker) RequestStarted(string)            {}
+func (noopPeerTracker) RequestFinished(string, bool)     {}
+func (noopPeerTracker) ObserveRTT(string, time.Duration) {}
+func (noopPeerTracker) PeerStats(string) (pool.PeerStats, bool) {
+	return pool.PeerStats{}, false
 }
 
 type packetHandler interface {
@@ -19,33 +19,53 @@ package transport
 
 import (
 	"sync"
+	"time"
 
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/transport/packet"
+	"github.com/insolar/insolar/network/transport/packet/types"
 )
 
 type futureManagerImpl struct {
 	mutex   sync.RWMutex
 	futures map[network.RequestID]Future
+	peers   peerTracker
 }
 
-func newFutureManagerImpl() *futureManagerImpl {
+func newFutureManagerImpl(peers peerTracker) *futureManagerImpl {
 	return &futureManagerImpl{
 		futures: make(map[network.RequestID]Future),
+		peers:   peers,
 	}
 }
 
 func (fm *futureManagerImpl) Create(msg *packet.Packet) Future {
-	future := NewFuture(msg.RequestID, msg.Receiver, msg, func(f Future) {
+	var address string
+	if msg.Receiver != nil && msg.Receiver.Address != nil {
+		address = msg.Receiver.Address.String()
+		fm.peers.RequestStarted(address)
+	}
+	started := time.Now()
+
+	var f *future
+	f = newFuture(msg.RequestID, msg.Receiver, msg, func(Future) {
 		fm.delete(f.ID())
+		if address == "" {
+			return
+		}
+		fm.peers.RequestFinished(address, f.answered)
+		// RTT is measured by pings only, other requests include time of processing on the peer
+		if f.answered && msg.Type == types.Ping {
+			fm.peers.ObserveRTT(address, time.Since(started))
+		}
 	})
 
 	fm.mutex.Lock()
 	defer fm.mutex.Unlock()
 
-	fm.futures[msg.RequestID] = future
+	fm.futures[msg.RequestID] = f
 
-	return future
+	return f
 }
 
 func (fm *futureManagerImpl) Get(msg *packet.Packet) Future {
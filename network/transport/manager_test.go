@@ -0,0 +1,104 @@
+/*
+ * The Clear BSD License
+ *
+ * Copyright (c) 2019 Insolar Technologies
+ *
+ * All rights reserved.
+ *
+ * Redistribution and use in source and binary forms, with or without modification, are permitted (subject to the limitations in the disclaimer below) provided that the following conditions are met:
+ *
+ *  Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+ *  Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+ *  Neither the name of Insolar Technologies nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+ *
+ * NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ */
+
+package transport
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/transport/host"
+	"github.com/insolar/insolar/network/transport/packet"
+	"github.com/insolar/insolar/network/transport/packet/types"
+	"github.com/insolar/insolar/network/transport/pool"
+)
+
+type recordingPeerTracker struct {
+	mutex    sync.Mutex
+	started  []string
+	finished map[string][]bool
+	rtts     map[string][]time.Duration
+}
+
+func newRecordingPeerTracker() *recordingPeerTracker {
+	return &recordingPeerTracker{
+		finished: make(map[string][]bool),
+		rtts:     make(map[string][]time.Duration),
+	}
+}
+
+func (r *recordingPeerTracker) RequestStarted(address string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.started = append(r.started, address)
+}
+
+func (r *recordingPeerTracker) RequestFinished(address string, ok bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.finished[address] = append(r.finished[address], ok)
+}
+
+func (r *recordingPeerTracker) ObserveRTT(address string, rtt time.Duration) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.rtts[address] = append(r.rtts[address], rtt)
+}
+
+func (r *recordingPeerTracker) PeerStats(address string) (pool.PeerStats, bool) {
+	return pool.PeerStats{}, false
+}
+
+func newTestRequest(t *testing.T, id network.RequestID, packetType types.PacketType) *packet.Packet {
+	receiver, err := host.NewHost("127.0.0.1:8080")
+	require.NoError(t, err)
+	return &packet.Packet{RequestID: id, Type: packetType, Receiver: receiver}
+}
+
+func TestFutureManager_TracksPeers(t *testing.T) {
+	tracker := newRecordingPeerTracker()
+	fm := newFutureManagerImpl(tracker)
+	address := "127.0.0.1:8080"
+
+	ping := fm.Create(newTestRequest(t, 1, types.Ping))
+	rpc := fm.Create(newTestRequest(t, 2, types.RPC))
+	timedOut := fm.Create(newTestRequest(t, 3, types.Ping))
+	require.Equal(t, []string{address, address, address}, tracker.started)
+
+	ping.SetResult(&packet.Packet{})
+	rpc.SetResult(&packet.Packet{})
+	_, err := timedOut.GetResult(time.Millisecond)
+	require.Equal(t, ErrTimeout, err)
+
+	require.Equal(t, []bool{true, true, false}, tracker.finished[address])
+	require.Len(t, tracker.rtts[address], 1)
+}
+
+func TestFutureManager_SkipsRequestsWithoutReceiver(t *testing.T) {
+	tracker := newRecordingPeerTracker()
+	fm := newFutureManagerImpl(tracker)
+
+	f := fm.Create(&packet.Packet{RequestID: 1, Type: types.Ping})
+	f.Cancel()
+
+	require.Empty(t, tracker.started)
+	require.Empty(t, tracker.finished)
+}
@@ -51,6 +51,15 @@ Or with builder:
 	// do something with packet
 
 
+Packet data must implement Payload interface and be registered for the packet type:
+
+	func init() {
+		packet.RegisterRequest(types.Ping, func() packet.Payload { return &PingRequest{} })
+	}
+
+Packet is serialized to the versioned binary format, its header contains protocol version and body length.
+Packets of versions other than MinProtocolVersion..ProtocolVersion are rejected by DeserializePacket.
+
 Packet may be serialized:
 
 	msg := &packet.Packet{}
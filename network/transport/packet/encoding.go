@@ -0,0 +1,264 @@
+/*
+ * The Clear BSD License
+ *
+ * Copyright (c) 2019 Insolar Technologies
+ *
+ * All rights reserved.
+ *
+ * Redistribution and use in source and binary forms, with or without modification, are permitted (subject to the limitations in the disclaimer below) provided that the following conditions are met:
+ *
+ *  Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+ *  Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+ *  Neither the name of Insolar Technologies nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+ *
+ * NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ */
+package packet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+
+	"github.com/insolar/insolar/core"
+	"github.com/pkg/errors"
+)
+
+var byteOrder = binary.BigEndian
+
+// Encoder writes values of the packet wire format.
+// After the first error all following writes are ignored, the error is returned by Err.
+type Encoder struct {
+	buf bytes.Buffer
+	err error
+}
+
+// Bytes returns encoded data.
+func (e *Encoder) Bytes() []byte {
+	return e.buf.Bytes()
+}
+
+// Err returns the first error occurred during encoding.
+func (e *Encoder) Err() error {
+	return e.err
+}
+
+// Fail stops encoding with the error.
+func (e *Encoder) Fail(err error) {
+	if e.err == nil {
+		e.err = err
+	}
+}
+
+// WriteUint8 writes single byte.
+func (e *Encoder) WriteUint8(v uint8) {
+	if e.err != nil {
+		return
+	}
+	e.buf.WriteByte(v)
+}
+
+// WriteBool writes boolean as single byte.
+func (e *Encoder) WriteBool(v bool) {
+	if v {
+		e.WriteUint8(1)
+	} else {
+		e.WriteUint8(0)
+	}
+}
+
+// WriteUint16 writes 2-byte unsigned integer.
+func (e *Encoder) WriteUint16(v uint16) {
+	var b [2]byte
+	byteOrder.PutUint16(b[:], v)
+	e.WriteFixed(b[:])
+}
+
+// WriteUint32 writes 4-byte unsigned integer.
+func (e *Encoder) WriteUint32(v uint32) {
+	var b [4]byte
+	byteOrder.PutUint32(b[:], v)
+	e.WriteFixed(b[:])
+}
+
+// WriteUint64 writes 8-byte unsigned integer.
+func (e *Encoder) WriteUint64(v uint64) {
+	var b [8]byte
+	byteOrder.PutUint64(b[:], v)
+	e.WriteFixed(b[:])
+}
+
+// WriteInt64 writes 8-byte signed integer.
+func (e *Encoder) WriteInt64(v int64) {
+	e.WriteUint64(uint64(v))
+}
+
+// WriteFixed writes data as is, without length prefix.
+func (e *Encoder) WriteFixed(data []byte) {
+	if e.err != nil {
+		return
+	}
+	e.buf.Write(data)
+}
+
+// WriteCount writes number of elements of the following collection.
+func (e *Encoder) WriteCount(count int) {
+	if uint64(count) > math.MaxUint32 {
+		e.Fail(errors.Errorf("too many elements to encode: %d", count))
+		return
+	}
+	e.WriteUint32(uint32(count))
+}
+
+// WriteBytes writes data with length prefix.
+func (e *Encoder) WriteBytes(data []byte) {
+	e.WriteCount(len(data))
+	e.WriteFixed(data)
+}
+
+// WriteString writes string with length prefix.
+func (e *Encoder) WriteString(s string) {
+	e.WriteBytes([]byte(s))
+}
+
+// WriteRef writes record reference.
+func (e *Encoder) WriteRef(ref core.RecordRef) {
+	e.WriteFixed(ref[:])
+}
+
+// Decoder reads values of the packet wire format.
+// After the first error all following reads return zero values, the error is returned by Err.
+type Decoder struct {
+	data []byte
+	err  error
+}
+
+// NewDecoder creates decoder reading from data.
+func NewDecoder(data []byte) *Decoder {
+	return &Decoder{data: data}
+}
+
+// Err returns the first error occurred during decoding.
+func (d *Decoder) Err() error {
+	return d.err
+}
+
+// Fail stops decoding with the error.
+func (d *Decoder) Fail(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
+
+// Len returns number of bytes left unread.
+func (d *Decoder) Len() int {
+	return len(d.data)
+}
+
+func (d *Decoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n > len(d.data) {
+		d.Fail(io.ErrUnexpectedEOF)
+		return nil
+	}
+	result := d.data[:n]
+	d.data = d.data[n:]
+	return result
+}
+
+// ReadUint8 reads single byte.
+func (d *Decoder) ReadUint8() uint8 {
+	b := d.next(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+// ReadBool reads boolean encoded as single byte.
+func (d *Decoder) ReadBool() bool {
+	switch v := d.ReadUint8(); v {
+	case 0:
+		return false
+	case 1:
+		return true
+	default:
+		d.Fail(errors.Errorf("invalid boolean value: %d", v))
+		return false
+	}
+}
+
+// ReadUint16 reads 2-byte unsigned integer.
+func (d *Decoder) ReadUint16() uint16 {
+	b := d.next(2)
+	if b == nil {
+		return 0
+	}
+	return byteOrder.Uint16(b)
+}
+
+// ReadUint32 reads 4-byte unsigned integer.
+func (d *Decoder) ReadUint32() uint32 {
+	b := d.next(4)
+	if b == nil {
+		return 0
+	}
+	return byteOrder.Uint32(b)
+}
+
+// ReadUint64 reads 8-byte unsigned integer.
+func (d *Decoder) ReadUint64() uint64 {
+	b := d.next(8)
+	if b == nil {
+		return 0
+	}
+	return byteOrder.Uint64(b)
+}
+
+// ReadInt64 reads 8-byte signed integer.
+func (d *Decoder) ReadInt64() int64 {
+	return int64(d.ReadUint64())
+}
+
+// ReadFixed fills dst with the next len(dst) bytes.
+func (d *Decoder) ReadFixed(dst []byte) {
+	copy(dst, d.next(len(dst)))
+}
+
+// ReadCount reads number of elements of the following collection.
+// Every element takes at least one byte, so count can't exceed number of unread bytes.
+func (d *Decoder) ReadCount() int {
+	count := d.ReadUint32()
+	if uint64(count) > uint64(len(d.data)) {
+		d.Fail(io.ErrUnexpectedEOF)
+		return 0
+	}
+	return int(count)
+}
+
+// ReadBytes reads data with length prefix. Empty data is returned as nil.
+func (d *Decoder) ReadBytes() []byte {
+	b := d.next(d.ReadCount())
+	if len(b) == 0 {
+		return nil
+	}
+	result := make([]byte, len(b))
+	copy(result, b)
+	return result
+}
+
+// ReadString reads string with length prefix.
+func (d *Decoder) ReadString() string {
+	return string(d.next(d.ReadCount()))
+}
+
+// ReadRef reads record reference.
+func (d *Decoder) ReadRef() core.RecordRef {
+	var ref core.RecordRef
+	d.ReadFixed(ref[:])
+	return ref
+}
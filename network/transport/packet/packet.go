@@ -18,11 +18,13 @@
 package packet
 
 import (
-	"bytes"
 	"encoding/binary"
-	"encoding/gob"
 	"io"
+	"io/ioutil"
+	"math"
+	"net"
 
+	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/log"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/transport/host"
@@ -30,6 +32,21 @@ import (
 	"github.com/pkg/errors"
 )
 
+const (
+	// ProtocolVersion is the version of the packet wire format written by this node.
+	ProtocolVersion uint16 = 1
+	// MinProtocolVersion is the oldest version of the packet wire format this node is able to read.
+	MinProtocolVersion uint16 = 1
+
+	// headerSize is the size of protocol version and body length.
+	headerSize = 6
+	// MaxPacketSize is the maximum allowed size of the packet body.
+	MaxPacketSize = 1 << 27
+)
+
+// ErrUnsupportedVersion is returned when a packet of unknown protocol version is received.
+var ErrUnsupportedVersion = errors.New("unsupported protocol version")
+
 // Packet is DHT packet object.
 type Packet struct {
 	Sender        *host.Host
@@ -44,38 +61,101 @@ type Packet struct {
 	IsResponse bool
 }
 
-// SerializePacket converts packet to byte slice.
+// IsSupportedVersion checks if packets of the protocol version can be read by this node.
+func IsSupportedVersion(version uint16) bool {
+	return version >= MinProtocolVersion && version <= ProtocolVersion
+}
+
+// NegotiateVersion returns the highest protocol version supported both by this node
+// and by the remote node that supports versions from minVersion to maxVersion.
+func NegotiateVersion(minVersion, maxVersion uint16) (uint16, error) {
+	version := maxVersion
+	if version > ProtocolVersion {
+		version = ProtocolVersion
+	}
+	if version < minVersion || version < MinProtocolVersion {
+		return 0, errors.Wrapf(ErrUnsupportedVersion, "remote supports versions %d-%d, local supports %d-%d",
+			minVersion, maxVersion, MinProtocolVersion, ProtocolVersion)
+	}
+	return version, nil
+}
+
+// SerializePacket converts packet to byte slice using the latest protocol version.
 func SerializePacket(q *Packet) ([]byte, error) {
-	var msgBuffer bytes.Buffer
-	enc := gob.NewEncoder(&msgBuffer)
-	err := enc.Encode(q)
-	if err != nil {
-		return nil, errors.Wrap(err, "Failed to serialize packet")
+	return SerializePacketVersion(q, ProtocolVersion)
+}
+
+// SerializePacketVersion converts packet to byte slice using the protocol version negotiated with the receiver.
+//
+// Wire format is:
+//   protocol version (uint16) | body length (uint32) | body
+// where body is:
+//   type (uint32) | request id (uint64) | is response (bool) | sender | receiver |
+//   remote address (string) | trace id (string) | error | data
+func SerializePacketVersion(q *Packet, version uint16) ([]byte, error) {
+	if !IsSupportedVersion(version) {
+		return nil, errors.Wrapf(ErrUnsupportedVersion, "Failed to serialize packet: version %d", version)
 	}
+	e := &Encoder{}
+	e.WriteUint16(version)
+	e.WriteUint32(0) // body length, filled below
 
-	length := msgBuffer.Len()
+	if q.Type < 0 || int64(q.Type) > math.MaxUint32 {
+		return nil, errors.Errorf("Failed to serialize packet: invalid packet type %d", q.Type)
+	}
+	e.WriteUint32(uint32(q.Type))
+	e.WriteUint64(uint64(q.RequestID))
+	e.WriteBool(q.IsResponse)
+	encodeOptionalHost(e, q.Sender)
+	encodeOptionalHost(e, q.Receiver)
+	e.WriteString(q.RemoteAddress)
+	e.WriteString(q.TraceID)
 
-	var lengthBytes [8]byte
-	binary.PutUvarint(lengthBytes[:], uint64(length))
+	e.WriteBool(q.Error != nil)
+	if q.Error != nil {
+		e.WriteString(q.Error.Error())
+	}
 
-	var result []byte
-	result = append(result, lengthBytes[:]...)
-	result = append(result, msgBuffer.Bytes()...)
+	e.WriteBool(q.Data != nil)
+	if q.Data != nil {
+		payload, ok := q.Data.(Payload)
+		if !ok {
+			return nil, errors.Errorf("Failed to serialize packet: %T is not a packet payload", q.Data)
+		}
+		payload.Encode(e)
+	}
 
+	if e.Err() != nil {
+		return nil, errors.Wrap(e.Err(), "Failed to serialize packet")
+	}
+
+	result := e.Bytes()
+	length := len(result) - headerSize
+	if length > MaxPacketSize {
+		return nil, errors.Errorf("Failed to serialize packet: packet size %d exceeds maximum %d", length, MaxPacketSize)
+	}
+	binary.BigEndian.PutUint32(result[2:headerSize], uint32(length))
 	return result, nil
 }
 
 // DeserializePacket reads packet from io.Reader.
 func DeserializePacket(conn io.Reader) (*Packet, error) {
-
-	lengthBytes := make([]byte, 8)
-	if _, err := io.ReadFull(conn, lengthBytes); err != nil {
+	var header [headerSize]byte
+	if _, err := io.ReadFull(conn, header[:]); err != nil {
 		return nil, err
 	}
-	lengthReader := bytes.NewBuffer(lengthBytes)
-	length, err := binary.ReadUvarint(lengthReader)
-	if err != nil {
-		return nil, io.ErrUnexpectedEOF
+	version := binary.BigEndian.Uint16(header[:2])
+	length := binary.BigEndian.Uint32(header[2:])
+	if length > MaxPacketSize {
+		return nil, errors.Errorf("[ DeserializePacket ] packet size %d exceeds maximum %d", length, MaxPacketSize)
+	}
+
+	if !IsSupportedVersion(version) {
+		// skip the body to keep the stream consistent
+		if _, err := io.CopyN(ioutil.Discard, conn, int64(length)); err != nil {
+			return nil, err
+		}
+		return nil, errors.Wrapf(ErrUnsupportedVersion, "[ DeserializePacket ] version %d", version)
 	}
 
 	log.Debugf("[ DeserializePacket ] packet length %d", length)
@@ -86,10 +166,7 @@ func DeserializePacket(conn io.Reader) (*Packet, error) {
 	}
 	log.Debugf("[ DeserializePacket ] read packet")
 
-	msg := &Packet{}
-	dec := gob.NewDecoder(bytes.NewReader(buf))
-
-	err = dec.Decode(msg)
+	msg, err := decodePacket(buf)
 	if err != nil {
 		log.Error("[ DeserializePacket ] couldn't decode packet: ", err)
 		return nil, err
@@ -100,10 +177,91 @@ func DeserializePacket(conn io.Reader) (*Packet, error) {
 	return msg, nil
 }
 
+func decodePacket(data []byte) (*Packet, error) {
+	d := NewDecoder(data)
+	msg := &Packet{}
+
+	msg.Type = types.PacketType(d.ReadUint32())
+	msg.RequestID = network.RequestID(d.ReadUint64())
+	msg.IsResponse = d.ReadBool()
+	msg.Sender = decodeOptionalHost(d)
+	msg.Receiver = decodeOptionalHost(d)
+	msg.RemoteAddress = d.ReadString()
+	msg.TraceID = d.ReadString()
+
+	if d.ReadBool() {
+		msg.Error = errors.New(d.ReadString())
+	}
+
+	if d.ReadBool() && d.Err() == nil {
+		payload, ok := newPayload(msg.Type, msg.IsResponse)
+		if !ok {
+			return nil, errors.Errorf("unknown payload of %s packet (response: %t)", msg.Type, msg.IsResponse)
+		}
+		payload.Decode(d)
+		msg.Data = payload
+	}
+
+	if d.Err() != nil {
+		return nil, errors.Wrap(d.Err(), "failed to decode packet")
+	}
+	if d.Len() != 0 {
+		return nil, errors.Errorf("failed to decode packet: %d unexpected trailing bytes", d.Len())
+	}
+	return msg, nil
+}
+
+func encodeOptionalHost(e *Encoder, h *host.Host) {
+	e.WriteBool(h != nil)
+	if h != nil {
+		encodeHost(e, h)
+	}
+}
+
+func decodeOptionalHost(d *Decoder) *host.Host {
+	if !d.ReadBool() {
+		return nil
+	}
+	h := &host.Host{}
+	decodeHost(d, h)
+	return h
+}
+
+func encodeHost(e *Encoder, h *host.Host) {
+	e.WriteRef(h.NodeID)
+	e.WriteUint32(uint32(h.ShortID))
+
+	e.WriteBool(h.Address != nil)
+	if h.Address == nil {
+		return
+	}
+	if h.Address.Port < 0 || h.Address.Port > math.MaxUint16 {
+		e.Fail(errors.Errorf("invalid port %d", h.Address.Port))
+		return
+	}
+	e.WriteBytes(h.Address.IP)
+	e.WriteUint16(uint16(h.Address.Port))
+	e.WriteString(h.Address.Zone)
+}
+
+func decodeHost(d *Decoder, h *host.Host) {
+	h.NodeID = d.ReadRef()
+	h.ShortID = core.ShortNodeID(d.ReadUint32())
+
+	if !d.ReadBool() {
+		return
+	}
+	h.Address = &host.Address{UDPAddr: net.UDPAddr{
+		IP:   net.IP(d.ReadBytes()),
+		Port: int(d.ReadUint16()),
+		Zone: d.ReadString(),
+	}}
+}
+
 func init() {
-	gob.Register(&RequestPulse{})
-	gob.Register(&RequestGetRandomHosts{})
+	RegisterRequest(types.Pulse, func() Payload { return &RequestPulse{} })
+	RegisterRequest(types.GetRandomHosts, func() Payload { return &RequestGetRandomHosts{} })
 
-	gob.Register(&ResponsePulse{})
-	gob.Register(&ResponseGetRandomHosts{})
+	RegisterResponse(types.Pulse, func() Payload { return &ResponsePulse{} })
+	RegisterResponse(types.GetRandomHosts, func() Payload { return &ResponseGetRandomHosts{} })
 }
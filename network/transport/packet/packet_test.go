@@ -20,18 +20,20 @@ package packet
 import (
 	"bytes"
 	"crypto/rand"
-	"encoding/gob"
+	"encoding/binary"
+	"io"
 	"testing"
 
+	"github.com/google/gofuzz"
+	"github.com/insolar/insolar/core"
 	"github.com/insolar/insolar/network/transport/host"
+	"github.com/insolar/insolar/network/transport/packet/types"
 	"github.com/insolar/insolar/testutils"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func init() {
-	gob.Register(&RequestTest{})
-}
-
 func TestSerializePacket(t *testing.T) {
 	sender, _ := host.NewHostN("127.0.0.1:31337", testutils.RandomRef())
 	receiver, _ := host.NewHostN("127.0.0.2:31338", testutils.RandomRef())
@@ -82,3 +84,197 @@ func TestDeserializeBigPacket(t *testing.T) {
 	deserializedData := deserializedMsg.Data.(*RequestTest).Data
 	require.Equal(t, data, deserializedData)
 }
+
+func roundTrip(t *testing.T, msg *Packet) *Packet {
+	serialized, err := SerializePacket(msg)
+	require.NoError(t, err)
+
+	deserialized, err := DeserializePacket(bytes.NewReader(serialized))
+	require.NoError(t, err)
+	return deserialized
+}
+
+func TestSerializePacket_Payloads(t *testing.T) {
+	sender, _ := host.NewHostNS("127.0.0.1:31337", testutils.RandomRef(), 42)
+	receiver, _ := host.NewHostN("[::1]:31338", testutils.RandomRef())
+	builder := NewBuilder(sender).Receiver(receiver).RequestID(7).TraceID("trace")
+
+	pulse := core.Pulse{
+		PulseNumber:      core.FirstPulseNumber,
+		PrevPulseNumber:  core.FirstPulseNumber - 10,
+		NextPulseNumber:  core.FirstPulseNumber + 10,
+		PulseTimestamp:   1234567,
+		EpochPulseNumber: 1,
+		Signs: map[string]core.PulseSenderConfirmation{
+			"key1": {PulseNumber: core.FirstPulseNumber, ChosenPublicKey: "chosen1", Signature: []byte{1, 2}},
+			"key2": {PulseNumber: core.FirstPulseNumber, ChosenPublicKey: "chosen2", Signature: []byte{3, 4}},
+		},
+	}
+	fuzz.New().Fuzz(&pulse.Entropy)
+	fuzz.New().Fuzz(&pulse.OriginID)
+
+	msgs := []*Packet{
+		builder.Type(types.Ping).Build(),
+		builder.Type(types.Pulse).Request(&RequestPulse{Pulse: pulse}).Build(),
+		builder.Type(types.Pulse).Response(&ResponsePulse{Success: true}).Build(),
+		builder.Type(types.GetRandomHosts).Request(&RequestGetRandomHosts{HostsNumber: 3}).Build(),
+		builder.Type(types.GetRandomHosts).Response(&ResponseGetRandomHosts{Hosts: []host.Host{*sender, *receiver}}).Build(),
+		builder.Type(TestPacket).Response(&ResponseTest{Number: -5}).Error(errors.New("test error")).Build(),
+	}
+	for _, msg := range msgs {
+		deserialized := roundTrip(t, msg)
+		if msg.Error != nil {
+			require.Error(t, deserialized.Error)
+			assert.Equal(t, msg.Error.Error(), deserialized.Error.Error())
+			deserialized.Error = msg.Error
+		}
+		assert.Equal(t, msg, deserialized)
+	}
+}
+
+func TestSerializePacket_UnknownPayload(t *testing.T) {
+	hostOne, _ := host.NewHost("127.0.0.1:31337")
+
+	msg := NewBuilder(hostOne).Type(TestPacket).Request(&struct{}{}).Build()
+	_, err := SerializePacket(msg)
+	assert.Error(t, err)
+
+	msg = NewBuilder(hostOne).Type(types.Ping).Request(&RequestTest{}).Build()
+	serialized, err := SerializePacket(msg)
+	require.NoError(t, err)
+	_, err = DeserializePacket(bytes.NewReader(serialized))
+	assert.Error(t, err)
+}
+
+func TestSerializePacketVersion(t *testing.T) {
+	hostOne, _ := host.NewHost("127.0.0.1:31337")
+	msg := NewBuilder(hostOne).Type(TestPacket).Request(&RequestTest{[]byte{0, 1, 2, 3}}).Build()
+
+	serialized, err := SerializePacketVersion(msg, MinProtocolVersion)
+	require.NoError(t, err)
+	assert.Equal(t, MinProtocolVersion, binary.BigEndian.Uint16(serialized))
+
+	_, err = SerializePacketVersion(msg, ProtocolVersion+1)
+	assert.Equal(t, ErrUnsupportedVersion, errors.Cause(err))
+}
+
+func TestDeserializePacket_UnsupportedVersion(t *testing.T) {
+	hostOne, _ := host.NewHost("127.0.0.1:31337")
+	msg := NewBuilder(hostOne).Type(TestPacket).Request(&RequestTest{[]byte{0, 1, 2, 3}}).Build()
+
+	serialized, err := SerializePacket(msg)
+	require.NoError(t, err)
+	unsupported := make([]byte, len(serialized))
+	copy(unsupported, serialized)
+	binary.BigEndian.PutUint16(unsupported, ProtocolVersion+1)
+
+	var buffer bytes.Buffer
+	buffer.Write(unsupported)
+	buffer.Write(serialized)
+
+	_, err = DeserializePacket(&buffer)
+	assert.Equal(t, ErrUnsupportedVersion, errors.Cause(err))
+
+	// the next packet in the stream is still readable
+	deserialized, err := DeserializePacket(&buffer)
+	require.NoError(t, err)
+	assert.Equal(t, msg, deserialized)
+}
+
+func TestDeserializePacket_TooBig(t *testing.T) {
+	header := make([]byte, headerSize)
+	binary.BigEndian.PutUint16(header, ProtocolVersion)
+	binary.BigEndian.PutUint32(header[2:], MaxPacketSize+1)
+
+	_, err := DeserializePacket(bytes.NewReader(header))
+	assert.Error(t, err)
+}
+
+func TestDeserializePacket_Truncated(t *testing.T) {
+	sender, _ := host.NewHostN("127.0.0.1:31337", testutils.RandomRef())
+	msg := NewBuilder(sender).Receiver(sender).Type(TestPacket).Request(&RequestTest{[]byte{0, 1, 2, 3}}).Build()
+	serialized, err := SerializePacket(msg)
+	require.NoError(t, err)
+
+	for i := 0; i < len(serialized); i++ {
+		_, err := DeserializePacket(bytes.NewReader(serialized[:i]))
+		assert.Error(t, err)
+	}
+
+	// body is shorter than declared length
+	body := serialized[headerSize:]
+	for i := 0; i < len(body); i++ {
+		_, err := decodePacket(body[:i])
+		assert.Error(t, err)
+	}
+
+	// trailing bytes after the packet
+	_, err = decodePacket(append(body, 0))
+	assert.Error(t, err)
+}
+
+func TestDeserializePacket_Fuzz(t *testing.T) {
+	sender, _ := host.NewHostN("127.0.0.1:31337", testutils.RandomRef())
+	builder := NewBuilder(sender).Receiver(sender)
+	msgs := []*Packet{
+		builder.Type(TestPacket).Request(&RequestTest{[]byte{0, 1, 2, 3}}).Build(),
+		builder.Type(types.Pulse).Request(&RequestPulse{Pulse: core.Pulse{Signs: map[string]core.PulseSenderConfirmation{"key": {}}}}).Build(),
+		builder.Type(types.GetRandomHosts).Response(&ResponseGetRandomHosts{Hosts: []host.Host{*sender}}).Build(),
+	}
+
+	f := fuzz.New()
+	for _, msg := range msgs {
+		serialized, err := SerializePacket(msg)
+		require.NoError(t, err)
+		body := serialized[headerSize:]
+
+		for i := 0; i < 1000; i++ {
+			// corrupt random bytes of the valid packet
+			corrupted := make([]byte, len(body))
+			copy(corrupted, body)
+			var index uint16
+			var value byte
+			for j := 0; j < 3; j++ {
+				f.Fuzz(&index)
+				f.Fuzz(&value)
+				corrupted[int(index)%len(corrupted)] = value
+			}
+			assert.NotPanics(t, func() { decodePacket(corrupted) })
+		}
+	}
+
+	for i := 0; i < 1000; i++ {
+		var data []byte
+		f.Fuzz(&data)
+		assert.NotPanics(t, func() { decodePacket(data) })
+		assert.NotPanics(t, func() { DeserializePacket(bytes.NewReader(data)) })
+	}
+}
+
+func TestNegotiateVersion(t *testing.T) {
+	version, err := NegotiateVersion(MinProtocolVersion, ProtocolVersion)
+	require.NoError(t, err)
+	assert.Equal(t, ProtocolVersion, version)
+
+	version, err = NegotiateVersion(MinProtocolVersion, ProtocolVersion+10)
+	require.NoError(t, err)
+	assert.Equal(t, ProtocolVersion, version)
+
+	_, err = NegotiateVersion(ProtocolVersion+1, ProtocolVersion+10)
+	assert.Equal(t, ErrUnsupportedVersion, errors.Cause(err))
+
+	_, err = NegotiateVersion(0, MinProtocolVersion-1)
+	assert.Equal(t, ErrUnsupportedVersion, errors.Cause(err))
+}
+
+func TestDecoder_Errors(t *testing.T) {
+	d := NewDecoder([]byte{2, 0, 0, 0, 10, 1})
+	assert.False(t, d.ReadBool())
+	assert.Error(t, d.Err())
+
+	d = NewDecoder([]byte{0, 0, 0, 10, 1})
+	assert.Nil(t, d.ReadBytes())
+	assert.Equal(t, io.ErrUnexpectedEOF, d.Err())
+	// all reads after an error return zero values
+	assert.Equal(t, uint8(0), d.ReadUint8())
+}
@@ -0,0 +1,63 @@
+/*
+ * The Clear BSD License
+ *
+ * Copyright (c) 2019 Insolar Technologies
+ *
+ * All rights reserved.
+ *
+ * Redistribution and use in source and binary forms, with or without modification, are permitted (subject to the limitations in the disclaimer below) provided that the following conditions are met:
+ *
+ *  Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+ *  Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+ *  Neither the name of Insolar Technologies nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+ *
+ * NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ */
+package packet
+
+import (
+	"fmt"
+
+	"github.com/insolar/insolar/network/transport/packet/types"
+)
+
+// Payload is the data of a packet that knows how to write itself to the wire.
+type Payload interface {
+	Encode(e *Encoder)
+	Decode(d *Decoder)
+}
+
+type payloadKey struct {
+	packetType types.PacketType
+	isResponse bool
+}
+
+var payloadFactories = make(map[payloadKey]func() Payload)
+
+// RegisterRequest registers the payload constructor for requests of packetType.
+// It should be called from init functions only.
+func RegisterRequest(packetType types.PacketType, factory func() Payload) {
+	registerPayload(payloadKey{packetType: packetType}, factory)
+}
+
+// RegisterResponse registers the payload constructor for responses to packetType.
+// It should be called from init functions only.
+func RegisterResponse(packetType types.PacketType, factory func() Payload) {
+	registerPayload(payloadKey{packetType: packetType, isResponse: true}, factory)
+}
+
+func registerPayload(key payloadKey, factory func() Payload) {
+	if _, ok := payloadFactories[key]; ok {
+		panic(fmt.Sprintf("payload for %s (response: %t) is already registered", key.packetType, key.isResponse))
+	}
+	payloadFactories[key] = factory
+}
+
+func newPayload(packetType types.PacketType, isResponse bool) (Payload, bool) {
+	factory, ok := payloadFactories[payloadKey{packetType: packetType, isResponse: isResponse}]
+	if !ok {
+		return nil, false
+	}
+	return factory(), true
+}
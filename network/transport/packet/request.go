@@ -18,6 +18,8 @@
 package packet
 
 import (
+	"sort"
+
 	"github.com/insolar/insolar/core"
 )
 
@@ -30,3 +32,75 @@ type RequestPulse struct {
 type RequestGetRandomHosts struct {
 	HostsNumber int
 }
+
+// Encode implements Payload interface.
+func (r *RequestPulse) Encode(e *Encoder) {
+	encodePulse(e, &r.Pulse)
+}
+
+// Decode implements Payload interface.
+func (r *RequestPulse) Decode(d *Decoder) {
+	decodePulse(d, &r.Pulse)
+}
+
+// Encode implements Payload interface.
+func (r *RequestGetRandomHosts) Encode(e *Encoder) {
+	e.WriteInt64(int64(r.HostsNumber))
+}
+
+// Decode implements Payload interface.
+func (r *RequestGetRandomHosts) Decode(d *Decoder) {
+	r.HostsNumber = int(d.ReadInt64())
+}
+
+func encodePulse(e *Encoder, pulse *core.Pulse) {
+	e.WriteUint32(uint32(pulse.PulseNumber))
+	e.WriteUint32(uint32(pulse.PrevPulseNumber))
+	e.WriteUint32(uint32(pulse.NextPulseNumber))
+	e.WriteInt64(pulse.PulseTimestamp)
+	e.WriteInt64(int64(pulse.EpochPulseNumber))
+	e.WriteFixed(pulse.OriginID[:])
+	e.WriteFixed(pulse.Entropy[:])
+
+	// sort keys to get the same bytes for the same pulse
+	keys := make([]string, 0, len(pulse.Signs))
+	for key := range pulse.Signs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	e.WriteCount(len(keys))
+	for _, key := range keys {
+		sign := pulse.Signs[key]
+		e.WriteString(key)
+		e.WriteUint32(uint32(sign.PulseNumber))
+		e.WriteString(sign.ChosenPublicKey)
+		e.WriteFixed(sign.Entropy[:])
+		e.WriteBytes(sign.Signature)
+	}
+}
+
+func decodePulse(d *Decoder, pulse *core.Pulse) {
+	pulse.PulseNumber = core.PulseNumber(d.ReadUint32())
+	pulse.PrevPulseNumber = core.PulseNumber(d.ReadUint32())
+	pulse.NextPulseNumber = core.PulseNumber(d.ReadUint32())
+	pulse.PulseTimestamp = d.ReadInt64()
+	pulse.EpochPulseNumber = int(d.ReadInt64())
+	d.ReadFixed(pulse.OriginID[:])
+	d.ReadFixed(pulse.Entropy[:])
+
+	count := d.ReadCount()
+	if count == 0 {
+		return
+	}
+	pulse.Signs = make(map[string]core.PulseSenderConfirmation, count)
+	for i := 0; i < count && d.Err() == nil; i++ {
+		key := d.ReadString()
+		sign := core.PulseSenderConfirmation{}
+		sign.PulseNumber = core.PulseNumber(d.ReadUint32())
+		sign.ChosenPublicKey = d.ReadString()
+		d.ReadFixed(sign.Entropy[:])
+		sign.Signature = d.ReadBytes()
+		pulse.Signs[key] = sign
+	}
+}
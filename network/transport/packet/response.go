@@ -32,3 +32,36 @@ type ResponseGetRandomHosts struct {
 	Hosts []host.Host
 	Error string
 }
+
+// Encode implements Payload interface.
+func (r *ResponsePulse) Encode(e *Encoder) {
+	e.WriteBool(r.Success)
+	e.WriteString(r.Error)
+}
+
+// Decode implements Payload interface.
+func (r *ResponsePulse) Decode(d *Decoder) {
+	r.Success = d.ReadBool()
+	r.Error = d.ReadString()
+}
+
+// Encode implements Payload interface.
+func (r *ResponseGetRandomHosts) Encode(e *Encoder) {
+	e.WriteCount(len(r.Hosts))
+	for i := range r.Hosts {
+		encodeHost(e, &r.Hosts[i])
+	}
+	e.WriteString(r.Error)
+}
+
+// Decode implements Payload interface.
+func (r *ResponseGetRandomHosts) Decode(d *Decoder) {
+	count := d.ReadCount()
+	if count > 0 {
+		r.Hosts = make([]host.Host, count)
+		for i := 0; i < count && d.Err() == nil; i++ {
+			decodeHost(d, &r.Hosts[i])
+		}
+	}
+	r.Error = d.ReadString()
+}
@@ -32,3 +32,28 @@ type RequestTest struct {
 type ResponseTest struct {
 	Number int
 }
+
+// Encode implements Payload interface.
+func (r *RequestTest) Encode(e *Encoder) {
+	e.WriteBytes(r.Data)
+}
+
+// Decode implements Payload interface.
+func (r *RequestTest) Decode(d *Decoder) {
+	r.Data = d.ReadBytes()
+}
+
+// Encode implements Payload interface.
+func (r *ResponseTest) Encode(e *Encoder) {
+	e.WriteInt64(int64(r.Number))
+}
+
+// Decode implements Payload interface.
+func (r *ResponseTest) Decode(d *Decoder) {
+	r.Number = int(d.ReadInt64())
+}
+
+func init() {
+	RegisterRequest(TestPacket, func() Payload { return &RequestTest{} })
+	RegisterResponse(TestPacket, func() Payload { return &ResponseTest{} })
+}
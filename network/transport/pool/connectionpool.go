@@ -21,41 +21,72 @@ import (
 	"context"
 	"net"
 	"sync"
+	"time"
 
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/metrics"
+	"github.com/insolar/insolar/utils/backoff"
 )
 
+// maxReconnectAttempts is an amount of reconnect attempts before the pool gives up on the peer.
+const maxReconnectAttempts = 10
+
 type connectionPool struct {
 	connectionFactory connectionFactory
+	backoff           *backoff.Backoff
 
 	entryHolder entryHolder
 	mutex       sync.RWMutex
+	stopped     chan struct{}
+
+	peers      map[string]*peerHealth
+	peersMutex sync.Mutex
 }
 
 func newConnectionPool(connectionFactory connectionFactory) *connectionPool {
 	return &connectionPool{
 		connectionFactory: connectionFactory,
+		backoff: &backoff.Backoff{
+			Min:    100 * time.Millisecond,
+			Max:    5 * time.Second,
+			Factor: 2,
+			Jitter: true,
+		},
 
 		entryHolder: newEntryHolder(),
+		stopped:     make(chan struct{}),
+
+		peers: make(map[string]*peerHealth),
 	}
 }
 
+// GetConnection returns connection to the address, dialing it if needed.
+// Peer is dialed even if the pool is reconnecting to it in background, so a single failure doesn't block the peer.
 func (cp *connectionPool) GetConnection(ctx context.Context, address net.Addr) (net.Conn, error) {
 	logger := inslogger.FromContext(ctx)
 
+	health := cp.getPeer(address.String())
 	entry, ok := cp.getEntry(address)
 
 	logger.Debugf("[ GetConnection ] Finding entry for connection to %s in pool: %t", address, ok)
 
-	if ok {
-		return entry.Open(ctx)
+	if !ok {
+		logger.Debugf("[ GetConnection ] Missing entry for connection to %s in pool ", address)
+		entry = cp.getOrCreateEntry(ctx, address)
 	}
 
-	logger.Debugf("[ GetConnection ] Missing entry for connection to %s in pool ", address)
-	entry = cp.getOrCreateEntry(ctx, address)
-
-	return entry.Open(ctx)
+	conn, err := entry.Open(ctx)
+	if err != nil {
+		health.setUnreachable(true)
+		cp.startReconnect(ctx, address, health)
+		return nil, err
+	}
+	if health.isUnreachable() {
+		// background reconnect will find the connection opened and finish
+		logger.Infof("[ GetConnection ] Connected to %s while reconnecting", address)
+		health.setUnreachable(false)
+	}
+	return conn, nil
 }
 
 func (cp *connectionPool) CloseConnection(ctx context.Context, address net.Addr) {
@@ -76,6 +107,49 @@ func (cp *connectionPool) CloseConnection(ctx context.Context, address net.Addr)
 	}
 }
 
+// onRemoteClose drops connection closed by the remote peer and restores it in background.
+func (cp *connectionPool) onRemoteClose(ctx context.Context, address net.Addr) {
+	cp.CloseConnection(ctx, address)
+	cp.startReconnect(ctx, address, cp.getPeer(address.String()))
+}
+
+func (cp *connectionPool) startReconnect(ctx context.Context, address net.Addr, health *peerHealth) {
+	if !health.startReconnect() {
+		return
+	}
+
+	cp.mutex.RLock()
+	stopped := cp.stopped
+	cp.mutex.RUnlock()
+
+	go cp.reconnect(ctx, address, health, stopped)
+}
+
+func (cp *connectionPool) reconnect(ctx context.Context, address net.Addr, health *peerHealth, stopped <-chan struct{}) {
+	logger := inslogger.FromContext(ctx)
+	defer health.stopReconnect()
+
+	b := cp.backoff.Copy()
+	for b.Attempt() < maxReconnectAttempts {
+		select {
+		case <-stopped:
+			return
+		case <-time.After(b.Duration()):
+		}
+
+		_, err := cp.getOrCreateEntry(ctx, address).Open(ctx)
+		if err == nil {
+			logger.Infof("[ reconnect ] Restored connection to %s", address)
+			health.reconnected()
+			return
+		}
+		logger.Debugf("[ reconnect ] Attempt %d to reconnect to %s failed: %s", b.Attempt(), address, err)
+	}
+
+	// peer stays unreachable until it is dialed successfully
+	logger.Warnf("[ reconnect ] Failed to reconnect to %s after %d attempts", address, maxReconnectAttempts)
+}
+
 func (cp *connectionPool) getEntry(address net.Addr) (entry, bool) {
 	cp.mutex.RLock()
 	defer cp.mutex.RUnlock()
@@ -98,7 +172,7 @@ func (cp *connectionPool) getOrCreateEntry(ctx context.Context, address net.Addr
 
 	logger.Debugf("[ getOrCreateEntry ] Failed to retrieve entry for connection to %s, creating it", address)
 
-	entry = newEntry(cp.connectionFactory, address, cp.CloseConnection)
+	entry = newEntry(cp.connectionFactory, address, cp.onRemoteClose)
 
 	cp.entryHolder.Add(address, entry)
 	size := cp.entryHolder.Size()
@@ -112,13 +186,55 @@ func (cp *connectionPool) getOrCreateEntry(ctx context.Context, address net.Addr
 	return entry
 }
 
+func (cp *connectionPool) getPeer(address string) *peerHealth {
+	cp.peersMutex.Lock()
+	defer cp.peersMutex.Unlock()
+
+	health, ok := cp.peers[address]
+	if !ok {
+		health = newPeerHealth(address)
+		cp.peers[address] = health
+	}
+	return health
+}
+
+func (cp *connectionPool) RequestStarted(address string) {
+	cp.getPeer(address).requestStarted()
+}
+
+func (cp *connectionPool) RequestFinished(address string, ok bool) {
+	cp.getPeer(address).requestFinished(ok)
+}
+
+func (cp *connectionPool) ObserveRTT(address string, rtt time.Duration) {
+	cp.getPeer(address).observeRTT(rtt)
+}
+
+func (cp *connectionPool) PeerStats(address string) (PeerStats, bool) {
+	cp.peersMutex.Lock()
+	health, ok := cp.peers[address]
+	cp.peersMutex.Unlock()
+
+	if !ok {
+		return PeerStats{}, false
+	}
+	return health.Stats(), true
+}
+
 func (cp *connectionPool) Reset() {
 	cp.mutex.Lock()
 	defer cp.mutex.Unlock()
 
+	close(cp.stopped)
+	cp.stopped = make(chan struct{})
+
 	cp.entryHolder.Iterate(func(entry entry) {
 		entry.Close()
 	})
 	cp.entryHolder.Clear()
 	metrics.NetworkConnections.Set(float64(cp.entryHolder.Size()))
+
+	cp.peersMutex.Lock()
+	cp.peers = make(map[string]*peerHealth)
+	cp.peersMutex.Unlock()
 }
@@ -0,0 +1,254 @@
+/*
+ * The Clear BSD License
+ *
+ * Copyright (c) 2019 Insolar Technologies
+ *
+ * All rights reserved.
+ *
+ * Redistribution and use in source and binary forms, with or without modification, are permitted (subject to the limitations in the disclaimer below) provided that the following conditions are met:
+ *
+ *  Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+ *  Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+ *  Neither the name of Insolar Technologies nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+ *
+ * NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ */
+
+package pool
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/utils/backoff"
+)
+
+type pipeFactory struct {
+	mutex sync.Mutex
+	// fails is an amount of connection attempts that should fail
+	fails   int
+	calls   int
+	remotes []net.Conn
+}
+
+func (f *pipeFactory) CreateConnection(ctx context.Context, address net.Addr) (net.Conn, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.calls++
+	if f.fails > 0 {
+		f.fails--
+		return nil, errors.New("connection refused")
+	}
+	local, remote := net.Pipe()
+	f.remotes = append(f.remotes, remote)
+	return local, nil
+}
+
+func (f *pipeFactory) getCalls() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.calls
+}
+
+func (f *pipeFactory) closeRemotes() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, conn := range f.remotes {
+		conn.Close()
+	}
+}
+
+func newTestPool(factory connectionFactory) *connectionPool {
+	cp := newConnectionPool(factory)
+	cp.backoff = &backoff.Backoff{Min: 5 * time.Millisecond, Max: 20 * time.Millisecond}
+	return cp
+}
+
+func waitPeerStats(t *testing.T, cp *connectionPool, address string, check func(PeerStats) bool) PeerStats {
+	for i := 0; i < 300; i++ {
+		if stats, ok := cp.PeerStats(address); ok && check(stats) {
+			return stats
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	stats, _ := cp.PeerStats(address)
+	require.FailNow(t, "peer stats were not updated", "%+v", stats)
+	return stats
+}
+
+func waitFactoryCalls(t *testing.T, factory *pipeFactory, calls int) {
+	for i := 0; i < 300; i++ {
+		if factory.getCalls() >= calls {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.FailNow(t, "connection was not dialed", "calls: %d", factory.getCalls())
+}
+
+func TestPeerStats_Score(t *testing.T) {
+	require.Equal(t, 1.0, PeerStats{}.Score())
+	require.True(t, PeerStats{}.Healthy())
+
+	require.Equal(t, 0.5, PeerStats{RTT: scoreRTT}.Score())
+	require.Equal(t, 0.5, PeerStats{InFlight: scoreInFlight}.Score())
+	require.Equal(t, 0.5, PeerStats{ErrorRate: 0.5}.Score())
+	require.Equal(t, 0.0, PeerStats{Unreachable: true}.Score())
+
+	require.False(t, PeerStats{ErrorRate: unhealthyErrorRate}.Healthy())
+	require.False(t, PeerStats{Unreachable: true}.Healthy())
+}
+
+func TestConnectionPool_PeerStats(t *testing.T) {
+	cp := newTestPool(&pipeFactory{})
+	address := "127.0.0.1:1234"
+
+	_, ok := cp.PeerStats(address)
+	require.False(t, ok)
+
+	cp.RequestStarted(address)
+	cp.RequestStarted(address)
+	stats, ok := cp.PeerStats(address)
+	require.True(t, ok)
+	require.Equal(t, int64(2), stats.InFlight)
+
+	cp.RequestFinished(address, true)
+	cp.RequestFinished(address, false)
+	cp.ObserveRTT(address, 80*time.Millisecond)
+	cp.ObserveRTT(address, 160*time.Millisecond)
+
+	stats, _ = cp.PeerStats(address)
+	require.Equal(t, PeerStats{
+		Address:   address,
+		RTT:       90 * time.Millisecond,
+		Requests:  2,
+		Errors:    1,
+		ErrorRate: errorSmoothing,
+	}, stats)
+
+	cp.Reset()
+	_, ok = cp.PeerStats(address)
+	require.False(t, ok)
+}
+
+func TestConnectionPool_ReconnectsUnreachablePeer(t *testing.T) {
+	ctx := context.Background()
+	factory := &pipeFactory{fails: 3}
+	cp := newTestPool(factory)
+	defer cp.Reset()
+	defer factory.closeRemotes()
+	address := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1234}
+
+	_, err := cp.GetConnection(ctx, address)
+	require.Error(t, err)
+	stats, _ := cp.PeerStats(address.String())
+	require.True(t, stats.Unreachable)
+
+	stats = waitPeerStats(t, cp, address.String(), func(s PeerStats) bool { return s.Reconnects > 0 })
+	require.False(t, stats.Unreachable)
+	require.Equal(t, 4, factory.getCalls())
+
+	conn, err := cp.GetConnection(ctx, address)
+	require.NoError(t, err)
+	require.NotNil(t, conn)
+	require.Equal(t, 4, factory.getCalls())
+}
+
+func TestConnectionPool_DialsDuringReconnect(t *testing.T) {
+	ctx := context.Background()
+	factory := &pipeFactory{fails: 1}
+	cp := newTestPool(factory)
+	// background reconnect waits longer than the test
+	cp.backoff = &backoff.Backoff{Min: time.Minute, Max: time.Minute}
+	defer cp.Reset()
+	defer factory.closeRemotes()
+	address := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1234}
+
+	_, err := cp.GetConnection(ctx, address)
+	require.Error(t, err)
+	stats, _ := cp.PeerStats(address.String())
+	require.True(t, stats.Unreachable)
+
+	// a single failure doesn't block the peer until reconnect finishes
+	conn, err := cp.GetConnection(ctx, address)
+	require.NoError(t, err)
+	require.NotNil(t, conn)
+	require.Equal(t, 2, factory.getCalls())
+	stats, _ = cp.PeerStats(address.String())
+	require.False(t, stats.Unreachable)
+}
+
+func TestConnectionPool_ReconnectsClosedConnection(t *testing.T) {
+	ctx := context.Background()
+	factory := &pipeFactory{}
+	cp := newTestPool(factory)
+	defer cp.Reset()
+	defer factory.closeRemotes()
+	address := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1234}
+
+	conn, err := cp.GetConnection(ctx, address)
+	require.NoError(t, err)
+
+	factory.closeRemotes()
+
+	waitPeerStats(t, cp, address.String(), func(s PeerStats) bool { return s.Reconnects > 0 })
+	require.Equal(t, 2, factory.getCalls())
+
+	restored, err := cp.GetConnection(ctx, address)
+	require.NoError(t, err)
+	require.NotEqual(t, conn, restored)
+}
+
+func TestConnectionPool_CloseConnectionDoesNotReconnect(t *testing.T) {
+	ctx := context.Background()
+	factory := &pipeFactory{}
+	cp := newTestPool(factory)
+	defer cp.Reset()
+	defer factory.closeRemotes()
+	address := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1234}
+
+	_, err := cp.GetConnection(ctx, address)
+	require.NoError(t, err)
+
+	cp.CloseConnection(ctx, address)
+	time.Sleep(50 * time.Millisecond)
+
+	require.Equal(t, 1, factory.getCalls())
+	stats, _ := cp.PeerStats(address.String())
+	require.Equal(t, uint64(0), stats.Reconnects)
+}
+
+func TestConnectionPool_GivesUpReconnect(t *testing.T) {
+	ctx := context.Background()
+	factory := &pipeFactory{fails: maxReconnectAttempts + 1}
+	cp := newTestPool(factory)
+	defer cp.Reset()
+	defer factory.closeRemotes()
+	address := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1234}
+
+	_, err := cp.GetConnection(ctx, address)
+	require.Error(t, err)
+
+	waitFactoryCalls(t, factory, maxReconnectAttempts+1)
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, maxReconnectAttempts+1, factory.getCalls())
+	stats, _ := cp.PeerStats(address.String())
+	require.True(t, stats.Unreachable)
+	require.Equal(t, uint64(0), stats.Reconnects)
+
+	// successful dial clears unreachable state
+	_, err = cp.GetConnection(ctx, address)
+	require.NoError(t, err)
+	stats, _ = cp.PeerStats(address.String())
+	require.False(t, stats.Unreachable)
+}
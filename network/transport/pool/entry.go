@@ -82,6 +82,10 @@ func (e *entryImpl) open(ctx context.Context) (net.Conn, error) {
 		b := make([]byte, 1)
 		_, err := conn.Read(b)
 		if err != nil {
+			if !e.isCurrent(conn) {
+				// connection was closed by the pool
+				return
+			}
 			logger.Infof("[ Open ] remote host 'closed' connection to %s: %s", e.address, err)
 			e.onClose(ctx, e.address)
 			return
@@ -93,11 +97,19 @@ func (e *entryImpl) open(ctx context.Context) (net.Conn, error) {
 	return conn, nil
 }
 
+func (e *entryImpl) isCurrent(conn net.Conn) bool {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return e.conn == conn
+}
+
 func (e *entryImpl) Close() {
 	e.mutex.Lock()
 	defer e.mutex.Unlock()
 
 	if e.conn != nil {
 		utils.CloseVerbose(e.conn)
+		e.conn = nil
 	}
 }
@@ -0,0 +1,182 @@
+/*
+ * The Clear BSD License
+ *
+ * Copyright (c) 2019 Insolar Technologies
+ *
+ * All rights reserved.
+ *
+ * Redistribution and use in source and binary forms, with or without modification, are permitted (subject to the limitations in the disclaimer below) provided that the following conditions are met:
+ *
+ *  Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+ *  Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+ *  Neither the name of Insolar Technologies nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+ *
+ * NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ */
+
+package pool
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+)
+
+const (
+	// rttSmoothing is a weight of the new RTT sample, the same as for TCP smoothed RTT (RFC 6298).
+	rttSmoothing = 0.125
+	// errorSmoothing is a weight of the last request outcome in the error rate.
+	errorSmoothing = 0.1
+	// unhealthyErrorRate is an error rate starting from which the peer is considered unhealthy.
+	unhealthyErrorRate = 0.5
+	// scoreRTT and scoreInFlight are RTT and amount of in-flight requests that halve the peer score.
+	scoreRTT      = 100 * time.Millisecond
+	scoreInFlight = 16
+)
+
+// PeerStats is a health state of the remote peer.
+type PeerStats struct {
+	Address string
+	// RTT is a smoothed round trip time of pings to the peer, zero if the peer was not pinged yet.
+	RTT time.Duration
+	// InFlight is an amount of requests waiting for response from the peer.
+	InFlight int64
+	// Requests is an amount of finished requests to the peer.
+	Requests uint64
+	// Errors is an amount of requests to the peer that failed or timed out.
+	Errors uint64
+	// ErrorRate is an exponentially weighted share of failed requests.
+	ErrorRate float64
+	// Reconnects is an amount of connections to the peer restored after failure.
+	Reconnects uint64
+	// Unreachable is true since the last failed connection attempt to the peer until connection is restored.
+	Unreachable bool
+}
+
+// Healthy returns true if the peer is reachable and most of recent requests to it succeed.
+func (s PeerStats) Healthy() bool {
+	return !s.Unreachable && s.ErrorRate < unhealthyErrorRate
+}
+
+// Score returns health score of the peer from 0 to 1, higher is better.
+// Score decreases with error rate, RTT and amount of in-flight requests.
+func (s PeerStats) Score() float64 {
+	if s.Unreachable {
+		return 0
+	}
+	load := 1 + float64(s.RTT)/float64(scoreRTT) + float64(s.InFlight)/scoreInFlight
+	return (1 - s.ErrorRate) / load
+}
+
+type peerHealth struct {
+	ctx context.Context
+
+	mutex        sync.Mutex
+	stats        PeerStats
+	reconnecting bool
+}
+
+func newPeerHealth(address string) *peerHealth {
+	return &peerHealth{
+		ctx:   insmetrics.InsertTag(context.Background(), tagPeer, address),
+		stats: PeerStats{Address: address},
+	}
+}
+
+func (h *peerHealth) Stats() PeerStats {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	return h.stats
+}
+
+func (h *peerHealth) requestStarted() {
+	h.mutex.Lock()
+	h.stats.InFlight++
+	inFlight := h.stats.InFlight
+	h.mutex.Unlock()
+
+	stats.Record(h.ctx, statPeerInFlight.M(inFlight))
+}
+
+func (h *peerHealth) requestFinished(ok bool) {
+	h.mutex.Lock()
+	// stats could be reset while request was in flight
+	if h.stats.InFlight > 0 {
+		h.stats.InFlight--
+	}
+	h.stats.Requests++
+	outcome := 0.0
+	if !ok {
+		h.stats.Errors++
+		outcome = 1
+	}
+	h.stats.ErrorRate += errorSmoothing * (outcome - h.stats.ErrorRate)
+	inFlight := h.stats.InFlight
+	h.mutex.Unlock()
+
+	stats.Record(h.ctx, statPeerInFlight.M(inFlight), statPeerRequests.M(1))
+	if !ok {
+		stats.Record(h.ctx, statPeerErrors.M(1))
+	}
+}
+
+func (h *peerHealth) observeRTT(rtt time.Duration) {
+	h.mutex.Lock()
+	if h.stats.RTT == 0 {
+		h.stats.RTT = rtt
+	} else {
+		h.stats.RTT += time.Duration(rttSmoothing * float64(rtt-h.stats.RTT))
+	}
+	smoothed := h.stats.RTT
+	h.mutex.Unlock()
+
+	stats.Record(h.ctx, statPeerRTT.M(int64(smoothed/time.Millisecond)))
+}
+
+func (h *peerHealth) isUnreachable() bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	return h.stats.Unreachable
+}
+
+func (h *peerHealth) setUnreachable(unreachable bool) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.stats.Unreachable = unreachable
+}
+
+func (h *peerHealth) reconnected() {
+	h.mutex.Lock()
+	h.stats.Unreachable = false
+	h.stats.Reconnects++
+	h.mutex.Unlock()
+
+	stats.Record(h.ctx, statPeerReconnects.M(1))
+}
+
+// startReconnect returns false if reconnect to the peer is already in progress.
+func (h *peerHealth) startReconnect() bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if h.reconnecting {
+		return false
+	}
+	h.reconnecting = true
+	return true
+}
+
+func (h *peerHealth) stopReconnect() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.reconnecting = false
+}
@@ -20,12 +20,21 @@ package pool
 import (
 	"context"
 	"net"
+	"time"
 )
 
 type ConnectionPool interface {
 	GetConnection(ctx context.Context, address net.Addr) (net.Conn, error)
 	CloseConnection(ctx context.Context, address net.Addr)
 	Reset()
+
+	// RequestStarted and RequestFinished track requests waiting for response from the peer.
+	RequestStarted(address string)
+	RequestFinished(address string, ok bool)
+	// ObserveRTT updates round trip time to the peer.
+	ObserveRTT(address string, rtt time.Duration)
+	// PeerStats returns health state of the peer, false if there were no requests to it.
+	PeerStats(address string) (PeerStats, bool)
 }
 
 type connectionFactory interface {
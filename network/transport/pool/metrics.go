@@ -0,0 +1,91 @@
+/*
+ * The Clear BSD License
+ *
+ * Copyright (c) 2019 Insolar Technologies
+ *
+ * All rights reserved.
+ *
+ * Redistribution and use in source and binary forms, with or without modification, are permitted (subject to the limitations in the disclaimer below) provided that the following conditions are met:
+ *
+ *  Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+ *  Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+ *  Neither the name of Insolar Technologies nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+ *
+ * NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ */
+
+package pool
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+)
+
+var (
+	tagPeer = insmetrics.MustTagKey("peer")
+)
+
+var (
+	statPeerRTT = stats.Int64(
+		"network/peer/rtt",
+		"smoothed round trip time to the peer",
+		stats.UnitMilliseconds,
+	)
+	statPeerInFlight = stats.Int64(
+		"network/peer/inflight",
+		"number of requests to the peer waiting for response",
+		stats.UnitDimensionless,
+	)
+	statPeerRequests = stats.Int64(
+		"network/peer/requests",
+		"number of finished requests to the peer",
+		stats.UnitDimensionless,
+	)
+	statPeerErrors = stats.Int64(
+		"network/peer/errors",
+		"number of failed requests to the peer",
+		stats.UnitDimensionless,
+	)
+	statPeerReconnects = stats.Int64(
+		"network/peer/reconnects",
+		"number of connections to the peer restored after failure",
+		stats.UnitDimensionless,
+	)
+)
+
+func init() {
+	err := view.Register(
+		&view.View{
+			Measure:     statPeerRTT,
+			Aggregation: view.LastValue(),
+			TagKeys:     []tag.Key{tagPeer},
+		},
+		&view.View{
+			Measure:     statPeerInFlight,
+			Aggregation: view.LastValue(),
+			TagKeys:     []tag.Key{tagPeer},
+		},
+		&view.View{
+			Measure:     statPeerRequests,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagPeer},
+		},
+		&view.View{
+			Measure:     statPeerErrors,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagPeer},
+		},
+		&view.View{
+			Measure:     statPeerReconnects,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagPeer},
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -44,19 +44,25 @@ type quicTransport struct {
 	l           quic.Listener
 	conn        net.PacketConn
 	connections map[string]quicConnection
+	tlsConfig   *tls.Config
 }
 
-func newQuicTransport(conn net.PacketConn, proxy relay.Proxy, publicAddress string) (*quicTransport, error) {
-	listener, err := quic.Listen(conn, generateTLSConfig(), nil)
+func newQuicTransport(conn net.PacketConn, proxy relay.Proxy, publicAddress string, tlsConfig *tls.Config) (*quicTransport, error) {
+	listenConfig := tlsConfig
+	if listenConfig == nil {
+		listenConfig = generateTLSConfig()
+	}
+	listener, err := quic.Listen(conn, listenConfig, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	transport := &quicTransport{
-		baseTransport: newBaseTransport(proxy, publicAddress),
+		baseTransport: newBaseTransport(proxy, publicAddress, noopPeerTracker{}),
 		l:             listener,
 		conn:          conn,
 		connections:   make(map[string]quicConnection),
+		tlsConfig:     tlsConfig,
 	}
 
 	transport.sendFunc = transport.send
@@ -69,7 +75,7 @@ func (t *quicTransport) send(recvAddress string, data []byte) error {
 	var err error
 	if !ok {
 		var session quic.Session
-		session, stream, err = createConnection(recvAddress)
+		session, stream, err = createConnection(recvAddress, t.tlsConfig)
 		if err != nil {
 			return errors.Wrap(err, "[ send ] failed to create a connection")
 		}
@@ -140,9 +146,12 @@ func (t *quicTransport) handleAcceptedConnection(session quic.Session) {
 	utils.CloseVerbose(stream)
 }
 
-func createConnection(addr string) (quic.Session, quic.Stream, error) {
-	// TODO: NETD18-78
-	session, err := quic.DialAddr(addr, &tls.Config{InsecureSkipVerify: true}, nil) //nolint: gosec
+func createConnection(addr string, tlsConfig *tls.Config) (quic.Session, quic.Stream, error) {
+	if tlsConfig == nil {
+		// TODO: NETD18-78
+		tlsConfig = &tls.Config{InsecureSkipVerify: true} //nolint: gosec
+	}
+	session, err := quic.DialAddr(addr, tlsConfig, nil)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "[ createConnection ] failed to create a session")
 	}
@@ -19,6 +19,7 @@ package transport
 
 import (
 	"context"
+	"crypto/tls"
 	"io"
 	"net"
 
@@ -35,16 +36,19 @@ import (
 type tcpTransport struct {
 	baseTransport
 
-	pool     pool.ConnectionPool
-	listener net.Listener
-	addr     string
+	pool      pool.ConnectionPool
+	listener  net.Listener
+	addr      string
+	tlsConfig *tls.Config
 }
 
-func newTCPTransport(addr string, proxy relay.Proxy, publicAddress string) (*tcpTransport, error) {
+func newTCPTransport(addr string, proxy relay.Proxy, publicAddress string, tlsConfig *tls.Config) (*tcpTransport, error) {
+	connectionPool := pool.NewConnectionPool(&tcpConnectionFactory{tlsConfig: tlsConfig})
 	transport := &tcpTransport{
-		baseTransport: newBaseTransport(proxy, publicAddress),
+		baseTransport: newBaseTransport(proxy, publicAddress, connectionPool),
 		addr:          addr,
-		pool:          pool.NewConnectionPool(&tcpConnectionFactory{}),
+		pool:          connectionPool,
+		tlsConfig:     tlsConfig,
 	}
 
 	transport.sendFunc = transport.send
@@ -105,6 +109,9 @@ func (t *tcpTransport) prepareListen() error {
 		return err
 	}
 
+	if t.tlsConfig != nil {
+		listener = tls.NewListener(listener, t.tlsConfig)
+	}
 	t.listener = listener
 
 	return nil
@@ -150,6 +157,13 @@ func (t *tcpTransport) Stop() {
 func (t *tcpTransport) handleAcceptedConnection(conn net.Conn) {
 	defer utils.CloseVerbose(conn)
 
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		if err := tlsConn.Handshake(); err != nil {
+			log.Warnf("[ handleAcceptedConnection ] TLS handshake with %s failed: %s", conn.RemoteAddr(), err.Error())
+			return
+		}
+	}
+
 	for {
 		msg, err := t.serializer.DeserializePacket(conn)
 
@@ -169,9 +183,11 @@ func (t *tcpTransport) handleAcceptedConnection(conn net.Conn) {
 	}
 }
 
-type tcpConnectionFactory struct{}
+type tcpConnectionFactory struct {
+	tlsConfig *tls.Config
+}
 
-func (*tcpConnectionFactory) CreateConnection(ctx context.Context, address net.Addr) (net.Conn, error) {
+func (f *tcpConnectionFactory) CreateConnection(ctx context.Context, address net.Addr) (net.Conn, error) {
 	logger := inslogger.FromContext(ctx)
 	tcpAddress, ok := address.(*net.TCPAddr)
 	if !ok {
@@ -194,5 +210,17 @@ func (*tcpConnectionFactory) CreateConnection(ctx context.Context, address net.A
 		logger.Error("[ createConnection ] Failed to set connection no delay: ", err.Error())
 	}
 
-	return conn, nil
+	if f.tlsConfig == nil {
+		return conn, nil
+	}
+
+	tlsConn := tls.Client(conn, f.tlsConfig)
+	err = tlsConn.Handshake()
+	if err != nil {
+		utils.CloseVerbose(tlsConn)
+		logger.Errorf("[ createConnection ] TLS handshake with %s failed: %s", address, err.Error())
+		return nil, errors.Wrap(err, "[ createConnection ] TLS handshake failed")
+	}
+
+	return tlsConn, nil
 }
@@ -0,0 +1,123 @@
+/*
+ * The Clear BSD License
+ *
+ * Copyright (c) 2019 Insolar Technologies
+ *
+ * All rights reserved.
+ *
+ * Redistribution and use in source and binary forms, with or without modification, are permitted (subject to the limitations in the disclaimer below) provided that the following conditions are met:
+ *
+ *  Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+ *  Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+ *  Neither the name of Insolar Technologies nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+ *
+ * NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ */
+
+package transport
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// authCertExtensionID is an id of x509 certificate extension with serialized node authorization certificate.
+var authCertExtensionID = asn1.ObjectIdentifier{2, 25, 1661428049}
+
+// PeerVerifier checks that remote node is allowed to connect.
+// Certificate is serialized authorization certificate sent by the remote node, it can be empty.
+type PeerVerifier func(key crypto.PublicKey, certificate []byte) error
+
+// TLSIdentity is a node identity used for mutually authenticated TLS between nodes.
+type TLSIdentity struct {
+	// PrivateKey is a node private key, TLS certificate is self-signed with it
+	PrivateKey crypto.PrivateKey
+	// Certificate is serialized node authorization certificate, peers use it to check nodes that are not active yet
+	Certificate []byte
+	// VerifyPeer checks the key of the remote node, connection is rejected if it returns error
+	VerifyPeer PeerVerifier
+}
+
+// newTLSConfig creates TLS config with certificate derived from the node key.
+// Peer certificates are checked with TLSIdentity.VerifyPeer instead of the certificate chain,
+// so the config requires certificate from both sides of the connection.
+func newTLSConfig(identity *TLSIdentity) (*tls.Config, error) {
+	if identity == nil || identity.PrivateKey == nil || identity.VerifyPeer == nil {
+		return nil, errors.New("[ newTLSConfig ] TLS identity is not set")
+	}
+	key, ok := identity.PrivateKey.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("[ newTLSConfig ] Node key is not ECDSA key")
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, "[ newTLSConfig ] Failed to generate serial number")
+	}
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "insolar node"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(24 * 365 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if len(identity.Certificate) > 0 {
+		template.ExtraExtensions = []pkix.Extension{{Id: authCertExtensionID, Value: identity.Certificate}}
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ newTLSConfig ] Failed to create certificate")
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{certDER}, PrivateKey: key}},
+		ClientAuth:   tls.RequireAnyClientCert,
+		// certificates are self-signed, peers are checked in VerifyPeerCertificate
+		InsecureSkipVerify: true, //nolint: gosec
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return verifyPeerCertificate(rawCerts, identity.VerifyPeer)
+		},
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
+
+func verifyPeerCertificate(rawCerts [][]byte, verifyPeer PeerVerifier) error {
+	if len(rawCerts) == 0 {
+		return errors.New("peer has not sent certificate")
+	}
+	cert, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return errors.Wrap(err, "failed to parse peer certificate")
+	}
+	// handshake proves that peer owns the key, self-signature proves that the certificate is not altered
+	err = cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature)
+	if err != nil {
+		return errors.Wrap(err, "invalid peer certificate signature")
+	}
+
+	var authCert []byte
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(authCertExtensionID) {
+			authCert = ext.Value
+		}
+	}
+
+	err = verifyPeer(cert.PublicKey, authCert)
+	if err != nil {
+		return errors.Wrap(err, "peer is rejected")
+	}
+	return nil
+}
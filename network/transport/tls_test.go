@@ -0,0 +1,146 @@
+/*
+ * The Clear BSD License
+ *
+ * Copyright (c) 2019 Insolar Technologies
+ *
+ * All rights reserved.
+ *
+ * Redistribution and use in source and binary forms, with or without modification, are permitted (subject to the limitations in the disclaimer below) provided that the following conditions are met:
+ *
+ *  Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+ *  Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+ *  Neither the name of Insolar Technologies nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+ *
+ * NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ */
+
+package transport
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"net"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+func generateKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := platformpolicy.NewKeyProcessor().GeneratePrivateKey()
+	require.NoError(t, err)
+	return key.(*ecdsa.PrivateKey)
+}
+
+func allowKeys(keys ...*ecdsa.PublicKey) PeerVerifier {
+	return func(key crypto.PublicKey, certificate []byte) error {
+		peerKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return errors.New("not ECDSA key")
+		}
+		for _, known := range keys {
+			if known.X.Cmp(peerKey.X) == 0 && known.Y.Cmp(peerKey.Y) == 0 {
+				return nil
+			}
+		}
+		return errors.New("unknown key")
+	}
+}
+
+// handshake makes TLS handshake between client and server and returns their errors
+func handshake(t *testing.T, client, server *TLSIdentity) (error, error) {
+	clientConfig, err := newTLSConfig(client)
+	require.NoError(t, err)
+	serverConfig, err := newTLSConfig(server)
+	require.NoError(t, err)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+		serverErr <- conn.(*tls.Conn).Handshake()
+	}()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+	clientConn := tls.Client(conn, clientConfig)
+	clientErr := clientConn.Handshake()
+	result := <-serverErr
+	clientConn.Close()
+	return clientErr, result
+}
+
+func TestNewTLSConfig_KnownPeers(t *testing.T) {
+	key1 := generateKey(t)
+	key2 := generateKey(t)
+
+	clientErr, serverErr := handshake(t,
+		&TLSIdentity{PrivateKey: key1, VerifyPeer: allowKeys(&key2.PublicKey)},
+		&TLSIdentity{PrivateKey: key2, VerifyPeer: allowKeys(&key1.PublicKey)},
+	)
+	assert.NoError(t, clientErr)
+	assert.NoError(t, serverErr)
+}
+
+func TestNewTLSConfig_UnknownClient(t *testing.T) {
+	key1 := generateKey(t)
+	key2 := generateKey(t)
+
+	_, serverErr := handshake(t,
+		&TLSIdentity{PrivateKey: key1, VerifyPeer: allowKeys(&key2.PublicKey)},
+		&TLSIdentity{PrivateKey: key2, VerifyPeer: allowKeys()},
+	)
+	assert.Error(t, serverErr)
+}
+
+func TestNewTLSConfig_UnknownServer(t *testing.T) {
+	key1 := generateKey(t)
+	key2 := generateKey(t)
+
+	clientErr, _ := handshake(t,
+		&TLSIdentity{PrivateKey: key1, VerifyPeer: allowKeys()},
+		&TLSIdentity{PrivateKey: key2, VerifyPeer: allowKeys(&key1.PublicKey)},
+	)
+	assert.Error(t, clientErr)
+}
+
+func TestNewTLSConfig_AuthorizationCertificate(t *testing.T) {
+	key1 := generateKey(t)
+	key2 := generateKey(t)
+	authCert := []byte("authorization certificate")
+
+	var received []byte
+	_, serverErr := handshake(t,
+		&TLSIdentity{PrivateKey: key1, Certificate: authCert, VerifyPeer: allowKeys(&key2.PublicKey)},
+		&TLSIdentity{PrivateKey: key2, VerifyPeer: func(key crypto.PublicKey, certificate []byte) error {
+			received = certificate
+			return nil
+		}},
+	)
+	require.NoError(t, serverErr)
+	assert.Equal(t, authCert, received)
+}
+
+func TestNewTLSConfig_InvalidIdentity(t *testing.T) {
+	_, err := newTLSConfig(nil)
+	assert.Error(t, err)
+
+	_, err = newTLSConfig(&TLSIdentity{PrivateKey: generateKey(t)})
+	assert.Error(t, err)
+
+	_, err = newTLSConfig(&TLSIdentity{PrivateKey: "not a key", VerifyPeer: allowKeys()})
+	assert.Error(t, err)
+}
@@ -19,6 +19,7 @@ package transport
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
 
 	"github.com/insolar/insolar/configuration"
@@ -26,6 +27,7 @@ import (
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/transport/connection"
 	"github.com/insolar/insolar/network/transport/packet"
+	"github.com/insolar/insolar/network/transport/pool"
 	"github.com/insolar/insolar/network/transport/relay"
 	"github.com/insolar/insolar/network/transport/resolver"
 	"github.com/insolar/insolar/network/utils"
@@ -61,10 +63,34 @@ type Transport interface {
 
 	// PublicAddress returns PublicAddress
 	PublicAddress() string
+
+	// SetProtocolVersion sets packet protocol version negotiated with the peer with address.
+	SetProtocolVersion(address string, version uint16)
+
+	// PeerStats returns health state of the peer with address, false if transport doesn't track peers.
+	PeerStats(address string) (pool.PeerStats, bool)
 }
 
 // NewTransport creates new Transport with particular configuration
 func NewTransport(cfg configuration.Transport, proxy relay.Proxy) (Transport, error) {
+	return NewSecureTransport(cfg, proxy, nil)
+}
+
+// NewSecureTransport creates new Transport with particular configuration.
+// If TLS is enabled in configuration, TCP and QUIC transports use mutually authenticated TLS with the node identity.
+func NewSecureTransport(cfg configuration.Transport, proxy relay.Proxy, identity *TLSIdentity) (Transport, error) {
+	var tlsConfig *tls.Config
+	if cfg.TLS {
+		if cfg.Protocol == "PURE_UDP" {
+			return nil, errors.New("[ NewTransport ] TLS is not supported by PURE_UDP transport")
+		}
+		var err error
+		tlsConfig, err = newTLSConfig(identity)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ NewTransport ] Failed to create TLS config")
+		}
+	}
+
 	// TODO: let each transport creates connection in their constructor
 	conn, publicAddress, err := NewConnection(cfg)
 	if err != nil {
@@ -76,7 +102,7 @@ func NewTransport(cfg configuration.Transport, proxy relay.Proxy) (Transport, er
 		// TODO: little hack: It's better to change interface for NewConnection
 		utils.CloseVerbose(conn)
 
-		return newTCPTransport(conn.LocalAddr().String(), proxy, publicAddress)
+		return newTCPTransport(conn.LocalAddr().String(), proxy, publicAddress, tlsConfig)
 	case "PURE_UDP":
 		// TODO: not little hack: @AndreyBronin rewrite all this mess, please!
 		localAddress := conn.LocalAddr().String()
@@ -84,7 +110,7 @@ func NewTransport(cfg configuration.Transport, proxy relay.Proxy) (Transport, er
 
 		return newUDPTransport(localAddress, proxy, publicAddress)
 	case "QUIC":
-		return newQuicTransport(conn, proxy, publicAddress)
+		return newQuicTransport(conn, proxy, publicAddress, tlsConfig)
 	default:
 		utils.CloseVerbose(conn)
 		return nil, errors.New("invalid transport configuration")
@@ -20,7 +20,6 @@ package transport
 import (
 	"context"
 	"crypto/rand"
-	"encoding/gob"
 	"testing"
 
 	"github.com/insolar/insolar/configuration"
@@ -36,6 +35,7 @@ import (
 
 type node struct {
 	config    configuration.Transport
+	identity  *TLSIdentity
 	transport Transport
 	host      *host.Host
 }
@@ -59,14 +59,13 @@ func setupNode(t *transportSuite, n *node) {
 	n.host, err = host.NewHost(n.config.Address)
 	t.Assert().NoError(err)
 
-	n.transport, err = NewTransport(n.config, relay.NewProxy())
+	n.transport, err = NewSecureTransport(n.config, relay.NewProxy(), n.identity)
 	t.Require().NoError(err)
 	t.Require().NotNil(n.transport)
 	t.Require().Implements((*Transport)(nil), n.transport)
 }
 
 func (t *transportSuite) SetupTest() {
-	gob.Register(&packet.RequestTest{})
 	setupNode(t, &t.node1)
 	setupNode(t, &t.node2)
 }
@@ -171,6 +170,29 @@ func TestTCPTransport(t *testing.T) {
 	suite.Run(t, NewSuite(cfg1, cfg2))
 }
 
+func TestTCPTransport_TLS(t *testing.T) {
+	key1 := generateKey(t)
+	key2 := generateKey(t)
+
+	cfg1 := configuration.Transport{Protocol: "TCP", Address: "127.0.0.1:17020", TLS: true}
+	cfg2 := configuration.Transport{Protocol: "TCP", Address: "127.0.0.1:17021", TLS: true}
+	s := NewSuite(cfg1, cfg2)
+	s.node1.identity = &TLSIdentity{PrivateKey: key1, VerifyPeer: allowKeys(&key2.PublicKey)}
+	s.node2.identity = &TLSIdentity{PrivateKey: key2, VerifyPeer: allowKeys(&key1.PublicKey)}
+
+	suite.Run(t, s)
+}
+
+func TestNewSecureTransport_Errors(t *testing.T) {
+	cfg := configuration.Transport{Protocol: "TCP", Address: "127.0.0.1:0", TLS: true}
+	_, err := NewSecureTransport(cfg, relay.NewProxy(), nil)
+	assert.Error(t, err)
+
+	cfg.Protocol = "PURE_UDP"
+	_, err = NewSecureTransport(cfg, relay.NewProxy(), &TLSIdentity{PrivateKey: generateKey(t), VerifyPeer: allowKeys()})
+	assert.Error(t, err)
+}
+
 func TestQuicTransport(t *testing.T) {
 	t.Skip("QUIC internals racing atm. Skip until we want to use it in production")
 
@@ -203,3 +225,34 @@ func Test_createResolver(t *testing.T) {
 	a.Error(err)
 	a.Nil(r)
 }
+
+type versionRecordingSerializer struct {
+	baseSerializer
+	versions []uint16
+}
+
+func (s *versionRecordingSerializer) SerializePacket(q *packet.Packet, version uint16) ([]byte, error) {
+	s.versions = append(s.versions, version)
+	return s.baseSerializer.SerializePacket(q, version)
+}
+
+func TestBaseTransport_ProtocolVersion(t *testing.T) {
+	tp := newBaseTransport(relay.NewProxy(), "", noopPeerTracker{})
+	serializer := &versionRecordingSerializer{}
+	tp.serializer = serializer
+	tp.sendFunc = func(string, []byte) error { return nil }
+
+	negotiated, err := host.NewHost("127.0.0.1:31337")
+	assert.NoError(t, err)
+	other, err := host.NewHost("127.0.0.1:31338")
+	assert.NoError(t, err)
+	tp.SetProtocolVersion(negotiated.Address.String(), packet.MinProtocolVersion)
+
+	ctx := context.Background()
+	assert.NoError(t, tp.SendPacket(ctx, packet.NewBuilder(other).Receiver(negotiated).Type(types.Ping).Build()))
+	assert.NoError(t, tp.SendPacket(ctx, packet.NewBuilder(negotiated).Receiver(other).Type(types.Ping).Build()))
+	assert.Equal(t, []uint16{packet.MinProtocolVersion, packet.ProtocolVersion}, serializer.versions)
+
+	tp.SetProtocolVersion(negotiated.Address.String(), packet.ProtocolVersion+1)
+	assert.Error(t, tp.SendPacket(ctx, packet.NewBuilder(other).Receiver(negotiated).Type(types.Ping).Build()))
+}
@@ -45,7 +45,7 @@ type udpTransport struct {
 
 type udpSerializer struct{}
 
-func (b *udpSerializer) SerializePacket(q *packet.Packet) ([]byte, error) {
+func (b *udpSerializer) SerializePacket(q *packet.Packet, _ uint16) ([]byte, error) {
 	data, ok := q.Data.(packets.ConsensusPacket)
 	if !ok {
 		return nil, errors.New("could not convert packet to ConsensusPacket type")
@@ -64,7 +64,7 @@ func (b *udpSerializer) DeserializePacket(conn io.Reader) (*packet.Packet, error
 }
 
 func newUDPTransport(addr string, proxy relay.Proxy, publicAddress string) (*udpTransport, error) {
-	transport := &udpTransport{baseTransport: newBaseTransport(proxy, publicAddress), address: addr}
+	transport := &udpTransport{baseTransport: newBaseTransport(proxy, publicAddress, noopPeerTracker{}), address: addr}
 	transport.sendFunc = transport.send
 	transport.serializer = &udpSerializer{}
 
@@ -0,0 +1,109 @@
+/*
+ * The Clear BSD License
+ *
+ * Copyright (c) 2019 Insolar Technologies
+ *
+ * All rights reserved.
+ *
+ * Redistribution and use in source and binary forms, with or without modification, are permitted (subject to the limitations in the disclaimer below) provided that the following conditions are met:
+ *
+ *  Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+ *  Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+ *  Neither the name of Insolar Technologies nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+ *
+ * NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ */
+
+package networkcoordinator
+
+import (
+	"context"
+
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/pkg/errors"
+)
+
+// Leave schedules graceful leave of the node from the network at the ETA pulse.
+// Node stops accepting new executions and waits until its execution queues are drained
+// and pulses pending for replication are synced to heavy node, the last pulses are flushed on the ETA pulse.
+// ETA must be after the next pulse.
+func (nc *NetworkCoordinator) Leave(ctx context.Context, ETA core.PulseNumber) error {
+	if err := nc.scheduleLeave(ctx, ETA); err != nil {
+		return err
+	}
+	inslogger.FromContext(ctx).Infof("[ Leave ] node leave is scheduled at pulse %d", ETA)
+	nc.HeavySyncStatus.FlushHeavySyncOnPulse(ctx, ETA)
+
+	if err := nc.LogicRunner.Leave(ctx, ETA); err != nil {
+		return errors.Wrapf(err, "[ Leave ] leave is scheduled at pulse %d, but executions are not finished", ETA)
+	}
+
+	if err := nc.HeavySyncStatus.FlushHeavySync(ctx); err != nil {
+		return errors.Wrapf(err, "[ Leave ] leave is scheduled at pulse %d, but heavy sync is not finished", ETA)
+	}
+	return nil
+}
+
+func (nc *NetworkCoordinator) scheduleLeave(ctx context.Context, ETA core.PulseNumber) error {
+	nc.leaveLock.Lock()
+	defer nc.leaveLock.Unlock()
+
+	if nc.leaveETA != 0 {
+		return errors.Errorf("leave is already scheduled at pulse %d", nc.leaveETA)
+	}
+
+	pulse, err := nc.PS.Current(ctx)
+	if err != nil {
+		return errors.Wrap(err, "[ Leave ] failed to get current pulse")
+	}
+	if ETA <= pulse.NextPulseNumber {
+		return errors.Errorf("leave ETA %d must be after the next pulse %d", ETA, pulse.NextPulseNumber)
+	}
+
+	if err := nc.Network.Leave(ctx, ETA); err != nil {
+		return errors.Wrap(err, "[ Leave ] failed to notify network")
+	}
+	nc.leaveETA = ETA
+	return nil
+}
+
+// LeaveStatus returns progress of the scheduled graceful leave
+func (nc *NetworkCoordinator) LeaveStatus(ctx context.Context) (*core.LeaveStatus, error) {
+	nc.leaveLock.Lock()
+	ETA := nc.leaveETA
+	nc.leaveLock.Unlock()
+
+	if ETA == 0 {
+		return &core.LeaveStatus{State: core.NoLeaveState}, nil
+	}
+
+	pulse, err := nc.PS.Current(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ LeaveStatus ] failed to get current pulse")
+	}
+
+	jets, err := nc.HeavySyncStatus.JetsSyncStatus(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ LeaveStatus ] failed to get heavy sync status")
+	}
+
+	status := &core.LeaveStatus{
+		ETA:              ETA,
+		ActiveExecutions: nc.LogicRunner.ActiveExecutions(ctx),
+	}
+	for _, jet := range jets {
+		status.UnsyncedPulses += len(jet.UnsyncedPulses)
+	}
+
+	switch {
+	case pulse.PulseNumber < ETA:
+		status.State = core.ScheduledLeaveState
+	case status.UnsyncedPulses > 0 || status.ActiveExecutions > 0:
+		status.State = core.DrainingLeaveState
+	default:
+		status.State = core.ReadyLeaveState
+	}
+	return status, nil
+}
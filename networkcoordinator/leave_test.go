@@ -0,0 +1,176 @@
+/*
+ * The Clear BSD License
+ *
+ * Copyright (c) 2019 Insolar Technologies
+ *
+ * All rights reserved.
+ *
+ * Redistribution and use in source and binary forms, with or without modification, are permitted (subject to the limitations in the disclaimer below) provided that the following conditions are met:
+ *
+ *  Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+ *  Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+ *  Neither the name of Insolar Technologies nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+ *
+ * NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ */
+
+package networkcoordinator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/insolar/insolar/core"
+	"github.com/insolar/insolar/testutils"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type leaveNetwork struct {
+	core.Network
+	ETA core.PulseNumber
+	err error
+}
+
+func (n *leaveNetwork) Leave(ctx context.Context, ETA core.PulseNumber) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.ETA = ETA
+	return nil
+}
+
+func mockLeaveCoordinator(t *testing.T, pulse core.PulseNumber) (*NetworkCoordinator, *leaveNetwork) {
+	ps := testutils.NewPulseStorageMock(t)
+	ps.CurrentFunc = func(context.Context) (*core.Pulse, error) {
+		return &core.Pulse{PulseNumber: pulse, NextPulseNumber: pulse + 10}, nil
+	}
+	network := &leaveNetwork{}
+	nc := &NetworkCoordinator{
+		PS:              ps,
+		Network:         network,
+		LogicRunner:     testutils.NewLogicRunnerMock(t),
+		HeavySyncStatus: testutils.NewHeavySyncStatusMock(t),
+	}
+	return nc, network
+}
+
+func TestNetworkCoordinator_Leave(t *testing.T) {
+	ctx := context.Background()
+	nc, network := mockLeaveCoordinator(t, core.FirstPulseNumber)
+	hss := nc.HeavySyncStatus.(*testutils.HeavySyncStatusMock)
+	hss.FlushHeavySyncMock.Return(nil)
+	hss.FlushHeavySyncOnPulseMock.Expect(ctx, core.FirstPulseNumber+20).Return()
+	lr := nc.LogicRunner.(*testutils.LogicRunnerMock)
+	lr.LeaveMock.Expect(ctx, core.FirstPulseNumber+20).Return(nil)
+
+	err := nc.Leave(ctx, core.FirstPulseNumber+10)
+	require.Error(t, err)
+	require.Equal(t, core.PulseNumber(0), network.ETA)
+
+	err = nc.Leave(ctx, core.FirstPulseNumber+20)
+	require.NoError(t, err)
+	require.Equal(t, core.PulseNumber(core.FirstPulseNumber+20), network.ETA)
+	require.Equal(t, uint64(1), hss.FlushHeavySyncCounter)
+	require.Equal(t, uint64(1), hss.FlushHeavySyncOnPulseCounter)
+	require.Equal(t, uint64(1), lr.LeaveCounter)
+
+	err = nc.Leave(ctx, core.FirstPulseNumber+30)
+	require.Error(t, err)
+	require.Equal(t, core.PulseNumber(core.FirstPulseNumber+20), network.ETA)
+}
+
+func TestNetworkCoordinator_Leave_NetworkError(t *testing.T) {
+	nc, network := mockLeaveCoordinator(t, core.FirstPulseNumber)
+	network.err = errors.New("test_error")
+
+	err := nc.Leave(context.Background(), core.FirstPulseNumber+20)
+	require.Error(t, err)
+	require.Equal(t, core.PulseNumber(0), nc.leaveETA)
+}
+
+func TestNetworkCoordinator_Leave_FlushError(t *testing.T) {
+	ctx := context.Background()
+	nc, network := mockLeaveCoordinator(t, core.FirstPulseNumber)
+	hss := nc.HeavySyncStatus.(*testutils.HeavySyncStatusMock)
+	hss.FlushHeavySyncMock.Return(context.DeadlineExceeded)
+	hss.FlushHeavySyncOnPulseMock.Return()
+	nc.LogicRunner.(*testutils.LogicRunnerMock).LeaveMock.Return(nil)
+
+	err := nc.Leave(ctx, core.FirstPulseNumber+20)
+	require.Error(t, err)
+	require.Equal(t, context.DeadlineExceeded, errors.Cause(err))
+	// leave stays scheduled, replication continues in background
+	require.Equal(t, core.PulseNumber(core.FirstPulseNumber+20), network.ETA)
+	require.Equal(t, core.PulseNumber(core.FirstPulseNumber+20), nc.leaveETA)
+}
+
+func TestNetworkCoordinator_Leave_DrainError(t *testing.T) {
+	ctx := context.Background()
+	nc, network := mockLeaveCoordinator(t, core.FirstPulseNumber)
+	hss := nc.HeavySyncStatus.(*testutils.HeavySyncStatusMock)
+	hss.FlushHeavySyncOnPulseMock.Return()
+	nc.LogicRunner.(*testutils.LogicRunnerMock).LeaveMock.Return(context.DeadlineExceeded)
+
+	err := nc.Leave(ctx, core.FirstPulseNumber+20)
+	require.Error(t, err)
+	require.Equal(t, context.DeadlineExceeded, errors.Cause(err))
+	// pending pulses are still flushed on the ETA pulse
+	require.Equal(t, uint64(1), hss.FlushHeavySyncOnPulseCounter)
+	require.Equal(t, uint64(0), hss.FlushHeavySyncCounter)
+	require.Equal(t, core.PulseNumber(core.FirstPulseNumber+20), network.ETA)
+	require.Equal(t, core.PulseNumber(core.FirstPulseNumber+20), nc.leaveETA)
+}
+
+func TestNetworkCoordinator_Leave_PulseError(t *testing.T) {
+	ps := testutils.NewPulseStorageMock(t)
+	ps.CurrentMock.Return(nil, errors.New("test_error"))
+	nc := &NetworkCoordinator{PS: ps}
+
+	err := nc.Leave(context.Background(), core.FirstPulseNumber+20)
+	require.Error(t, err)
+	require.Equal(t, core.PulseNumber(0), nc.leaveETA)
+}
+
+func TestNetworkCoordinator_LeaveStatus(t *testing.T) {
+	ctx := context.Background()
+	ETA := core.PulseNumber(core.FirstPulseNumber + 20)
+
+	nc, _ := mockLeaveCoordinator(t, core.FirstPulseNumber)
+	status, err := nc.LeaveStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &core.LeaveStatus{State: core.NoLeaveState}, status)
+
+	tests := []struct {
+		name       string
+		pulse      core.PulseNumber
+		unsynced   []core.PulseNumber
+		executions int
+		state      core.LeaveState
+	}{
+		{"scheduled", core.FirstPulseNumber + 10, nil, 0, core.ScheduledLeaveState},
+		{"draining heavy sync", ETA, []core.PulseNumber{ETA - 10, ETA}, 0, core.DrainingLeaveState},
+		{"draining executions", ETA + 10, nil, 3, core.DrainingLeaveState},
+		{"ready", ETA, nil, 0, core.ReadyLeaveState},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nc, _ := mockLeaveCoordinator(t, tt.pulse)
+			nc.leaveETA = ETA
+			nc.HeavySyncStatus.(*testutils.HeavySyncStatusMock).JetsSyncStatusMock.Return(
+				[]core.JetSyncStatus{{UnsyncedPulses: tt.unsynced}, {}}, nil,
+			)
+			nc.LogicRunner.(*testutils.LogicRunnerMock).ActiveExecutionsMock.Return(tt.executions)
+
+			status, err := nc.LeaveStatus(ctx)
+			require.NoError(t, err)
+			require.Equal(t, &core.LeaveStatus{
+				State:            tt.state,
+				ETA:              ETA,
+				UnsyncedPulses:   len(tt.unsynced),
+				ActiveExecutions: tt.executions,
+			}, status)
+		})
+	}
+}
@@ -19,6 +19,7 @@ package networkcoordinator
 
 import (
 	"context"
+	"sync"
 
 	"github.com/insolar/insolar/core"
 )
@@ -31,10 +32,16 @@ type NetworkCoordinator struct {
 	MessageBus         core.MessageBus          `inject:""`
 	CS                 core.CryptographyService `inject:""`
 	PS                 core.PulseStorage        `inject:""`
+	Network            core.Network             `inject:""`
+	LogicRunner        core.LogicRunner         `inject:""`
+	HeavySyncStatus    core.HeavySyncStatus     `inject:""`
 
 	realCoordinator Coordinator
 	zeroCoordinator Coordinator
 	isStarted       bool
+
+	leaveLock sync.Mutex
+	leaveETA  core.PulseNumber
 }
 
 // New creates new NetworkCoordinator
@@ -165,6 +165,7 @@ func main() {
 		}
 
 		conf.APIRunner.Address = fmt.Sprintf(defaultHost+":191%02d", nodeIndex)
+		conf.APIRunner.AdminAddress = fmt.Sprintf(defaultHost+":190%02d", nodeIndex)
 		conf.Metrics.ListenAddress = fmt.Sprintf(defaultHost+":80%02d", nodeIndex)
 
 		conf.Tracer.Jaeger.AgentEndpoint = defaultJaegerEndPoint
@@ -200,6 +201,7 @@ func main() {
 		}
 
 		conf.APIRunner.Address = fmt.Sprintf(defaultHost+":191%02d", nodeIndex+len(genesisConf.DiscoveryNodes))
+		conf.APIRunner.AdminAddress = fmt.Sprintf(defaultHost+":190%02d", nodeIndex+len(genesisConf.DiscoveryNodes))
 		conf.Metrics.ListenAddress = fmt.Sprintf(defaultHost+":80%02d", nodeIndex+len(genesisConf.DiscoveryNodes))
 
 		conf.Tracer.Jaeger.AgentEndpoint = defaultJaegerEndPoint
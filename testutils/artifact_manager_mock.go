@@ -40,11 +40,21 @@ type ArtifactManagerMock struct {
 	DeclareTypePreCounter uint64
 	DeclareTypeMock       mArtifactManagerMockDeclareType
 
-	DeployCodeFunc       func(p context.Context, p1 core.RecordRef, p2 core.RecordRef, p3 []byte, p4 core.MachineType) (r *core.RecordID, r1 error)
+	DeployCodeFunc       func(p context.Context, p1 core.RecordRef, p2 core.RecordRef, p3 []byte, p4 core.MachineType, p5 []byte) (r *core.RecordID, r1 error)
 	DeployCodeCounter    uint64
 	DeployCodePreCounter uint64
 	DeployCodeMock       mArtifactManagerMockDeployCode
 
+	FindObjectsByPrototypeFunc       func(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 int) (r *core.IndexedRecords, r1 error)
+	FindObjectsByPrototypeCounter    uint64
+	FindObjectsByPrototypePreCounter uint64
+	FindObjectsByPrototypeMock       mArtifactManagerMockFindObjectsByPrototype
+
+	FindRequestsByCallerFunc       func(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 int) (r *core.IndexedRecords, r1 error)
+	FindRequestsByCallerCounter    uint64
+	FindRequestsByCallerPreCounter uint64
+	FindRequestsByCallerMock       mArtifactManagerMockFindRequestsByCaller
+
 	GenesisRefFunc       func() (r *core.RecordRef)
 	GenesisRefCounter    uint64
 	GenesisRefPreCounter uint64
@@ -65,21 +75,51 @@ type ArtifactManagerMock struct {
 	GetDelegatePreCounter uint64
 	GetDelegateMock       mArtifactManagerMockGetDelegate
 
+	GetEventsFunc       func(p context.Context, p1 core.RecordRef, p2 string, p3 *core.PulseNumber, p4 *core.PulseNumber) (r core.EventIterator, r1 error)
+	GetEventsCounter    uint64
+	GetEventsPreCounter uint64
+	GetEventsMock       mArtifactManagerMockGetEvents
+
 	GetObjectFunc       func(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 bool) (r core.ObjectDescriptor, r1 error)
 	GetObjectCounter    uint64
 	GetObjectPreCounter uint64
 	GetObjectMock       mArtifactManagerMockGetObject
 
+	GetObjectAtPulseFunc       func(p context.Context, p1 core.RecordRef, p2 core.PulseNumber) (r core.ObjectDescriptor, r1 error)
+	GetObjectAtPulseCounter    uint64
+	GetObjectAtPulsePreCounter uint64
+	GetObjectAtPulseMock       mArtifactManagerMockGetObjectAtPulse
+
+	GetObjectHistoryFunc       func(p context.Context, p1 core.RecordRef, p2 *core.PulseNumber, p3 *core.PulseNumber) (r core.ObjectHistoryIterator, r1 error)
+	GetObjectHistoryCounter    uint64
+	GetObjectHistoryPreCounter uint64
+	GetObjectHistoryMock       mArtifactManagerMockGetObjectHistory
+
 	GetPendingRequestFunc       func(p context.Context, p1 core.RecordID) (r core.Parcel, r1 error)
 	GetPendingRequestCounter    uint64
 	GetPendingRequestPreCounter uint64
 	GetPendingRequestMock       mArtifactManagerMockGetPendingRequest
 
+	GetReceiptFunc       func(p context.Context, p1 core.RecordRef, p2 core.RecordRef) (r *core.RequestReceipt, r1 error)
+	GetReceiptCounter    uint64
+	GetReceiptPreCounter uint64
+	GetReceiptMock       mArtifactManagerMockGetReceipt
+
+	GetRecordProofFunc       func(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 core.RecordID) (r *core.RecordProof, r1 error)
+	GetRecordProofCounter    uint64
+	GetRecordProofPreCounter uint64
+	GetRecordProofMock       mArtifactManagerMockGetRecordProof
+
 	HasPendingRequestsFunc       func(p context.Context, p1 core.RecordRef) (r bool, r1 error)
 	HasPendingRequestsCounter    uint64
 	HasPendingRequestsPreCounter uint64
 	HasPendingRequestsMock       mArtifactManagerMockHasPendingRequests
 
+	RegisterEventFunc       func(p context.Context, p1 core.RecordRef, p2 core.RecordRef, p3 string, p4 []byte) (r *core.RecordID, r1 error)
+	RegisterEventCounter    uint64
+	RegisterEventPreCounter uint64
+	RegisterEventMock       mArtifactManagerMockRegisterEvent
+
 	RegisterRequestFunc       func(p context.Context, p1 core.RecordRef, p2 core.Parcel) (r *core.RecordID, r1 error)
 	RegisterRequestCounter    uint64
 	RegisterRequestPreCounter uint64
@@ -124,13 +164,21 @@ func NewArtifactManagerMock(t minimock.Tester) *ArtifactManagerMock {
 	m.DeactivateObjectMock = mArtifactManagerMockDeactivateObject{mock: m}
 	m.DeclareTypeMock = mArtifactManagerMockDeclareType{mock: m}
 	m.DeployCodeMock = mArtifactManagerMockDeployCode{mock: m}
+	m.FindObjectsByPrototypeMock = mArtifactManagerMockFindObjectsByPrototype{mock: m}
+	m.FindRequestsByCallerMock = mArtifactManagerMockFindRequestsByCaller{mock: m}
 	m.GenesisRefMock = mArtifactManagerMockGenesisRef{mock: m}
 	m.GetChildrenMock = mArtifactManagerMockGetChildren{mock: m}
 	m.GetCodeMock = mArtifactManagerMockGetCode{mock: m}
 	m.GetDelegateMock = mArtifactManagerMockGetDelegate{mock: m}
+	m.GetEventsMock = mArtifactManagerMockGetEvents{mock: m}
 	m.GetObjectMock = mArtifactManagerMockGetObject{mock: m}
+	m.GetObjectAtPulseMock = mArtifactManagerMockGetObjectAtPulse{mock: m}
+	m.GetObjectHistoryMock = mArtifactManagerMockGetObjectHistory{mock: m}
 	m.GetPendingRequestMock = mArtifactManagerMockGetPendingRequest{mock: m}
+	m.GetReceiptMock = mArtifactManagerMockGetReceipt{mock: m}
+	m.GetRecordProofMock = mArtifactManagerMockGetRecordProof{mock: m}
 	m.HasPendingRequestsMock = mArtifactManagerMockHasPendingRequests{mock: m}
+	m.RegisterEventMock = mArtifactManagerMockRegisterEvent{mock: m}
 	m.RegisterRequestMock = mArtifactManagerMockRegisterRequest{mock: m}
 	m.RegisterResultMock = mArtifactManagerMockRegisterResult{mock: m}
 	m.RegisterValidationMock = mArtifactManagerMockRegisterValidation{mock: m}
@@ -775,6 +823,7 @@ type ArtifactManagerMockDeployCodeInput struct {
 	p2 core.RecordRef
 	p3 []byte
 	p4 core.MachineType
+	p5 []byte
 }
 
 type ArtifactManagerMockDeployCodeResult struct {
@@ -783,14 +832,14 @@ type ArtifactManagerMockDeployCodeResult struct {
 }
 
 //Expect specifies that invocation of ArtifactManager.DeployCode is expected from 1 to Infinity times
-func (m *mArtifactManagerMockDeployCode) Expect(p context.Context, p1 core.RecordRef, p2 core.RecordRef, p3 []byte, p4 core.MachineType) *mArtifactManagerMockDeployCode {
+func (m *mArtifactManagerMockDeployCode) Expect(p context.Context, p1 core.RecordRef, p2 core.RecordRef, p3 []byte, p4 core.MachineType, p5 []byte) *mArtifactManagerMockDeployCode {
 	m.mock.DeployCodeFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
 		m.mainExpectation = &ArtifactManagerMockDeployCodeExpectation{}
 	}
-	m.mainExpectation.input = &ArtifactManagerMockDeployCodeInput{p, p1, p2, p3, p4}
+	m.mainExpectation.input = &ArtifactManagerMockDeployCodeInput{p, p1, p2, p3, p4, p5}
 	return m
 }
 
@@ -807,12 +856,12 @@ func (m *mArtifactManagerMockDeployCode) Return(r *core.RecordID, r1 error) *Art
 }
 
 //ExpectOnce specifies that invocation of ArtifactManager.DeployCode is expected once
-func (m *mArtifactManagerMockDeployCode) ExpectOnce(p context.Context, p1 core.RecordRef, p2 core.RecordRef, p3 []byte, p4 core.MachineType) *ArtifactManagerMockDeployCodeExpectation {
+func (m *mArtifactManagerMockDeployCode) ExpectOnce(p context.Context, p1 core.RecordRef, p2 core.RecordRef, p3 []byte, p4 core.MachineType, p5 []byte) *ArtifactManagerMockDeployCodeExpectation {
 	m.mock.DeployCodeFunc = nil
 	m.mainExpectation = nil
 
 	expectation := &ArtifactManagerMockDeployCodeExpectation{}
-	expectation.input = &ArtifactManagerMockDeployCodeInput{p, p1, p2, p3, p4}
+	expectation.input = &ArtifactManagerMockDeployCodeInput{p, p1, p2, p3, p4, p5}
 	m.expectationSeries = append(m.expectationSeries, expectation)
 	return expectation
 }
@@ -822,7 +871,7 @@ func (e *ArtifactManagerMockDeployCodeExpectation) Return(r *core.RecordID, r1 e
 }
 
 //Set uses given function f as a mock of ArtifactManager.DeployCode method
-func (m *mArtifactManagerMockDeployCode) Set(f func(p context.Context, p1 core.RecordRef, p2 core.RecordRef, p3 []byte, p4 core.MachineType) (r *core.RecordID, r1 error)) *ArtifactManagerMock {
+func (m *mArtifactManagerMockDeployCode) Set(f func(p context.Context, p1 core.RecordRef, p2 core.RecordRef, p3 []byte, p4 core.MachineType, p5 []byte) (r *core.RecordID, r1 error)) *ArtifactManagerMock {
 	m.mainExpectation = nil
 	m.expectationSeries = nil
 
@@ -831,18 +880,18 @@ func (m *mArtifactManagerMockDeployCode) Set(f func(p context.Context, p1 core.R
 }
 
 //DeployCode implements github.com/insolar/insolar/core.ArtifactManager interface
-func (m *ArtifactManagerMock) DeployCode(p context.Context, p1 core.RecordRef, p2 core.RecordRef, p3 []byte, p4 core.MachineType) (r *core.RecordID, r1 error) {
+func (m *ArtifactManagerMock) DeployCode(p context.Context, p1 core.RecordRef, p2 core.RecordRef, p3 []byte, p4 core.MachineType, p5 []byte) (r *core.RecordID, r1 error) {
 	counter := atomic.AddUint64(&m.DeployCodePreCounter, 1)
 	defer atomic.AddUint64(&m.DeployCodeCounter, 1)
 
 	if len(m.DeployCodeMock.expectationSeries) > 0 {
 		if counter > uint64(len(m.DeployCodeMock.expectationSeries)) {
-			m.t.Fatalf("Unexpected call to ArtifactManagerMock.DeployCode. %v %v %v %v %v", p, p1, p2, p3, p4)
+			m.t.Fatalf("Unexpected call to ArtifactManagerMock.DeployCode. %v %v %v %v %v %v", p, p1, p2, p3, p4, p5)
 			return
 		}
 
 		input := m.DeployCodeMock.expectationSeries[counter-1].input
-		testify_assert.Equal(m.t, *input, ArtifactManagerMockDeployCodeInput{p, p1, p2, p3, p4}, "ArtifactManager.DeployCode got unexpected parameters")
+		testify_assert.Equal(m.t, *input, ArtifactManagerMockDeployCodeInput{p, p1, p2, p3, p4, p5}, "ArtifactManager.DeployCode got unexpected parameters")
 
 		result := m.DeployCodeMock.expectationSeries[counter-1].result
 		if result == nil {
@@ -860,7 +909,7 @@ func (m *ArtifactManagerMock) DeployCode(p context.Context, p1 core.RecordRef, p
 
 		input := m.DeployCodeMock.mainExpectation.input
 		if input != nil {
-			testify_assert.Equal(m.t, *input, ArtifactManagerMockDeployCodeInput{p, p1, p2, p3, p4}, "ArtifactManager.DeployCode got unexpected parameters")
+			testify_assert.Equal(m.t, *input, ArtifactManagerMockDeployCodeInput{p, p1, p2, p3, p4, p5}, "ArtifactManager.DeployCode got unexpected parameters")
 		}
 
 		result := m.DeployCodeMock.mainExpectation.result
@@ -875,11 +924,11 @@ func (m *ArtifactManagerMock) DeployCode(p context.Context, p1 core.RecordRef, p
 	}
 
 	if m.DeployCodeFunc == nil {
-		m.t.Fatalf("Unexpected call to ArtifactManagerMock.DeployCode. %v %v %v %v %v", p, p1, p2, p3, p4)
+		m.t.Fatalf("Unexpected call to ArtifactManagerMock.DeployCode. %v %v %v %v %v %v", p, p1, p2, p3, p4, p5)
 		return
 	}
 
-	return m.DeployCodeFunc(p, p1, p2, p3, p4)
+	return m.DeployCodeFunc(p, p1, p2, p3, p4, p5)
 }
 
 //DeployCodeMinimockCounter returns a count of ArtifactManagerMock.DeployCodeFunc invocations
@@ -912,6 +961,312 @@ func (m *ArtifactManagerMock) DeployCodeFinished() bool {
 	return true
 }
 
+type mArtifactManagerMockFindObjectsByPrototype struct {
+	mock              *ArtifactManagerMock
+	mainExpectation   *ArtifactManagerMockFindObjectsByPrototypeExpectation
+	expectationSeries []*ArtifactManagerMockFindObjectsByPrototypeExpectation
+}
+
+type ArtifactManagerMockFindObjectsByPrototypeExpectation struct {
+	input  *ArtifactManagerMockFindObjectsByPrototypeInput
+	result *ArtifactManagerMockFindObjectsByPrototypeResult
+}
+
+type ArtifactManagerMockFindObjectsByPrototypeInput struct {
+	p  context.Context
+	p1 core.RecordRef
+	p2 *core.RecordID
+	p3 int
+}
+
+type ArtifactManagerMockFindObjectsByPrototypeResult struct {
+	r  *core.IndexedRecords
+	r1 error
+}
+
+//Expect specifies that invocation of ArtifactManager.FindObjectsByPrototype is expected from 1 to Infinity times
+func (m *mArtifactManagerMockFindObjectsByPrototype) Expect(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 int) *mArtifactManagerMockFindObjectsByPrototype {
+	m.mock.FindObjectsByPrototypeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArtifactManagerMockFindObjectsByPrototypeExpectation{}
+	}
+	m.mainExpectation.input = &ArtifactManagerMockFindObjectsByPrototypeInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of ArtifactManager.FindObjectsByPrototype
+func (m *mArtifactManagerMockFindObjectsByPrototype) Return(r *core.IndexedRecords, r1 error) *ArtifactManagerMock {
+	m.mock.FindObjectsByPrototypeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArtifactManagerMockFindObjectsByPrototypeExpectation{}
+	}
+	m.mainExpectation.result = &ArtifactManagerMockFindObjectsByPrototypeResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ArtifactManager.FindObjectsByPrototype is expected once
+func (m *mArtifactManagerMockFindObjectsByPrototype) ExpectOnce(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 int) *ArtifactManagerMockFindObjectsByPrototypeExpectation {
+	m.mock.FindObjectsByPrototypeFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ArtifactManagerMockFindObjectsByPrototypeExpectation{}
+	expectation.input = &ArtifactManagerMockFindObjectsByPrototypeInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ArtifactManagerMockFindObjectsByPrototypeExpectation) Return(r *core.IndexedRecords, r1 error) {
+	e.result = &ArtifactManagerMockFindObjectsByPrototypeResult{r, r1}
+}
+
+//Set uses given function f as a mock of ArtifactManager.FindObjectsByPrototype method
+func (m *mArtifactManagerMockFindObjectsByPrototype) Set(f func(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 int) (r *core.IndexedRecords, r1 error)) *ArtifactManagerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.FindObjectsByPrototypeFunc = f
+	return m.mock
+}
+
+//FindObjectsByPrototype implements github.com/insolar/insolar/core.ArtifactManager interface
+func (m *ArtifactManagerMock) FindObjectsByPrototype(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 int) (r *core.IndexedRecords, r1 error) {
+	counter := atomic.AddUint64(&m.FindObjectsByPrototypePreCounter, 1)
+	defer atomic.AddUint64(&m.FindObjectsByPrototypeCounter, 1)
+
+	if len(m.FindObjectsByPrototypeMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.FindObjectsByPrototypeMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ArtifactManagerMock.FindObjectsByPrototype. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.FindObjectsByPrototypeMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ArtifactManagerMockFindObjectsByPrototypeInput{p, p1, p2, p3}, "ArtifactManager.FindObjectsByPrototype got unexpected parameters")
+
+		result := m.FindObjectsByPrototypeMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArtifactManagerMock.FindObjectsByPrototype")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.FindObjectsByPrototypeMock.mainExpectation != nil {
+
+		input := m.FindObjectsByPrototypeMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ArtifactManagerMockFindObjectsByPrototypeInput{p, p1, p2, p3}, "ArtifactManager.FindObjectsByPrototype got unexpected parameters")
+		}
+
+		result := m.FindObjectsByPrototypeMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArtifactManagerMock.FindObjectsByPrototype")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.FindObjectsByPrototypeFunc == nil {
+		m.t.Fatalf("Unexpected call to ArtifactManagerMock.FindObjectsByPrototype. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.FindObjectsByPrototypeFunc(p, p1, p2, p3)
+}
+
+//FindObjectsByPrototypeMinimockCounter returns a count of ArtifactManagerMock.FindObjectsByPrototypeFunc invocations
+func (m *ArtifactManagerMock) FindObjectsByPrototypeMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.FindObjectsByPrototypeCounter)
+}
+
+//FindObjectsByPrototypeMinimockPreCounter returns the value of ArtifactManagerMock.FindObjectsByPrototype invocations
+func (m *ArtifactManagerMock) FindObjectsByPrototypeMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.FindObjectsByPrototypePreCounter)
+}
+
+//FindObjectsByPrototypeFinished returns true if mock invocations count is ok
+func (m *ArtifactManagerMock) FindObjectsByPrototypeFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.FindObjectsByPrototypeMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.FindObjectsByPrototypeCounter) == uint64(len(m.FindObjectsByPrototypeMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.FindObjectsByPrototypeMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.FindObjectsByPrototypeCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.FindObjectsByPrototypeFunc != nil {
+		return atomic.LoadUint64(&m.FindObjectsByPrototypeCounter) > 0
+	}
+
+	return true
+}
+
+type mArtifactManagerMockFindRequestsByCaller struct {
+	mock              *ArtifactManagerMock
+	mainExpectation   *ArtifactManagerMockFindRequestsByCallerExpectation
+	expectationSeries []*ArtifactManagerMockFindRequestsByCallerExpectation
+}
+
+type ArtifactManagerMockFindRequestsByCallerExpectation struct {
+	input  *ArtifactManagerMockFindRequestsByCallerInput
+	result *ArtifactManagerMockFindRequestsByCallerResult
+}
+
+type ArtifactManagerMockFindRequestsByCallerInput struct {
+	p  context.Context
+	p1 core.RecordRef
+	p2 *core.RecordID
+	p3 int
+}
+
+type ArtifactManagerMockFindRequestsByCallerResult struct {
+	r  *core.IndexedRecords
+	r1 error
+}
+
+//Expect specifies that invocation of ArtifactManager.FindRequestsByCaller is expected from 1 to Infinity times
+func (m *mArtifactManagerMockFindRequestsByCaller) Expect(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 int) *mArtifactManagerMockFindRequestsByCaller {
+	m.mock.FindRequestsByCallerFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArtifactManagerMockFindRequestsByCallerExpectation{}
+	}
+	m.mainExpectation.input = &ArtifactManagerMockFindRequestsByCallerInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of ArtifactManager.FindRequestsByCaller
+func (m *mArtifactManagerMockFindRequestsByCaller) Return(r *core.IndexedRecords, r1 error) *ArtifactManagerMock {
+	m.mock.FindRequestsByCallerFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArtifactManagerMockFindRequestsByCallerExpectation{}
+	}
+	m.mainExpectation.result = &ArtifactManagerMockFindRequestsByCallerResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ArtifactManager.FindRequestsByCaller is expected once
+func (m *mArtifactManagerMockFindRequestsByCaller) ExpectOnce(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 int) *ArtifactManagerMockFindRequestsByCallerExpectation {
+	m.mock.FindRequestsByCallerFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ArtifactManagerMockFindRequestsByCallerExpectation{}
+	expectation.input = &ArtifactManagerMockFindRequestsByCallerInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ArtifactManagerMockFindRequestsByCallerExpectation) Return(r *core.IndexedRecords, r1 error) {
+	e.result = &ArtifactManagerMockFindRequestsByCallerResult{r, r1}
+}
+
+//Set uses given function f as a mock of ArtifactManager.FindRequestsByCaller method
+func (m *mArtifactManagerMockFindRequestsByCaller) Set(f func(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 int) (r *core.IndexedRecords, r1 error)) *ArtifactManagerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.FindRequestsByCallerFunc = f
+	return m.mock
+}
+
+//FindRequestsByCaller implements github.com/insolar/insolar/core.ArtifactManager interface
+func (m *ArtifactManagerMock) FindRequestsByCaller(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 int) (r *core.IndexedRecords, r1 error) {
+	counter := atomic.AddUint64(&m.FindRequestsByCallerPreCounter, 1)
+	defer atomic.AddUint64(&m.FindRequestsByCallerCounter, 1)
+
+	if len(m.FindRequestsByCallerMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.FindRequestsByCallerMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ArtifactManagerMock.FindRequestsByCaller. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.FindRequestsByCallerMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ArtifactManagerMockFindRequestsByCallerInput{p, p1, p2, p3}, "ArtifactManager.FindRequestsByCaller got unexpected parameters")
+
+		result := m.FindRequestsByCallerMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArtifactManagerMock.FindRequestsByCaller")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.FindRequestsByCallerMock.mainExpectation != nil {
+
+		input := m.FindRequestsByCallerMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ArtifactManagerMockFindRequestsByCallerInput{p, p1, p2, p3}, "ArtifactManager.FindRequestsByCaller got unexpected parameters")
+		}
+
+		result := m.FindRequestsByCallerMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArtifactManagerMock.FindRequestsByCaller")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.FindRequestsByCallerFunc == nil {
+		m.t.Fatalf("Unexpected call to ArtifactManagerMock.FindRequestsByCaller. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.FindRequestsByCallerFunc(p, p1, p2, p3)
+}
+
+//FindRequestsByCallerMinimockCounter returns a count of ArtifactManagerMock.FindRequestsByCallerFunc invocations
+func (m *ArtifactManagerMock) FindRequestsByCallerMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.FindRequestsByCallerCounter)
+}
+
+//FindRequestsByCallerMinimockPreCounter returns the value of ArtifactManagerMock.FindRequestsByCaller invocations
+func (m *ArtifactManagerMock) FindRequestsByCallerMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.FindRequestsByCallerPreCounter)
+}
+
+//FindRequestsByCallerFinished returns true if mock invocations count is ok
+func (m *ArtifactManagerMock) FindRequestsByCallerFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.FindRequestsByCallerMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.FindRequestsByCallerCounter) == uint64(len(m.FindRequestsByCallerMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.FindRequestsByCallerMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.FindRequestsByCallerCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.FindRequestsByCallerFunc != nil {
+		return atomic.LoadUint64(&m.FindRequestsByCallerCounter) > 0
+	}
+
+	return true
+}
+
 type mArtifactManagerMockGenesisRef struct {
 	mock              *ArtifactManagerMock
 	mainExpectation   *ArtifactManagerMockGenesisRefExpectation
@@ -1501,94 +1856,95 @@ func (m *ArtifactManagerMock) GetDelegateFinished() bool {
 	return true
 }
 
-type mArtifactManagerMockGetObject struct {
+type mArtifactManagerMockGetEvents struct {
 	mock              *ArtifactManagerMock
-	mainExpectation   *ArtifactManagerMockGetObjectExpectation
-	expectationSeries []*ArtifactManagerMockGetObjectExpectation
+	mainExpectation   *ArtifactManagerMockGetEventsExpectation
+	expectationSeries []*ArtifactManagerMockGetEventsExpectation
 }
 
-type ArtifactManagerMockGetObjectExpectation struct {
-	input  *ArtifactManagerMockGetObjectInput
-	result *ArtifactManagerMockGetObjectResult
+type ArtifactManagerMockGetEventsExpectation struct {
+	input  *ArtifactManagerMockGetEventsInput
+	result *ArtifactManagerMockGetEventsResult
 }
 
-type ArtifactManagerMockGetObjectInput struct {
+type ArtifactManagerMockGetEventsInput struct {
 	p  context.Context
 	p1 core.RecordRef
-	p2 *core.RecordID
-	p3 bool
+	p2 string
+	p3 *core.PulseNumber
+	p4 *core.PulseNumber
 }
 
-type ArtifactManagerMockGetObjectResult struct {
-	r  core.ObjectDescriptor
+type ArtifactManagerMockGetEventsResult struct {
+	r  core.EventIterator
 	r1 error
 }
 
-//Expect specifies that invocation of ArtifactManager.GetObject is expected from 1 to Infinity times
-func (m *mArtifactManagerMockGetObject) Expect(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 bool) *mArtifactManagerMockGetObject {
-	m.mock.GetObjectFunc = nil
+//Expect specifies that invocation of ArtifactManager.GetEvents is expected from 1 to Infinity times
+func (m *mArtifactManagerMockGetEvents) Expect(p context.Context, p1 core.RecordRef, p2 string, p3 *core.PulseNumber, p4 *core.PulseNumber) *mArtifactManagerMockGetEvents {
+	m.mock.GetEventsFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
-		m.mainExpectation = &ArtifactManagerMockGetObjectExpectation{}
+		m.mainExpectation = &ArtifactManagerMockGetEventsExpectation{}
 	}
-	m.mainExpectation.input = &ArtifactManagerMockGetObjectInput{p, p1, p2, p3}
+	m.mainExpectation.input = &ArtifactManagerMockGetEventsInput{p, p1, p2, p3, p4}
 	return m
 }
 
-//Return specifies results of invocation of ArtifactManager.GetObject
-func (m *mArtifactManagerMockGetObject) Return(r core.ObjectDescriptor, r1 error) *ArtifactManagerMock {
-	m.mock.GetObjectFunc = nil
+//Return specifies results of invocation of ArtifactManager.GetEvents
+func (m *mArtifactManagerMockGetEvents) Return(r core.EventIterator, r1 error) *ArtifactManagerMock {
+	m.mock.GetEventsFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
-		m.mainExpectation = &ArtifactManagerMockGetObjectExpectation{}
+		m.mainExpectation = &ArtifactManagerMockGetEventsExpectation{}
 	}
-	m.mainExpectation.result = &ArtifactManagerMockGetObjectResult{r, r1}
+	m.mainExpectation.result = &ArtifactManagerMockGetEventsResult{r, r1}
 	return m.mock
 }
 
-//ExpectOnce specifies that invocation of ArtifactManager.GetObject is expected once
-func (m *mArtifactManagerMockGetObject) ExpectOnce(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 bool) *ArtifactManagerMockGetObjectExpectation {
-	m.mock.GetObjectFunc = nil
+//ExpectOnce specifies that invocation of ArtifactManager.GetEvents is expected once
+func (m *mArtifactManagerMockGetEvents) ExpectOnce(p context.Context, p1 core.RecordRef, p2 string, p3 *core.PulseNumber, p4 *core.PulseNumber) *ArtifactManagerMockGetEventsExpectation {
+	m.mock.GetEventsFunc = nil
 	m.mainExpectation = nil
 
-	expectation := &ArtifactManagerMockGetObjectExpectation{}
-	expectation.input = &ArtifactManagerMockGetObjectInput{p, p1, p2, p3}
+	expectation := &ArtifactManagerMockGetEventsExpectation{}
+	expectation.input = &ArtifactManagerMockGetEventsInput{p, p1, p2, p3, p4}
 	m.expectationSeries = append(m.expectationSeries, expectation)
 	return expectation
 }
 
-func (e *ArtifactManagerMockGetObjectExpectation) Return(r core.ObjectDescriptor, r1 error) {
-	e.result = &ArtifactManagerMockGetObjectResult{r, r1}
+func (e *ArtifactManagerMockGetEventsExpectation) Return(r core.EventIterator, r1 error) {
+	e.result = &ArtifactManagerMockGetEventsResult{r, r1}
 }
 
-//Set uses given function f as a mock of ArtifactManager.GetObject method
-func (m *mArtifactManagerMockGetObject) Set(f func(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 bool) (r core.ObjectDescriptor, r1 error)) *ArtifactManagerMock {
+//Set uses given function f as a mock of ArtifactManager.GetEvents method
+func (m *mArtifactManagerMockGetEvents) Set(f func(p context.Context, p1 core.RecordRef, p2 string, p3 *core.PulseNumber, p4 *core.PulseNumber) (r core.EventIterator, r1 error)) *ArtifactManagerMock {
 	m.mainExpectation = nil
 	m.expectationSeries = nil
 
-	m.mock.GetObjectFunc = f
+	m.mock.GetEventsFunc = f
 	return m.mock
 }
 
-//GetObject implements github.com/insolar/insolar/core.ArtifactManager interface
-func (m *ArtifactManagerMock) GetObject(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 bool) (r core.ObjectDescriptor, r1 error) {
-	counter := atomic.AddUint64(&m.GetObjectPreCounter, 1)
-	defer atomic.AddUint64(&m.GetObjectCounter, 1)
+//GetEvents implements github.com/insolar/insolar/core.ArtifactManager interface
+func (m *ArtifactManagerMock) GetEvents(p context.Context, p1 core.RecordRef, p2 string, p3 *core.PulseNumber, p4 *core.PulseNumber) (r core.EventIterator, r1 error) {
+	counter := atomic.AddUint64(&m.GetEventsPreCounter, 1)
+	defer atomic.AddUint64(&m.GetEventsCounter, 1)
 
-	if len(m.GetObjectMock.expectationSeries) > 0 {
-		if counter > uint64(len(m.GetObjectMock.expectationSeries)) {
-			m.t.Fatalf("Unexpected call to ArtifactManagerMock.GetObject. %v %v %v %v", p, p1, p2, p3)
+	if len(m.GetEventsMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetEventsMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ArtifactManagerMock.GetEvents. %v %v %v %v %v", p, p1, p2, p3, p4)
 			return
 		}
 
-		input := m.GetObjectMock.expectationSeries[counter-1].input
-		testify_assert.Equal(m.t, *input, ArtifactManagerMockGetObjectInput{p, p1, p2, p3}, "ArtifactManager.GetObject got unexpected parameters")
+		input := m.GetEventsMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ArtifactManagerMockGetEventsInput{p, p1, p2, p3, p4}, "ArtifactManager.GetEvents got unexpected parameters")
 
-		result := m.GetObjectMock.expectationSeries[counter-1].result
+		result := m.GetEventsMock.expectationSeries[counter-1].result
 		if result == nil {
-			m.t.Fatal("No results are set for the ArtifactManagerMock.GetObject")
+			m.t.Fatal("No results are set for the ArtifactManagerMock.GetEvents")
 			return
 		}
 
@@ -1598,16 +1954,16 @@ func (m *ArtifactManagerMock) GetObject(p context.Context, p1 core.RecordRef, p2
 		return
 	}
 
-	if m.GetObjectMock.mainExpectation != nil {
+	if m.GetEventsMock.mainExpectation != nil {
 
-		input := m.GetObjectMock.mainExpectation.input
+		input := m.GetEventsMock.mainExpectation.input
 		if input != nil {
-			testify_assert.Equal(m.t, *input, ArtifactManagerMockGetObjectInput{p, p1, p2, p3}, "ArtifactManager.GetObject got unexpected parameters")
+			testify_assert.Equal(m.t, *input, ArtifactManagerMockGetEventsInput{p, p1, p2, p3, p4}, "ArtifactManager.GetEvents got unexpected parameters")
 		}
 
-		result := m.GetObjectMock.mainExpectation.result
+		result := m.GetEventsMock.mainExpectation.result
 		if result == nil {
-			m.t.Fatal("No results are set for the ArtifactManagerMock.GetObject")
+			m.t.Fatal("No results are set for the ArtifactManagerMock.GetEvents")
 		}
 
 		r = result.r
@@ -1616,130 +1972,893 @@ func (m *ArtifactManagerMock) GetObject(p context.Context, p1 core.RecordRef, p2
 		return
 	}
 
-	if m.GetObjectFunc == nil {
-		m.t.Fatalf("Unexpected call to ArtifactManagerMock.GetObject. %v %v %v %v", p, p1, p2, p3)
+	if m.GetEventsFunc == nil {
+		m.t.Fatalf("Unexpected call to ArtifactManagerMock.GetEvents. %v %v %v %v %v", p, p1, p2, p3, p4)
 		return
 	}
 
-	return m.GetObjectFunc(p, p1, p2, p3)
+	return m.GetEventsFunc(p, p1, p2, p3, p4)
 }
 
-//GetObjectMinimockCounter returns a count of ArtifactManagerMock.GetObjectFunc invocations
-func (m *ArtifactManagerMock) GetObjectMinimockCounter() uint64 {
-	return atomic.LoadUint64(&m.GetObjectCounter)
+//GetEventsMinimockCounter returns a count of ArtifactManagerMock.GetEventsFunc invocations
+func (m *ArtifactManagerMock) GetEventsMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetEventsCounter)
 }
 
-//GetObjectMinimockPreCounter returns the value of ArtifactManagerMock.GetObject invocations
-func (m *ArtifactManagerMock) GetObjectMinimockPreCounter() uint64 {
-	return atomic.LoadUint64(&m.GetObjectPreCounter)
+//GetEventsMinimockPreCounter returns the value of ArtifactManagerMock.GetEvents invocations
+func (m *ArtifactManagerMock) GetEventsMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetEventsPreCounter)
 }
 
-//GetObjectFinished returns true if mock invocations count is ok
-func (m *ArtifactManagerMock) GetObjectFinished() bool {
+//GetEventsFinished returns true if mock invocations count is ok
+func (m *ArtifactManagerMock) GetEventsFinished() bool {
 	// if expectation series were set then invocations count should be equal to expectations count
-	if len(m.GetObjectMock.expectationSeries) > 0 {
-		return atomic.LoadUint64(&m.GetObjectCounter) == uint64(len(m.GetObjectMock.expectationSeries))
+	if len(m.GetEventsMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetEventsCounter) == uint64(len(m.GetEventsMock.expectationSeries))
 	}
 
 	// if main expectation was set then invocations count should be greater than zero
-	if m.GetObjectMock.mainExpectation != nil {
-		return atomic.LoadUint64(&m.GetObjectCounter) > 0
+	if m.GetEventsMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetEventsCounter) > 0
 	}
 
 	// if func was set then invocations count should be greater than zero
-	if m.GetObjectFunc != nil {
-		return atomic.LoadUint64(&m.GetObjectCounter) > 0
+	if m.GetEventsFunc != nil {
+		return atomic.LoadUint64(&m.GetEventsCounter) > 0
 	}
 
 	return true
 }
 
-type mArtifactManagerMockGetPendingRequest struct {
+type mArtifactManagerMockGetObject struct {
 	mock              *ArtifactManagerMock
-	mainExpectation   *ArtifactManagerMockGetPendingRequestExpectation
+	mainExpectation   *ArtifactManagerMockGetObjectExpectation
+	expectationSeries []*ArtifactManagerMockGetObjectExpectation
+}
+
+type ArtifactManagerMockGetObjectExpectation struct {
+	input  *ArtifactManagerMockGetObjectInput
+	result *ArtifactManagerMockGetObjectResult
+}
+
+type ArtifactManagerMockGetObjectInput struct {
+	p  context.Context
+	p1 core.RecordRef
+	p2 *core.RecordID
+	p3 bool
+}
+
+type ArtifactManagerMockGetObjectResult struct {
+	r  core.ObjectDescriptor
+	r1 error
+}
+
+//Expect specifies that invocation of ArtifactManager.GetObject is expected from 1 to Infinity times
+func (m *mArtifactManagerMockGetObject) Expect(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 bool) *mArtifactManagerMockGetObject {
+	m.mock.GetObjectFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArtifactManagerMockGetObjectExpectation{}
+	}
+	m.mainExpectation.input = &ArtifactManagerMockGetObjectInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of ArtifactManager.GetObject
+func (m *mArtifactManagerMockGetObject) Return(r core.ObjectDescriptor, r1 error) *ArtifactManagerMock {
+	m.mock.GetObjectFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArtifactManagerMockGetObjectExpectation{}
+	}
+	m.mainExpectation.result = &ArtifactManagerMockGetObjectResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ArtifactManager.GetObject is expected once
+func (m *mArtifactManagerMockGetObject) ExpectOnce(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 bool) *ArtifactManagerMockGetObjectExpectation {
+	m.mock.GetObjectFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ArtifactManagerMockGetObjectExpectation{}
+	expectation.input = &ArtifactManagerMockGetObjectInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ArtifactManagerMockGetObjectExpectation) Return(r core.ObjectDescriptor, r1 error) {
+	e.result = &ArtifactManagerMockGetObjectResult{r, r1}
+}
+
+//Set uses given function f as a mock of ArtifactManager.GetObject method
+func (m *mArtifactManagerMockGetObject) Set(f func(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 bool) (r core.ObjectDescriptor, r1 error)) *ArtifactManagerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetObjectFunc = f
+	return m.mock
+}
+
+//GetObject implements github.com/insolar/insolar/core.ArtifactManager interface
+func (m *ArtifactManagerMock) GetObject(p context.Context, p1 core.RecordRef, p2 *core.RecordID, p3 bool) (r core.ObjectDescriptor, r1 error) {
+	counter := atomic.AddUint64(&m.GetObjectPreCounter, 1)
+	defer atomic.AddUint64(&m.GetObjectCounter, 1)
+
+	if len(m.GetObjectMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetObjectMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ArtifactManagerMock.GetObject. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.GetObjectMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ArtifactManagerMockGetObjectInput{p, p1, p2, p3}, "ArtifactManager.GetObject got unexpected parameters")
+
+		result := m.GetObjectMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArtifactManagerMock.GetObject")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetObjectMock.mainExpectation != nil {
+
+		input := m.GetObjectMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ArtifactManagerMockGetObjectInput{p, p1, p2, p3}, "ArtifactManager.GetObject got unexpected parameters")
+		}
+
+		result := m.GetObjectMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArtifactManagerMock.GetObject")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetObjectFunc == nil {
+		m.t.Fatalf("Unexpected call to ArtifactManagerMock.GetObject. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.GetObjectFunc(p, p1, p2, p3)
+}
+
+//GetObjectMinimockCounter returns a count of ArtifactManagerMock.GetObjectFunc invocations
+func (m *ArtifactManagerMock) GetObjectMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetObjectCounter)
+}
+
+//GetObjectMinimockPreCounter returns the value of ArtifactManagerMock.GetObject invocations
+func (m *ArtifactManagerMock) GetObjectMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetObjectPreCounter)
+}
+
+//GetObjectFinished returns true if mock invocations count is ok
+func (m *ArtifactManagerMock) GetObjectFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetObjectMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetObjectCounter) == uint64(len(m.GetObjectMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetObjectMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetObjectCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetObjectFunc != nil {
+		return atomic.LoadUint64(&m.GetObjectCounter) > 0
+	}
+
+	return true
+}
+
+type mArtifactManagerMockGetObjectAtPulse struct {
+	mock              *ArtifactManagerMock
+	mainExpectation   *ArtifactManagerMockGetObjectAtPulseExpectation
+	expectationSeries []*ArtifactManagerMockGetObjectAtPulseExpectation
+}
+
+type ArtifactManagerMockGetObjectAtPulseExpectation struct {
+	input  *ArtifactManagerMockGetObjectAtPulseInput
+	result *ArtifactManagerMockGetObjectAtPulseResult
+}
+
+type ArtifactManagerMockGetObjectAtPulseInput struct {
+	p  context.Context
+	p1 core.RecordRef
+	p2 core.PulseNumber
+}
+
+type ArtifactManagerMockGetObjectAtPulseResult struct {
+	r  core.ObjectDescriptor
+	r1 error
+}
+
+//Expect specifies that invocation of ArtifactManager.GetObjectAtPulse is expected from 1 to Infinity times
+func (m *mArtifactManagerMockGetObjectAtPulse) Expect(p context.Context, p1 core.RecordRef, p2 core.PulseNumber) *mArtifactManagerMockGetObjectAtPulse {
+	m.mock.GetObjectAtPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArtifactManagerMockGetObjectAtPulseExpectation{}
+	}
+	m.mainExpectation.input = &ArtifactManagerMockGetObjectAtPulseInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of ArtifactManager.GetObjectAtPulse
+func (m *mArtifactManagerMockGetObjectAtPulse) Return(r core.ObjectDescriptor, r1 error) *ArtifactManagerMock {
+	m.mock.GetObjectAtPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArtifactManagerMockGetObjectAtPulseExpectation{}
+	}
+	m.mainExpectation.result = &ArtifactManagerMockGetObjectAtPulseResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ArtifactManager.GetObjectAtPulse is expected once
+func (m *mArtifactManagerMockGetObjectAtPulse) ExpectOnce(p context.Context, p1 core.RecordRef, p2 core.PulseNumber) *ArtifactManagerMockGetObjectAtPulseExpectation {
+	m.mock.GetObjectAtPulseFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ArtifactManagerMockGetObjectAtPulseExpectation{}
+	expectation.input = &ArtifactManagerMockGetObjectAtPulseInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ArtifactManagerMockGetObjectAtPulseExpectation) Return(r core.ObjectDescriptor, r1 error) {
+	e.result = &ArtifactManagerMockGetObjectAtPulseResult{r, r1}
+}
+
+//Set uses given function f as a mock of ArtifactManager.GetObjectAtPulse method
+func (m *mArtifactManagerMockGetObjectAtPulse) Set(f func(p context.Context, p1 core.RecordRef, p2 core.PulseNumber) (r core.ObjectDescriptor, r1 error)) *ArtifactManagerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetObjectAtPulseFunc = f
+	return m.mock
+}
+
+//GetObjectAtPulse implements github.com/insolar/insolar/core.ArtifactManager interface
+func (m *ArtifactManagerMock) GetObjectAtPulse(p context.Context, p1 core.RecordRef, p2 core.PulseNumber) (r core.ObjectDescriptor, r1 error) {
+	counter := atomic.AddUint64(&m.GetObjectAtPulsePreCounter, 1)
+	defer atomic.AddUint64(&m.GetObjectAtPulseCounter, 1)
+
+	if len(m.GetObjectAtPulseMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetObjectAtPulseMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ArtifactManagerMock.GetObjectAtPulse. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.GetObjectAtPulseMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ArtifactManagerMockGetObjectAtPulseInput{p, p1, p2}, "ArtifactManager.GetObjectAtPulse got unexpected parameters")
+
+		result := m.GetObjectAtPulseMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArtifactManagerMock.GetObjectAtPulse")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetObjectAtPulseMock.mainExpectation != nil {
+
+		input := m.GetObjectAtPulseMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ArtifactManagerMockGetObjectAtPulseInput{p, p1, p2}, "ArtifactManager.GetObjectAtPulse got unexpected parameters")
+		}
+
+		result := m.GetObjectAtPulseMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArtifactManagerMock.GetObjectAtPulse")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetObjectAtPulseFunc == nil {
+		m.t.Fatalf("Unexpected call to ArtifactManagerMock.GetObjectAtPulse. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.GetObjectAtPulseFunc(p, p1, p2)
+}
+
+//GetObjectAtPulseMinimockCounter returns a count of ArtifactManagerMock.GetObjectAtPulseFunc invocations
+func (m *ArtifactManagerMock) GetObjectAtPulseMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetObjectAtPulseCounter)
+}
+
+//GetObjectAtPulseMinimockPreCounter returns the value of ArtifactManagerMock.GetObjectAtPulse invocations
+func (m *ArtifactManagerMock) GetObjectAtPulseMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetObjectAtPulsePreCounter)
+}
+
+//GetObjectAtPulseFinished returns true if mock invocations count is ok
+func (m *ArtifactManagerMock) GetObjectAtPulseFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetObjectAtPulseMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetObjectAtPulseCounter) == uint64(len(m.GetObjectAtPulseMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetObjectAtPulseMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetObjectAtPulseCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetObjectAtPulseFunc != nil {
+		return atomic.LoadUint64(&m.GetObjectAtPulseCounter) > 0
+	}
+
+	return true
+}
+
+type mArtifactManagerMockGetObjectHistory struct {
+	mock              *ArtifactManagerMock
+	mainExpectation   *ArtifactManagerMockGetObjectHistoryExpectation
+	expectationSeries []*ArtifactManagerMockGetObjectHistoryExpectation
+}
+
+type ArtifactManagerMockGetObjectHistoryExpectation struct {
+	input  *ArtifactManagerMockGetObjectHistoryInput
+	result *ArtifactManagerMockGetObjectHistoryResult
+}
+
+type ArtifactManagerMockGetObjectHistoryInput struct {
+	p  context.Context
+	p1 core.RecordRef
+	p2 *core.PulseNumber
+	p3 *core.PulseNumber
+}
+
+type ArtifactManagerMockGetObjectHistoryResult struct {
+	r  core.ObjectHistoryIterator
+	r1 error
+}
+
+//Expect specifies that invocation of ArtifactManager.GetObjectHistory is expected from 1 to Infinity times
+func (m *mArtifactManagerMockGetObjectHistory) Expect(p context.Context, p1 core.RecordRef, p2 *core.PulseNumber, p3 *core.PulseNumber) *mArtifactManagerMockGetObjectHistory {
+	m.mock.GetObjectHistoryFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArtifactManagerMockGetObjectHistoryExpectation{}
+	}
+	m.mainExpectation.input = &ArtifactManagerMockGetObjectHistoryInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of ArtifactManager.GetObjectHistory
+func (m *mArtifactManagerMockGetObjectHistory) Return(r core.ObjectHistoryIterator, r1 error) *ArtifactManagerMock {
+	m.mock.GetObjectHistoryFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArtifactManagerMockGetObjectHistoryExpectation{}
+	}
+	m.mainExpectation.result = &ArtifactManagerMockGetObjectHistoryResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ArtifactManager.GetObjectHistory is expected once
+func (m *mArtifactManagerMockGetObjectHistory) ExpectOnce(p context.Context, p1 core.RecordRef, p2 *core.PulseNumber, p3 *core.PulseNumber) *ArtifactManagerMockGetObjectHistoryExpectation {
+	m.mock.GetObjectHistoryFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ArtifactManagerMockGetObjectHistoryExpectation{}
+	expectation.input = &ArtifactManagerMockGetObjectHistoryInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ArtifactManagerMockGetObjectHistoryExpectation) Return(r core.ObjectHistoryIterator, r1 error) {
+	e.result = &ArtifactManagerMockGetObjectHistoryResult{r, r1}
+}
+
+//Set uses given function f as a mock of ArtifactManager.GetObjectHistory method
+func (m *mArtifactManagerMockGetObjectHistory) Set(f func(p context.Context, p1 core.RecordRef, p2 *core.PulseNumber, p3 *core.PulseNumber) (r core.ObjectHistoryIterator, r1 error)) *ArtifactManagerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetObjectHistoryFunc = f
+	return m.mock
+}
+
+//GetObjectHistory implements github.com/insolar/insolar/core.ArtifactManager interface
+func (m *ArtifactManagerMock) GetObjectHistory(p context.Context, p1 core.RecordRef, p2 *core.PulseNumber, p3 *core.PulseNumber) (r core.ObjectHistoryIterator, r1 error) {
+	counter := atomic.AddUint64(&m.GetObjectHistoryPreCounter, 1)
+	defer atomic.AddUint64(&m.GetObjectHistoryCounter, 1)
+
+	if len(m.GetObjectHistoryMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetObjectHistoryMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ArtifactManagerMock.GetObjectHistory. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.GetObjectHistoryMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ArtifactManagerMockGetObjectHistoryInput{p, p1, p2, p3}, "ArtifactManager.GetObjectHistory got unexpected parameters")
+
+		result := m.GetObjectHistoryMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArtifactManagerMock.GetObjectHistory")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetObjectHistoryMock.mainExpectation != nil {
+
+		input := m.GetObjectHistoryMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ArtifactManagerMockGetObjectHistoryInput{p, p1, p2, p3}, "ArtifactManager.GetObjectHistory got unexpected parameters")
+		}
+
+		result := m.GetObjectHistoryMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArtifactManagerMock.GetObjectHistory")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetObjectHistoryFunc == nil {
+		m.t.Fatalf("Unexpected call to ArtifactManagerMock.GetObjectHistory. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.GetObjectHistoryFunc(p, p1, p2, p3)
+}
+
+//GetObjectHistoryMinimockCounter returns a count of ArtifactManagerMock.GetObjectHistoryFunc invocations
+func (m *ArtifactManagerMock) GetObjectHistoryMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetObjectHistoryCounter)
+}
+
+//GetObjectHistoryMinimockPreCounter returns the value of ArtifactManagerMock.GetObjectHistory invocations
+func (m *ArtifactManagerMock) GetObjectHistoryMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetObjectHistoryPreCounter)
+}
+
+//GetObjectHistoryFinished returns true if mock invocations count is ok
+func (m *ArtifactManagerMock) GetObjectHistoryFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetObjectHistoryMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetObjectHistoryCounter) == uint64(len(m.GetObjectHistoryMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetObjectHistoryMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetObjectHistoryCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetObjectHistoryFunc != nil {
+		return atomic.LoadUint64(&m.GetObjectHistoryCounter) > 0
+	}
+
+	return true
+}
+
+type mArtifactManagerMockGetPendingRequest struct {
+	mock              *ArtifactManagerMock
+	mainExpectation   *ArtifactManagerMockGetPendingRequestExpectation
 	expectationSeries []*ArtifactManagerMockGetPendingRequestExpectation
 }
 
-type ArtifactManagerMockGetPendingRequestExpectation struct {
-	input  *ArtifactManagerMockGetPendingRequestInput
-	result *ArtifactManagerMockGetPendingRequestResult
+type ArtifactManagerMockGetPendingRequestExpectation struct {
+	input  *ArtifactManagerMockGetPendingRequestInput
+	result *ArtifactManagerMockGetPendingRequestResult
+}
+
+type ArtifactManagerMockGetPendingRequestInput struct {
+	p  context.Context
+	p1 core.RecordID
+}
+
+type ArtifactManagerMockGetPendingRequestResult struct {
+	r  core.Parcel
+	r1 error
+}
+
+//Expect specifies that invocation of ArtifactManager.GetPendingRequest is expected from 1 to Infinity times
+func (m *mArtifactManagerMockGetPendingRequest) Expect(p context.Context, p1 core.RecordID) *mArtifactManagerMockGetPendingRequest {
+	m.mock.GetPendingRequestFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArtifactManagerMockGetPendingRequestExpectation{}
+	}
+	m.mainExpectation.input = &ArtifactManagerMockGetPendingRequestInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of ArtifactManager.GetPendingRequest
+func (m *mArtifactManagerMockGetPendingRequest) Return(r core.Parcel, r1 error) *ArtifactManagerMock {
+	m.mock.GetPendingRequestFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArtifactManagerMockGetPendingRequestExpectation{}
+	}
+	m.mainExpectation.result = &ArtifactManagerMockGetPendingRequestResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ArtifactManager.GetPendingRequest is expected once
+func (m *mArtifactManagerMockGetPendingRequest) ExpectOnce(p context.Context, p1 core.RecordID) *ArtifactManagerMockGetPendingRequestExpectation {
+	m.mock.GetPendingRequestFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ArtifactManagerMockGetPendingRequestExpectation{}
+	expectation.input = &ArtifactManagerMockGetPendingRequestInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ArtifactManagerMockGetPendingRequestExpectation) Return(r core.Parcel, r1 error) {
+	e.result = &ArtifactManagerMockGetPendingRequestResult{r, r1}
+}
+
+//Set uses given function f as a mock of ArtifactManager.GetPendingRequest method
+func (m *mArtifactManagerMockGetPendingRequest) Set(f func(p context.Context, p1 core.RecordID) (r core.Parcel, r1 error)) *ArtifactManagerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetPendingRequestFunc = f
+	return m.mock
+}
+
+//GetPendingRequest implements github.com/insolar/insolar/core.ArtifactManager interface
+func (m *ArtifactManagerMock) GetPendingRequest(p context.Context, p1 core.RecordID) (r core.Parcel, r1 error) {
+	counter := atomic.AddUint64(&m.GetPendingRequestPreCounter, 1)
+	defer atomic.AddUint64(&m.GetPendingRequestCounter, 1)
+
+	if len(m.GetPendingRequestMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetPendingRequestMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ArtifactManagerMock.GetPendingRequest. %v %v", p, p1)
+			return
+		}
+
+		input := m.GetPendingRequestMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ArtifactManagerMockGetPendingRequestInput{p, p1}, "ArtifactManager.GetPendingRequest got unexpected parameters")
+
+		result := m.GetPendingRequestMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArtifactManagerMock.GetPendingRequest")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetPendingRequestMock.mainExpectation != nil {
+
+		input := m.GetPendingRequestMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ArtifactManagerMockGetPendingRequestInput{p, p1}, "ArtifactManager.GetPendingRequest got unexpected parameters")
+		}
+
+		result := m.GetPendingRequestMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArtifactManagerMock.GetPendingRequest")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetPendingRequestFunc == nil {
+		m.t.Fatalf("Unexpected call to ArtifactManagerMock.GetPendingRequest. %v %v", p, p1)
+		return
+	}
+
+	return m.GetPendingRequestFunc(p, p1)
+}
+
+//GetPendingRequestMinimockCounter returns a count of ArtifactManagerMock.GetPendingRequestFunc invocations
+func (m *ArtifactManagerMock) GetPendingRequestMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetPendingRequestCounter)
+}
+
+//GetPendingRequestMinimockPreCounter returns the value of ArtifactManagerMock.GetPendingRequest invocations
+func (m *ArtifactManagerMock) GetPendingRequestMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetPendingRequestPreCounter)
+}
+
+//GetPendingRequestFinished returns true if mock invocations count is ok
+func (m *ArtifactManagerMock) GetPendingRequestFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetPendingRequestMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetPendingRequestCounter) == uint64(len(m.GetPendingRequestMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetPendingRequestMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetPendingRequestCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetPendingRequestFunc != nil {
+		return atomic.LoadUint64(&m.GetPendingRequestCounter) > 0
+	}
+
+	return true
+}
+
+type mArtifactManagerMockGetReceipt struct {
+	mock              *ArtifactManagerMock
+	mainExpectation   *ArtifactManagerMockGetReceiptExpectation
+	expectationSeries []*ArtifactManagerMockGetReceiptExpectation
+}
+
+type ArtifactManagerMockGetReceiptExpectation struct {
+	input  *ArtifactManagerMockGetReceiptInput
+	result *ArtifactManagerMockGetReceiptResult
+}
+
+type ArtifactManagerMockGetReceiptInput struct {
+	p  context.Context
+	p1 core.RecordRef
+	p2 core.RecordRef
+}
+
+type ArtifactManagerMockGetReceiptResult struct {
+	r  *core.RequestReceipt
+	r1 error
+}
+
+//Expect specifies that invocation of ArtifactManager.GetReceipt is expected from 1 to Infinity times
+func (m *mArtifactManagerMockGetReceipt) Expect(p context.Context, p1 core.RecordRef, p2 core.RecordRef) *mArtifactManagerMockGetReceipt {
+	m.mock.GetReceiptFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArtifactManagerMockGetReceiptExpectation{}
+	}
+	m.mainExpectation.input = &ArtifactManagerMockGetReceiptInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of ArtifactManager.GetReceipt
+func (m *mArtifactManagerMockGetReceipt) Return(r *core.RequestReceipt, r1 error) *ArtifactManagerMock {
+	m.mock.GetReceiptFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArtifactManagerMockGetReceiptExpectation{}
+	}
+	m.mainExpectation.result = &ArtifactManagerMockGetReceiptResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ArtifactManager.GetReceipt is expected once
+func (m *mArtifactManagerMockGetReceipt) ExpectOnce(p context.Context, p1 core.RecordRef, p2 core.RecordRef) *ArtifactManagerMockGetReceiptExpectation {
+	m.mock.GetReceiptFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ArtifactManagerMockGetReceiptExpectation{}
+	expectation.input = &ArtifactManagerMockGetReceiptInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ArtifactManagerMockGetReceiptExpectation) Return(r *core.RequestReceipt, r1 error) {
+	e.result = &ArtifactManagerMockGetReceiptResult{r, r1}
+}
+
+//Set uses given function f as a mock of ArtifactManager.GetReceipt method
+func (m *mArtifactManagerMockGetReceipt) Set(f func(p context.Context, p1 core.RecordRef, p2 core.RecordRef) (r *core.RequestReceipt, r1 error)) *ArtifactManagerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetReceiptFunc = f
+	return m.mock
+}
+
+//GetReceipt implements github.com/insolar/insolar/core.ArtifactManager interface
+func (m *ArtifactManagerMock) GetReceipt(p context.Context, p1 core.RecordRef, p2 core.RecordRef) (r *core.RequestReceipt, r1 error) {
+	counter := atomic.AddUint64(&m.GetReceiptPreCounter, 1)
+	defer atomic.AddUint64(&m.GetReceiptCounter, 1)
+
+	if len(m.GetReceiptMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetReceiptMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ArtifactManagerMock.GetReceipt. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.GetReceiptMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ArtifactManagerMockGetReceiptInput{p, p1, p2}, "ArtifactManager.GetReceipt got unexpected parameters")
+
+		result := m.GetReceiptMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArtifactManagerMock.GetReceipt")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetReceiptMock.mainExpectation != nil {
+
+		input := m.GetReceiptMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ArtifactManagerMockGetReceiptInput{p, p1, p2}, "ArtifactManager.GetReceipt got unexpected parameters")
+		}
+
+		result := m.GetReceiptMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArtifactManagerMock.GetReceipt")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetReceiptFunc == nil {
+		m.t.Fatalf("Unexpected call to ArtifactManagerMock.GetReceipt. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.GetReceiptFunc(p, p1, p2)
+}
+
+//GetReceiptMinimockCounter returns a count of ArtifactManagerMock.GetReceiptFunc invocations
+func (m *ArtifactManagerMock) GetReceiptMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetReceiptCounter)
 }
 
-type ArtifactManagerMockGetPendingRequestInput struct {
+//GetReceiptMinimockPreCounter returns the value of ArtifactManagerMock.GetReceipt invocations
+func (m *ArtifactManagerMock) GetReceiptMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetReceiptPreCounter)
+}
+
+//GetReceiptFinished returns true if mock invocations count is ok
+func (m *ArtifactManagerMock) GetReceiptFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetReceiptMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetReceiptCounter) == uint64(len(m.GetReceiptMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetReceiptMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetReceiptCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetReceiptFunc != nil {
+		return atomic.LoadUint64(&m.GetReceiptCounter) > 0
+	}
+
+	return true
+}
+
+type mArtifactManagerMockGetRecordProof struct {
+	mock              *ArtifactManagerMock
+	mainExpectation   *ArtifactManagerMockGetRecordProofExpectation
+	expectationSeries []*ArtifactManagerMockGetRecordProofExpectation
+}
+
+type ArtifactManagerMockGetRecordProofExpectation struct {
+	input  *ArtifactManagerMockGetRecordProofInput
+	result *ArtifactManagerMockGetRecordProofResult
+}
+
+type ArtifactManagerMockGetRecordProofInput struct {
 	p  context.Context
 	p1 core.RecordID
+	p2 core.PulseNumber
+	p3 core.RecordID
 }
 
-type ArtifactManagerMockGetPendingRequestResult struct {
-	r  core.Parcel
+type ArtifactManagerMockGetRecordProofResult struct {
+	r  *core.RecordProof
 	r1 error
 }
 
-//Expect specifies that invocation of ArtifactManager.GetPendingRequest is expected from 1 to Infinity times
-func (m *mArtifactManagerMockGetPendingRequest) Expect(p context.Context, p1 core.RecordID) *mArtifactManagerMockGetPendingRequest {
-	m.mock.GetPendingRequestFunc = nil
+//Expect specifies that invocation of ArtifactManager.GetRecordProof is expected from 1 to Infinity times
+func (m *mArtifactManagerMockGetRecordProof) Expect(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 core.RecordID) *mArtifactManagerMockGetRecordProof {
+	m.mock.GetRecordProofFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
-		m.mainExpectation = &ArtifactManagerMockGetPendingRequestExpectation{}
+		m.mainExpectation = &ArtifactManagerMockGetRecordProofExpectation{}
 	}
-	m.mainExpectation.input = &ArtifactManagerMockGetPendingRequestInput{p, p1}
+	m.mainExpectation.input = &ArtifactManagerMockGetRecordProofInput{p, p1, p2, p3}
 	return m
 }
 
-//Return specifies results of invocation of ArtifactManager.GetPendingRequest
-func (m *mArtifactManagerMockGetPendingRequest) Return(r core.Parcel, r1 error) *ArtifactManagerMock {
-	m.mock.GetPendingRequestFunc = nil
+//Return specifies results of invocation of ArtifactManager.GetRecordProof
+func (m *mArtifactManagerMockGetRecordProof) Return(r *core.RecordProof, r1 error) *ArtifactManagerMock {
+	m.mock.GetRecordProofFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
-		m.mainExpectation = &ArtifactManagerMockGetPendingRequestExpectation{}
+		m.mainExpectation = &ArtifactManagerMockGetRecordProofExpectation{}
 	}
-	m.mainExpectation.result = &ArtifactManagerMockGetPendingRequestResult{r, r1}
+	m.mainExpectation.result = &ArtifactManagerMockGetRecordProofResult{r, r1}
 	return m.mock
 }
 
-//ExpectOnce specifies that invocation of ArtifactManager.GetPendingRequest is expected once
-func (m *mArtifactManagerMockGetPendingRequest) ExpectOnce(p context.Context, p1 core.RecordID) *ArtifactManagerMockGetPendingRequestExpectation {
-	m.mock.GetPendingRequestFunc = nil
+//ExpectOnce specifies that invocation of ArtifactManager.GetRecordProof is expected once
+func (m *mArtifactManagerMockGetRecordProof) ExpectOnce(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 core.RecordID) *ArtifactManagerMockGetRecordProofExpectation {
+	m.mock.GetRecordProofFunc = nil
 	m.mainExpectation = nil
 
-	expectation := &ArtifactManagerMockGetPendingRequestExpectation{}
-	expectation.input = &ArtifactManagerMockGetPendingRequestInput{p, p1}
+	expectation := &ArtifactManagerMockGetRecordProofExpectation{}
+	expectation.input = &ArtifactManagerMockGetRecordProofInput{p, p1, p2, p3}
 	m.expectationSeries = append(m.expectationSeries, expectation)
 	return expectation
 }
 
-func (e *ArtifactManagerMockGetPendingRequestExpectation) Return(r core.Parcel, r1 error) {
-	e.result = &ArtifactManagerMockGetPendingRequestResult{r, r1}
+func (e *ArtifactManagerMockGetRecordProofExpectation) Return(r *core.RecordProof, r1 error) {
+	e.result = &ArtifactManagerMockGetRecordProofResult{r, r1}
 }
 
-//Set uses given function f as a mock of ArtifactManager.GetPendingRequest method
-func (m *mArtifactManagerMockGetPendingRequest) Set(f func(p context.Context, p1 core.RecordID) (r core.Parcel, r1 error)) *ArtifactManagerMock {
+//Set uses given function f as a mock of ArtifactManager.GetRecordProof method
+func (m *mArtifactManagerMockGetRecordProof) Set(f func(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 core.RecordID) (r *core.RecordProof, r1 error)) *ArtifactManagerMock {
 	m.mainExpectation = nil
 	m.expectationSeries = nil
 
-	m.mock.GetPendingRequestFunc = f
+	m.mock.GetRecordProofFunc = f
 	return m.mock
 }
 
-//GetPendingRequest implements github.com/insolar/insolar/core.ArtifactManager interface
-func (m *ArtifactManagerMock) GetPendingRequest(p context.Context, p1 core.RecordID) (r core.Parcel, r1 error) {
-	counter := atomic.AddUint64(&m.GetPendingRequestPreCounter, 1)
-	defer atomic.AddUint64(&m.GetPendingRequestCounter, 1)
+//GetRecordProof implements github.com/insolar/insolar/core.ArtifactManager interface
+func (m *ArtifactManagerMock) GetRecordProof(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 core.RecordID) (r *core.RecordProof, r1 error) {
+	counter := atomic.AddUint64(&m.GetRecordProofPreCounter, 1)
+	defer atomic.AddUint64(&m.GetRecordProofCounter, 1)
 
-	if len(m.GetPendingRequestMock.expectationSeries) > 0 {
-		if counter > uint64(len(m.GetPendingRequestMock.expectationSeries)) {
-			m.t.Fatalf("Unexpected call to ArtifactManagerMock.GetPendingRequest. %v %v", p, p1)
+	if len(m.GetRecordProofMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetRecordProofMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ArtifactManagerMock.GetRecordProof. %v %v %v %v", p, p1, p2, p3)
 			return
 		}
 
-		input := m.GetPendingRequestMock.expectationSeries[counter-1].input
-		testify_assert.Equal(m.t, *input, ArtifactManagerMockGetPendingRequestInput{p, p1}, "ArtifactManager.GetPendingRequest got unexpected parameters")
+		input := m.GetRecordProofMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ArtifactManagerMockGetRecordProofInput{p, p1, p2, p3}, "ArtifactManager.GetRecordProof got unexpected parameters")
 
-		result := m.GetPendingRequestMock.expectationSeries[counter-1].result
+		result := m.GetRecordProofMock.expectationSeries[counter-1].result
 		if result == nil {
-			m.t.Fatal("No results are set for the ArtifactManagerMock.GetPendingRequest")
+			m.t.Fatal("No results are set for the ArtifactManagerMock.GetRecordProof")
 			return
 		}
 
@@ -1749,16 +2868,16 @@ func (m *ArtifactManagerMock) GetPendingRequest(p context.Context, p1 core.Recor
 		return
 	}
 
-	if m.GetPendingRequestMock.mainExpectation != nil {
+	if m.GetRecordProofMock.mainExpectation != nil {
 
-		input := m.GetPendingRequestMock.mainExpectation.input
+		input := m.GetRecordProofMock.mainExpectation.input
 		if input != nil {
-			testify_assert.Equal(m.t, *input, ArtifactManagerMockGetPendingRequestInput{p, p1}, "ArtifactManager.GetPendingRequest got unexpected parameters")
+			testify_assert.Equal(m.t, *input, ArtifactManagerMockGetRecordProofInput{p, p1, p2, p3}, "ArtifactManager.GetRecordProof got unexpected parameters")
 		}
 
-		result := m.GetPendingRequestMock.mainExpectation.result
+		result := m.GetRecordProofMock.mainExpectation.result
 		if result == nil {
-			m.t.Fatal("No results are set for the ArtifactManagerMock.GetPendingRequest")
+			m.t.Fatal("No results are set for the ArtifactManagerMock.GetRecordProof")
 		}
 
 		r = result.r
@@ -1767,39 +2886,39 @@ func (m *ArtifactManagerMock) GetPendingRequest(p context.Context, p1 core.Recor
 		return
 	}
 
-	if m.GetPendingRequestFunc == nil {
-		m.t.Fatalf("Unexpected call to ArtifactManagerMock.GetPendingRequest. %v %v", p, p1)
+	if m.GetRecordProofFunc == nil {
+		m.t.Fatalf("Unexpected call to ArtifactManagerMock.GetRecordProof. %v %v %v %v", p, p1, p2, p3)
 		return
 	}
 
-	return m.GetPendingRequestFunc(p, p1)
+	return m.GetRecordProofFunc(p, p1, p2, p3)
 }
 
-//GetPendingRequestMinimockCounter returns a count of ArtifactManagerMock.GetPendingRequestFunc invocations
-func (m *ArtifactManagerMock) GetPendingRequestMinimockCounter() uint64 {
-	return atomic.LoadUint64(&m.GetPendingRequestCounter)
+//GetRecordProofMinimockCounter returns a count of ArtifactManagerMock.GetRecordProofFunc invocations
+func (m *ArtifactManagerMock) GetRecordProofMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetRecordProofCounter)
 }
 
-//GetPendingRequestMinimockPreCounter returns the value of ArtifactManagerMock.GetPendingRequest invocations
-func (m *ArtifactManagerMock) GetPendingRequestMinimockPreCounter() uint64 {
-	return atomic.LoadUint64(&m.GetPendingRequestPreCounter)
+//GetRecordProofMinimockPreCounter returns the value of ArtifactManagerMock.GetRecordProof invocations
+func (m *ArtifactManagerMock) GetRecordProofMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetRecordProofPreCounter)
 }
 
-//GetPendingRequestFinished returns true if mock invocations count is ok
-func (m *ArtifactManagerMock) GetPendingRequestFinished() bool {
+//GetRecordProofFinished returns true if mock invocations count is ok
+func (m *ArtifactManagerMock) GetRecordProofFinished() bool {
 	// if expectation series were set then invocations count should be equal to expectations count
-	if len(m.GetPendingRequestMock.expectationSeries) > 0 {
-		return atomic.LoadUint64(&m.GetPendingRequestCounter) == uint64(len(m.GetPendingRequestMock.expectationSeries))
+	if len(m.GetRecordProofMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetRecordProofCounter) == uint64(len(m.GetRecordProofMock.expectationSeries))
 	}
 
 	// if main expectation was set then invocations count should be greater than zero
-	if m.GetPendingRequestMock.mainExpectation != nil {
-		return atomic.LoadUint64(&m.GetPendingRequestCounter) > 0
+	if m.GetRecordProofMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetRecordProofCounter) > 0
 	}
 
 	// if func was set then invocations count should be greater than zero
-	if m.GetPendingRequestFunc != nil {
-		return atomic.LoadUint64(&m.GetPendingRequestCounter) > 0
+	if m.GetRecordProofFunc != nil {
+		return atomic.LoadUint64(&m.GetRecordProofCounter) > 0
 	}
 
 	return true
@@ -1956,6 +3075,160 @@ func (m *ArtifactManagerMock) HasPendingRequestsFinished() bool {
 	return true
 }
 
+type mArtifactManagerMockRegisterEvent struct {
+	mock              *ArtifactManagerMock
+	mainExpectation   *ArtifactManagerMockRegisterEventExpectation
+	expectationSeries []*ArtifactManagerMockRegisterEventExpectation
+}
+
+type ArtifactManagerMockRegisterEventExpectation struct {
+	input  *ArtifactManagerMockRegisterEventInput
+	result *ArtifactManagerMockRegisterEventResult
+}
+
+type ArtifactManagerMockRegisterEventInput struct {
+	p  context.Context
+	p1 core.RecordRef
+	p2 core.RecordRef
+	p3 string
+	p4 []byte
+}
+
+type ArtifactManagerMockRegisterEventResult struct {
+	r  *core.RecordID
+	r1 error
+}
+
+//Expect specifies that invocation of ArtifactManager.RegisterEvent is expected from 1 to Infinity times
+func (m *mArtifactManagerMockRegisterEvent) Expect(p context.Context, p1 core.RecordRef, p2 core.RecordRef, p3 string, p4 []byte) *mArtifactManagerMockRegisterEvent {
+	m.mock.RegisterEventFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArtifactManagerMockRegisterEventExpectation{}
+	}
+	m.mainExpectation.input = &ArtifactManagerMockRegisterEventInput{p, p1, p2, p3, p4}
+	return m
+}
+
+//Return specifies results of invocation of ArtifactManager.RegisterEvent
+func (m *mArtifactManagerMockRegisterEvent) Return(r *core.RecordID, r1 error) *ArtifactManagerMock {
+	m.mock.RegisterEventFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ArtifactManagerMockRegisterEventExpectation{}
+	}
+	m.mainExpectation.result = &ArtifactManagerMockRegisterEventResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ArtifactManager.RegisterEvent is expected once
+func (m *mArtifactManagerMockRegisterEvent) ExpectOnce(p context.Context, p1 core.RecordRef, p2 core.RecordRef, p3 string, p4 []byte) *ArtifactManagerMockRegisterEventExpectation {
+	m.mock.RegisterEventFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ArtifactManagerMockRegisterEventExpectation{}
+	expectation.input = &ArtifactManagerMockRegisterEventInput{p, p1, p2, p3, p4}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ArtifactManagerMockRegisterEventExpectation) Return(r *core.RecordID, r1 error) {
+	e.result = &ArtifactManagerMockRegisterEventResult{r, r1}
+}
+
+//Set uses given function f as a mock of ArtifactManager.RegisterEvent method
+func (m *mArtifactManagerMockRegisterEvent) Set(f func(p context.Context, p1 core.RecordRef, p2 core.RecordRef, p3 string, p4 []byte) (r *core.RecordID, r1 error)) *ArtifactManagerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.RegisterEventFunc = f
+	return m.mock
+}
+
+//RegisterEvent implements github.com/insolar/insolar/core.ArtifactManager interface
+func (m *ArtifactManagerMock) RegisterEvent(p context.Context, p1 core.RecordRef, p2 core.RecordRef, p3 string, p4 []byte) (r *core.RecordID, r1 error) {
+	counter := atomic.AddUint64(&m.RegisterEventPreCounter, 1)
+	defer atomic.AddUint64(&m.RegisterEventCounter, 1)
+
+	if len(m.RegisterEventMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.RegisterEventMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ArtifactManagerMock.RegisterEvent. %v %v %v %v %v", p, p1, p2, p3, p4)
+			return
+		}
+
+		input := m.RegisterEventMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ArtifactManagerMockRegisterEventInput{p, p1, p2, p3, p4}, "ArtifactManager.RegisterEvent got unexpected parameters")
+
+		result := m.RegisterEventMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArtifactManagerMock.RegisterEvent")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.RegisterEventMock.mainExpectation != nil {
+
+		input := m.RegisterEventMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ArtifactManagerMockRegisterEventInput{p, p1, p2, p3, p4}, "ArtifactManager.RegisterEvent got unexpected parameters")
+		}
+
+		result := m.RegisterEventMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ArtifactManagerMock.RegisterEvent")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.RegisterEventFunc == nil {
+		m.t.Fatalf("Unexpected call to ArtifactManagerMock.RegisterEvent. %v %v %v %v %v", p, p1, p2, p3, p4)
+		return
+	}
+
+	return m.RegisterEventFunc(p, p1, p2, p3, p4)
+}
+
+//RegisterEventMinimockCounter returns a count of ArtifactManagerMock.RegisterEventFunc invocations
+func (m *ArtifactManagerMock) RegisterEventMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.RegisterEventCounter)
+}
+
+//RegisterEventMinimockPreCounter returns the value of ArtifactManagerMock.RegisterEvent invocations
+func (m *ArtifactManagerMock) RegisterEventMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.RegisterEventPreCounter)
+}
+
+//RegisterEventFinished returns true if mock invocations count is ok
+func (m *ArtifactManagerMock) RegisterEventFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.RegisterEventMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.RegisterEventCounter) == uint64(len(m.RegisterEventMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.RegisterEventMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.RegisterEventCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.RegisterEventFunc != nil {
+		return atomic.LoadUint64(&m.RegisterEventCounter) > 0
+	}
+
+	return true
+}
+
 type mArtifactManagerMockRegisterRequest struct {
 	mock              *ArtifactManagerMock
 	mainExpectation   *ArtifactManagerMockRegisterRequestExpectation
@@ -2882,6 +4155,14 @@ func (m *ArtifactManagerMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ArtifactManagerMock.DeployCode")
 	}
 
+	if !m.FindObjectsByPrototypeFinished() {
+		m.t.Fatal("Expected call to ArtifactManagerMock.FindObjectsByPrototype")
+	}
+
+	if !m.FindRequestsByCallerFinished() {
+		m.t.Fatal("Expected call to ArtifactManagerMock.FindRequestsByCaller")
+	}
+
 	if !m.GenesisRefFinished() {
 		m.t.Fatal("Expected call to ArtifactManagerMock.GenesisRef")
 	}
@@ -2898,18 +4179,42 @@ func (m *ArtifactManagerMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ArtifactManagerMock.GetDelegate")
 	}
 
+	if !m.GetEventsFinished() {
+		m.t.Fatal("Expected call to ArtifactManagerMock.GetEvents")
+	}
+
 	if !m.GetObjectFinished() {
 		m.t.Fatal("Expected call to ArtifactManagerMock.GetObject")
 	}
 
+	if !m.GetObjectAtPulseFinished() {
+		m.t.Fatal("Expected call to ArtifactManagerMock.GetObjectAtPulse")
+	}
+
+	if !m.GetObjectHistoryFinished() {
+		m.t.Fatal("Expected call to ArtifactManagerMock.GetObjectHistory")
+	}
+
 	if !m.GetPendingRequestFinished() {
 		m.t.Fatal("Expected call to ArtifactManagerMock.GetPendingRequest")
 	}
 
+	if !m.GetReceiptFinished() {
+		m.t.Fatal("Expected call to ArtifactManagerMock.GetReceipt")
+	}
+
+	if !m.GetRecordProofFinished() {
+		m.t.Fatal("Expected call to ArtifactManagerMock.GetRecordProof")
+	}
+
 	if !m.HasPendingRequestsFinished() {
 		m.t.Fatal("Expected call to ArtifactManagerMock.HasPendingRequests")
 	}
 
+	if !m.RegisterEventFinished() {
+		m.t.Fatal("Expected call to ArtifactManagerMock.RegisterEvent")
+	}
+
 	if !m.RegisterRequestFinished() {
 		m.t.Fatal("Expected call to ArtifactManagerMock.RegisterRequest")
 	}
@@ -2971,6 +4276,14 @@ func (m *ArtifactManagerMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ArtifactManagerMock.DeployCode")
 	}
 
+	if !m.FindObjectsByPrototypeFinished() {
+		m.t.Fatal("Expected call to ArtifactManagerMock.FindObjectsByPrototype")
+	}
+
+	if !m.FindRequestsByCallerFinished() {
+		m.t.Fatal("Expected call to ArtifactManagerMock.FindRequestsByCaller")
+	}
+
 	if !m.GenesisRefFinished() {
 		m.t.Fatal("Expected call to ArtifactManagerMock.GenesisRef")
 	}
@@ -2987,18 +4300,42 @@ func (m *ArtifactManagerMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ArtifactManagerMock.GetDelegate")
 	}
 
+	if !m.GetEventsFinished() {
+		m.t.Fatal("Expected call to ArtifactManagerMock.GetEvents")
+	}
+
 	if !m.GetObjectFinished() {
 		m.t.Fatal("Expected call to ArtifactManagerMock.GetObject")
 	}
 
+	if !m.GetObjectAtPulseFinished() {
+		m.t.Fatal("Expected call to ArtifactManagerMock.GetObjectAtPulse")
+	}
+
+	if !m.GetObjectHistoryFinished() {
+		m.t.Fatal("Expected call to ArtifactManagerMock.GetObjectHistory")
+	}
+
 	if !m.GetPendingRequestFinished() {
 		m.t.Fatal("Expected call to ArtifactManagerMock.GetPendingRequest")
 	}
 
+	if !m.GetReceiptFinished() {
+		m.t.Fatal("Expected call to ArtifactManagerMock.GetReceipt")
+	}
+
+	if !m.GetRecordProofFinished() {
+		m.t.Fatal("Expected call to ArtifactManagerMock.GetRecordProof")
+	}
+
 	if !m.HasPendingRequestsFinished() {
 		m.t.Fatal("Expected call to ArtifactManagerMock.HasPendingRequests")
 	}
 
+	if !m.RegisterEventFinished() {
+		m.t.Fatal("Expected call to ArtifactManagerMock.RegisterEvent")
+	}
+
 	if !m.RegisterRequestFinished() {
 		m.t.Fatal("Expected call to ArtifactManagerMock.RegisterRequest")
 	}
@@ -3042,13 +4379,21 @@ func (m *ArtifactManagerMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.DeactivateObjectFinished()
 		ok = ok && m.DeclareTypeFinished()
 		ok = ok && m.DeployCodeFinished()
+		ok = ok && m.FindObjectsByPrototypeFinished()
+		ok = ok && m.FindRequestsByCallerFinished()
 		ok = ok && m.GenesisRefFinished()
 		ok = ok && m.GetChildrenFinished()
 		ok = ok && m.GetCodeFinished()
 		ok = ok && m.GetDelegateFinished()
+		ok = ok && m.GetEventsFinished()
 		ok = ok && m.GetObjectFinished()
+		ok = ok && m.GetObjectAtPulseFinished()
+		ok = ok && m.GetObjectHistoryFinished()
 		ok = ok && m.GetPendingRequestFinished()
+		ok = ok && m.GetReceiptFinished()
+		ok = ok && m.GetRecordProofFinished()
 		ok = ok && m.HasPendingRequestsFinished()
+		ok = ok && m.RegisterEventFinished()
 		ok = ok && m.RegisterRequestFinished()
 		ok = ok && m.RegisterResultFinished()
 		ok = ok && m.RegisterValidationFinished()
@@ -3083,6 +4428,14 @@ func (m *ArtifactManagerMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ArtifactManagerMock.DeployCode")
 			}
 
+			if !m.FindObjectsByPrototypeFinished() {
+				m.t.Error("Expected call to ArtifactManagerMock.FindObjectsByPrototype")
+			}
+
+			if !m.FindRequestsByCallerFinished() {
+				m.t.Error("Expected call to ArtifactManagerMock.FindRequestsByCaller")
+			}
+
 			if !m.GenesisRefFinished() {
 				m.t.Error("Expected call to ArtifactManagerMock.GenesisRef")
 			}
@@ -3099,18 +4452,42 @@ func (m *ArtifactManagerMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ArtifactManagerMock.GetDelegate")
 			}
 
+			if !m.GetEventsFinished() {
+				m.t.Error("Expected call to ArtifactManagerMock.GetEvents")
+			}
+
 			if !m.GetObjectFinished() {
 				m.t.Error("Expected call to ArtifactManagerMock.GetObject")
 			}
 
+			if !m.GetObjectAtPulseFinished() {
+				m.t.Error("Expected call to ArtifactManagerMock.GetObjectAtPulse")
+			}
+
+			if !m.GetObjectHistoryFinished() {
+				m.t.Error("Expected call to ArtifactManagerMock.GetObjectHistory")
+			}
+
 			if !m.GetPendingRequestFinished() {
 				m.t.Error("Expected call to ArtifactManagerMock.GetPendingRequest")
 			}
 
+			if !m.GetReceiptFinished() {
+				m.t.Error("Expected call to ArtifactManagerMock.GetReceipt")
+			}
+
+			if !m.GetRecordProofFinished() {
+				m.t.Error("Expected call to ArtifactManagerMock.GetRecordProof")
+			}
+
 			if !m.HasPendingRequestsFinished() {
 				m.t.Error("Expected call to ArtifactManagerMock.HasPendingRequests")
 			}
 
+			if !m.RegisterEventFinished() {
+				m.t.Error("Expected call to ArtifactManagerMock.RegisterEvent")
+			}
+
 			if !m.RegisterRequestFinished() {
 				m.t.Error("Expected call to ArtifactManagerMock.RegisterRequest")
 			}
@@ -3167,6 +4544,14 @@ func (m *ArtifactManagerMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.FindObjectsByPrototypeFinished() {
+		return false
+	}
+
+	if !m.FindRequestsByCallerFinished() {
+		return false
+	}
+
 	if !m.GenesisRefFinished() {
 		return false
 	}
@@ -3183,18 +4568,42 @@ func (m *ArtifactManagerMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.GetEventsFinished() {
+		return false
+	}
+
 	if !m.GetObjectFinished() {
 		return false
 	}
 
+	if !m.GetObjectAtPulseFinished() {
+		return false
+	}
+
+	if !m.GetObjectHistoryFinished() {
+		return false
+	}
+
 	if !m.GetPendingRequestFinished() {
 		return false
 	}
 
+	if !m.GetReceiptFinished() {
+		return false
+	}
+
+	if !m.GetRecordProofFinished() {
+		return false
+	}
+
 	if !m.HasPendingRequestsFinished() {
 		return false
 	}
 
+	if !m.RegisterEventFinished() {
+		return false
+	}
+
 	if !m.RegisterRequestFinished() {
 		return false
 	}
@@ -17,6 +17,11 @@ import (
 type CodeDescriptorMock struct {
 	t minimock.Tester
 
+	ABIFunc       func() (r []byte, r1 error)
+	ABICounter    uint64
+	ABIPreCounter uint64
+	ABIMock       mCodeDescriptorMockABI
+
 	CodeFunc       func() (r []byte, r1 error)
 	CodeCounter    uint64
 	CodePreCounter uint64
@@ -41,6 +46,7 @@ func NewCodeDescriptorMock(t minimock.Tester) *CodeDescriptorMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.ABIMock = mCodeDescriptorMockABI{mock: m}
 	m.CodeMock = mCodeDescriptorMockCode{mock: m}
 	m.MachineTypeMock = mCodeDescriptorMockMachineType{mock: m}
 	m.RefMock = mCodeDescriptorMockRef{mock: m}
@@ -48,6 +54,143 @@ func NewCodeDescriptorMock(t minimock.Tester) *CodeDescriptorMock {
 	return m
 }
 
+type mCodeDescriptorMockABI struct {
+	mock              *CodeDescriptorMock
+	mainExpectation   *CodeDescriptorMockABIExpectation
+	expectationSeries []*CodeDescriptorMockABIExpectation
+}
+
+type CodeDescriptorMockABIExpectation struct {
+	result *CodeDescriptorMockABIResult
+}
+
+type CodeDescriptorMockABIResult struct {
+	r  []byte
+	r1 error
+}
+
+//Expect specifies that invocation of CodeDescriptor.ABI is expected from 1 to Infinity times
+func (m *mCodeDescriptorMockABI) Expect() *mCodeDescriptorMockABI {
+	m.mock.ABIFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &CodeDescriptorMockABIExpectation{}
+	}
+
+	return m
+}
+
+//Return specifies results of invocation of CodeDescriptor.ABI
+func (m *mCodeDescriptorMockABI) Return(r []byte, r1 error) *CodeDescriptorMock {
+	m.mock.ABIFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &CodeDescriptorMockABIExpectation{}
+	}
+	m.mainExpectation.result = &CodeDescriptorMockABIResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of CodeDescriptor.ABI is expected once
+func (m *mCodeDescriptorMockABI) ExpectOnce() *CodeDescriptorMockABIExpectation {
+	m.mock.ABIFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &CodeDescriptorMockABIExpectation{}
+
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *CodeDescriptorMockABIExpectation) Return(r []byte, r1 error) {
+	e.result = &CodeDescriptorMockABIResult{r, r1}
+}
+
+//Set uses given function f as a mock of CodeDescriptor.ABI method
+func (m *mCodeDescriptorMockABI) Set(f func() (r []byte, r1 error)) *CodeDescriptorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ABIFunc = f
+	return m.mock
+}
+
+//ABI implements github.com/insolar/insolar/core.CodeDescriptor interface
+func (m *CodeDescriptorMock) ABI() (r []byte, r1 error) {
+	counter := atomic.AddUint64(&m.ABIPreCounter, 1)
+	defer atomic.AddUint64(&m.ABICounter, 1)
+
+	if len(m.ABIMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ABIMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to CodeDescriptorMock.ABI.")
+			return
+		}
+
+		result := m.ABIMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the CodeDescriptorMock.ABI")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ABIMock.mainExpectation != nil {
+
+		result := m.ABIMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the CodeDescriptorMock.ABI")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ABIFunc == nil {
+		m.t.Fatalf("Unexpected call to CodeDescriptorMock.ABI.")
+		return
+	}
+
+	return m.ABIFunc()
+}
+
+//ABIMinimockCounter returns a count of CodeDescriptorMock.ABIFunc invocations
+func (m *CodeDescriptorMock) ABIMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ABICounter)
+}
+
+//ABIMinimockPreCounter returns the value of CodeDescriptorMock.ABI invocations
+func (m *CodeDescriptorMock) ABIMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ABIPreCounter)
+}
+
+//ABIFinished returns true if mock invocations count is ok
+func (m *CodeDescriptorMock) ABIFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ABIMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ABICounter) == uint64(len(m.ABIMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ABIMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ABICounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ABIFunc != nil {
+		return atomic.LoadUint64(&m.ABICounter) > 0
+	}
+
+	return true
+}
+
 type mCodeDescriptorMockCode struct {
 	mock              *CodeDescriptorMock
 	mainExpectation   *CodeDescriptorMockCodeExpectation
@@ -457,6 +600,12 @@ func (m *CodeDescriptorMock) RefFinished() bool {
 //Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
 func (m *CodeDescriptorMock) ValidateCallCounters() {
 
+	if !m.ABIFinished() {
+		m.t.Fatal("Expected call to CodeDescriptorMock.ABI")
+
+	}
+
+
 	if !m.CodeFinished() {
 		m.t.Fatal("Expected call to CodeDescriptorMock.Code")
 	}
@@ -486,6 +635,12 @@ func (m *CodeDescriptorMock) Finish() {
 //MinimockFinish checks that all mocked methods of the interface have been called at least once
 func (m *CodeDescriptorMock) MinimockFinish() {
 
+	if !m.ABIFinished() {
+		m.t.Fatal("Expected call to CodeDescriptorMock.ABI")
+
+	}
+
+
 	if !m.CodeFinished() {
 		m.t.Fatal("Expected call to CodeDescriptorMock.Code")
 	}
@@ -512,6 +667,7 @@ func (m *CodeDescriptorMock) MinimockWait(timeout time.Duration) {
 	timeoutCh := time.After(timeout)
 	for {
 		ok := true
+		ok = ok && m.ABIFinished()
 		ok = ok && m.CodeFinished()
 		ok = ok && m.MachineTypeFinished()
 		ok = ok && m.RefFinished()
@@ -523,6 +679,12 @@ func (m *CodeDescriptorMock) MinimockWait(timeout time.Duration) {
 		select {
 		case <-timeoutCh:
 
+			if !m.ABIFinished() {
+				m.t.Error("Expected call to CodeDescriptorMock.ABI")
+
+			}
+
+
 			if !m.CodeFinished() {
 				m.t.Error("Expected call to CodeDescriptorMock.Code")
 			}
@@ -547,6 +709,12 @@ func (m *CodeDescriptorMock) MinimockWait(timeout time.Duration) {
 //it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
 func (m *CodeDescriptorMock) AllMocksCalled() bool {
 
+	if !m.ABIFinished() {
+		return false
+
+	}
+
+
 	if !m.CodeFinished() {
 		return false
 	}
@@ -29,11 +29,31 @@ type DelegationTokenFactoryMock struct {
 	IssueGetCodeRedirectPreCounter uint64
 	IssueGetCodeRedirectMock       mDelegationTokenFactoryMockIssueGetCodeRedirect
 
+	IssueGetEventsRedirectFunc       func(p *core.RecordRef, p1 core.Message) (r core.DelegationToken, r1 error)
+	IssueGetEventsRedirectCounter    uint64
+	IssueGetEventsRedirectPreCounter uint64
+	IssueGetEventsRedirectMock       mDelegationTokenFactoryMockIssueGetEventsRedirect
+
+	IssueGetObjectHistoryRedirectFunc       func(p *core.RecordRef, p1 core.Message) (r core.DelegationToken, r1 error)
+	IssueGetObjectHistoryRedirectCounter    uint64
+	IssueGetObjectHistoryRedirectPreCounter uint64
+	IssueGetObjectHistoryRedirectMock       mDelegationTokenFactoryMockIssueGetObjectHistoryRedirect
+
 	IssueGetObjectRedirectFunc       func(p *core.RecordRef, p1 core.Message) (r core.DelegationToken, r1 error)
 	IssueGetObjectRedirectCounter    uint64
 	IssueGetObjectRedirectPreCounter uint64
 	IssueGetObjectRedirectMock       mDelegationTokenFactoryMockIssueGetObjectRedirect
 
+	IssueGetReceiptRedirectFunc       func(p *core.RecordRef, p1 core.Message) (r core.DelegationToken, r1 error)
+	IssueGetReceiptRedirectCounter    uint64
+	IssueGetReceiptRedirectPreCounter uint64
+	IssueGetReceiptRedirectMock       mDelegationTokenFactoryMockIssueGetReceiptRedirect
+
+	IssueGetRecordProofRedirectFunc       func(p *core.RecordRef, p1 core.Message) (r core.DelegationToken, r1 error)
+	IssueGetRecordProofRedirectCounter    uint64
+	IssueGetRecordProofRedirectPreCounter uint64
+	IssueGetRecordProofRedirectMock       mDelegationTokenFactoryMockIssueGetRecordProofRedirect
+
 	IssuePendingExecutionFunc       func(p core.Message, p1 core.PulseNumber) (r core.DelegationToken, r1 error)
 	IssuePendingExecutionCounter    uint64
 	IssuePendingExecutionPreCounter uint64
@@ -55,7 +75,11 @@ func NewDelegationTokenFactoryMock(t minimock.Tester) *DelegationTokenFactoryMoc
 
 	m.IssueGetChildrenRedirectMock = mDelegationTokenFactoryMockIssueGetChildrenRedirect{mock: m}
 	m.IssueGetCodeRedirectMock = mDelegationTokenFactoryMockIssueGetCodeRedirect{mock: m}
+	m.IssueGetEventsRedirectMock = mDelegationTokenFactoryMockIssueGetEventsRedirect{mock: m}
+	m.IssueGetObjectHistoryRedirectMock = mDelegationTokenFactoryMockIssueGetObjectHistoryRedirect{mock: m}
 	m.IssueGetObjectRedirectMock = mDelegationTokenFactoryMockIssueGetObjectRedirect{mock: m}
+	m.IssueGetReceiptRedirectMock = mDelegationTokenFactoryMockIssueGetReceiptRedirect{mock: m}
+	m.IssueGetRecordProofRedirectMock = mDelegationTokenFactoryMockIssueGetRecordProofRedirect{mock: m}
 	m.IssuePendingExecutionMock = mDelegationTokenFactoryMockIssuePendingExecution{mock: m}
 	m.VerifyMock = mDelegationTokenFactoryMockVerify{mock: m}
 
@@ -364,6 +388,308 @@ func (m *DelegationTokenFactoryMock) IssueGetCodeRedirectFinished() bool {
 	return true
 }
 
+type mDelegationTokenFactoryMockIssueGetEventsRedirect struct {
+	mock              *DelegationTokenFactoryMock
+	mainExpectation   *DelegationTokenFactoryMockIssueGetEventsRedirectExpectation
+	expectationSeries []*DelegationTokenFactoryMockIssueGetEventsRedirectExpectation
+}
+
+type DelegationTokenFactoryMockIssueGetEventsRedirectExpectation struct {
+	input  *DelegationTokenFactoryMockIssueGetEventsRedirectInput
+	result *DelegationTokenFactoryMockIssueGetEventsRedirectResult
+}
+
+type DelegationTokenFactoryMockIssueGetEventsRedirectInput struct {
+	p  *core.RecordRef
+	p1 core.Message
+}
+
+type DelegationTokenFactoryMockIssueGetEventsRedirectResult struct {
+	r  core.DelegationToken
+	r1 error
+}
+
+//Expect specifies that invocation of DelegationTokenFactory.IssueGetEventsRedirect is expected from 1 to Infinity times
+func (m *mDelegationTokenFactoryMockIssueGetEventsRedirect) Expect(p *core.RecordRef, p1 core.Message) *mDelegationTokenFactoryMockIssueGetEventsRedirect {
+	m.mock.IssueGetEventsRedirectFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DelegationTokenFactoryMockIssueGetEventsRedirectExpectation{}
+	}
+	m.mainExpectation.input = &DelegationTokenFactoryMockIssueGetEventsRedirectInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of DelegationTokenFactory.IssueGetEventsRedirect
+func (m *mDelegationTokenFactoryMockIssueGetEventsRedirect) Return(r core.DelegationToken, r1 error) *DelegationTokenFactoryMock {
+	m.mock.IssueGetEventsRedirectFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DelegationTokenFactoryMockIssueGetEventsRedirectExpectation{}
+	}
+	m.mainExpectation.result = &DelegationTokenFactoryMockIssueGetEventsRedirectResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of DelegationTokenFactory.IssueGetEventsRedirect is expected once
+func (m *mDelegationTokenFactoryMockIssueGetEventsRedirect) ExpectOnce(p *core.RecordRef, p1 core.Message) *DelegationTokenFactoryMockIssueGetEventsRedirectExpectation {
+	m.mock.IssueGetEventsRedirectFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &DelegationTokenFactoryMockIssueGetEventsRedirectExpectation{}
+	expectation.input = &DelegationTokenFactoryMockIssueGetEventsRedirectInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *DelegationTokenFactoryMockIssueGetEventsRedirectExpectation) Return(r core.DelegationToken, r1 error) {
+	e.result = &DelegationTokenFactoryMockIssueGetEventsRedirectResult{r, r1}
+}
+
+//Set uses given function f as a mock of DelegationTokenFactory.IssueGetEventsRedirect method
+func (m *mDelegationTokenFactoryMockIssueGetEventsRedirect) Set(f func(p *core.RecordRef, p1 core.Message) (r core.DelegationToken, r1 error)) *DelegationTokenFactoryMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.IssueGetEventsRedirectFunc = f
+	return m.mock
+}
+
+//IssueGetEventsRedirect implements github.com/insolar/insolar/core.DelegationTokenFactory interface
+func (m *DelegationTokenFactoryMock) IssueGetEventsRedirect(p *core.RecordRef, p1 core.Message) (r core.DelegationToken, r1 error) {
+	counter := atomic.AddUint64(&m.IssueGetEventsRedirectPreCounter, 1)
+	defer atomic.AddUint64(&m.IssueGetEventsRedirectCounter, 1)
+
+	if len(m.IssueGetEventsRedirectMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.IssueGetEventsRedirectMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DelegationTokenFactoryMock.IssueGetEventsRedirect. %v %v", p, p1)
+			return
+		}
+
+		input := m.IssueGetEventsRedirectMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, DelegationTokenFactoryMockIssueGetEventsRedirectInput{p, p1}, "DelegationTokenFactory.IssueGetEventsRedirect got unexpected parameters")
+
+		result := m.IssueGetEventsRedirectMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the DelegationTokenFactoryMock.IssueGetEventsRedirect")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.IssueGetEventsRedirectMock.mainExpectation != nil {
+
+		input := m.IssueGetEventsRedirectMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, DelegationTokenFactoryMockIssueGetEventsRedirectInput{p, p1}, "DelegationTokenFactory.IssueGetEventsRedirect got unexpected parameters")
+		}
+
+		result := m.IssueGetEventsRedirectMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the DelegationTokenFactoryMock.IssueGetEventsRedirect")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.IssueGetEventsRedirectFunc == nil {
+		m.t.Fatalf("Unexpected call to DelegationTokenFactoryMock.IssueGetEventsRedirect. %v %v", p, p1)
+		return
+	}
+
+	return m.IssueGetEventsRedirectFunc(p, p1)
+}
+
+//IssueGetEventsRedirectMinimockCounter returns a count of DelegationTokenFactoryMock.IssueGetEventsRedirectFunc invocations
+func (m *DelegationTokenFactoryMock) IssueGetEventsRedirectMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.IssueGetEventsRedirectCounter)
+}
+
+//IssueGetEventsRedirectMinimockPreCounter returns the value of DelegationTokenFactoryMock.IssueGetEventsRedirect invocations
+func (m *DelegationTokenFactoryMock) IssueGetEventsRedirectMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.IssueGetEventsRedirectPreCounter)
+}
+
+//IssueGetEventsRedirectFinished returns true if mock invocations count is ok
+func (m *DelegationTokenFactoryMock) IssueGetEventsRedirectFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.IssueGetEventsRedirectMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.IssueGetEventsRedirectCounter) == uint64(len(m.IssueGetEventsRedirectMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.IssueGetEventsRedirectMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.IssueGetEventsRedirectCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.IssueGetEventsRedirectFunc != nil {
+		return atomic.LoadUint64(&m.IssueGetEventsRedirectCounter) > 0
+	}
+
+	return true
+}
+
+type mDelegationTokenFactoryMockIssueGetObjectHistoryRedirect struct {
+	mock              *DelegationTokenFactoryMock
+	mainExpectation   *DelegationTokenFactoryMockIssueGetObjectHistoryRedirectExpectation
+	expectationSeries []*DelegationTokenFactoryMockIssueGetObjectHistoryRedirectExpectation
+}
+
+type DelegationTokenFactoryMockIssueGetObjectHistoryRedirectExpectation struct {
+	input  *DelegationTokenFactoryMockIssueGetObjectHistoryRedirectInput
+	result *DelegationTokenFactoryMockIssueGetObjectHistoryRedirectResult
+}
+
+type DelegationTokenFactoryMockIssueGetObjectHistoryRedirectInput struct {
+	p  *core.RecordRef
+	p1 core.Message
+}
+
+type DelegationTokenFactoryMockIssueGetObjectHistoryRedirectResult struct {
+	r  core.DelegationToken
+	r1 error
+}
+
+//Expect specifies that invocation of DelegationTokenFactory.IssueGetObjectHistoryRedirect is expected from 1 to Infinity times
+func (m *mDelegationTokenFactoryMockIssueGetObjectHistoryRedirect) Expect(p *core.RecordRef, p1 core.Message) *mDelegationTokenFactoryMockIssueGetObjectHistoryRedirect {
+	m.mock.IssueGetObjectHistoryRedirectFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DelegationTokenFactoryMockIssueGetObjectHistoryRedirectExpectation{}
+	}
+	m.mainExpectation.input = &DelegationTokenFactoryMockIssueGetObjectHistoryRedirectInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of DelegationTokenFactory.IssueGetObjectHistoryRedirect
+func (m *mDelegationTokenFactoryMockIssueGetObjectHistoryRedirect) Return(r core.DelegationToken, r1 error) *DelegationTokenFactoryMock {
+	m.mock.IssueGetObjectHistoryRedirectFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DelegationTokenFactoryMockIssueGetObjectHistoryRedirectExpectation{}
+	}
+	m.mainExpectation.result = &DelegationTokenFactoryMockIssueGetObjectHistoryRedirectResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of DelegationTokenFactory.IssueGetObjectHistoryRedirect is expected once
+func (m *mDelegationTokenFactoryMockIssueGetObjectHistoryRedirect) ExpectOnce(p *core.RecordRef, p1 core.Message) *DelegationTokenFactoryMockIssueGetObjectHistoryRedirectExpectation {
+	m.mock.IssueGetObjectHistoryRedirectFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &DelegationTokenFactoryMockIssueGetObjectHistoryRedirectExpectation{}
+	expectation.input = &DelegationTokenFactoryMockIssueGetObjectHistoryRedirectInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *DelegationTokenFactoryMockIssueGetObjectHistoryRedirectExpectation) Return(r core.DelegationToken, r1 error) {
+	e.result = &DelegationTokenFactoryMockIssueGetObjectHistoryRedirectResult{r, r1}
+}
+
+//Set uses given function f as a mock of DelegationTokenFactory.IssueGetObjectHistoryRedirect method
+func (m *mDelegationTokenFactoryMockIssueGetObjectHistoryRedirect) Set(f func(p *core.RecordRef, p1 core.Message) (r core.DelegationToken, r1 error)) *DelegationTokenFactoryMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.IssueGetObjectHistoryRedirectFunc = f
+	return m.mock
+}
+
+//IssueGetObjectHistoryRedirect implements github.com/insolar/insolar/core.DelegationTokenFactory interface
+func (m *DelegationTokenFactoryMock) IssueGetObjectHistoryRedirect(p *core.RecordRef, p1 core.Message) (r core.DelegationToken, r1 error) {
+	counter := atomic.AddUint64(&m.IssueGetObjectHistoryRedirectPreCounter, 1)
+	defer atomic.AddUint64(&m.IssueGetObjectHistoryRedirectCounter, 1)
+
+	if len(m.IssueGetObjectHistoryRedirectMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.IssueGetObjectHistoryRedirectMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DelegationTokenFactoryMock.IssueGetObjectHistoryRedirect. %v %v", p, p1)
+			return
+		}
+
+		input := m.IssueGetObjectHistoryRedirectMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, DelegationTokenFactoryMockIssueGetObjectHistoryRedirectInput{p, p1}, "DelegationTokenFactory.IssueGetObjectHistoryRedirect got unexpected parameters")
+
+		result := m.IssueGetObjectHistoryRedirectMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the DelegationTokenFactoryMock.IssueGetObjectHistoryRedirect")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.IssueGetObjectHistoryRedirectMock.mainExpectation != nil {
+
+		input := m.IssueGetObjectHistoryRedirectMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, DelegationTokenFactoryMockIssueGetObjectHistoryRedirectInput{p, p1}, "DelegationTokenFactory.IssueGetObjectHistoryRedirect got unexpected parameters")
+		}
+
+		result := m.IssueGetObjectHistoryRedirectMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the DelegationTokenFactoryMock.IssueGetObjectHistoryRedirect")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.IssueGetObjectHistoryRedirectFunc == nil {
+		m.t.Fatalf("Unexpected call to DelegationTokenFactoryMock.IssueGetObjectHistoryRedirect. %v %v", p, p1)
+		return
+	}
+
+	return m.IssueGetObjectHistoryRedirectFunc(p, p1)
+}
+
+//IssueGetObjectHistoryRedirectMinimockCounter returns a count of DelegationTokenFactoryMock.IssueGetObjectHistoryRedirectFunc invocations
+func (m *DelegationTokenFactoryMock) IssueGetObjectHistoryRedirectMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.IssueGetObjectHistoryRedirectCounter)
+}
+
+//IssueGetObjectHistoryRedirectMinimockPreCounter returns the value of DelegationTokenFactoryMock.IssueGetObjectHistoryRedirect invocations
+func (m *DelegationTokenFactoryMock) IssueGetObjectHistoryRedirectMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.IssueGetObjectHistoryRedirectPreCounter)
+}
+
+//IssueGetObjectHistoryRedirectFinished returns true if mock invocations count is ok
+func (m *DelegationTokenFactoryMock) IssueGetObjectHistoryRedirectFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.IssueGetObjectHistoryRedirectMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.IssueGetObjectHistoryRedirectCounter) == uint64(len(m.IssueGetObjectHistoryRedirectMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.IssueGetObjectHistoryRedirectMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.IssueGetObjectHistoryRedirectCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.IssueGetObjectHistoryRedirectFunc != nil {
+		return atomic.LoadUint64(&m.IssueGetObjectHistoryRedirectCounter) > 0
+	}
+
+	return true
+}
+
 type mDelegationTokenFactoryMockIssueGetObjectRedirect struct {
 	mock              *DelegationTokenFactoryMock
 	mainExpectation   *DelegationTokenFactoryMockIssueGetObjectRedirectExpectation
@@ -515,6 +841,308 @@ func (m *DelegationTokenFactoryMock) IssueGetObjectRedirectFinished() bool {
 	return true
 }
 
+type mDelegationTokenFactoryMockIssueGetReceiptRedirect struct {
+	mock              *DelegationTokenFactoryMock
+	mainExpectation   *DelegationTokenFactoryMockIssueGetReceiptRedirectExpectation
+	expectationSeries []*DelegationTokenFactoryMockIssueGetReceiptRedirectExpectation
+}
+
+type DelegationTokenFactoryMockIssueGetReceiptRedirectExpectation struct {
+	input  *DelegationTokenFactoryMockIssueGetReceiptRedirectInput
+	result *DelegationTokenFactoryMockIssueGetReceiptRedirectResult
+}
+
+type DelegationTokenFactoryMockIssueGetReceiptRedirectInput struct {
+	p  *core.RecordRef
+	p1 core.Message
+}
+
+type DelegationTokenFactoryMockIssueGetReceiptRedirectResult struct {
+	r  core.DelegationToken
+	r1 error
+}
+
+//Expect specifies that invocation of DelegationTokenFactory.IssueGetReceiptRedirect is expected from 1 to Infinity times
+func (m *mDelegationTokenFactoryMockIssueGetReceiptRedirect) Expect(p *core.RecordRef, p1 core.Message) *mDelegationTokenFactoryMockIssueGetReceiptRedirect {
+	m.mock.IssueGetReceiptRedirectFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DelegationTokenFactoryMockIssueGetReceiptRedirectExpectation{}
+	}
+	m.mainExpectation.input = &DelegationTokenFactoryMockIssueGetReceiptRedirectInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of DelegationTokenFactory.IssueGetReceiptRedirect
+func (m *mDelegationTokenFactoryMockIssueGetReceiptRedirect) Return(r core.DelegationToken, r1 error) *DelegationTokenFactoryMock {
+	m.mock.IssueGetReceiptRedirectFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DelegationTokenFactoryMockIssueGetReceiptRedirectExpectation{}
+	}
+	m.mainExpectation.result = &DelegationTokenFactoryMockIssueGetReceiptRedirectResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of DelegationTokenFactory.IssueGetReceiptRedirect is expected once
+func (m *mDelegationTokenFactoryMockIssueGetReceiptRedirect) ExpectOnce(p *core.RecordRef, p1 core.Message) *DelegationTokenFactoryMockIssueGetReceiptRedirectExpectation {
+	m.mock.IssueGetReceiptRedirectFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &DelegationTokenFactoryMockIssueGetReceiptRedirectExpectation{}
+	expectation.input = &DelegationTokenFactoryMockIssueGetReceiptRedirectInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *DelegationTokenFactoryMockIssueGetReceiptRedirectExpectation) Return(r core.DelegationToken, r1 error) {
+	e.result = &DelegationTokenFactoryMockIssueGetReceiptRedirectResult{r, r1}
+}
+
+//Set uses given function f as a mock of DelegationTokenFactory.IssueGetReceiptRedirect method
+func (m *mDelegationTokenFactoryMockIssueGetReceiptRedirect) Set(f func(p *core.RecordRef, p1 core.Message) (r core.DelegationToken, r1 error)) *DelegationTokenFactoryMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.IssueGetReceiptRedirectFunc = f
+	return m.mock
+}
+
+//IssueGetReceiptRedirect implements github.com/insolar/insolar/core.DelegationTokenFactory interface
+func (m *DelegationTokenFactoryMock) IssueGetReceiptRedirect(p *core.RecordRef, p1 core.Message) (r core.DelegationToken, r1 error) {
+	counter := atomic.AddUint64(&m.IssueGetReceiptRedirectPreCounter, 1)
+	defer atomic.AddUint64(&m.IssueGetReceiptRedirectCounter, 1)
+
+	if len(m.IssueGetReceiptRedirectMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.IssueGetReceiptRedirectMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DelegationTokenFactoryMock.IssueGetReceiptRedirect. %v %v", p, p1)
+			return
+		}
+
+		input := m.IssueGetReceiptRedirectMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, DelegationTokenFactoryMockIssueGetReceiptRedirectInput{p, p1}, "DelegationTokenFactory.IssueGetReceiptRedirect got unexpected parameters")
+
+		result := m.IssueGetReceiptRedirectMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the DelegationTokenFactoryMock.IssueGetReceiptRedirect")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.IssueGetReceiptRedirectMock.mainExpectation != nil {
+
+		input := m.IssueGetReceiptRedirectMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, DelegationTokenFactoryMockIssueGetReceiptRedirectInput{p, p1}, "DelegationTokenFactory.IssueGetReceiptRedirect got unexpected parameters")
+		}
+
+		result := m.IssueGetReceiptRedirectMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the DelegationTokenFactoryMock.IssueGetReceiptRedirect")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.IssueGetReceiptRedirectFunc == nil {
+		m.t.Fatalf("Unexpected call to DelegationTokenFactoryMock.IssueGetReceiptRedirect. %v %v", p, p1)
+		return
+	}
+
+	return m.IssueGetReceiptRedirectFunc(p, p1)
+}
+
+//IssueGetReceiptRedirectMinimockCounter returns a count of DelegationTokenFactoryMock.IssueGetReceiptRedirectFunc invocations
+func (m *DelegationTokenFactoryMock) IssueGetReceiptRedirectMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.IssueGetReceiptRedirectCounter)
+}
+
+//IssueGetReceiptRedirectMinimockPreCounter returns the value of DelegationTokenFactoryMock.IssueGetReceiptRedirect invocations
+func (m *DelegationTokenFactoryMock) IssueGetReceiptRedirectMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.IssueGetReceiptRedirectPreCounter)
+}
+
+//IssueGetReceiptRedirectFinished returns true if mock invocations count is ok
+func (m *DelegationTokenFactoryMock) IssueGetReceiptRedirectFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.IssueGetReceiptRedirectMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.IssueGetReceiptRedirectCounter) == uint64(len(m.IssueGetReceiptRedirectMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.IssueGetReceiptRedirectMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.IssueGetReceiptRedirectCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.IssueGetReceiptRedirectFunc != nil {
+		return atomic.LoadUint64(&m.IssueGetReceiptRedirectCounter) > 0
+	}
+
+	return true
+}
+
+type mDelegationTokenFactoryMockIssueGetRecordProofRedirect struct {
+	mock              *DelegationTokenFactoryMock
+	mainExpectation   *DelegationTokenFactoryMockIssueGetRecordProofRedirectExpectation
+	expectationSeries []*DelegationTokenFactoryMockIssueGetRecordProofRedirectExpectation
+}
+
+type DelegationTokenFactoryMockIssueGetRecordProofRedirectExpectation struct {
+	input  *DelegationTokenFactoryMockIssueGetRecordProofRedirectInput
+	result *DelegationTokenFactoryMockIssueGetRecordProofRedirectResult
+}
+
+type DelegationTokenFactoryMockIssueGetRecordProofRedirectInput struct {
+	p  *core.RecordRef
+	p1 core.Message
+}
+
+type DelegationTokenFactoryMockIssueGetRecordProofRedirectResult struct {
+	r  core.DelegationToken
+	r1 error
+}
+
+//Expect specifies that invocation of DelegationTokenFactory.IssueGetRecordProofRedirect is expected from 1 to Infinity times
+func (m *mDelegationTokenFactoryMockIssueGetRecordProofRedirect) Expect(p *core.RecordRef, p1 core.Message) *mDelegationTokenFactoryMockIssueGetRecordProofRedirect {
+	m.mock.IssueGetRecordProofRedirectFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DelegationTokenFactoryMockIssueGetRecordProofRedirectExpectation{}
+	}
+	m.mainExpectation.input = &DelegationTokenFactoryMockIssueGetRecordProofRedirectInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of DelegationTokenFactory.IssueGetRecordProofRedirect
+func (m *mDelegationTokenFactoryMockIssueGetRecordProofRedirect) Return(r core.DelegationToken, r1 error) *DelegationTokenFactoryMock {
+	m.mock.IssueGetRecordProofRedirectFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DelegationTokenFactoryMockIssueGetRecordProofRedirectExpectation{}
+	}
+	m.mainExpectation.result = &DelegationTokenFactoryMockIssueGetRecordProofRedirectResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of DelegationTokenFactory.IssueGetRecordProofRedirect is expected once
+func (m *mDelegationTokenFactoryMockIssueGetRecordProofRedirect) ExpectOnce(p *core.RecordRef, p1 core.Message) *DelegationTokenFactoryMockIssueGetRecordProofRedirectExpectation {
+	m.mock.IssueGetRecordProofRedirectFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &DelegationTokenFactoryMockIssueGetRecordProofRedirectExpectation{}
+	expectation.input = &DelegationTokenFactoryMockIssueGetRecordProofRedirectInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *DelegationTokenFactoryMockIssueGetRecordProofRedirectExpectation) Return(r core.DelegationToken, r1 error) {
+	e.result = &DelegationTokenFactoryMockIssueGetRecordProofRedirectResult{r, r1}
+}
+
+//Set uses given function f as a mock of DelegationTokenFactory.IssueGetRecordProofRedirect method
+func (m *mDelegationTokenFactoryMockIssueGetRecordProofRedirect) Set(f func(p *core.RecordRef, p1 core.Message) (r core.DelegationToken, r1 error)) *DelegationTokenFactoryMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.IssueGetRecordProofRedirectFunc = f
+	return m.mock
+}
+
+//IssueGetRecordProofRedirect implements github.com/insolar/insolar/core.DelegationTokenFactory interface
+func (m *DelegationTokenFactoryMock) IssueGetRecordProofRedirect(p *core.RecordRef, p1 core.Message) (r core.DelegationToken, r1 error) {
+	counter := atomic.AddUint64(&m.IssueGetRecordProofRedirectPreCounter, 1)
+	defer atomic.AddUint64(&m.IssueGetRecordProofRedirectCounter, 1)
+
+	if len(m.IssueGetRecordProofRedirectMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.IssueGetRecordProofRedirectMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DelegationTokenFactoryMock.IssueGetRecordProofRedirect. %v %v", p, p1)
+			return
+		}
+
+		input := m.IssueGetRecordProofRedirectMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, DelegationTokenFactoryMockIssueGetRecordProofRedirectInput{p, p1}, "DelegationTokenFactory.IssueGetRecordProofRedirect got unexpected parameters")
+
+		result := m.IssueGetRecordProofRedirectMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the DelegationTokenFactoryMock.IssueGetRecordProofRedirect")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.IssueGetRecordProofRedirectMock.mainExpectation != nil {
+
+		input := m.IssueGetRecordProofRedirectMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, DelegationTokenFactoryMockIssueGetRecordProofRedirectInput{p, p1}, "DelegationTokenFactory.IssueGetRecordProofRedirect got unexpected parameters")
+		}
+
+		result := m.IssueGetRecordProofRedirectMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the DelegationTokenFactoryMock.IssueGetRecordProofRedirect")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.IssueGetRecordProofRedirectFunc == nil {
+		m.t.Fatalf("Unexpected call to DelegationTokenFactoryMock.IssueGetRecordProofRedirect. %v %v", p, p1)
+		return
+	}
+
+	return m.IssueGetRecordProofRedirectFunc(p, p1)
+}
+
+//IssueGetRecordProofRedirectMinimockCounter returns a count of DelegationTokenFactoryMock.IssueGetRecordProofRedirectFunc invocations
+func (m *DelegationTokenFactoryMock) IssueGetRecordProofRedirectMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.IssueGetRecordProofRedirectCounter)
+}
+
+//IssueGetRecordProofRedirectMinimockPreCounter returns the value of DelegationTokenFactoryMock.IssueGetRecordProofRedirect invocations
+func (m *DelegationTokenFactoryMock) IssueGetRecordProofRedirectMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.IssueGetRecordProofRedirectPreCounter)
+}
+
+//IssueGetRecordProofRedirectFinished returns true if mock invocations count is ok
+func (m *DelegationTokenFactoryMock) IssueGetRecordProofRedirectFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.IssueGetRecordProofRedirectMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.IssueGetRecordProofRedirectCounter) == uint64(len(m.IssueGetRecordProofRedirectMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.IssueGetRecordProofRedirectMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.IssueGetRecordProofRedirectCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.IssueGetRecordProofRedirectFunc != nil {
+		return atomic.LoadUint64(&m.IssueGetRecordProofRedirectCounter) > 0
+	}
+
+	return true
+}
+
 type mDelegationTokenFactoryMockIssuePendingExecution struct {
 	mock              *DelegationTokenFactoryMock
 	mainExpectation   *DelegationTokenFactoryMockIssuePendingExecutionExpectation
@@ -828,10 +1456,26 @@ func (m *DelegationTokenFactoryMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to DelegationTokenFactoryMock.IssueGetCodeRedirect")
 	}
 
+	if !m.IssueGetEventsRedirectFinished() {
+		m.t.Fatal("Expected call to DelegationTokenFactoryMock.IssueGetEventsRedirect")
+	}
+
+	if !m.IssueGetObjectHistoryRedirectFinished() {
+		m.t.Fatal("Expected call to DelegationTokenFactoryMock.IssueGetObjectHistoryRedirect")
+	}
+
 	if !m.IssueGetObjectRedirectFinished() {
 		m.t.Fatal("Expected call to DelegationTokenFactoryMock.IssueGetObjectRedirect")
 	}
 
+	if !m.IssueGetReceiptRedirectFinished() {
+		m.t.Fatal("Expected call to DelegationTokenFactoryMock.IssueGetReceiptRedirect")
+	}
+
+	if !m.IssueGetRecordProofRedirectFinished() {
+		m.t.Fatal("Expected call to DelegationTokenFactoryMock.IssueGetRecordProofRedirect")
+	}
+
 	if !m.IssuePendingExecutionFinished() {
 		m.t.Fatal("Expected call to DelegationTokenFactoryMock.IssuePendingExecution")
 	}
@@ -865,10 +1509,26 @@ func (m *DelegationTokenFactoryMock) MinimockFinish() {
 		m.t.Fatal("Expected call to DelegationTokenFactoryMock.IssueGetCodeRedirect")
 	}
 
+	if !m.IssueGetEventsRedirectFinished() {
+		m.t.Fatal("Expected call to DelegationTokenFactoryMock.IssueGetEventsRedirect")
+	}
+
+	if !m.IssueGetObjectHistoryRedirectFinished() {
+		m.t.Fatal("Expected call to DelegationTokenFactoryMock.IssueGetObjectHistoryRedirect")
+	}
+
 	if !m.IssueGetObjectRedirectFinished() {
 		m.t.Fatal("Expected call to DelegationTokenFactoryMock.IssueGetObjectRedirect")
 	}
 
+	if !m.IssueGetReceiptRedirectFinished() {
+		m.t.Fatal("Expected call to DelegationTokenFactoryMock.IssueGetReceiptRedirect")
+	}
+
+	if !m.IssueGetRecordProofRedirectFinished() {
+		m.t.Fatal("Expected call to DelegationTokenFactoryMock.IssueGetRecordProofRedirect")
+	}
+
 	if !m.IssuePendingExecutionFinished() {
 		m.t.Fatal("Expected call to DelegationTokenFactoryMock.IssuePendingExecution")
 	}
@@ -893,7 +1553,11 @@ func (m *DelegationTokenFactoryMock) MinimockWait(timeout time.Duration) {
 		ok := true
 		ok = ok && m.IssueGetChildrenRedirectFinished()
 		ok = ok && m.IssueGetCodeRedirectFinished()
+		ok = ok && m.IssueGetEventsRedirectFinished()
+		ok = ok && m.IssueGetObjectHistoryRedirectFinished()
 		ok = ok && m.IssueGetObjectRedirectFinished()
+		ok = ok && m.IssueGetReceiptRedirectFinished()
+		ok = ok && m.IssueGetRecordProofRedirectFinished()
 		ok = ok && m.IssuePendingExecutionFinished()
 		ok = ok && m.VerifyFinished()
 
@@ -912,10 +1576,26 @@ func (m *DelegationTokenFactoryMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to DelegationTokenFactoryMock.IssueGetCodeRedirect")
 			}
 
+			if !m.IssueGetEventsRedirectFinished() {
+				m.t.Error("Expected call to DelegationTokenFactoryMock.IssueGetEventsRedirect")
+			}
+
+			if !m.IssueGetObjectHistoryRedirectFinished() {
+				m.t.Error("Expected call to DelegationTokenFactoryMock.IssueGetObjectHistoryRedirect")
+			}
+
 			if !m.IssueGetObjectRedirectFinished() {
 				m.t.Error("Expected call to DelegationTokenFactoryMock.IssueGetObjectRedirect")
 			}
 
+			if !m.IssueGetReceiptRedirectFinished() {
+				m.t.Error("Expected call to DelegationTokenFactoryMock.IssueGetReceiptRedirect")
+			}
+
+			if !m.IssueGetRecordProofRedirectFinished() {
+				m.t.Error("Expected call to DelegationTokenFactoryMock.IssueGetRecordProofRedirect")
+			}
+
 			if !m.IssuePendingExecutionFinished() {
 				m.t.Error("Expected call to DelegationTokenFactoryMock.IssuePendingExecution")
 			}
@@ -944,10 +1624,26 @@ func (m *DelegationTokenFactoryMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.IssueGetEventsRedirectFinished() {
+		return false
+	}
+
+	if !m.IssueGetObjectHistoryRedirectFinished() {
+		return false
+	}
+
 	if !m.IssueGetObjectRedirectFinished() {
 		return false
 	}
 
+	if !m.IssueGetReceiptRedirectFinished() {
+		return false
+	}
+
+	if !m.IssueGetRecordProofRedirectFinished() {
+		return false
+	}
+
 	if !m.IssuePendingExecutionFinished() {
 		return false
 	}
@@ -25,7 +25,7 @@ type HeavySyncMock struct {
 	ResetPreCounter uint64
 	ResetMock       mHeavySyncMockReset
 
-	StartFunc       func(p context.Context, p1 core.RecordID, p2 core.PulseNumber) (r error)
+	StartFunc       func(p context.Context, p1 core.RecordID, p2 core.PulseNumber) (r core.HeavySyncProgress, r1 error)
 	StartCounter    uint64
 	StartPreCounter uint64
 	StartMock       mHeavySyncMockStart
@@ -35,7 +35,7 @@ type HeavySyncMock struct {
 	StopPreCounter uint64
 	StopMock       mHeavySyncMockStop
 
-	StoreFunc       func(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 []core.KV) (r error)
+	StoreFunc       func(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 uint32, p4 []byte, p5 []core.KV) (r core.HeavySyncProgress, r1 error)
 	StoreCounter    uint64
 	StorePreCounter uint64
 	StoreMock       mHeavySyncMockStore
@@ -224,7 +224,8 @@ type HeavySyncMockStartInput struct {
 }
 
 type HeavySyncMockStartResult struct {
-	r error
+	r  core.HeavySyncProgress
+	r1 error
 }
 
 //Expect specifies that invocation of HeavySync.Start is expected from 1 to Infinity times
@@ -240,14 +241,14 @@ func (m *mHeavySyncMockStart) Expect(p context.Context, p1 core.RecordID, p2 cor
 }
 
 //Return specifies results of invocation of HeavySync.Start
-func (m *mHeavySyncMockStart) Return(r error) *HeavySyncMock {
+func (m *mHeavySyncMockStart) Return(r core.HeavySyncProgress, r1 error) *HeavySyncMock {
 	m.mock.StartFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
 		m.mainExpectation = &HeavySyncMockStartExpectation{}
 	}
-	m.mainExpectation.result = &HeavySyncMockStartResult{r}
+	m.mainExpectation.result = &HeavySyncMockStartResult{r, r1}
 	return m.mock
 }
 
@@ -262,12 +263,12 @@ func (m *mHeavySyncMockStart) ExpectOnce(p context.Context, p1 core.RecordID, p2
 	return expectation
 }
 
-func (e *HeavySyncMockStartExpectation) Return(r error) {
-	e.result = &HeavySyncMockStartResult{r}
+func (e *HeavySyncMockStartExpectation) Return(r core.HeavySyncProgress, r1 error) {
+	e.result = &HeavySyncMockStartResult{r, r1}
 }
 
 //Set uses given function f as a mock of HeavySync.Start method
-func (m *mHeavySyncMockStart) Set(f func(p context.Context, p1 core.RecordID, p2 core.PulseNumber) (r error)) *HeavySyncMock {
+func (m *mHeavySyncMockStart) Set(f func(p context.Context, p1 core.RecordID, p2 core.PulseNumber) (r core.HeavySyncProgress, r1 error)) *HeavySyncMock {
 	m.mainExpectation = nil
 	m.expectationSeries = nil
 
@@ -276,7 +277,7 @@ func (m *mHeavySyncMockStart) Set(f func(p context.Context, p1 core.RecordID, p2
 }
 
 //Start implements github.com/insolar/insolar/core.HeavySync interface
-func (m *HeavySyncMock) Start(p context.Context, p1 core.RecordID, p2 core.PulseNumber) (r error) {
+func (m *HeavySyncMock) Start(p context.Context, p1 core.RecordID, p2 core.PulseNumber) (r core.HeavySyncProgress, r1 error) {
 	counter := atomic.AddUint64(&m.StartPreCounter, 1)
 	defer atomic.AddUint64(&m.StartCounter, 1)
 
@@ -296,6 +297,7 @@ func (m *HeavySyncMock) Start(p context.Context, p1 core.RecordID, p2 core.Pulse
 		}
 
 		r = result.r
+		r1 = result.r1
 
 		return
 	}
@@ -313,6 +315,7 @@ func (m *HeavySyncMock) Start(p context.Context, p1 core.RecordID, p2 core.Pulse
 		}
 
 		r = result.r
+		r1 = result.r1
 
 		return
 	}
@@ -519,54 +522,57 @@ type HeavySyncMockStoreInput struct {
 	p  context.Context
 	p1 core.RecordID
 	p2 core.PulseNumber
-	p3 []core.KV
+	p3 uint32
+	p4 []byte
+	p5 []core.KV
 }
 
 type HeavySyncMockStoreResult struct {
-	r error
+	r  core.HeavySyncProgress
+	r1 error
 }
 
 //Expect specifies that invocation of HeavySync.Store is expected from 1 to Infinity times
-func (m *mHeavySyncMockStore) Expect(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 []core.KV) *mHeavySyncMockStore {
+func (m *mHeavySyncMockStore) Expect(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 uint32, p4 []byte, p5 []core.KV) *mHeavySyncMockStore {
 	m.mock.StoreFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
 		m.mainExpectation = &HeavySyncMockStoreExpectation{}
 	}
-	m.mainExpectation.input = &HeavySyncMockStoreInput{p, p1, p2, p3}
+	m.mainExpectation.input = &HeavySyncMockStoreInput{p, p1, p2, p3, p4, p5}
 	return m
 }
 
 //Return specifies results of invocation of HeavySync.Store
-func (m *mHeavySyncMockStore) Return(r error) *HeavySyncMock {
+func (m *mHeavySyncMockStore) Return(r core.HeavySyncProgress, r1 error) *HeavySyncMock {
 	m.mock.StoreFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
 		m.mainExpectation = &HeavySyncMockStoreExpectation{}
 	}
-	m.mainExpectation.result = &HeavySyncMockStoreResult{r}
+	m.mainExpectation.result = &HeavySyncMockStoreResult{r, r1}
 	return m.mock
 }
 
 //ExpectOnce specifies that invocation of HeavySync.Store is expected once
-func (m *mHeavySyncMockStore) ExpectOnce(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 []core.KV) *HeavySyncMockStoreExpectation {
+func (m *mHeavySyncMockStore) ExpectOnce(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 uint32, p4 []byte, p5 []core.KV) *HeavySyncMockStoreExpectation {
 	m.mock.StoreFunc = nil
 	m.mainExpectation = nil
 
 	expectation := &HeavySyncMockStoreExpectation{}
-	expectation.input = &HeavySyncMockStoreInput{p, p1, p2, p3}
+	expectation.input = &HeavySyncMockStoreInput{p, p1, p2, p3, p4, p5}
 	m.expectationSeries = append(m.expectationSeries, expectation)
 	return expectation
 }
 
-func (e *HeavySyncMockStoreExpectation) Return(r error) {
-	e.result = &HeavySyncMockStoreResult{r}
+func (e *HeavySyncMockStoreExpectation) Return(r core.HeavySyncProgress, r1 error) {
+	e.result = &HeavySyncMockStoreResult{r, r1}
 }
 
 //Set uses given function f as a mock of HeavySync.Store method
-func (m *mHeavySyncMockStore) Set(f func(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 []core.KV) (r error)) *HeavySyncMock {
+func (m *mHeavySyncMockStore) Set(f func(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 uint32, p4 []byte, p5 []core.KV) (r core.HeavySyncProgress, r1 error)) *HeavySyncMock {
 	m.mainExpectation = nil
 	m.expectationSeries = nil
 
@@ -575,18 +581,18 @@ func (m *mHeavySyncMockStore) Set(f func(p context.Context, p1 core.RecordID, p2
 }
 
 //Store implements github.com/insolar/insolar/core.HeavySync interface
-func (m *HeavySyncMock) Store(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 []core.KV) (r error) {
+func (m *HeavySyncMock) Store(p context.Context, p1 core.RecordID, p2 core.PulseNumber, p3 uint32, p4 []byte, p5 []core.KV) (r core.HeavySyncProgress, r1 error) {
 	counter := atomic.AddUint64(&m.StorePreCounter, 1)
 	defer atomic.AddUint64(&m.StoreCounter, 1)
 
 	if len(m.StoreMock.expectationSeries) > 0 {
 		if counter > uint64(len(m.StoreMock.expectationSeries)) {
-			m.t.Fatalf("Unexpected call to HeavySyncMock.Store. %v %v %v %v", p, p1, p2, p3)
+			m.t.Fatalf("Unexpected call to HeavySyncMock.Store. %v %v %v %v %v %v", p, p1, p2, p3, p4, p5)
 			return
 		}
 
 		input := m.StoreMock.expectationSeries[counter-1].input
-		testify_assert.Equal(m.t, *input, HeavySyncMockStoreInput{p, p1, p2, p3}, "HeavySync.Store got unexpected parameters")
+		testify_assert.Equal(m.t, *input, HeavySyncMockStoreInput{p, p1, p2, p3, p4, p5}, "HeavySync.Store got unexpected parameters")
 
 		result := m.StoreMock.expectationSeries[counter-1].result
 		if result == nil {
@@ -595,6 +601,7 @@ func (m *HeavySyncMock) Store(p context.Context, p1 core.RecordID, p2 core.Pulse
 		}
 
 		r = result.r
+		r1 = result.r1
 
 		return
 	}
@@ -603,7 +610,7 @@ func (m *HeavySyncMock) Store(p context.Context, p1 core.RecordID, p2 core.Pulse
 
 		input := m.StoreMock.mainExpectation.input
 		if input != nil {
-			testify_assert.Equal(m.t, *input, HeavySyncMockStoreInput{p, p1, p2, p3}, "HeavySync.Store got unexpected parameters")
+			testify_assert.Equal(m.t, *input, HeavySyncMockStoreInput{p, p1, p2, p3, p4, p5}, "HeavySync.Store got unexpected parameters")
 		}
 
 		result := m.StoreMock.mainExpectation.result
@@ -612,16 +619,17 @@ func (m *HeavySyncMock) Store(p context.Context, p1 core.RecordID, p2 core.Pulse
 		}
 
 		r = result.r
+		r1 = result.r1
 
 		return
 	}
 
 	if m.StoreFunc == nil {
-		m.t.Fatalf("Unexpected call to HeavySyncMock.Store. %v %v %v %v", p, p1, p2, p3)
+		m.t.Fatalf("Unexpected call to HeavySyncMock.Store. %v %v %v %v %v %v", p, p1, p2, p3, p4, p5)
 		return
 	}
 
-	return m.StoreFunc(p, p1, p2, p3)
+	return m.StoreFunc(p, p1, p2, p3, p4, p5)
 }
 
 //StoreMinimockCounter returns a count of HeavySyncMock.StoreFunc invocations
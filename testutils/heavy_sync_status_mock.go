@@ -0,0 +1,598 @@
+package testutils
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "HeavySyncStatus" can be found in github.com/insolar/insolar/core
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	core "github.com/insolar/insolar/core"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//HeavySyncStatusMock implements github.com/insolar/insolar/core.HeavySyncStatus
+type HeavySyncStatusMock struct {
+	t minimock.Tester
+
+	FlushHeavySyncFunc       func(p context.Context) (r error)
+	FlushHeavySyncCounter    uint64
+	FlushHeavySyncPreCounter uint64
+	FlushHeavySyncMock       mHeavySyncStatusMockFlushHeavySync
+
+	FlushHeavySyncOnPulseFunc       func(p context.Context, p1 core.PulseNumber)
+	FlushHeavySyncOnPulseCounter    uint64
+	FlushHeavySyncOnPulsePreCounter uint64
+	FlushHeavySyncOnPulseMock       mHeavySyncStatusMockFlushHeavySyncOnPulse
+
+	JetsSyncStatusFunc       func(p context.Context) (r []core.JetSyncStatus, r1 error)
+	JetsSyncStatusCounter    uint64
+	JetsSyncStatusPreCounter uint64
+	JetsSyncStatusMock       mHeavySyncStatusMockJetsSyncStatus
+}
+
+//NewHeavySyncStatusMock returns a mock for github.com/insolar/insolar/core.HeavySyncStatus
+func NewHeavySyncStatusMock(t minimock.Tester) *HeavySyncStatusMock {
+	m := &HeavySyncStatusMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.FlushHeavySyncMock = mHeavySyncStatusMockFlushHeavySync{mock: m}
+	m.FlushHeavySyncOnPulseMock = mHeavySyncStatusMockFlushHeavySyncOnPulse{mock: m}
+	m.JetsSyncStatusMock = mHeavySyncStatusMockJetsSyncStatus{mock: m}
+
+	return m
+}
+
+type mHeavySyncStatusMockFlushHeavySync struct {
+	mock              *HeavySyncStatusMock
+	mainExpectation   *HeavySyncStatusMockFlushHeavySyncExpectation
+	expectationSeries []*HeavySyncStatusMockFlushHeavySyncExpectation
+}
+
+type HeavySyncStatusMockFlushHeavySyncExpectation struct {
+	input  *HeavySyncStatusMockFlushHeavySyncInput
+	result *HeavySyncStatusMockFlushHeavySyncResult
+}
+
+type HeavySyncStatusMockFlushHeavySyncInput struct {
+	p context.Context
+}
+
+type HeavySyncStatusMockFlushHeavySyncResult struct {
+	r error
+}
+
+//Expect specifies that invocation of HeavySyncStatus.FlushHeavySync is expected from 1 to Infinity times
+func (m *mHeavySyncStatusMockFlushHeavySync) Expect(p context.Context) *mHeavySyncStatusMockFlushHeavySync {
+	m.mock.FlushHeavySyncFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &HeavySyncStatusMockFlushHeavySyncExpectation{}
+	}
+	m.mainExpectation.input = &HeavySyncStatusMockFlushHeavySyncInput{p}
+	return m
+}
+
+//Return specifies results of invocation of HeavySyncStatus.FlushHeavySync
+func (m *mHeavySyncStatusMockFlushHeavySync) Return(r error) *HeavySyncStatusMock {
+	m.mock.FlushHeavySyncFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &HeavySyncStatusMockFlushHeavySyncExpectation{}
+	}
+	m.mainExpectation.result = &HeavySyncStatusMockFlushHeavySyncResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of HeavySyncStatus.FlushHeavySync is expected once
+func (m *mHeavySyncStatusMockFlushHeavySync) ExpectOnce(p context.Context) *HeavySyncStatusMockFlushHeavySyncExpectation {
+	m.mock.FlushHeavySyncFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &HeavySyncStatusMockFlushHeavySyncExpectation{}
+	expectation.input = &HeavySyncStatusMockFlushHeavySyncInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *HeavySyncStatusMockFlushHeavySyncExpectation) Return(r error) {
+	e.result = &HeavySyncStatusMockFlushHeavySyncResult{r}
+}
+
+//Set uses given function f as a mock of HeavySyncStatus.FlushHeavySync method
+func (m *mHeavySyncStatusMockFlushHeavySync) Set(f func(p context.Context) (r error)) *HeavySyncStatusMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.FlushHeavySyncFunc = f
+	return m.mock
+}
+
+//FlushHeavySync implements github.com/insolar/insolar/core.HeavySyncStatus interface
+func (m *HeavySyncStatusMock) FlushHeavySync(p context.Context) (r error) {
+	counter := atomic.AddUint64(&m.FlushHeavySyncPreCounter, 1)
+	defer atomic.AddUint64(&m.FlushHeavySyncCounter, 1)
+
+	if len(m.FlushHeavySyncMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.FlushHeavySyncMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to HeavySyncStatusMock.FlushHeavySync. %v", p)
+			return
+		}
+
+		input := m.FlushHeavySyncMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, HeavySyncStatusMockFlushHeavySyncInput{p}, "HeavySyncStatus.FlushHeavySync got unexpected parameters")
+
+		result := m.FlushHeavySyncMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the HeavySyncStatusMock.FlushHeavySync")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.FlushHeavySyncMock.mainExpectation != nil {
+
+		input := m.FlushHeavySyncMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, HeavySyncStatusMockFlushHeavySyncInput{p}, "HeavySyncStatus.FlushHeavySync got unexpected parameters")
+		}
+
+		result := m.FlushHeavySyncMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the HeavySyncStatusMock.FlushHeavySync")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.FlushHeavySyncFunc == nil {
+		m.t.Fatalf("Unexpected call to HeavySyncStatusMock.FlushHeavySync. %v", p)
+		return
+	}
+
+	return m.FlushHeavySyncFunc(p)
+}
+
+//FlushHeavySyncMinimockCounter returns a count of HeavySyncStatusMock.FlushHeavySyncFunc invocations
+func (m *HeavySyncStatusMock) FlushHeavySyncMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.FlushHeavySyncCounter)
+}
+
+//FlushHeavySyncMinimockPreCounter returns the value of HeavySyncStatusMock.FlushHeavySync invocations
+func (m *HeavySyncStatusMock) FlushHeavySyncMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.FlushHeavySyncPreCounter)
+}
+
+//FlushHeavySyncFinished returns true if mock invocations count is ok
+func (m *HeavySyncStatusMock) FlushHeavySyncFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.FlushHeavySyncMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.FlushHeavySyncCounter) == uint64(len(m.FlushHeavySyncMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.FlushHeavySyncMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.FlushHeavySyncCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.FlushHeavySyncFunc != nil {
+		return atomic.LoadUint64(&m.FlushHeavySyncCounter) > 0
+	}
+
+	return true
+}
+
+type mHeavySyncStatusMockFlushHeavySyncOnPulse struct {
+	mock              *HeavySyncStatusMock
+	mainExpectation   *HeavySyncStatusMockFlushHeavySyncOnPulseExpectation
+	expectationSeries []*HeavySyncStatusMockFlushHeavySyncOnPulseExpectation
+}
+
+type HeavySyncStatusMockFlushHeavySyncOnPulseExpectation struct {
+	input *HeavySyncStatusMockFlushHeavySyncOnPulseInput
+}
+
+type HeavySyncStatusMockFlushHeavySyncOnPulseInput struct {
+	p  context.Context
+	p1 core.PulseNumber
+}
+
+//Expect specifies that invocation of HeavySyncStatus.FlushHeavySyncOnPulse is expected from 1 to Infinity times
+func (m *mHeavySyncStatusMockFlushHeavySyncOnPulse) Expect(p context.Context, p1 core.PulseNumber) *mHeavySyncStatusMockFlushHeavySyncOnPulse {
+	m.mock.FlushHeavySyncOnPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &HeavySyncStatusMockFlushHeavySyncOnPulseExpectation{}
+	}
+	m.mainExpectation.input = &HeavySyncStatusMockFlushHeavySyncOnPulseInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of HeavySyncStatus.FlushHeavySyncOnPulse
+func (m *mHeavySyncStatusMockFlushHeavySyncOnPulse) Return() *HeavySyncStatusMock {
+	m.mock.FlushHeavySyncOnPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &HeavySyncStatusMockFlushHeavySyncOnPulseExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of HeavySyncStatus.FlushHeavySyncOnPulse is expected once
+func (m *mHeavySyncStatusMockFlushHeavySyncOnPulse) ExpectOnce(p context.Context, p1 core.PulseNumber) *HeavySyncStatusMockFlushHeavySyncOnPulseExpectation {
+	m.mock.FlushHeavySyncOnPulseFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &HeavySyncStatusMockFlushHeavySyncOnPulseExpectation{}
+	expectation.input = &HeavySyncStatusMockFlushHeavySyncOnPulseInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of HeavySyncStatus.FlushHeavySyncOnPulse method
+func (m *mHeavySyncStatusMockFlushHeavySyncOnPulse) Set(f func(p context.Context, p1 core.PulseNumber)) *HeavySyncStatusMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.FlushHeavySyncOnPulseFunc = f
+	return m.mock
+}
+
+//FlushHeavySyncOnPulse implements github.com/insolar/insolar/core.HeavySyncStatus interface
+func (m *HeavySyncStatusMock) FlushHeavySyncOnPulse(p context.Context, p1 core.PulseNumber) {
+	counter := atomic.AddUint64(&m.FlushHeavySyncOnPulsePreCounter, 1)
+	defer atomic.AddUint64(&m.FlushHeavySyncOnPulseCounter, 1)
+
+	if len(m.FlushHeavySyncOnPulseMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.FlushHeavySyncOnPulseMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to HeavySyncStatusMock.FlushHeavySyncOnPulse. %v %v", p, p1)
+			return
+		}
+
+		input := m.FlushHeavySyncOnPulseMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, HeavySyncStatusMockFlushHeavySyncOnPulseInput{p, p1}, "HeavySyncStatus.FlushHeavySyncOnPulse got unexpected parameters")
+
+		return
+	}
+
+	if m.FlushHeavySyncOnPulseMock.mainExpectation != nil {
+
+		input := m.FlushHeavySyncOnPulseMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, HeavySyncStatusMockFlushHeavySyncOnPulseInput{p, p1}, "HeavySyncStatus.FlushHeavySyncOnPulse got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.FlushHeavySyncOnPulseFunc == nil {
+		m.t.Fatalf("Unexpected call to HeavySyncStatusMock.FlushHeavySyncOnPulse. %v %v", p, p1)
+		return
+	}
+
+	m.FlushHeavySyncOnPulseFunc(p, p1)
+}
+
+//FlushHeavySyncOnPulseMinimockCounter returns a count of HeavySyncStatusMock.FlushHeavySyncOnPulseFunc invocations
+func (m *HeavySyncStatusMock) FlushHeavySyncOnPulseMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.FlushHeavySyncOnPulseCounter)
+}
+
+//FlushHeavySyncOnPulseMinimockPreCounter returns the value of HeavySyncStatusMock.FlushHeavySyncOnPulse invocations
+func (m *HeavySyncStatusMock) FlushHeavySyncOnPulseMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.FlushHeavySyncOnPulsePreCounter)
+}
+
+//FlushHeavySyncOnPulseFinished returns true if mock invocations count is ok
+func (m *HeavySyncStatusMock) FlushHeavySyncOnPulseFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.FlushHeavySyncOnPulseMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.FlushHeavySyncOnPulseCounter) == uint64(len(m.FlushHeavySyncOnPulseMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.FlushHeavySyncOnPulseMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.FlushHeavySyncOnPulseCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.FlushHeavySyncOnPulseFunc != nil {
+		return atomic.LoadUint64(&m.FlushHeavySyncOnPulseCounter) > 0
+	}
+
+	return true
+}
+
+type mHeavySyncStatusMockJetsSyncStatus struct {
+	mock              *HeavySyncStatusMock
+	mainExpectation   *HeavySyncStatusMockJetsSyncStatusExpectation
+	expectationSeries []*HeavySyncStatusMockJetsSyncStatusExpectation
+}
+
+type HeavySyncStatusMockJetsSyncStatusExpectation struct {
+	input  *HeavySyncStatusMockJetsSyncStatusInput
+	result *HeavySyncStatusMockJetsSyncStatusResult
+}
+
+type HeavySyncStatusMockJetsSyncStatusInput struct {
+	p context.Context
+}
+
+type HeavySyncStatusMockJetsSyncStatusResult struct {
+	r  []core.JetSyncStatus
+	r1 error
+}
+
+//Expect specifies that invocation of HeavySyncStatus.JetsSyncStatus is expected from 1 to Infinity times
+func (m *mHeavySyncStatusMockJetsSyncStatus) Expect(p context.Context) *mHeavySyncStatusMockJetsSyncStatus {
+	m.mock.JetsSyncStatusFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &HeavySyncStatusMockJetsSyncStatusExpectation{}
+	}
+	m.mainExpectation.input = &HeavySyncStatusMockJetsSyncStatusInput{p}
+	return m
+}
+
+//Return specifies results of invocation of HeavySyncStatus.JetsSyncStatus
+func (m *mHeavySyncStatusMockJetsSyncStatus) Return(r []core.JetSyncStatus, r1 error) *HeavySyncStatusMock {
+	m.mock.JetsSyncStatusFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &HeavySyncStatusMockJetsSyncStatusExpectation{}
+	}
+	m.mainExpectation.result = &HeavySyncStatusMockJetsSyncStatusResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of HeavySyncStatus.JetsSyncStatus is expected once
+func (m *mHeavySyncStatusMockJetsSyncStatus) ExpectOnce(p context.Context) *HeavySyncStatusMockJetsSyncStatusExpectation {
+	m.mock.JetsSyncStatusFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &HeavySyncStatusMockJetsSyncStatusExpectation{}
+	expectation.input = &HeavySyncStatusMockJetsSyncStatusInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *HeavySyncStatusMockJetsSyncStatusExpectation) Return(r []core.JetSyncStatus, r1 error) {
+	e.result = &HeavySyncStatusMockJetsSyncStatusResult{r, r1}
+}
+
+//Set uses given function f as a mock of HeavySyncStatus.JetsSyncStatus method
+func (m *mHeavySyncStatusMockJetsSyncStatus) Set(f func(p context.Context) (r []core.JetSyncStatus, r1 error)) *HeavySyncStatusMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.JetsSyncStatusFunc = f
+	return m.mock
+}
+
+//JetsSyncStatus implements github.com/insolar/insolar/core.HeavySyncStatus interface
+func (m *HeavySyncStatusMock) JetsSyncStatus(p context.Context) (r []core.JetSyncStatus, r1 error) {
+	counter := atomic.AddUint64(&m.JetsSyncStatusPreCounter, 1)
+	defer atomic.AddUint64(&m.JetsSyncStatusCounter, 1)
+
+	if len(m.JetsSyncStatusMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.JetsSyncStatusMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to HeavySyncStatusMock.JetsSyncStatus. %v", p)
+			return
+		}
+
+		input := m.JetsSyncStatusMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, HeavySyncStatusMockJetsSyncStatusInput{p}, "HeavySyncStatus.JetsSyncStatus got unexpected parameters")
+
+		result := m.JetsSyncStatusMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the HeavySyncStatusMock.JetsSyncStatus")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.JetsSyncStatusMock.mainExpectation != nil {
+
+		input := m.JetsSyncStatusMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, HeavySyncStatusMockJetsSyncStatusInput{p}, "HeavySyncStatus.JetsSyncStatus got unexpected parameters")
+		}
+
+		result := m.JetsSyncStatusMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the HeavySyncStatusMock.JetsSyncStatus")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.JetsSyncStatusFunc == nil {
+		m.t.Fatalf("Unexpected call to HeavySyncStatusMock.JetsSyncStatus. %v", p)
+		return
+	}
+
+	return m.JetsSyncStatusFunc(p)
+}
+
+//JetsSyncStatusMinimockCounter returns a count of HeavySyncStatusMock.JetsSyncStatusFunc invocations
+func (m *HeavySyncStatusMock) JetsSyncStatusMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.JetsSyncStatusCounter)
+}
+
+//JetsSyncStatusMinimockPreCounter returns the value of HeavySyncStatusMock.JetsSyncStatus invocations
+func (m *HeavySyncStatusMock) JetsSyncStatusMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.JetsSyncStatusPreCounter)
+}
+
+//JetsSyncStatusFinished returns true if mock invocations count is ok
+func (m *HeavySyncStatusMock) JetsSyncStatusFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.JetsSyncStatusMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.JetsSyncStatusCounter) == uint64(len(m.JetsSyncStatusMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.JetsSyncStatusMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.JetsSyncStatusCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.JetsSyncStatusFunc != nil {
+		return atomic.LoadUint64(&m.JetsSyncStatusCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *HeavySyncStatusMock) ValidateCallCounters() {
+
+	if !m.FlushHeavySyncFinished() {
+		m.t.Fatal("Expected call to HeavySyncStatusMock.Set")
+
+	}
+
+
+	if !m.FlushHeavySyncOnPulseFinished() {
+		m.t.Fatal("Expected call to HeavySyncStatusMock.Set")
+
+	}
+
+
+	if !m.JetsSyncStatusFinished() {
+		m.t.Fatal("Expected call to HeavySyncStatusMock.Set")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *HeavySyncStatusMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *HeavySyncStatusMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *HeavySyncStatusMock) MinimockFinish() {
+
+	if !m.FlushHeavySyncFinished() {
+		m.t.Fatal("Expected call to HeavySyncStatusMock.Set")
+
+	}
+
+
+	if !m.FlushHeavySyncOnPulseFinished() {
+		m.t.Fatal("Expected call to HeavySyncStatusMock.Set")
+
+	}
+
+
+	if !m.JetsSyncStatusFinished() {
+		m.t.Fatal("Expected call to HeavySyncStatusMock.Set")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *HeavySyncStatusMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *HeavySyncStatusMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.FlushHeavySyncFinished()
+		ok = ok && m.FlushHeavySyncOnPulseFinished()
+		ok = ok && m.JetsSyncStatusFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.FlushHeavySyncFinished() {
+				m.t.Error("Expected call to HeavySyncStatusMock.Set")
+
+			}
+
+
+			if !m.FlushHeavySyncOnPulseFinished() {
+				m.t.Error("Expected call to HeavySyncStatusMock.Set")
+
+			}
+
+
+			if !m.JetsSyncStatusFinished() {
+				m.t.Error("Expected call to HeavySyncStatusMock.Set")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *HeavySyncStatusMock) AllMocksCalled() bool {
+
+	if !m.FlushHeavySyncFinished() {
+		return false
+
+	}
+
+
+	if !m.FlushHeavySyncOnPulseFinished() {
+		return false
+
+	}
+
+
+	if !m.JetsSyncStatusFinished() {
+		return false
+	}
+
+	return true
+}
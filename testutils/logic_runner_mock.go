@@ -20,6 +20,11 @@ import (
 type LogicRunnerMock struct {
 	t minimock.Tester
 
+	ActiveExecutionsFunc       func(p context.Context) (r int)
+	ActiveExecutionsCounter    uint64
+	ActiveExecutionsPreCounter uint64
+	ActiveExecutionsMock       mLogicRunnerMockActiveExecutions
+
 	ExecuteFunc       func(p context.Context, p1 core.Parcel) (r core.Reply, r1 error)
 	ExecuteCounter    uint64
 	ExecutePreCounter uint64
@@ -40,6 +45,11 @@ type LogicRunnerMock struct {
 	HandleValidationResultsMessagePreCounter uint64
 	HandleValidationResultsMessageMock       mLogicRunnerMockHandleValidationResultsMessage
 
+	LeaveFunc       func(p context.Context, p1 core.PulseNumber) (r error)
+	LeaveCounter    uint64
+	LeavePreCounter uint64
+	LeaveMock       mLogicRunnerMockLeave
+
 	OnPulseFunc       func(p context.Context, p1 core.Pulse) (r error)
 	OnPulseCounter    uint64
 	OnPulsePreCounter uint64
@@ -54,15 +64,164 @@ func NewLogicRunnerMock(t minimock.Tester) *LogicRunnerMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.ActiveExecutionsMock = mLogicRunnerMockActiveExecutions{mock: m}
 	m.ExecuteMock = mLogicRunnerMockExecute{mock: m}
 	m.HandleExecutorResultsMessageMock = mLogicRunnerMockHandleExecutorResultsMessage{mock: m}
 	m.HandleValidateCaseBindMessageMock = mLogicRunnerMockHandleValidateCaseBindMessage{mock: m}
 	m.HandleValidationResultsMessageMock = mLogicRunnerMockHandleValidationResultsMessage{mock: m}
+	m.LeaveMock = mLogicRunnerMockLeave{mock: m}
 	m.OnPulseMock = mLogicRunnerMockOnPulse{mock: m}
 
 	return m
 }
 
+type mLogicRunnerMockActiveExecutions struct {
+	mock              *LogicRunnerMock
+	mainExpectation   *LogicRunnerMockActiveExecutionsExpectation
+	expectationSeries []*LogicRunnerMockActiveExecutionsExpectation
+}
+
+type LogicRunnerMockActiveExecutionsExpectation struct {
+	input  *LogicRunnerMockActiveExecutionsInput
+	result *LogicRunnerMockActiveExecutionsResult
+}
+
+type LogicRunnerMockActiveExecutionsInput struct {
+	p context.Context
+}
+
+type LogicRunnerMockActiveExecutionsResult struct {
+	r int
+}
+
+//Expect specifies that invocation of LogicRunner.ActiveExecutions is expected from 1 to Infinity times
+func (m *mLogicRunnerMockActiveExecutions) Expect(p context.Context) *mLogicRunnerMockActiveExecutions {
+	m.mock.ActiveExecutionsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &LogicRunnerMockActiveExecutionsExpectation{}
+	}
+	m.mainExpectation.input = &LogicRunnerMockActiveExecutionsInput{p}
+	return m
+}
+
+//Return specifies results of invocation of LogicRunner.ActiveExecutions
+func (m *mLogicRunnerMockActiveExecutions) Return(r int) *LogicRunnerMock {
+	m.mock.ActiveExecutionsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &LogicRunnerMockActiveExecutionsExpectation{}
+	}
+	m.mainExpectation.result = &LogicRunnerMockActiveExecutionsResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of LogicRunner.ActiveExecutions is expected once
+func (m *mLogicRunnerMockActiveExecutions) ExpectOnce(p context.Context) *LogicRunnerMockActiveExecutionsExpectation {
+	m.mock.ActiveExecutionsFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &LogicRunnerMockActiveExecutionsExpectation{}
+	expectation.input = &LogicRunnerMockActiveExecutionsInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *LogicRunnerMockActiveExecutionsExpectation) Return(r int) {
+	e.result = &LogicRunnerMockActiveExecutionsResult{r}
+}
+
+//Set uses given function f as a mock of LogicRunner.ActiveExecutions method
+func (m *mLogicRunnerMockActiveExecutions) Set(f func(p context.Context) (r int)) *LogicRunnerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ActiveExecutionsFunc = f
+	return m.mock
+}
+
+//ActiveExecutions implements github.com/insolar/insolar/core.LogicRunner interface
+func (m *LogicRunnerMock) ActiveExecutions(p context.Context) (r int) {
+	counter := atomic.AddUint64(&m.ActiveExecutionsPreCounter, 1)
+	defer atomic.AddUint64(&m.ActiveExecutionsCounter, 1)
+
+	if len(m.ActiveExecutionsMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ActiveExecutionsMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to LogicRunnerMock.ActiveExecutions. %v", p)
+			return
+		}
+
+		input := m.ActiveExecutionsMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, LogicRunnerMockActiveExecutionsInput{p}, "LogicRunner.ActiveExecutions got unexpected parameters")
+
+		result := m.ActiveExecutionsMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the LogicRunnerMock.ActiveExecutions")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.ActiveExecutionsMock.mainExpectation != nil {
+
+		input := m.ActiveExecutionsMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, LogicRunnerMockActiveExecutionsInput{p}, "LogicRunner.ActiveExecutions got unexpected parameters")
+		}
+
+		result := m.ActiveExecutionsMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the LogicRunnerMock.ActiveExecutions")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.ActiveExecutionsFunc == nil {
+		m.t.Fatalf("Unexpected call to LogicRunnerMock.ActiveExecutions. %v", p)
+		return
+	}
+
+	return m.ActiveExecutionsFunc(p)
+}
+
+//ActiveExecutionsMinimockCounter returns a count of LogicRunnerMock.ActiveExecutionsFunc invocations
+func (m *LogicRunnerMock) ActiveExecutionsMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ActiveExecutionsCounter)
+}
+
+//ActiveExecutionsMinimockPreCounter returns the value of LogicRunnerMock.ActiveExecutions invocations
+func (m *LogicRunnerMock) ActiveExecutionsMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ActiveExecutionsPreCounter)
+}
+
+//ActiveExecutionsFinished returns true if mock invocations count is ok
+func (m *LogicRunnerMock) ActiveExecutionsFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ActiveExecutionsMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ActiveExecutionsCounter) == uint64(len(m.ActiveExecutionsMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ActiveExecutionsMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ActiveExecutionsCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ActiveExecutionsFunc != nil {
+		return atomic.LoadUint64(&m.ActiveExecutionsCounter) > 0
+	}
+
+	return true
+}
+
 type mLogicRunnerMockExecute struct {
 	mock              *LogicRunnerMock
 	mainExpectation   *LogicRunnerMockExecuteExpectation
@@ -667,6 +826,154 @@ func (m *LogicRunnerMock) HandleValidationResultsMessageFinished() bool {
 	return true
 }
 
+type mLogicRunnerMockLeave struct {
+	mock              *LogicRunnerMock
+	mainExpectation   *LogicRunnerMockLeaveExpectation
+	expectationSeries []*LogicRunnerMockLeaveExpectation
+}
+
+type LogicRunnerMockLeaveExpectation struct {
+	input  *LogicRunnerMockLeaveInput
+	result *LogicRunnerMockLeaveResult
+}
+
+type LogicRunnerMockLeaveInput struct {
+	p  context.Context
+	p1 core.PulseNumber
+}
+
+type LogicRunnerMockLeaveResult struct {
+	r error
+}
+
+//Expect specifies that invocation of LogicRunner.Leave is expected from 1 to Infinity times
+func (m *mLogicRunnerMockLeave) Expect(p context.Context, p1 core.PulseNumber) *mLogicRunnerMockLeave {
+	m.mock.LeaveFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &LogicRunnerMockLeaveExpectation{}
+	}
+	m.mainExpectation.input = &LogicRunnerMockLeaveInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of LogicRunner.Leave
+func (m *mLogicRunnerMockLeave) Return(r error) *LogicRunnerMock {
+	m.mock.LeaveFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &LogicRunnerMockLeaveExpectation{}
+	}
+	m.mainExpectation.result = &LogicRunnerMockLeaveResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of LogicRunner.Leave is expected once
+func (m *mLogicRunnerMockLeave) ExpectOnce(p context.Context, p1 core.PulseNumber) *LogicRunnerMockLeaveExpectation {
+	m.mock.LeaveFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &LogicRunnerMockLeaveExpectation{}
+	expectation.input = &LogicRunnerMockLeaveInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *LogicRunnerMockLeaveExpectation) Return(r error) {
+	e.result = &LogicRunnerMockLeaveResult{r}
+}
+
+//Set uses given function f as a mock of LogicRunner.Leave method
+func (m *mLogicRunnerMockLeave) Set(f func(p context.Context, p1 core.PulseNumber) (r error)) *LogicRunnerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.LeaveFunc = f
+	return m.mock
+}
+
+//Leave implements github.com/insolar/insolar/core.LogicRunner interface
+func (m *LogicRunnerMock) Leave(p context.Context, p1 core.PulseNumber) (r error) {
+	counter := atomic.AddUint64(&m.LeavePreCounter, 1)
+	defer atomic.AddUint64(&m.LeaveCounter, 1)
+
+	if len(m.LeaveMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.LeaveMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to LogicRunnerMock.Leave. %v %v", p, p1)
+			return
+		}
+
+		input := m.LeaveMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, LogicRunnerMockLeaveInput{p, p1}, "LogicRunner.Leave got unexpected parameters")
+
+		result := m.LeaveMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the LogicRunnerMock.Leave")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.LeaveMock.mainExpectation != nil {
+
+		input := m.LeaveMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, LogicRunnerMockLeaveInput{p, p1}, "LogicRunner.Leave got unexpected parameters")
+		}
+
+		result := m.LeaveMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the LogicRunnerMock.Leave")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.LeaveFunc == nil {
+		m.t.Fatalf("Unexpected call to LogicRunnerMock.Leave. %v %v", p, p1)
+		return
+	}
+
+	return m.LeaveFunc(p, p1)
+}
+
+//LeaveMinimockCounter returns a count of LogicRunnerMock.LeaveFunc invocations
+func (m *LogicRunnerMock) LeaveMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.LeaveCounter)
+}
+
+//LeaveMinimockPreCounter returns the value of LogicRunnerMock.Leave invocations
+func (m *LogicRunnerMock) LeaveMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.LeavePreCounter)
+}
+
+//LeaveFinished returns true if mock invocations count is ok
+func (m *LogicRunnerMock) LeaveFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.LeaveMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.LeaveCounter) == uint64(len(m.LeaveMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.LeaveMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.LeaveCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.LeaveFunc != nil {
+		return atomic.LoadUint64(&m.LeaveCounter) > 0
+	}
+
+	return true
+}
+
 type mLogicRunnerMockOnPulse struct {
 	mock              *LogicRunnerMock
 	mainExpectation   *LogicRunnerMockOnPulseExpectation
@@ -819,6 +1126,12 @@ func (m *LogicRunnerMock) OnPulseFinished() bool {
 //Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
 func (m *LogicRunnerMock) ValidateCallCounters() {
 
+	if !m.ActiveExecutionsFinished() {
+		m.t.Fatal("Expected call to LogicRunnerMock.ActiveExecutions")
+
+	}
+
+
 	if !m.ExecuteFinished() {
 		m.t.Fatal("Expected call to LogicRunnerMock.Execute")
 	}
@@ -835,6 +1148,10 @@ func (m *LogicRunnerMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to LogicRunnerMock.HandleValidationResultsMessage")
 	}
 
+	if !m.LeaveFinished() {
+		m.t.Fatal("Expected call to LogicRunnerMock.Leave")
+	}
+
 	if !m.OnPulseFinished() {
 		m.t.Fatal("Expected call to LogicRunnerMock.OnPulse")
 	}
@@ -856,6 +1173,12 @@ func (m *LogicRunnerMock) Finish() {
 //MinimockFinish checks that all mocked methods of the interface have been called at least once
 func (m *LogicRunnerMock) MinimockFinish() {
 
+	if !m.ActiveExecutionsFinished() {
+		m.t.Fatal("Expected call to LogicRunnerMock.ActiveExecutions")
+
+	}
+
+
 	if !m.ExecuteFinished() {
 		m.t.Fatal("Expected call to LogicRunnerMock.Execute")
 	}
@@ -872,6 +1195,10 @@ func (m *LogicRunnerMock) MinimockFinish() {
 		m.t.Fatal("Expected call to LogicRunnerMock.HandleValidationResultsMessage")
 	}
 
+	if !m.LeaveFinished() {
+		m.t.Fatal("Expected call to LogicRunnerMock.Leave")
+	}
+
 	if !m.OnPulseFinished() {
 		m.t.Fatal("Expected call to LogicRunnerMock.OnPulse")
 	}
@@ -890,10 +1217,12 @@ func (m *LogicRunnerMock) MinimockWait(timeout time.Duration) {
 	timeoutCh := time.After(timeout)
 	for {
 		ok := true
+		ok = ok && m.ActiveExecutionsFinished()
 		ok = ok && m.ExecuteFinished()
 		ok = ok && m.HandleExecutorResultsMessageFinished()
 		ok = ok && m.HandleValidateCaseBindMessageFinished()
 		ok = ok && m.HandleValidationResultsMessageFinished()
+		ok = ok && m.LeaveFinished()
 		ok = ok && m.OnPulseFinished()
 
 		if ok {
@@ -903,6 +1232,12 @@ func (m *LogicRunnerMock) MinimockWait(timeout time.Duration) {
 		select {
 		case <-timeoutCh:
 
+			if !m.ActiveExecutionsFinished() {
+				m.t.Error("Expected call to LogicRunnerMock.ActiveExecutions")
+
+			}
+
+
 			if !m.ExecuteFinished() {
 				m.t.Error("Expected call to LogicRunnerMock.Execute")
 			}
@@ -919,6 +1254,10 @@ func (m *LogicRunnerMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to LogicRunnerMock.HandleValidationResultsMessage")
 			}
 
+			if !m.LeaveFinished() {
+				m.t.Error("Expected call to LogicRunnerMock.Leave")
+			}
+
 			if !m.OnPulseFinished() {
 				m.t.Error("Expected call to LogicRunnerMock.OnPulse")
 			}
@@ -935,6 +1274,12 @@ func (m *LogicRunnerMock) MinimockWait(timeout time.Duration) {
 //it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
 func (m *LogicRunnerMock) AllMocksCalled() bool {
 
+	if !m.ActiveExecutionsFinished() {
+		return false
+
+	}
+
+
 	if !m.ExecuteFinished() {
 		return false
 	}
@@ -951,6 +1296,10 @@ func (m *LogicRunnerMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.LeaveFinished() {
+		return false
+	}
+
 	if !m.OnPulseFinished() {
 		return false
 	}
@@ -17,6 +17,8 @@
 package network
 
 import (
+	"context"
+
 	"github.com/insolar/insolar/core"
 )
 
@@ -32,6 +34,13 @@ func (n *testNetwork) SendCascadeMessage(data core.Cascade, method string, msg c
 func (n *testNetwork) RemoteProcedureRegister(name string, method core.RemoteProcedure) {
 
 }
+func (n *testNetwork) Leave(ctx context.Context, ETA core.PulseNumber) error {
+	return nil
+}
+
+func (n *testNetwork) PreferHealthy(nodes []core.RecordRef) []core.RecordRef {
+	return nodes
+}
 
 func GetTestNetwork() core.Network {
 	return &testNetwork{}
@@ -30,6 +30,16 @@ type NetworkCoordinatorMock struct {
 	IsStartedPreCounter uint64
 	IsStartedMock       mNetworkCoordinatorMockIsStarted
 
+	LeaveFunc       func(p context.Context, p1 core.PulseNumber) (r error)
+	LeaveCounter    uint64
+	LeavePreCounter uint64
+	LeaveMock       mNetworkCoordinatorMockLeave
+
+	LeaveStatusFunc       func(p context.Context) (r *core.LeaveStatus, r1 error)
+	LeaveStatusCounter    uint64
+	LeaveStatusPreCounter uint64
+	LeaveStatusMock       mNetworkCoordinatorMockLeaveStatus
+
 	SetPulseFunc       func(p context.Context, p1 core.Pulse) (r error)
 	SetPulseCounter    uint64
 	SetPulsePreCounter uint64
@@ -51,6 +61,8 @@ func NewNetworkCoordinatorMock(t minimock.Tester) *NetworkCoordinatorMock {
 
 	m.GetCertMock = mNetworkCoordinatorMockGetCert{mock: m}
 	m.IsStartedMock = mNetworkCoordinatorMockIsStarted{mock: m}
+	m.LeaveMock = mNetworkCoordinatorMockLeave{mock: m}
+	m.LeaveStatusMock = mNetworkCoordinatorMockLeaveStatus{mock: m}
 	m.SetPulseMock = mNetworkCoordinatorMockSetPulse{mock: m}
 	m.ValidateCertMock = mNetworkCoordinatorMockValidateCert{mock: m}
 
@@ -342,6 +354,304 @@ func (m *NetworkCoordinatorMock) IsStartedFinished() bool {
 	return true
 }
 
+type mNetworkCoordinatorMockLeave struct {
+	mock              *NetworkCoordinatorMock
+	mainExpectation   *NetworkCoordinatorMockLeaveExpectation
+	expectationSeries []*NetworkCoordinatorMockLeaveExpectation
+}
+
+type NetworkCoordinatorMockLeaveExpectation struct {
+	input  *NetworkCoordinatorMockLeaveInput
+	result *NetworkCoordinatorMockLeaveResult
+}
+
+type NetworkCoordinatorMockLeaveInput struct {
+	p  context.Context
+	p1 core.PulseNumber
+}
+
+type NetworkCoordinatorMockLeaveResult struct {
+	r error
+}
+
+//Expect specifies that invocation of NetworkCoordinator.Leave is expected from 1 to Infinity times
+func (m *mNetworkCoordinatorMockLeave) Expect(p context.Context, p1 core.PulseNumber) *mNetworkCoordinatorMockLeave {
+	m.mock.LeaveFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &NetworkCoordinatorMockLeaveExpectation{}
+	}
+	m.mainExpectation.input = &NetworkCoordinatorMockLeaveInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of NetworkCoordinator.Leave
+func (m *mNetworkCoordinatorMockLeave) Return(r error) *NetworkCoordinatorMock {
+	m.mock.LeaveFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &NetworkCoordinatorMockLeaveExpectation{}
+	}
+	m.mainExpectation.result = &NetworkCoordinatorMockLeaveResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of NetworkCoordinator.Leave is expected once
+func (m *mNetworkCoordinatorMockLeave) ExpectOnce(p context.Context, p1 core.PulseNumber) *NetworkCoordinatorMockLeaveExpectation {
+	m.mock.LeaveFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &NetworkCoordinatorMockLeaveExpectation{}
+	expectation.input = &NetworkCoordinatorMockLeaveInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *NetworkCoordinatorMockLeaveExpectation) Return(r error) {
+	e.result = &NetworkCoordinatorMockLeaveResult{r}
+}
+
+//Set uses given function f as a mock of NetworkCoordinator.Leave method
+func (m *mNetworkCoordinatorMockLeave) Set(f func(p context.Context, p1 core.PulseNumber) (r error)) *NetworkCoordinatorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.LeaveFunc = f
+	return m.mock
+}
+
+//Leave implements github.com/insolar/insolar/core.NetworkCoordinator interface
+func (m *NetworkCoordinatorMock) Leave(p context.Context, p1 core.PulseNumber) (r error) {
+	counter := atomic.AddUint64(&m.LeavePreCounter, 1)
+	defer atomic.AddUint64(&m.LeaveCounter, 1)
+
+	if len(m.LeaveMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.LeaveMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to NetworkCoordinatorMock.Leave. %v %v", p, p1)
+			return
+		}
+
+		input := m.LeaveMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, NetworkCoordinatorMockLeaveInput{p, p1}, "NetworkCoordinator.Leave got unexpected parameters")
+
+		result := m.LeaveMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the NetworkCoordinatorMock.Leave")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.LeaveMock.mainExpectation != nil {
+
+		input := m.LeaveMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, NetworkCoordinatorMockLeaveInput{p, p1}, "NetworkCoordinator.Leave got unexpected parameters")
+		}
+
+		result := m.LeaveMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the NetworkCoordinatorMock.Leave")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.LeaveFunc == nil {
+		m.t.Fatalf("Unexpected call to NetworkCoordinatorMock.Leave. %v %v", p, p1)
+		return
+	}
+
+	return m.LeaveFunc(p, p1)
+}
+
+//LeaveMinimockCounter returns a count of NetworkCoordinatorMock.LeaveFunc invocations
+func (m *NetworkCoordinatorMock) LeaveMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.LeaveCounter)
+}
+
+//LeaveMinimockPreCounter returns the value of NetworkCoordinatorMock.Leave invocations
+func (m *NetworkCoordinatorMock) LeaveMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.LeavePreCounter)
+}
+
+//LeaveFinished returns true if mock invocations count is ok
+func (m *NetworkCoordinatorMock) LeaveFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.LeaveMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.LeaveCounter) == uint64(len(m.LeaveMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.LeaveMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.LeaveCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.LeaveFunc != nil {
+		return atomic.LoadUint64(&m.LeaveCounter) > 0
+	}
+
+	return true
+}
+
+type mNetworkCoordinatorMockLeaveStatus struct {
+	mock              *NetworkCoordinatorMock
+	mainExpectation   *NetworkCoordinatorMockLeaveStatusExpectation
+	expectationSeries []*NetworkCoordinatorMockLeaveStatusExpectation
+}
+
+type NetworkCoordinatorMockLeaveStatusExpectation struct {
+	input  *NetworkCoordinatorMockLeaveStatusInput
+	result *NetworkCoordinatorMockLeaveStatusResult
+}
+
+type NetworkCoordinatorMockLeaveStatusInput struct {
+	p context.Context
+}
+
+type NetworkCoordinatorMockLeaveStatusResult struct {
+	r  *core.LeaveStatus
+	r1 error
+}
+
+//Expect specifies that invocation of NetworkCoordinator.LeaveStatus is expected from 1 to Infinity times
+func (m *mNetworkCoordinatorMockLeaveStatus) Expect(p context.Context) *mNetworkCoordinatorMockLeaveStatus {
+	m.mock.LeaveStatusFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &NetworkCoordinatorMockLeaveStatusExpectation{}
+	}
+	m.mainExpectation.input = &NetworkCoordinatorMockLeaveStatusInput{p}
+	return m
+}
+
+//Return specifies results of invocation of NetworkCoordinator.LeaveStatus
+func (m *mNetworkCoordinatorMockLeaveStatus) Return(r *core.LeaveStatus, r1 error) *NetworkCoordinatorMock {
+	m.mock.LeaveStatusFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &NetworkCoordinatorMockLeaveStatusExpectation{}
+	}
+	m.mainExpectation.result = &NetworkCoordinatorMockLeaveStatusResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of NetworkCoordinator.LeaveStatus is expected once
+func (m *mNetworkCoordinatorMockLeaveStatus) ExpectOnce(p context.Context) *NetworkCoordinatorMockLeaveStatusExpectation {
+	m.mock.LeaveStatusFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &NetworkCoordinatorMockLeaveStatusExpectation{}
+	expectation.input = &NetworkCoordinatorMockLeaveStatusInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *NetworkCoordinatorMockLeaveStatusExpectation) Return(r *core.LeaveStatus, r1 error) {
+	e.result = &NetworkCoordinatorMockLeaveStatusResult{r, r1}
+}
+
+//Set uses given function f as a mock of NetworkCoordinator.LeaveStatus method
+func (m *mNetworkCoordinatorMockLeaveStatus) Set(f func(p context.Context) (r *core.LeaveStatus, r1 error)) *NetworkCoordinatorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.LeaveStatusFunc = f
+	return m.mock
+}
+
+//LeaveStatus implements github.com/insolar/insolar/core.NetworkCoordinator interface
+func (m *NetworkCoordinatorMock) LeaveStatus(p context.Context) (r *core.LeaveStatus, r1 error) {
+	counter := atomic.AddUint64(&m.LeaveStatusPreCounter, 1)
+	defer atomic.AddUint64(&m.LeaveStatusCounter, 1)
+
+	if len(m.LeaveStatusMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.LeaveStatusMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to NetworkCoordinatorMock.LeaveStatus. %v", p)
+			return
+		}
+
+		input := m.LeaveStatusMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, NetworkCoordinatorMockLeaveStatusInput{p}, "NetworkCoordinator.LeaveStatus got unexpected parameters")
+
+		result := m.LeaveStatusMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the NetworkCoordinatorMock.LeaveStatus")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.LeaveStatusMock.mainExpectation != nil {
+
+		input := m.LeaveStatusMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, NetworkCoordinatorMockLeaveStatusInput{p}, "NetworkCoordinator.LeaveStatus got unexpected parameters")
+		}
+
+		result := m.LeaveStatusMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the NetworkCoordinatorMock.LeaveStatus")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.LeaveStatusFunc == nil {
+		m.t.Fatalf("Unexpected call to NetworkCoordinatorMock.LeaveStatus. %v", p)
+		return
+	}
+
+	return m.LeaveStatusFunc(p)
+}
+
+//LeaveStatusMinimockCounter returns a count of NetworkCoordinatorMock.LeaveStatusFunc invocations
+func (m *NetworkCoordinatorMock) LeaveStatusMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.LeaveStatusCounter)
+}
+
+//LeaveStatusMinimockPreCounter returns the value of NetworkCoordinatorMock.LeaveStatus invocations
+func (m *NetworkCoordinatorMock) LeaveStatusMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.LeaveStatusPreCounter)
+}
+
+//LeaveStatusFinished returns true if mock invocations count is ok
+func (m *NetworkCoordinatorMock) LeaveStatusFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.LeaveStatusMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.LeaveStatusCounter) == uint64(len(m.LeaveStatusMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.LeaveStatusMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.LeaveStatusCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.LeaveStatusFunc != nil {
+		return atomic.LoadUint64(&m.LeaveStatusCounter) > 0
+	}
+
+	return true
+}
+
 type mNetworkCoordinatorMockSetPulse struct {
 	mock              *NetworkCoordinatorMock
 	mainExpectation   *NetworkCoordinatorMockSetPulseExpectation
@@ -653,6 +963,14 @@ func (m *NetworkCoordinatorMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to NetworkCoordinatorMock.IsStarted")
 	}
 
+	if !m.LeaveFinished() {
+		m.t.Fatal("Expected call to NetworkCoordinatorMock.Leave")
+	}
+
+	if !m.LeaveStatusFinished() {
+		m.t.Fatal("Expected call to NetworkCoordinatorMock.LeaveStatus")
+	}
+
 	if !m.SetPulseFinished() {
 		m.t.Fatal("Expected call to NetworkCoordinatorMock.SetPulse")
 	}
@@ -686,6 +1004,14 @@ func (m *NetworkCoordinatorMock) MinimockFinish() {
 		m.t.Fatal("Expected call to NetworkCoordinatorMock.IsStarted")
 	}
 
+	if !m.LeaveFinished() {
+		m.t.Fatal("Expected call to NetworkCoordinatorMock.Leave")
+	}
+
+	if !m.LeaveStatusFinished() {
+		m.t.Fatal("Expected call to NetworkCoordinatorMock.LeaveStatus")
+	}
+
 	if !m.SetPulseFinished() {
 		m.t.Fatal("Expected call to NetworkCoordinatorMock.SetPulse")
 	}
@@ -710,6 +1036,8 @@ func (m *NetworkCoordinatorMock) MinimockWait(timeout time.Duration) {
 		ok := true
 		ok = ok && m.GetCertFinished()
 		ok = ok && m.IsStartedFinished()
+		ok = ok && m.LeaveFinished()
+		ok = ok && m.LeaveStatusFinished()
 		ok = ok && m.SetPulseFinished()
 		ok = ok && m.ValidateCertFinished()
 
@@ -728,6 +1056,14 @@ func (m *NetworkCoordinatorMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to NetworkCoordinatorMock.IsStarted")
 			}
 
+			if !m.LeaveFinished() {
+				m.t.Error("Expected call to NetworkCoordinatorMock.Leave")
+			}
+
+			if !m.LeaveStatusFinished() {
+				m.t.Error("Expected call to NetworkCoordinatorMock.LeaveStatus")
+			}
+
 			if !m.SetPulseFinished() {
 				m.t.Error("Expected call to NetworkCoordinatorMock.SetPulse")
 			}
@@ -756,6 +1092,14 @@ func (m *NetworkCoordinatorMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.LeaveFinished() {
+		return false
+	}
+
+	if !m.LeaveStatusFinished() {
+		return false
+	}
+
 	if !m.SetPulseFinished() {
 		return false
 	}